@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/dev"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+)
+
+func NewDevCommand() *cobra.Command {
+	var kubeconfig string
+
+	command := &cobra.Command{
+		Use:   "dev PATH...",
+		Short: "Run EventSources and Sensors locally with an embedded EventBus",
+		Long: `Dev runs the EventSources and Sensors in the resource files in a single process,
+the events are delivered through an in-memory EventBus, no Kubernetes cluster is needed.
+
+Examples:
+  # Run an EventSource and a Sensor
+  argo-events dev event-source.yaml sensor.yaml
+
+  # Run all of the EventSources and Sensors in a directory
+  argo-events dev examples/dev/
+
+  # Run a Sensor with a Kubernetes trigger
+  argo-events dev --kubeconfig ~/.kube/config event-source.yaml sensor.yaml
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				fmt.Println("Error: at least one path must be specified")
+				os.Exit(1)
+			}
+			logger := logging.NewArgoEventsLogger().Named("dev")
+			eventSources, sensors, err := dev.LoadObjects(args)
+			if err != nil {
+				logger.Fatalw("failed to load resource files", zap.Error(err))
+			}
+			if kubeconfig == "" {
+				kubeconfig = os.Getenv(v1alpha1.EnvVarKubeConfig)
+			}
+			ctx := logging.WithLogger(signals.SetupSignalHandler(), logger)
+			if err := dev.Run(ctx, dev.Options{
+				EventSources: eventSources,
+				Sensors:      sensors,
+				KubeConfig:   kubeconfig,
+			}); err != nil {
+				logger.Fatalw("failed to run", zap.Error(err))
+			}
+		},
+	}
+
+	command.Flags().StringVar(&kubeconfig, "kubeconfig", "", "Path to the kubeconfig file used by the Kubernetes and Argo Workflow triggers, defaults to $KUBECONFIG")
+
+	return command
+}
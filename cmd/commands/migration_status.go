@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/client/clientset/versioned"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+func NewMigrationStatusCommand() *cobra.Command {
+	var (
+		namespace  string
+		kubeconfig string
+	)
+
+	command := &cobra.Command{
+		Use:   "migration-status EVENTBUS",
+		Short: "Show the progress of an EventBus migration",
+		Long: `Migration-status shows the progress of the migration to an EventBus,
+which is the EventBus with "spec.migration" set.
+
+Examples:
+  # Show the progress of the migration to EventBus "jetstream" in namespace "argo-events"
+  argo-events migration-status -n argo-events jetstream
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if kubeconfig == "" {
+				kubeconfig = os.Getenv(v1alpha1.EnvVarKubeConfig)
+			}
+			restConfig, err := sharedutil.GetClientConfig(kubeconfig)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: failed to get kubeconfig: %v\n", err)
+				os.Exit(1)
+			}
+			client := versioned.NewForConfigOrDie(restConfig)
+			eb, err := client.ArgoprojV1alpha1().EventBus(namespace).Get(context.Background(), args[0], metav1.GetOptions{})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			if err := printMigrationStatus(os.Stdout, eb); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	command.Flags().StringVarP(&namespace, "namespace", "n", "argo-events", "Namespace of the EventBus")
+	command.Flags().StringVar(&kubeconfig, "kubeconfig", "", "Path to the kubeconfig file, defaults to $KUBECONFIG or the in-cluster config")
+
+	return command
+}
+
+func printMigrationStatus(w io.Writer, eb *v1alpha1.EventBus) error {
+	if eb.Spec.Migration == nil {
+		return fmt.Errorf("EventBus %q is not migrating from another EventBus", eb.Name)
+	}
+	status := eb.Status.Migration
+	if status == nil {
+		status = &v1alpha1.EventBusMigrationStatus{From: eb.Spec.Migration.From, Phase: v1alpha1.EventBusMigrationPending}
+	}
+	formatTime := func(t *metav1.Time) string {
+		if t == nil {
+			return "-"
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "EventBus:\t%s/%s\n", eb.Namespace, eb.Name)
+	fmt.Fprintf(tw, "From:\t%s\n", status.From)
+	fmt.Fprintf(tw, "Phase:\t%s\n", status.Phase)
+	fmt.Fprintf(tw, "Transition Window:\t%v\n", eb.Spec.Migration.GetTransitionWindow())
+	fmt.Fprintf(tw, "Dual Publishing Since:\t%s\n", formatTime(status.DualPublishingSince))
+	fmt.Fprintf(tw, "Switching Sensors Since:\t%s\n", formatTime(status.SwitchingSensorsSince))
+	fmt.Fprintf(tw, "Completed At:\t%s\n", formatTime(status.CompletedAt))
+	fmt.Fprintf(tw, "EventSources:\t%d/%d\n", status.EventSources.Migrated, status.EventSources.Total)
+	fmt.Fprintf(tw, "Sensors:\t%d/%d\n", status.Sensors.Migrated, status.Sensors.Total)
+	if status.Message != "" {
+		fmt.Fprintf(tw, "Message:\t%s\n", status.Message)
+	}
+	return tw.Flush()
+}
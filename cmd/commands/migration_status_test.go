@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+func TestPrintMigrationStatus(t *testing.T) {
+	eb := &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "argo-events", Name: "js"},
+	}
+
+	t.Run("not migrating", func(t *testing.T) {
+		err := printMigrationStatus(&bytes.Buffer{}, eb)
+		assert.Error(t, err)
+	})
+
+	t.Run("migrating", func(t *testing.T) {
+		since := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+		eb := eb.DeepCopy()
+		eb.Spec.Migration = &v1alpha1.EventBusMigration{From: "default"}
+		eb.Status.Migration = &v1alpha1.EventBusMigrationStatus{
+			From:                "default",
+			Phase:               v1alpha1.EventBusMigrationDualPublishing,
+			DualPublishingSince: &since,
+			EventSources:        v1alpha1.EventBusMigrationProgress{Total: 3, Migrated: 3},
+			Sensors:             v1alpha1.EventBusMigrationProgress{Total: 2},
+			Message:             "waiting",
+		}
+		buf := &bytes.Buffer{}
+		assert.NoError(t, printMigrationStatus(buf, eb))
+		out := buf.String()
+		assert.Contains(t, out, "argo-events/js")
+		assert.Contains(t, out, "DualPublishing")
+		assert.Contains(t, out, "2026-01-02T03:04:05Z")
+		assert.Contains(t, out, "3/3")
+		assert.Contains(t, out, "0/2")
+		assert.Contains(t, out, "waiting")
+	})
+}
@@ -28,4 +28,6 @@ func init() {
 	rootCmd.AddCommand(NewSensorCommand())
 	rootCmd.AddCommand(NewWebhookCommand())
 	rootCmd.AddCommand(NewLintCommand())
+	rootCmd.AddCommand(NewMigrationStatusCommand())
+	rootCmd.AddCommand(NewDevCommand())
 }
@@ -37,3 +37,8 @@ go-to-protobuf \
         --output-dir="${GOPATH}/src/" \
         --proto-import ./vendor
 
+# The gRPC event source service.
+protoc -I ./pkg/eventsources/sources/grpc \
+        --go_out=paths=source_relative:./pkg/eventsources/sources/grpc \
+        --go-grpc_out=paths=source_relative:./pkg/eventsources/sources/grpc \
+        cloudevents.proto ingress.proto
@@ -39,6 +39,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Amount":                       schema_pkg_apis_events_v1alpha1_Amount(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ArgoWorkflowTrigger":          schema_pkg_apis_events_v1alpha1_ArgoWorkflowTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ArtifactLocation":             schema_pkg_apis_events_v1alpha1_ArtifactLocation(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AvroDecoder":                  schema_pkg_apis_events_v1alpha1_AvroDecoder(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureDevOpsEventSource":       schema_pkg_apis_events_v1alpha1_AzureDevOpsEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureDevOpsRepositories":      schema_pkg_apis_events_v1alpha1_AzureDevOpsRepositories(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureEventHubsTrigger":        schema_pkg_apis_events_v1alpha1_AzureEventHubsTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureEventsHubEventSource":    schema_pkg_apis_events_v1alpha1_AzureEventsHubEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureQueueStorageEventSource": schema_pkg_apis_events_v1alpha1_AzureQueueStorageEventSource(ref),
@@ -54,6 +57,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketServerRepository":    schema_pkg_apis_events_v1alpha1_BitbucketServerRepository(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BusConfig":                    schema_pkg_apis_events_v1alpha1_BusConfig(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.CalendarEventSource":          schema_pkg_apis_events_v1alpha1_CalendarEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.CalendarStatus":               schema_pkg_apis_events_v1alpha1_CalendarStatus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.CatchupConfiguration":         schema_pkg_apis_events_v1alpha1_CatchupConfiguration(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Condition":                    schema_pkg_apis_events_v1alpha1_Condition(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ConditionsResetByTime":        schema_pkg_apis_events_v1alpha1_ConditionsResetByTime(ref),
@@ -64,10 +68,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.CustomTrigger":                schema_pkg_apis_events_v1alpha1_CustomTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.DataFilter":                   schema_pkg_apis_events_v1alpha1_DataFilter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EmailTrigger":                 schema_pkg_apis_events_v1alpha1_EmailTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EmbeddedBusConfig":            schema_pkg_apis_events_v1alpha1_EmbeddedBusConfig(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EmitterEventSource":           schema_pkg_apis_events_v1alpha1_EmitterEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Event":                        schema_pkg_apis_events_v1alpha1_Event(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBus":                     schema_pkg_apis_events_v1alpha1_EventBus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusList":                 schema_pkg_apis_events_v1alpha1_EventBusList(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusMigration":            schema_pkg_apis_events_v1alpha1_EventBusMigration(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusMigrationProgress":    schema_pkg_apis_events_v1alpha1_EventBusMigrationProgress(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusMigrationStatus":      schema_pkg_apis_events_v1alpha1_EventBusMigrationStatus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusSharing":              schema_pkg_apis_events_v1alpha1_EventBusSharing(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusSpec":                 schema_pkg_apis_events_v1alpha1_EventBusSpec(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusStatus":               schema_pkg_apis_events_v1alpha1_EventBusStatus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventContext":                 schema_pkg_apis_events_v1alpha1_EventContext(ref),
@@ -76,53 +85,83 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventDependencyTransformer":   schema_pkg_apis_events_v1alpha1_EventDependencyTransformer(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventPersistence":             schema_pkg_apis_events_v1alpha1_EventPersistence(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSource":                  schema_pkg_apis_events_v1alpha1_EventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceDeadLetter":        schema_pkg_apis_events_v1alpha1_EventSourceDeadLetter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter":            schema_pkg_apis_events_v1alpha1_EventSourceFilter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceList":              schema_pkg_apis_events_v1alpha1_EventSourceList(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute":             schema_pkg_apis_events_v1alpha1_EventSourceRoute(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema":            schema_pkg_apis_events_v1alpha1_EventSourceSchema(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSpec":              schema_pkg_apis_events_v1alpha1_EventSourceSpec(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceStatus":            schema_pkg_apis_events_v1alpha1_EventSourceStatus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform":         schema_pkg_apis_events_v1alpha1_EventSourceTransform(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ExprFilter":                   schema_pkg_apis_events_v1alpha1_ExprFilter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.FileArtifact":                 schema_pkg_apis_events_v1alpha1_FileArtifact(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.FileEventSource":              schema_pkg_apis_events_v1alpha1_FileEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GRPCEventSource":              schema_pkg_apis_events_v1alpha1_GRPCEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GenericEventSource":           schema_pkg_apis_events_v1alpha1_GenericEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GerritEventSource":            schema_pkg_apis_events_v1alpha1_GerritEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GitArtifact":                  schema_pkg_apis_events_v1alpha1_GitArtifact(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GitCreds":                     schema_pkg_apis_events_v1alpha1_GitCreds(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GitRemoteConfig":              schema_pkg_apis_events_v1alpha1_GitRemoteConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GiteaEventSource":             schema_pkg_apis_events_v1alpha1_GiteaEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GithubAppCreds":               schema_pkg_apis_events_v1alpha1_GithubAppCreds(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GithubDeliveryBackfill":       schema_pkg_apis_events_v1alpha1_GithubDeliveryBackfill(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GithubEventSource":            schema_pkg_apis_events_v1alpha1_GithubEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GitlabEventSource":            schema_pkg_apis_events_v1alpha1_GitlabEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.HDFSEventSource":              schema_pkg_apis_events_v1alpha1_HDFSEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.HTTPTrigger":                  schema_pkg_apis_events_v1alpha1_HTTPTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.HolidayCalendar":              schema_pkg_apis_events_v1alpha1_HolidayCalendar(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Int64OrString":                schema_pkg_apis_events_v1alpha1_Int64OrString(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.InvolvedObjectFilter":         schema_pkg_apis_events_v1alpha1_InvolvedObjectFilter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamBus":                 schema_pkg_apis_events_v1alpha1_JetStreamBus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamConfig":              schema_pkg_apis_events_v1alpha1_JetStreamConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamPlacement":           schema_pkg_apis_events_v1alpha1_JetStreamPlacement(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamStreamConfig":        schema_pkg_apis_events_v1alpha1_JetStreamStreamConfig(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.K8SResource":                  schema_pkg_apis_events_v1alpha1_K8SResource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.K8SResourcePolicy":            schema_pkg_apis_events_v1alpha1_K8SResourcePolicy(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaBus":                     schema_pkg_apis_events_v1alpha1_KafkaBus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaConsumerGroup":           schema_pkg_apis_events_v1alpha1_KafkaConsumerGroup(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaEventSource":             schema_pkg_apis_events_v1alpha1_KafkaEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaTrigger":                 schema_pkg_apis_events_v1alpha1_KafkaTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KubernetesAuditWebhook":       schema_pkg_apis_events_v1alpha1_KubernetesAuditWebhook(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KubernetesEventSource":        schema_pkg_apis_events_v1alpha1_KubernetesEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KubernetesEventsWatch":        schema_pkg_apis_events_v1alpha1_KubernetesEventsWatch(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.LogTrigger":                   schema_pkg_apis_events_v1alpha1_LogTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.MNSEventSource":               schema_pkg_apis_events_v1alpha1_MNSEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.MQTTEventSource":              schema_pkg_apis_events_v1alpha1_MQTTEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.MQTTTopic":                    schema_pkg_apis_events_v1alpha1_MQTTTopic(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Metadata":                     schema_pkg_apis_events_v1alpha1_Metadata(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSAuth":                     schema_pkg_apis_events_v1alpha1_NATSAuth(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSBus":                      schema_pkg_apis_events_v1alpha1_NATSBus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSConfig":                   schema_pkg_apis_events_v1alpha1_NATSConfig(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSEventsSource":             schema_pkg_apis_events_v1alpha1_NATSEventsSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSJetStreamConsumer":        schema_pkg_apis_events_v1alpha1_NATSJetStreamConsumer(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSTrigger":                  schema_pkg_apis_events_v1alpha1_NATSTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NSQEventSource":               schema_pkg_apis_events_v1alpha1_NSQEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NativeStrategy":               schema_pkg_apis_events_v1alpha1_NativeStrategy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.OAuth2ClientCredentials":      schema_pkg_apis_events_v1alpha1_OAuth2ClientCredentials(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ObjectStoreEventSource":       schema_pkg_apis_events_v1alpha1_ObjectStoreEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ObjectStorePoll":              schema_pkg_apis_events_v1alpha1_ObjectStorePoll(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ObjectStoreSQS":               schema_pkg_apis_events_v1alpha1_ObjectStoreSQS(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.OpenWhiskTrigger":             schema_pkg_apis_events_v1alpha1_OpenWhiskTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.OwnedRepositories":            schema_pkg_apis_events_v1alpha1_OwnedRepositories(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadDecoder":               schema_pkg_apis_events_v1alpha1_PayloadDecoder(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadField":                 schema_pkg_apis_events_v1alpha1_PayloadField(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PersistenceStrategy":          schema_pkg_apis_events_v1alpha1_PersistenceStrategy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PollCursor":                   schema_pkg_apis_events_v1alpha1_PollCursor(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PollEventSource":              schema_pkg_apis_events_v1alpha1_PollEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PollPagination":               schema_pkg_apis_events_v1alpha1_PollPagination(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PostgresEventSource":          schema_pkg_apis_events_v1alpha1_PostgresEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ProtobufDecoder":              schema_pkg_apis_events_v1alpha1_ProtobufDecoder(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PubSubEventSource":            schema_pkg_apis_events_v1alpha1_PubSubEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarBus":                    schema_pkg_apis_events_v1alpha1_PulsarBus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarEventSource":            schema_pkg_apis_events_v1alpha1_PulsarEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarSchemaRegistry":         schema_pkg_apis_events_v1alpha1_PulsarSchemaRegistry(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarTrigger":                schema_pkg_apis_events_v1alpha1_PulsarTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RateLimit":                    schema_pkg_apis_events_v1alpha1_RateLimit(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisBus":                     schema_pkg_apis_events_v1alpha1_RedisBus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisEventSource":             schema_pkg_apis_events_v1alpha1_RedisEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisStreamEventSource":       schema_pkg_apis_events_v1alpha1_RedisStreamEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ResourceConditionFilter":      schema_pkg_apis_events_v1alpha1_ResourceConditionFilter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ResourceEventSource":          schema_pkg_apis_events_v1alpha1_ResourceEventSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ResourceFilter":               schema_pkg_apis_events_v1alpha1_ResourceFilter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.S3Artifact":                   schema_pkg_apis_events_v1alpha1_S3Artifact(ref),
@@ -161,8 +200,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.URLArtifact":                  schema_pkg_apis_events_v1alpha1_URLArtifact(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ValueFromSource":              schema_pkg_apis_events_v1alpha1_ValueFromSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WatchPathConfig":              schema_pkg_apis_events_v1alpha1_WatchPathConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookChallenge":             schema_pkg_apis_events_v1alpha1_WebhookChallenge(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext":               schema_pkg_apis_events_v1alpha1_WebhookContext(ref),
 		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookEventSource":           schema_pkg_apis_events_v1alpha1_WebhookEventSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookMultipartSplit":        schema_pkg_apis_events_v1alpha1_WebhookMultipartSplit(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookRateLimit":             schema_pkg_apis_events_v1alpha1_WebhookRateLimit(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookResponse":              schema_pkg_apis_events_v1alpha1_WebhookResponse(ref),
+		"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookSplit":                 schema_pkg_apis_events_v1alpha1_WebhookSplit(ref),
 	}
 }
 
@@ -182,7 +226,7 @@ func schema_pkg_apis_events_v1alpha1_AMQPConsumeConfig(ref common.ReferenceCallb
 					},
 					"autoAck": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoAck when true, the server will acknowledge deliveries to this consumer prior to writing the delivery to the network",
+							Description: "AutoAck when true, the server will acknowledge deliveries to this consumer prior to writing the delivery to the network. When false, the deliveries are acknowledged after the events are published to the EventBus, and requeued when publishing fails. Defaults to true when the consume settings are not specified.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -329,12 +373,38 @@ func schema_pkg_apis_events_v1alpha1_AMQPEventSource(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"exchangeName", "exchangeType", "routingKey"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AMQPConsumeConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AMQPExchangeDeclareConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AMQPQueueBindConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AMQPQueueDeclareConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AMQPConsumeConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AMQPExchangeDeclareConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AMQPQueueBindConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AMQPQueueDeclareConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -689,6 +759,197 @@ func schema_pkg_apis_events_v1alpha1_ArtifactLocation(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_events_v1alpha1_AvroDecoder(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AvroDecoder is the Avro schema of the payloads, exactly one of Schema and ConfigMap is required",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema is the inline Avro schema",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap key holding the Avro schema",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ConfigMapKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_AzureDevOpsEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureDevOpsEventSource refers to event-source for Azure DevOps Repos events",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook refers to the configuration required to run a http server",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext"),
+						},
+					},
+					"organizationURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OrganizationURL is the URL of the Azure DevOps organization, e.g. https://dev.azure.com/example, or of the collection for Azure DevOps Server, e.g. https://devops.example.com/tfs/DefaultCollection",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events are the Azure DevOps event types the service hooks are subscribed to, e.g. git.push or git.pullrequest.created. Refer https://learn.microsoft.com/en-us/azure/devops/service-hooks/events.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"accessToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessToken refers to a K8s secret containing the personal access token, used to manage the service hooks",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auth holds the basic auth credentials the service hooks send the events with",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth"),
+						},
+					},
+					"repositories": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repositories holds the repositories the service hooks are created for",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureDevOpsRepositories"),
+									},
+								},
+							},
+						},
+					},
+					"deleteHookOnFinish": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeleteHookOnFinish determines whether to delete the Azure DevOps service hooks once the event source is stopped.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata holds the user defined metadata which will passed along the event payload.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"organizationURL", "events"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureDevOpsRepositories", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_AzureDevOpsRepositories(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureDevOpsRepositories refers to the repositories of an Azure DevOps project",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"project": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Project name",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"names": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repository names, all the repositories of the project if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"project"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_events_v1alpha1_AzureEventHubsTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -818,12 +1079,38 @@ func schema_pkg_apis_events_v1alpha1_AzureEventsHubEventSource(ref common.Refere
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"fqdn", "hubName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -891,6 +1178,32 @@ func schema_pkg_apis_events_v1alpha1_AzureQueueStorageEventSource(ref common.Ref
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 					"decodeMessage": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DecodeMessage specifies if all the messages should be base64 decoded. If set to true the decoding is done before the evaluation of JSONBody",
@@ -910,7 +1223,7 @@ func schema_pkg_apis_events_v1alpha1_AzureQueueStorageEventSource(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -986,6 +1299,32 @@ func schema_pkg_apis_events_v1alpha1_AzureServiceBusEventSource(ref common.Refer
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 					"fullyQualifiedNamespace": {
 						SchemaProps: spec.SchemaProps{
 							Description: "FullyQualifiedNamespace is the Service Bus namespace name (ex: myservicebus.servicebus.windows.net). This field is necessary to access via Azure AD (managed identity) and it is ignored if ConnectionString is set.",
@@ -1005,7 +1344,7 @@ func schema_pkg_apis_events_v1alpha1_AzureServiceBusEventSource(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -1308,12 +1647,38 @@ func schema_pkg_apis_events_v1alpha1_BitbucketEventSource(ref common.ReferenceCa
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"webhook", "auth", "events"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketRepository", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketRepository", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext"},
 	}
 }
 
@@ -1480,16 +1845,42 @@ func schema_pkg_apis_events_v1alpha1_BitbucketServerEventSource(ref common.Refer
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
-					"tls": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the bitbucketserver client.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
 						},
 					},
-					"checkInterval": {
+					"transform": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CheckInterval is a duration in which to wait before checking that the webhooks exist, e.g. 1s, 30m, 2h... (defaults to 1m)",
-							Default:     "",
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the bitbucketserver client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+						},
+					},
+					"checkInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckInterval is a duration in which to wait before checking that the webhooks exist, e.g. 1s, 30m, 2h... (defaults to 1m)",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1499,7 +1890,7 @@ func schema_pkg_apis_events_v1alpha1_BitbucketServerEventSource(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketServerRepository", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketServerRepository", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -1554,11 +1945,27 @@ func schema_pkg_apis_events_v1alpha1_BusConfig(ref common.ReferenceCallback) com
 							Ref: ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaBus"),
 						},
 					},
+					"redis": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisBus"),
+						},
+					},
+					"pulsar": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarBus"),
+						},
+					},
+					"embedded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Embedded is an in-process EventBus for local development and testing, it is never set by the controller.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EmbeddedBusConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaBus", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSConfig"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EmbeddedBusConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaBus", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarBus", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisBus"},
 	}
 }
 
@@ -1635,11 +2042,115 @@ func schema_pkg_apis_events_v1alpha1_CalendarEventSource(ref common.ReferenceCal
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules is a list of additional cron-like expressions, the event is fired at the times of all the schedules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"at": {
+						SchemaProps: spec.SchemaProps{
+							Description: "At is a list of one-shot times in RFC3339 format, e.g. 2026-12-31T23:00:00Z, the event is fired once at each of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"businessDaysOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BusinessDaysOnly skips the times on Saturdays and Sundays in the timezone.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"holidays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Holidays is a calendar of the dates to skip, in addition to the exclusion dates.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.HolidayCalendar"),
+						},
+					},
+					"jitter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jitter is the maximum random delay added to each time, e.g. 30s, to spread the events of many event sources.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventPersistence", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.HolidayCalendar"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_CalendarStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CalendarStatus is the status of a calendar event source",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nextFireTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextFireTimes are the next planned times of the events, without the jitter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventPersistence", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -2135,6 +2646,26 @@ func schema_pkg_apis_events_v1alpha1_EmailTrigger(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_events_v1alpha1_EmbeddedBusConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EmbeddedBusConfig is the configuration of an in-process EventBus, the events are kept in memory and are only delivered to the Sensors running in the same process.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the in-process EventBus, EventSources and Sensors using the same name share the events.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_events_v1alpha1_EmitterEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2219,12 +2750,38 @@ func schema_pkg_apis_events_v1alpha1_EmitterEventSource(ref common.ReferenceCall
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"broker", "channelKey", "channelName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -2352,169 +2909,357 @@ func schema_pkg_apis_events_v1alpha1_EventBusList(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_EventBusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_EventBusMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EventBusSpec refers to specification of eventbus resource",
+				Description: "EventBusMigration defines the migration from another EventBus.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nats": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NATS eventbus",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSBus"),
-						},
-					},
-					"jetstream": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamBus"),
-						},
-					},
-					"kafka": {
+					"from": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kafka eventbus",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaBus"),
+							Description: "From is the name of the EventBus to migrate from, it must be in the same namespace.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"jetstreamExotic": {
+					"transitionWindow": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exotic JetStream",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamConfig"),
+							Description: "TransitionWindow is how long the EventSources publish to both of the EventBuses before the Sensors switch over, it should be longer than the time the Sensors need to catch up with the events in the old EventBus. The migration completes once the switched Sensors have caught up with the events replayed from the new EventBus. Defaults to 10m.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"from"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamBus", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaBus", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSBus"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_EventBusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_EventBusMigrationProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EventBusStatus holds the status of the eventbus resource",
+				Description: "EventBusMigrationProgress is the number of the objects that are done with the current phase of a migration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions are the latest available observations of a resource's current state.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Condition"),
-									},
-								},
-							},
+							Description: "Total number of the objects using the EventBus migrating from.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"config": {
+					"migrated": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Config holds the fininalized configuration of EventBus",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BusConfig"),
+							Description: "Migrated is the number of the objects whose Deployment has rolled out with the configuration of the current phase.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"total", "migrated"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BusConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Condition"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_EventContext(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_EventBusMigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EventContext holds the context of the cloudevent received from an event source.",
+				Description: "EventBusMigrationStatus holds the progress of an EventBus migration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"from": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID of the event; must be non-empty and unique within the scope of the producer.",
+							Description: "From is the name of the EventBus migrating from.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"source": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source - A URI describing the event producer.",
+							Description: "Phase of the migration.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"specversion": {
+					"dualPublishingSince": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SpecVersion - The version of the CloudEvents specification used by the event.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DualPublishingSince is the time since when all the EventSources publish to both of the EventBuses.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"type": {
+					"switchingSensorsSince": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type - The type of the occurrence which has happened.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SwitchingSensorsSince is the time the Sensors started to switch over to the successor EventBus.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"datacontenttype": {
+					"completedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataContentType - A MIME (RFC2046) string describing the media type of `data`.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "CompletedAt is the time the migration completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"subject": {
+					"eventSources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subject - The subject of the event in the context of the event producer",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "EventSources is the progress of the EventSources in the current phase.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusMigrationProgress"),
 						},
 					},
-					"time": {
+					"sensors": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Time - A Timestamp when the event happened.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Sensors is the progress of the Sensors in the current phase.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusMigrationProgress"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable description of the migration state.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"id", "source", "specversion", "type", "datacontenttype", "subject", "time"},
+				Required: []string{"from", "phase"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusMigrationProgress", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_EventDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_EventBusSharing(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EventDependency describes a dependency",
+				Description: "EventBusSharing defines the namespaces an EventBus is shared with.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"allowedNamespaces": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is a unique name of this dependency",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AllowedNamespaces is the list of namespaces whose EventSources and Sensors are allowed to use the EventBus. Each of the namespaces gets an isolated NATS account, with the credentials provisioned by the controller in a Secret in that namespace, so that namespaces can not read the events of each other.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_EventBusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventBusSpec refers to specification of eventbus resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NATS eventbus",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSBus"),
+						},
+					},
+					"jetstream": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamBus"),
+						},
+					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kafka eventbus",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaBus"),
+						},
+					},
+					"jetstreamExotic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exotic JetStream",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamConfig"),
+						},
+					},
+					"sharing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sharing allows EventSources and Sensors in other namespaces to use this EventBus. Only supported by \"jetstream\".",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusSharing"),
+						},
+					},
+					"redis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Redis eventbus, backed by a Redis Stream",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisBus"),
+						},
+					},
+					"pulsar": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pulsar eventbus",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarBus"),
+						},
+					},
+					"migration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Migration marks this EventBus as the successor of another EventBus in the same namespace, the EventSources and Sensors using the old EventBus are moved to this one by the controller. It is only supported by a JetStream or Kafka EventBus, which are able to replay the events to the Sensors switching over.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusMigration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusMigration", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusSharing", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamBus", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaBus", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSBus", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarBus", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisBus"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_EventBusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventBusStatus holds the status of the eventbus resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the latest available observations of a resource's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config holds the fininalized configuration of EventBus",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BusConfig"),
+						},
+					},
+					"migration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Migration holds the progress of the migration from another EventBus",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusMigrationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BusConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Condition", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventBusMigrationStatus"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_EventContext(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventContext holds the context of the cloudevent received from an event source.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID of the event; must be non-empty and unique within the scope of the producer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source - A URI describing the event producer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"specversion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SpecVersion - The version of the CloudEvents specification used by the event.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type - The type of the occurrence which has happened.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"datacontenttype": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataContentType - A MIME (RFC2046) string describing the media type of `data`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject - The subject of the event in the context of the event producer",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time - A Timestamp when the event happened.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"id", "source", "specversion", "type", "datacontenttype", "subject", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_EventDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventDependency describes a dependency",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is a unique name of this dependency",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"eventSourceName": {
@@ -2738,6 +3483,28 @@ func schema_pkg_apis_events_v1alpha1_EventSource(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_events_v1alpha1_EventSourceDeadLetter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventSourceDeadLetter is where the invalid events are published to",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"eventName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventName the invalid events are published with, sensors depend on it like on the other events of the event source. It must not be the name of another event of the event source.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"eventName"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_events_v1alpha1_EventSourceFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2805,57 +3572,161 @@ func schema_pkg_apis_events_v1alpha1_EventSourceList(ref common.ReferenceCallbac
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_EventSourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_EventSourceRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EventSourceSpec refers to specification of event-source resource",
+				Description: "EventSourceRoute publishes the events matching a condition with another event name, so the sensors can depend on them directly. Exactly one of Expression and JSONPath is required.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"eventBusName": {
+					"eventName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EventBusName references to a EventBus name. By default the value is \"default\"",
+							Description: "EventName the matching events are published with. It must not be the name of another event of the event source.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"template": {
+					"expression": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template is the pod specification for the event source",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Template"),
+							Description: "Expression is an expr expression evaluated on the fields of the event data like the filter, the event matches if it is true",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"service": {
+					"jsonPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Service is the specifications of the service to expose the event source",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Service"),
+							Description: "JSONPath selects a value of the event data, the event matches if the value exists, and equals Value if any, e.g. $.header['X-Github-Event']",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"minio": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Minio event sources",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.S3Artifact"),
-									},
-								},
-							},
+							Description: "Value the value selected by JSONPath must be equal to, or one of the values if it is a list",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"calendar": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Calendar event sources",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.CalendarEventSource"),
+							Description: "Type is the CloudEvent type of the matching events, the type of the event source by default",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"eventName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_EventSourceSchema(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventSourceSchema is the JSON Schema the event data is validated with before it is published to the EventBus. Exactly one of Inline, ConfigMap, URL and SchemaRegistry is required.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inline JSON Schema",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap key holding the JSON Schema",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the JSON Schema, fetched when the event source starts",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schemaRegistry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchemaRegistry to get the JSON Schema from, by its schema ID, or the latest version of Subject",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SchemaRegistryConfig"),
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject of the schema registry, the latest version of its schema is used instead of the schema ID",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deadLetter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetter publishes the invalid events with another event name, instead of dropping them",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceDeadLetter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceDeadLetter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SchemaRegistryConfig", "k8s.io/api/core/v1.ConfigMapKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_EventSourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventSourceSpec refers to specification of event-source resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"eventBusName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventBusName references to a EventBus name. By default the value is \"default\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the pod specification for the event source",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Template"),
+						},
+					},
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service is the specifications of the service to expose the event source",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Service"),
+						},
+					},
+					"minio": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Minio event sources",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.S3Artifact"),
+									},
+								},
+							},
+						},
+					},
+					"calendar": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Calendar event sources",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.CalendarEventSource"),
 									},
 								},
 							},
@@ -3318,11 +4189,123 @@ func schema_pkg_apis_events_v1alpha1_EventSourceSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"eventBusNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventBusNamespace references to the namespace of the EventBus, defaults to the namespace of the EventSource. An EventBus in another namespace needs to have this namespace in its \"spec.sharing.allowedNamespaces\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"poll": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Poll event sources",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PollEventSource"),
+									},
+								},
+							},
+						},
+					},
+					"postgres": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Postgres event sources",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PostgresEventSource"),
+									},
+								},
+							},
+						},
+					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GRPC event sources",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GRPCEventSource"),
+									},
+								},
+							},
+						},
+					},
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes event sources",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KubernetesEventSource"),
+									},
+								},
+							},
+						},
+					},
+					"gitea": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gitea event sources, for Gitea and Forgejo",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GiteaEventSource"),
+									},
+								},
+							},
+						},
+					},
+					"azureDevOps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Azure DevOps event sources",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureDevOpsEventSource"),
+									},
+								},
+							},
+						},
+					},
+					"objectStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectStore event sources, for any S3 compatible object store",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ObjectStoreEventSource"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AMQPEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureEventsHubEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureQueueStorageEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureServiceBusEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketServerEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.CalendarEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EmitterEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.FileEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GenericEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GerritEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GithubEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GitlabEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.HDFSEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.MNSEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.MQTTEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSEventsSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NSQEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PubSubEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisStreamEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ResourceEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.S3Artifact", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SFTPEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SNSEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SQSEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Service", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SlackEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.StorageGridEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.StripeEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Template", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookEventSource"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AMQPEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureDevOpsEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureEventsHubEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureQueueStorageEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AzureServiceBusEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BitbucketServerEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.CalendarEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EmitterEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.FileEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GRPCEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GenericEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GerritEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GiteaEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GithubEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GitlabEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.HDFSEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KubernetesEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.MNSEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.MQTTEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSEventsSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NSQEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ObjectStoreEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PollEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PostgresEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PubSubEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.RedisStreamEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ResourceEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.S3Artifact", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SFTPEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SNSEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SQSEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Service", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SlackEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.StorageGridEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.StripeEventSource", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Template", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookEventSource"},
 	}
 }
 
@@ -3353,61 +4336,140 @@ func schema_pkg_apis_events_v1alpha1_EventSourceStatus(ref common.ReferenceCallb
 							},
 						},
 					},
+					"calendars": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Calendars holds the status of the calendar event sources by event name. It is updated by the event source pod if its service account is allowed to patch eventsources/status.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.CalendarStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Condition"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.CalendarStatus", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Condition"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_ExprFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_EventSourceTransform(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "EventSourceTransform shapes the event data before it is published to the EventBus, e.g. to trim large payloads once instead of in every sensor, and redacts its fields, e.g. secrets and PII. At most one of JQ, Script and Expression is allowed, the redaction applies to their output.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"expr": {
+					"jq": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Expr refers to the expression that determines the outcome of the filter.",
-							Default:     "",
+							Description: "JQ holds the jq command applied to the event data, its output must be a JSON object",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"fields": {
+					"script": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Fields refers to set of keys that refer to the paths within event payload.",
+							Description: "Script refers to a Lua script transforming the event data, available as the global \"event\", it must return a table",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is an expr expression evaluated on the fields of the event data like the filter, it must return a map",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"drop": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Drop is the list of the JSONPaths of the fields removed from the event data, e.g. $.body.password",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadField"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"mask": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mask is the list of the JSONPaths of the fields whose values are replaced with \"******\", e.g. $..email",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"expr", "fields"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadField"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_FileArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_ExprFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FileArtifact contains information about an artifact in a filesystem",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"path": {
+					"expr": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Description: "Expr refers to the expression that determines the outcome of the filter.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fields refers to set of keys that refer to the paths within event payload.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadField"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"expr", "fields"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadField"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_FileArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FileArtifact contains information about an artifact in a filesystem",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
@@ -3468,12 +4530,135 @@ func schema_pkg_apis_events_v1alpha1_FileEventSource(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"eventType", "watchPathConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WatchPathConfig"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WatchPathConfig"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_GRPCEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCEventSource describes an event source serving a gRPC and Connect endpoint, clients push events in the CloudEvents protobuf format to it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port on which the server listens for the gRPC and Connect requests.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serverCertSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerCertSecret refers to the secret that contains the TLS certificate of the server.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"serverKeySecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerKeySecret refers to the secret that contains the TLS private key of the server.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"authSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthSecret refers to the secret that contains the bearer token the clients need to send.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"maxMessageSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxMessageSize is the maximum size in bytes of a received message, defaults to 4194304 (4MB).",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata holds the user defined metadata which will passed along the event payload.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"port"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -3542,12 +4727,38 @@ func schema_pkg_apis_events_v1alpha1_GenericEventSource(ref common.ReferenceCall
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"url", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -3652,6 +4863,32 @@ func schema_pkg_apis_events_v1alpha1_GerritEventSource(ref common.ReferenceCallb
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 					"maxTries": {
 						SchemaProps: spec.SchemaProps{
 							Description: "MaxTries is number of attempts when posting an event to the target url",
@@ -3665,7 +4902,7 @@ func schema_pkg_apis_events_v1alpha1_GerritEventSource(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext"},
 	}
 }
 
@@ -3823,75 +5060,22 @@ func schema_pkg_apis_events_v1alpha1_GitRemoteConfig(ref common.ReferenceCallbac
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_GithubAppCreds(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"privateKey": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PrivateKey refers to a K8s secret containing the GitHub app private key",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
-						},
-					},
-					"appID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AppID refers to the GitHub App ID for the application you created",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"installationID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InstallationID refers to the Installation ID of the GitHub app you created and installed",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-				},
-				Required: []string{"privateKey", "appID", "installationID"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretKeySelector"},
-	}
-}
-
-func schema_pkg_apis_events_v1alpha1_GithubEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_GiteaEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GithubEventSource refers to event-source for github related events",
+				Description: "GiteaEventSource refers to event-source for Gitea and Forgejo events",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Id is the webhook's id\n\nDeprecated: This is not used at all, will be removed in v1.6",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
 					"webhook": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Webhook refers to the configuration required to run a http server",
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext"),
 						},
 					},
-					"owner": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeprecatedOwner refers to GitHub owner name i.e. argoproj\n\nDeprecated: use Repositories instead. Will be unsupported in v 1.6",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"repository": {
+					"giteaBaseURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeprecatedRepository refers to GitHub repo name i.e. argo-events\n\nDeprecated: use Repositories instead. Will be unsupported in v 1.6",
+							Description: "GiteaBaseURL is the base URL of the Gitea or Forgejo server, e.g. https://gitea.example.com",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -3899,7 +5083,7 @@ func schema_pkg_apis_events_v1alpha1_GithubEventSource(ref common.ReferenceCallb
 					},
 					"events": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Events refer to Github events to which the event source will subscribe",
+							Description: "Events are the Gitea events the hooks are subscribed to, e.g. push, pull_request or issues. Refer https://docs.gitea.com/usage/webhooks#event-information.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -3912,33 +5096,276 @@ func schema_pkg_apis_events_v1alpha1_GithubEventSource(ref common.ReferenceCallb
 							},
 						},
 					},
-					"apiToken": {
+					"accessToken": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIToken refers to a K8s secret containing github api token",
+							Description: "AccessToken refers to a K8s secret containing the Gitea API access token, used to manage the hooks",
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
 					"webhookSecret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WebhookSecret refers to K8s secret containing GitHub webhook secret https://developer.github.com/webhooks/securing/",
+							Description: "WebhookSecret refers to a K8s secret containing the secret the deliveries are signed with",
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"insecure": {
+					"repositories": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Insecure tls verification",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Repositories holds the information of repositories, which uses repo owner as the key, and list of repo names as the value.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.OwnedRepositories"),
+									},
+								},
+							},
 						},
 					},
-					"active": {
+					"organizations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Active refers to status of the webhook for event deliveries. https://developer.github.com/webhooks/creating/#active",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"contentType": {
+							Description: "Organizations holds the names of organizations (used for organization level hooks).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Insecure skips the TLS verification of the Gitea server when managing the hooks",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"deleteHookOnFinish": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeleteHookOnFinish determines whether to delete the Gitea hooks once the event source is stopped.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata holds the user defined metadata which will passed along the event payload.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"giteaBaseURL", "events"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.OwnedRepositories", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_GithubAppCreds(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"privateKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrivateKey refers to a K8s secret containing the GitHub app private key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"appID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppID refers to the GitHub App ID for the application you created",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"installationID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationID refers to the Installation ID of the GitHub app you created and installed",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"privateKey", "appID", "installationID"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_GithubDeliveryBackfill(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GithubDeliveryBackfill configures the redelivery of the failed deliveries of the GitHub hooks",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval between the checks of the deliveries, defaults to 5m",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the maximum age of the failed deliveries to redeliver, defaults to 24h. GitHub keeps the deliveries for 3 days.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appHook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppHook redelivers the failed deliveries of the webhook of the GitHub App, instead of the ones of the repository and organization hooks. It requires githubApp.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"persistence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Persistence holds the configuration to store the ID of the last delivery seen of each hook, so the deliveries are not checked again after the event source restarts.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventPersistence"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventPersistence"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_GithubEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GithubEventSource refers to event-source for github related events",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Id is the webhook's id\n\nDeprecated: This is not used at all, will be removed in v1.6",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook refers to the configuration required to run a http server",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext"),
+						},
+					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeprecatedOwner refers to GitHub owner name i.e. argoproj\n\nDeprecated: use Repositories instead. Will be unsupported in v 1.6",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeprecatedRepository refers to GitHub repo name i.e. argo-events\n\nDeprecated: use Repositories instead. Will be unsupported in v 1.6",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events refer to Github events to which the event source will subscribe",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"apiToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIToken refers to a K8s secret containing github api token",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"webhookSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookSecret refers to K8s secret containing GitHub webhook secret https://developer.github.com/webhooks/securing/",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Insecure tls verification",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Active refers to status of the webhook for event deliveries. https://developer.github.com/webhooks/creating/#active",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"contentType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ContentType of the event delivery",
 							Type:        []string{"string"},
@@ -4023,12 +5450,51 @@ func schema_pkg_apis_events_v1alpha1_GithubEventSource(ref common.ReferenceCallb
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+					"hookReconcileInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HookReconcileInterval is the interval between the reconciliations of the hooks, which recreate the deleted hooks and fix the events, the content type, the TLS verification and the secret of the hooks, e.g. 5m. By default, the hooks are reconciled every minute for 10 minutes after the event source starts.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"backfill": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backfill redelivers the failed deliveries of the hooks, e.g. the ones made while the event source was down",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GithubDeliveryBackfill"),
+						},
+					},
 				},
 				Required: []string{"events"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GithubAppCreds", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.OwnedRepositories", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GithubAppCreds", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.GithubDeliveryBackfill", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.OwnedRepositories", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -4138,9 +5604,35 @@ func schema_pkg_apis_events_v1alpha1_GitlabEventSource(ref common.ReferenceCallb
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
-					"groups": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of group IDs or group name like \"test\". Group level hook available in Premium and Ultimate Gitlab.",
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of group IDs or group name like \"test\". Group level hook available in Premium and Ultimate Gitlab.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -4158,7 +5650,7 @@ func schema_pkg_apis_events_v1alpha1_GitlabEventSource(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -4288,12 +5780,38 @@ func schema_pkg_apis_events_v1alpha1_HDFSEventSource(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"directory", "type", "addresses"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "k8s.io/api/core/v1.ConfigMapKeySelector", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "k8s.io/api/core/v1.ConfigMapKeySelector", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -4425,6 +5943,41 @@ func schema_pkg_apis_events_v1alpha1_HTTPTrigger(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_events_v1alpha1_HolidayCalendar(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HolidayCalendar is a calendar in the iCalendar format, the dates of its events are skipped.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL to load the calendar from",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap refers to the key of the ConfigMap holding the calendar",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+					"refreshInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RefreshInterval is the interval to reload the calendar, defaults to 24h",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ConfigMapKeySelector"},
+	}
+}
+
 func schema_pkg_apis_events_v1alpha1_Int64OrString(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4436,6 +5989,65 @@ func schema_pkg_apis_events_v1alpha1_Int64OrString(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_events_v1alpha1_InvolvedObjectFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InvolvedObjectFilter filters the K8s Events by their involved object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kinds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kinds of the involved object, e.g. Pod.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"names": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names of the involved object, regular expressions are supported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels of the involved object, same as the label filter of the resource event source. It needs the permissions to get the involved objects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Selector"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Selector"},
+	}
+}
+
 func schema_pkg_apis_events_v1alpha1_JetStreamBus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4605,11 +6217,17 @@ func schema_pkg_apis_events_v1alpha1_JetStreamBus(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"stream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stream is the typed configuration of the stream used by this EventBus. Fields specified here take precedence over \"streamConfig\" and the global settings in controller-config, and unlike \"streamConfig\", they can be updated after the EventBus is created.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamStreamConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ContainerTemplate", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Metadata", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ContainerTemplate", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamStreamConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Metadata", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
@@ -4644,11 +6262,136 @@ func schema_pkg_apis_events_v1alpha1_JetStreamConfig(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
 						},
 					},
+					"stream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stream is the typed configuration of the stream, fields specified here take precedence over \"streamConfig\".",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamStreamConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamStreamConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_JetStreamPlacement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JetStreamPlacement is used to guide the placement of the stream replicas in clustered JetStream.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cluster is the name of the cluster to place the stream in.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags are the server tags required for the servers to place the stream on.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_JetStreamStreamConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JetStreamStreamConfig is the typed configuration of a JetStream stream. See https://docs.nats.io/nats-concepts/jetstream/streams#configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention policy of the stream, \"Limits\" (default), \"Interest\" or \"WorkQueue\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxMsgs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum number of messages in the stream, -1 means unlimited.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum total size of the stream in bytes, -1 means unlimited.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum age of the messages in the stream, e.g. \"72h\". \"0s\" means unlimited.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxMsgSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum size of a single message in the stream in bytes, -1 means unlimited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of replicas of the stream, 1, 3 or 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"discard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Discard policy of the stream when the limits are reached, \"Old\" (default) or \"New\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duplicates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duplicates is the window to track duplicate messages in, e.g. \"5m\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compression of the stream storage, \"None\" (default) or \"S2\". Requires NATS server 2.10 or later.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"placement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Placement of the stream replicas.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamPlacement"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.JetStreamPlacement"},
 	}
 }
 
@@ -4906,6 +6649,32 @@ func schema_pkg_apis_events_v1alpha1_KafkaEventSource(ref common.ReferenceCallba
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 					"config": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Yaml format Sarama config for Kafka connection. It follows the struct of sarama.Config. See https://github.com/IBM/sarama/blob/main/config.go e.g.\n\nconsumer:\n  fetch:\n    min: 1\nnet:\n  MaxOpenRequests: 5",
@@ -4925,12 +6694,18 @@ func schema_pkg_apis_events_v1alpha1_KafkaEventSource(ref common.ReferenceCallba
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AWSMSKIAMConfig"),
 						},
 					},
+					"decoder": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Decoder decodes the binary payloads into JSON, instead of SchemaRegistry",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadDecoder"),
+						},
+					},
 				},
 				Required: []string{"url", "topic"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AWSMSKIAMConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaConsumerGroup", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SASLConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SchemaRegistryConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AWSMSKIAMConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KafkaConsumerGroup", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadDecoder", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SASLConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SchemaRegistryConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"},
 	}
 }
 
@@ -5084,129 +6859,120 @@ func schema_pkg_apis_events_v1alpha1_KafkaTrigger(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_LogTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_KubernetesAuditWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "KubernetesAuditWebhook describes the endpoint receiving the audit events from the API server audit webhook backend, each audit event of a batch is dispatched as an event.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"intervalSeconds": {
+					"webhook": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Only print messages every interval. Useful to prevent logging too much data for busy events.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Webhook holds configuration for the endpoint the audit webhook backend sends the batches to.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext"),
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_events_v1alpha1_MNSEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MNSEventSource refers to event-source for AlibabaCloud MNS related events",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"accessKey": {
+					"verbs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AccessKey refers K8s secret containing AlibabaCloud access key",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
-						},
-					},
-					"secretKey": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretKey refers K8s secret containing AlibabaCloud secret key",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Verbs filters the audit events by verb, e.g. create, delete.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"queue": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Queue is AlibabaCloud MNS queue to listen to for messages",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Resources filters the audit events by resource, in the form of \"resource\" for the core group, or \"group/resource\", e.g. secrets, apps/deployments.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"jsonBody": {
+					"namespaces": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Namespaces filters the audit events by the namespace of the object.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"endpoint": {
+					"users": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Endpoint configures connection to a specific AlibabaCloud MNS endpoint",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Users filters the audit events by the name of the user making the request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"filter": {
+					"stages": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+							Description: "Stages filters the audit events by stage, defaults to ResponseComplete.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"queue"},
+				Required: []string{"webhook"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_MQTTEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_KubernetesEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MQTTEventSource refers to event-source for MQTT related events",
+				Description: "KubernetesEventSource describes an event source for the K8s Events (core/v1 Event) reporting what happens to the objects in the cluster, and the audit events sent by the API server.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "URL to connect to broker",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"topic": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Topic name",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"clientId": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ClientID is the id of the client",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"connectionBackoff": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ConnectionBackoff holds backoff applied to connection.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff"),
-						},
-					},
-					"jsonBody": {
+					"events": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JSONBody specifies that all event body payload coming from this source will be JSON",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Events watches the core/v1 Events.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KubernetesEventsWatch"),
 						},
 					},
-					"tls": {
+					"audit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the mqtt client.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+							Description: "Audit receives the batches of audit events sent by the API server audit webhook backend.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KubernetesAuditWebhook"),
 						},
 					},
 					"metadata": {
@@ -5231,33 +6997,59 @@ func schema_pkg_apis_events_v1alpha1_MQTTEventSource(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
-					"auth": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Auth hosts secret selectors for username and password",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth"),
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"url", "topic", "clientId"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KubernetesAuditWebhook", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.KubernetesEventsWatch"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_Metadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_KubernetesEventsWatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Metadata holds the annotations and labels of an event source pod",
+				Description: "KubernetesEventsWatch describes the core/v1 Events to watch. An event is dispatched when a K8s Event is created, or updated with a different reason, type or message. The updates only bumping the count of a recurring K8s Event are not dispatched.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"annotations": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Namespace to watch the K8s Events in, all the namespaces if it is not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reasons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reasons filters the K8s Events by reason, e.g. BackOff, FailedScheduling.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -5268,11 +7060,11 @@ func schema_pkg_apis_events_v1alpha1_Metadata(ref common.ReferenceCallback) comm
 							},
 						},
 					},
-					"labels": {
+					"types": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Types filters the K8s Events by type, Normal or Warning.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -5283,138 +7075,161 @@ func schema_pkg_apis_events_v1alpha1_Metadata(ref common.ReferenceCallback) comm
 							},
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_events_v1alpha1_NATSAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NATSAuth refers to the auth info for NATS EventSource",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"basic": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Baisc auth with username and password",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth"),
-						},
-					},
-					"token": {
+					"involvedObject": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Token used to connect",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "InvolvedObject filters the K8s Events by the object they are about.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.InvolvedObjectFilter"),
 						},
 					},
-					"nkey": {
+					"includeInvolvedObject": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NKey used to connect",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "IncludeInvolvedObject adds the metadata of the involved object to the event payload. It needs the permissions to get the involved objects.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"credential": {
+					"deduplicationWindow": {
 						SchemaProps: spec.SchemaProps{
-							Description: "credential used to connect",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "DeduplicationWindow is the duration during which the K8s Events with the same involved object, reason, type and message are dispatched only once, e.g. 10m. By default, only the updates of the same K8s Event are deduplicated.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.InvolvedObjectFilter"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_NATSBus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_LogTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NATSBus holds the NATS eventbus information",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"native": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Native means to bring up a native NATS service",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NativeStrategy"),
-						},
-					},
-					"exotic": {
+					"intervalSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exotic holds an exotic NATS config",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSConfig"),
+							Description: "Only print messages every interval. Useful to prevent logging too much data for busy events.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NativeStrategy"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_NATSConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_MNSEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NATSConfig holds the config of NATS",
+				Description: "MNSEventSource refers to event-source for AlibabaCloud MNS related events",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"accessKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NATS streaming url",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AccessKey refers K8s secret containing AlibabaCloud access key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"clusterID": {
+					"secretKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Cluster ID for nats streaming",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SecretKey refers K8s secret containing AlibabaCloud secret key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"auth": {
+					"queue": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Auth strategy, default to AuthStrategyNone",
+							Description: "Queue is AlibabaCloud MNS queue to listen to for messages",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"accessSecret": {
+					"jsonBody": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Secret for auth",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretKeySelector"},
-	}
-}
-
-func schema_pkg_apis_events_v1alpha1_NATSEventsSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NATSEventsSource refers to event-source for NATS related events",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"url": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL to connect to NATS cluster",
+							Description: "Endpoint configures connection to a specific AlibabaCloud MNS endpoint",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"subject": {
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"queue"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_MQTTEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MQTTEventSource refers to event-source for MQTT related events",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL to connect to broker",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subject holds the name of the subject onto which messages are published",
+							Description: "Topic name, it is subscribed with QoS 0, use topics to subscribe with a different QoS.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientID is the id of the client",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -5435,7 +7250,7 @@ func schema_pkg_apis_events_v1alpha1_NATSEventsSource(ref common.ReferenceCallba
 					},
 					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the nats client.",
+							Description: "TLS configuration for the mqtt client.",
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
 						},
 					},
@@ -5455,158 +7270,120 @@ func schema_pkg_apis_events_v1alpha1_NATSEventsSource(ref common.ReferenceCallba
 							},
 						},
 					},
-					"auth": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Auth information",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSAuth"),
-						},
-					},
 					"filter": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Filter",
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
-					"queue": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Queue is the name of the queue group to subscribe as if specified. Uses QueueSubscribe logic to subscribe as queue group. If the queue is empty, uses default Subscribe logic.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"url", "subject"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"},
-	}
-}
-
-func schema_pkg_apis_events_v1alpha1_NATSTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NATSTrigger refers to the specification of the NATS trigger.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"url": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL of the NATS cluster.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
 						},
 					},
-					"subject": {
+					"transform": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the subject to put message on.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
 						},
 					},
-					"payload": {
+					"routes": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter"),
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
 									},
 								},
 							},
 						},
 					},
-					"parameters": {
+					"auth": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Auth hosts secret selectors for username and password",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth"),
+						},
+					},
+					"topics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topics is the list of topic filters to subscribe to, each with its own QoS.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter"),
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.MQTTTopic"),
 									},
 								},
 							},
 						},
 					},
-					"tls": {
+					"cleanSession": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the NATS producer.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+							Description: "CleanSession specifies if the broker discards the session when the client disconnects, defaults to true. Set it to false to have a persistent session, the broker keeps the subscriptions and the unacknowledged QoS 1 and 2 messages for the client ID, and delivers them once the client reconnects.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"auth": {
+					"sharedGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuthInformation",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSAuth"),
+							Description: "SharedGroup subscribes to all the topics as shared subscriptions of the group, i.e. \"$share/{group}/{topic}\", the broker distributes the messages among the subscribers of the group.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"url", "subject", "payload"},
+				Required: []string{"url", "clientId"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.MQTTTopic", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_NSQEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_MQTTTopic(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NSQEventSource describes the event source for NSQ PubSub More info at https://godoc.org/github.com/nsqio/go-nsq",
+				Description: "MQTTTopic is a topic filter to subscribe to",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"hostAddress": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HostAddress is the address of the host for NSQ lookup",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"topic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Topic to subscribe to.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"channel": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Channel used for subscription",
+							Description: "Topic filter, wildcards and shared subscriptions like \"$share/{group}/{topic}\" are supported.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"connectionBackoff": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Backoff holds parameters applied to connection.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff"),
-						},
-					},
-					"jsonBody": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JSONBody specifies that all event body payload coming from this source will be JSON",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"tls": {
+					"qos": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the nsq client.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+							Description: "QoS of the subscription, one of 0, 1 or 2, defaults to 0. Messages with QoS 1 and 2 are acknowledged after the events are published to the EventBus.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"metadata": {
+				},
+				Required: []string{"topic"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_Metadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Metadata holds the annotations and labels of an event source pod",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Metadata holds the user defined metadata which will passed along the event payload.",
-							Type:        []string{"object"},
+							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -5619,61 +7396,1293 @@ func schema_pkg_apis_events_v1alpha1_NSQEventSource(ref common.ReferenceCallback
 							},
 						},
 					},
-					"filter": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filter",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"hostAddress", "topic", "channel"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_NativeStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_NATSAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NativeStrategy indicates to install a native NATS service",
+				Description: "NATSAuth refers to the auth info for NATS EventSource",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
+					"basic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Size is the NATS StatefulSet size",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Baisc auth with username and password",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth"),
 						},
 					},
-					"auth": {
+					"token": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Token used to connect",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"persistence": {
+					"nkey": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PersistenceStrategy"),
+							Description: "NKey used to connect",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"containerTemplate": {
+					"credential": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContainerTemplate contains customized spec for NATS container",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ContainerTemplate"),
+							Description: "credential used to connect",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"metricsContainerTemplate": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_NATSBus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NATSBus holds the NATS eventbus information",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"native": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MetricsContainerTemplate contains customized spec for metrics container",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ContainerTemplate"),
+							Description: "Native means to bring up a native NATS service",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NativeStrategy"),
 						},
 					},
-					"nodeSelector": {
+					"exotic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exotic holds an exotic NATS config",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NativeStrategy"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_NATSConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NATSConfig holds the config of NATS",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NATS streaming url",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cluster ID for nats streaming",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auth strategy, default to AuthStrategyNone",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret for auth",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_NATSEventsSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NATSEventsSource refers to event-source for NATS related events",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL to connect to NATS cluster",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject holds the name of the subject onto which messages are published. With JetStream, it is used as the filter subject of the consumer if filterSubjects is not specified.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"connectionBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectionBackoff holds backoff applied to connection.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff"),
+						},
+					},
+					"jsonBody": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONBody specifies that all event body payload coming from this source will be JSON",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the nats client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata holds the user defined metadata which will passed along the event payload.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auth information",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSAuth"),
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+					"queue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Queue is the name of the queue group to subscribe as if specified. Uses QueueSubscribe logic to subscribe as queue group. If the queue is empty, uses default Subscribe logic.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jetStream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JetStream consumes the messages from a JetStream stream with a durable consumer instead of the core NATS subscription, the messages are acknowledged after the events are published to the EventBus.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSJetStreamConsumer"),
+						},
+					},
+					"decoder": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Decoder decodes the binary payloads into JSON",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadDecoder"),
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSJetStreamConsumer", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadDecoder", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_NATSJetStreamConsumer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NATSJetStreamConsumer refers to the durable consumer to consume a JetStream stream with.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stream is the name of the stream to consume, the stream must exist.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"consumer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Consumer is the name of the durable consumer, it is created if it does not exist. Defaults to {eventsource_name}-{event_name}.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode of the consumer, \"Pull\" or \"Push\", defaults to \"Pull\". A push consumer uses the queue as its deliver group if it is specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deliverPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeliverPolicy is where the consumer starts to deliver messages from when it is created, \"All\", \"New\", \"ByStartTime\" or \"ByStartSequence\", defaults to \"All\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time in RFC3339 format to start from, required by the \"ByStartTime\" deliver policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startSequence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartSequence is the stream sequence to start from, required by the \"ByStartSequence\" deliver policy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"filterSubjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FilterSubjects are the subjects of the stream to consume, defaults to the subject if it is specified, otherwise all the subjects of the stream.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"maxAckPending": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAckPending is the max number of the messages delivered but not acknowledged yet. Defaults to the server default.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ackWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AckWait is how long the server waits for the acknowledgement before redelivering a message, defaults to 30s.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fetchBatchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FetchBatchSize is the max number of the messages a pull consumer fetches at a time, defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"stream"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_NATSTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NATSTrigger refers to the specification of the NATS trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the NATS cluster.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the subject to put message on.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the NATS producer.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthInformation",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSAuth"),
+						},
+					},
+				},
+				Required: []string{"url", "subject", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.NATSAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_NSQEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NSQEventSource describes the event source for NSQ PubSub More info at https://godoc.org/github.com/nsqio/go-nsq",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hostAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostAddress is the address of the host for NSQ lookup",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic to subscribe to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"channel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Channel used for subscription",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"connectionBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff holds parameters applied to connection.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff"),
+						},
+					},
+					"jsonBody": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONBody specifies that all event body payload coming from this source will be JSON",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the nsq client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata holds the user defined metadata which will passed along the event payload.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"hostAddress", "topic", "channel"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_NativeStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NativeStrategy indicates to install a native NATS service",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the NATS StatefulSet size",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"persistence": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PersistenceStrategy"),
+						},
+					},
+					"containerTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerTemplate contains customized spec for NATS container",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ContainerTemplate"),
+						},
+					},
+					"metricsContainerTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricsContainerTemplate contains customized spec for metrics container",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ContainerTemplate"),
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the pod's tolerations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata sets the pods's metadata, i.e. annotations and labels",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Metadata"),
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty.  See type description for default values of each field.",
+							Ref:         ref("k8s.io/api/core/v1.PodSecurityContext"),
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max Age of existing messages, i.e. \"72h\", “4h35m”",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullSecrets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images#specifying-imagepullsecrets-on-a-pod",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountName to apply to NATS StatefulSet",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, indicates the EventSource pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The priority value. Various system components use this field to find the priority of the EventSource pod. When Priority Admission Controller is enabled, it prevents users from setting this field. The admission controller populates this field from PriorityClassName. The higher the value, the higher the priority. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The pod's scheduling constraints More info: https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/",
+							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"maxMsgs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum number of messages per channel, 0 means unlimited. Defaults to 1000000",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total size of messages per channel, 0 means unlimited. Defaults to 1GB",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSubs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum number of subscriptions per channel, 0 means unlimited. Defaults to 1000",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxPayload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum number of bytes in a message payload, 0 means unlimited. Defaults to 1MB",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"raftHeartbeatTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the time in follower state without a leader before attempting an election, i.e. \"72h\", “4h35m”. Defaults to 2s",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"raftElectionTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the time in candidate state without a leader before attempting an election, i.e. \"72h\", “4h35m”. Defaults to 2s",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"raftLeaseTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies how long a leader waits without being able to contact a quorum of nodes before stepping down as leader, i.e. \"72h\", “4h35m”. Defaults to 1s",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"raftCommitTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the time without an Apply() operation before sending an heartbeat to ensure timely commit, i.e. \"72h\", “4h35m”. Defaults to 100ms",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ContainerTemplate", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Metadata", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_OAuth2ClientCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OAuth2ClientCredentials contains the configuration to get an access token with the OAuth2 client credentials grant",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tokenURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenURL is the URL of the token endpoint of the authorization server.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientID refers to the Kubernetes secret that holds the client id.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"clientSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientSecret refers to the Kubernetes secret that holds the client secret.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"scopes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scopes to request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"endpointParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointParams holds the additional parameters of the token request, e.g. \"audience\".",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"tokenURL"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_ObjectStoreEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectStoreEventSource describes an event source for the objects created and removed in a bucket of an S3 compatible object store, either from the S3 event notifications delivered to an SQS queue, or by listing the objects periodically. One of sqs and poll must be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint of the object store, e.g. s3.amazonaws.com or minio.argo-events:9000. Required to poll.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bucket of the objects. Required to poll, the notifications of the other buckets are ignored if it is specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region of the bucket and the queue",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Insecure connects to the object store over HTTP instead of HTTPS",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"accessKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessKey refers to the K8s secret containing the access key. The AWS credentials of the environment, or of the EC2 instance or the service account, are used if it is not specified.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"secretKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretKey refers to the K8s secret containing the secret key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"caCertificate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CACertificate refers to the K8s secret containing the CA certificate of the object store",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix of the keys of the objects",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suffix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suffix of the keys of the objects",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sqs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SQS receives the S3 event notifications from an SQS queue, sent directly by S3 or through an SNS topic",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ObjectStoreSQS"),
+						},
+					},
+					"poll": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Poll lists the objects of the bucket periodically",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ObjectStorePoll"),
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata holds the user defined metadata which will passed along the event payload.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ObjectStorePoll", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ObjectStoreSQS", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_ObjectStorePoll(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectStorePoll describes how the objects of the bucket are listed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval between two listings, e.g. 30s, 5m... Defaults to 1m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"emitExisting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmitExisting emits an event for each object existing when the bucket is listed for the first time, otherwise only the objects created afterwards are.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"detectRemovals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DetectRemovals emits an event for each object removed between two listings. The keys of all the objects are kept in the state, so it is meant for buckets with a moderate number of objects: the removals are not detected while the keys and the ETags of the objects exceed 512 KiB.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"persistence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Persistence holds the configuration to store the watermark of the listings, so the objects are not emitted again after the event source restarts.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventPersistence"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventPersistence"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_ObjectStoreSQS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectStoreSQS describes the SQS queue the S3 event notifications are delivered to",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Queue is the name of the queue",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queueAccountId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueAccountID is the ID of the account that created the queue",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint of SQS, instead of the AWS one",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"roleARN": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleARN is the Amazon Resource Name (ARN) of the role to assume",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"waitTimeSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitTimeSeconds is the duration (in seconds) for which a receive call waits for a message. Defaults to 20.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"queue"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_OpenWhiskTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenWhiskTrigger refers to the specification of the OpenWhisk trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host URL of the OpenWhisk.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version for the API. Defaults to v1.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace for the action. Defaults to \"_\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthToken for authentication.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"actionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the action/function.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"host", "actionName", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_OwnedRepositories(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Organization or user name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"names": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repository names",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_PayloadDecoder(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PayloadDecoder decodes the binary payloads of the messages into JSON before the events are published, so the filters and the parameters of the sensors can address their fields. Exactly one of Avro, Protobuf, Confluent and Pulsar is required.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"avro": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Avro decodes the payloads encoded with an Avro schema",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AvroDecoder"),
+						},
+					},
+					"protobuf": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protobuf decodes the payloads encoded as a Protobuf message",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ProtobufDecoder"),
+						},
+					},
+					"confluent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Confluent decodes the payloads in the Confluent wire format, with the Avro, Protobuf or JSON Schema of the schema registry",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SchemaRegistryConfig"),
+						},
+					},
+					"pulsar": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pulsar decodes the payloads with the Avro, Protobuf native or JSON schema of the topic in the Pulsar schema registry",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarSchemaRegistry"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.AvroDecoder", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ProtobufDecoder", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PulsarSchemaRegistry", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SchemaRegistryConfig"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_PayloadField(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PayloadField binds a value at path within the event payload against a name.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the JSONPath of the event's (JSON decoded) data key Path is a series of keys separated by a dot. A key may contain wildcard characters '*' and '?'. To access an array value use the index as the key. The dot and wildcard characters can be escaped with '\\\\'. See https://github.com/tidwall/gjson#path-syntax for more information on how to use this.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name acts as key that holds the value at the path.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path", "name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_PersistenceStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistenceStrategy defines the strategy of persistence",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the StorageClass required by the claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Available access modes such as ReadWriteOnce, ReadWriteMany https://kubernetes.io/docs/concepts/storage/persistent-volumes/#access-modes",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume size, e.g. 10Gi",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_PollCursor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PollCursor describes an incremental cursor sent with the request of the next poll",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the JSONPath of the cursor in the response body, the value of the last page is used",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"param": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Param is the query parameter to send the cursor with",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path", "param"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_PollEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PollEventSource describes an event source polling an HTTP endpoint, one event is emitted for each new or changed item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the endpoint to poll",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method of the request, defaults to GET",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+							Description: "Headers of the request",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5687,248 +8696,329 @@ func schema_pkg_apis_events_v1alpha1_NativeStrategy(ref common.ReferenceCallback
 							},
 						},
 					},
-					"tolerations": {
+					"secureHeaders": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the pod's tolerations.",
+							Description: "SecureHeaders holds the headers of the request whose values are read from secrets or configmaps",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SecureHeader"),
 									},
 								},
 							},
 						},
 					},
-					"metadata": {
+					"body": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Metadata sets the pods's metadata, i.e. annotations and labels",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Metadata"),
+							Description: "Body of the request",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"securityContext": {
+					"basicAuth": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty.  See type description for default values of each field.",
-							Ref:         ref("k8s.io/api/core/v1.PodSecurityContext"),
+							Description: "BasicAuth configuration for the request",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth"),
 						},
 					},
-					"maxAge": {
+					"oauth2": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Max Age of existing messages, i.e. \"72h\", “4h35m”",
+							Description: "OAuth2 gets an access token with the client credentials grant for the request",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.OAuth2ClientCredentials"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the HTTP client",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is a string that describes the interval between two polls, e.g. 30s, 5m... Either interval or schedule must be specified.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imagePullSecrets": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron-like expression of the polls. For reference, see: https://en.wikipedia.org/wiki/Cron",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images#specifying-imagepullsecrets-on-a-pod",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
-									},
-								},
-							},
+							Description: "Timeout of a request, defaults to 30s",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"serviceAccountName": {
+					"itemsPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountName to apply to NATS StatefulSet",
+							Description: "ItemsPath is the JSONPath of the item array in the response body, e.g. \"$.data.items\". If it is not specified, the whole response body is treated as a single item.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"priorityClassName": {
+					"idPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, indicates the EventSource pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Description: "IDPath is the JSONPath of the ID of an item, relative to the item, e.g. \"$.id\". With an ID, an event is emitted when an item is new or its content changed, otherwise the items are identified by the hash of their content, and an event is emitted for each new content.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"priority": {
+					"pagination": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The priority value. Various system components use this field to find the priority of the EventSource pod. When Priority Admission Controller is enabled, it prevents users from setting this field. The admission controller populates this field from PriorityClassName. The higher the value, the higher the priority. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Pagination configures how to get the following pages of the response",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PollPagination"),
 						},
 					},
-					"affinity": {
+					"cursor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The pod's scheduling constraints More info: https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/",
-							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+							Description: "Cursor configures an incremental cursor, which is read from a response and sent with the request of the next poll",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PollCursor"),
 						},
 					},
-					"maxMsgs": {
+					"persistence": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Maximum number of messages per channel, 0 means unlimited. Defaults to 1000000",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Persistence holds the configuration to store the ETag, cursor and the hash of the items seen, so the items are not emitted again after the event source restarts.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventPersistence"),
 						},
 					},
-					"maxBytes": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Total size of messages per channel, 0 means unlimited. Defaults to 1GB",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Metadata holds the user defined metadata which will passed along the event payload.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"maxSubs": {
+					"filter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Maximum number of subscriptions per channel, 0 means unlimited. Defaults to 1000",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Filter",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
-					"maxPayload": {
+					"schema": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Maximum number of bytes in a message payload, 0 means unlimited. Defaults to 1MB",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
 						},
 					},
-					"raftHeartbeatTimeout": {
+					"transform": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the time in follower state without a leader before attempting an election, i.e. \"72h\", “4h35m”. Defaults to 2s",
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventPersistence", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.OAuth2ClientCredentials", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PollCursor", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PollPagination", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.SecureHeader", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_PollPagination(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PollPagination describes how to get the following pages of a response",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nextURLPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextURLPath is the JSONPath of the URL of the next page in the response body",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"raftElectionTimeout": {
+					"cursorPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the time in candidate state without a leader before attempting an election, i.e. \"72h\", “4h35m”. Defaults to 2s",
+							Description: "CursorPath is the JSONPath of the cursor of the next page in the response body, the cursor is sent as the cursorParam query parameter",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"raftLeaseTimeout": {
+					"cursorParam": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies how long a leader waits without being able to contact a quorum of nodes before stepping down as leader, i.e. \"72h\", “4h35m”. Defaults to 1s",
+							Description: "CursorParam is the query parameter to send the cursor of the next page with",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"raftCommitTimeout": {
+					"linkHeader": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the time without an Apply() operation before sending an heartbeat to ensure timely commit, i.e. \"72h\", “4h35m”. Defaults to 100ms",
-							Type:        []string{"string"},
+							Description: "LinkHeader follows the \"next\" relation of the Link response header",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"maxPages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPages is the maximum number of pages to get in a poll, defaults to 10",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ContainerTemplate", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Metadata", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_OpenWhiskTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_PostgresEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenWhiskTrigger refers to the specification of the OpenWhisk trigger.",
+				Description: "PostgresEventSource describes an event source consuming the row changes of PostgreSQL tables from a logical replication slot with the pgoutput plugin.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"host": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Host URL of the OpenWhisk.",
+							Description: "Host of the PostgreSQL server",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"port": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version for the API. Defaults to v1.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Port of the PostgreSQL server, defaults to 5432",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"namespace": {
+					"database": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace for the action. Defaults to \"_\".",
+							Description: "Database to replicate the changes of",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"authToken": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuthToken for authentication.",
+							Description: "Username refers to the K8s secret that holds the username, the user needs the REPLICATION attribute.",
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"actionName": {
+					"password": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the action/function.",
+							Description: "Password refers to the K8s secret that holds the password",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the connection",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+						},
+					},
+					"slot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Slot is the name of the logical replication slot",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"payload": {
+					"createSlot": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Description: "CreateSlot creates the replication slot with the pgoutput plugin if it does not exist",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"publications": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Publications to replicate the changes of, the publications need to exist.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"parameters": {
+					"tables": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Description: "Tables filters the changes by table, in the form of \"schema.table\", or \"table\" for the public schema. All the tables of the publications are included if it is not specified.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"host", "actionName", "payload"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
-	}
-}
-
-func schema_pkg_apis_events_v1alpha1_OwnedRepositories(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"owner": {
+					"operations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Organization or user name",
+							Description: "Operations filters the changes by operation, one or more of \"insert\", \"update\" and \"delete\". All the operations are included if it is not specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"statusInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StatusInterval is the interval to report the confirmed position to the server, defaults to 10s",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"names": {
+					"connectionBackoff": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Repository names",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "ConnectionBackoff holds backoff applied to connection.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff"),
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata holds the user defined metadata which will passed along the event payload.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -5939,74 +9029,74 @@ func schema_pkg_apis_events_v1alpha1_OwnedRepositories(ref common.ReferenceCallb
 							},
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_events_v1alpha1_PayloadField(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PayloadField binds a value at path within the event payload against a name.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"path": {
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is the JSONPath of the event's (JSON decoded) data key Path is a series of keys separated by a dot. A key may contain wildcard characters '*' and '?'. To access an array value use the index as the key. The dot and wildcard characters can be escaped with '\\\\'. See https://github.com/tidwall/gjson#path-syntax for more information on how to use this.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
 						},
 					},
-					"name": {
+					"routes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name acts as key that holds the value at the path.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"path", "name"},
+				Required: []string{"host", "database", "slot", "publications"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_events_v1alpha1_PersistenceStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_events_v1alpha1_ProtobufDecoder(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PersistenceStrategy defines the strategy of persistence",
+				Description: "ProtobufDecoder is the Protobuf message of the payloads",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"storageClassName": {
+					"descriptorSet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the StorageClass required by the claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DescriptorSet is the ConfigMap key holding the serialized FileDescriptorSet of the message, including its imports, e.g. generated with \"protoc --include_imports --descriptor_set_out\".",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
 						},
 					},
-					"accessMode": {
+					"messageType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Available access modes such as ReadWriteOnce, ReadWriteMany https://kubernetes.io/docs/concepts/storage/persistent-volumes/#access-modes",
+							Description: "MessageType is the full name of the message, e.g. acme.orders.v1.Order",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volumeSize": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Volume size, e.g. 10Gi",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
 				},
+				Required: []string{"descriptorSet", "messageType"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"k8s.io/api/core/v1.ConfigMapKeySelector"},
 	}
 }
 
@@ -6091,11 +9181,105 @@ func schema_pkg_apis_events_v1alpha1_PubSubEventSource(ref common.ReferenceCallb
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_PulsarBus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PulsarBus holds the PulsarBus EventBus information",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the Pulsar service, e.g. pulsar://pulsar.pulsar.svc:6650",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic name, defaults to {namespace_name}-{eventbus_name}",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subscriptionType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubscriptionType of the Sensor subscriptions, \"Shared\" or \"KeyShared\", defaults to \"KeyShared\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tlsTrustCertsSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Trusted TLS certs secret.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tlsAllowInsecureConnection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the Pulsar client accept untrusted TLS certificate from broker.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tlsValidateHostname": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the Pulsar client verify the validity of the host name from broker.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the pulsar client, the client cert and key are used for TLS authentication.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+						},
+					},
+					"authTokenSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthTokenSecret is token authentication.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -6203,6 +9387,32 @@ func schema_pkg_apis_events_v1alpha1_PulsarEventSource(ref common.ReferenceCallb
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 					"authAthenzParams": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Authentication athenz parameters for the pulsar client. Refer https://github.com/apache/pulsar-client-go/blob/master/pulsar/auth/athenz.go Either token or athenz can be set to use auth.",
@@ -6225,12 +9435,48 @@ func schema_pkg_apis_events_v1alpha1_PulsarEventSource(ref common.ReferenceCallb
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
+					"decoder": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Decoder decodes the binary payloads into JSON",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadDecoder"),
+						},
+					},
 				},
 				Required: []string{"topics", "url"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Backoff", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.PayloadDecoder", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_PulsarSchemaRegistry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PulsarSchemaRegistry is the Pulsar admin API the schemas of the topics are read from",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"adminURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdminURL is the URL of the Pulsar admin API, e.g. http://pulsar-broker:8080",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authTokenSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthTokenSecret is the secret selector to the token authenticating the requests to the admin API",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+				Required: []string{"adminURL"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -6379,6 +9625,68 @@ func schema_pkg_apis_events_v1alpha1_RateLimit(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_events_v1alpha1_RedisBus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RedisBus holds the RedisBus EventBus information, the events are stored in a Redis Stream.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the address of the Redis server, in the form of host:port.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stream name, defaults to {namespace_name}-{eventbus_name}",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"db": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DB to use, defaults to 0",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username required for ACL style authentication if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password refers to the K8s secret that holds the password.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the redis client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+						},
+					},
+					"maxLen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxLen is the approximate maximum number of the events kept in the stream, older events are trimmed when new events are added. Defaults to 0, which means the stream is not trimmed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
 func schema_pkg_apis_events_v1alpha1_RedisEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6456,6 +9764,32 @@ func schema_pkg_apis_events_v1alpha1_RedisEventSource(ref common.ReferenceCallba
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 					"jsonBody": {
 						SchemaProps: spec.SchemaProps{
 							Description: "JSONBody specifies that all event body payload coming from this source will be JSON",
@@ -6475,7 +9809,7 @@ func schema_pkg_apis_events_v1alpha1_RedisEventSource(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -6550,20 +9884,46 @@ func schema_pkg_apis_events_v1alpha1_RedisStreamEventSource(ref common.Reference
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
 									},
 								},
 							},
 						},
 					},
-					"filter": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Filter",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
-						},
-					},
 					"username": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Username required for ACL style authentication if any.",
@@ -6576,7 +9936,36 @@ func schema_pkg_apis_events_v1alpha1_RedisStreamEventSource(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_ResourceConditionFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceConditionFilter selects the transitions of a condition in \"status.conditions\" of a resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the condition, e.g. Ready",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status the condition transitions to, one of \"True\", \"False\" and \"Unknown\". Any transition of the status passes if it is not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
 	}
 }
 
@@ -6653,12 +10042,66 @@ func schema_pkg_apis_events_v1alpha1_ResourceEventSource(ref common.ReferenceCal
 							},
 						},
 					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources is the list of additional resources to watch in the same event. The group, version and resource above are optional if it is specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource"),
+									},
+								},
+							},
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces to watch by their labels, when the namespace is not specified. It needs the permissions to list and watch namespaces. Cluster scoped resources are not filtered by it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Selector"),
+									},
+								},
+							},
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"namespace", "group", "version", "resource", "eventTypes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ResourceFilter"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ResourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Selector", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource"},
 	}
 }
 
@@ -6717,11 +10160,40 @@ func schema_pkg_apis_events_v1alpha1_ResourceFilter(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"changedFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangedFields only lets the UPDATE events pass if any of the fields changed, e.g. \"status.phase\". The fields are paths in the GJSON syntax, see https://github.com/tidwall/gjson/blob/master/SYNTAX.md.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions only lets the UPDATE events pass if any of the status conditions transitioned. An UPDATE event passes if it matches either the changed fields or the conditions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ResourceConditionFilter"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Selector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.ResourceConditionFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.Selector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6971,6 +10443,32 @@ func schema_pkg_apis_events_v1alpha1_SFTPEventSource(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 					"pollIntervalDuration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PollIntervalDuration the interval at which to poll the SFTP server defaults to 10 seconds",
@@ -6984,7 +10482,7 @@ func schema_pkg_apis_events_v1alpha1_SFTPEventSource(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WatchPathConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WatchPathConfig", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -7065,6 +10563,32 @@ func schema_pkg_apis_events_v1alpha1_SNSEventSource(ref common.ReferenceCallback
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 					"endpoint": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Endpoint configures connection to a specific SNS endpoint instead of Amazons servers",
@@ -7078,7 +10602,7 @@ func schema_pkg_apis_events_v1alpha1_SNSEventSource(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -7175,6 +10699,32 @@ func schema_pkg_apis_events_v1alpha1_SQSEventSource(ref common.ReferenceCallback
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 					"endpoint": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Endpoint configures connection to a specific SQS endpoint instead of Amazons servers",
@@ -7194,7 +10744,7 @@ func schema_pkg_apis_events_v1alpha1_SQSEventSource(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -7228,12 +10778,18 @@ func schema_pkg_apis_events_v1alpha1_SchemaRegistryConfig(ref common.ReferenceCa
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth"),
 						},
 					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the schema registry client of the event sources. If omitted, the event sources don't verify the certificate of the schema registry.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
+						},
+					},
 				},
 				Required: []string{"url"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"},
 	}
 }
 
@@ -7483,6 +11039,13 @@ func schema_pkg_apis_events_v1alpha1_SensorSpec(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"eventBusNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventBusNamespace references to the namespace of the EventBus, defaults to the namespace of the Sensor. An EventBus in another namespace needs to have this namespace in its \"spec.sharing.allowedNamespaces\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"dependencies", "triggers"},
 			},
@@ -7627,11 +11190,37 @@ func schema_pkg_apis_events_v1alpha1_SlackEventSource(ref common.ReferenceCallba
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -7975,12 +11564,38 @@ func schema_pkg_apis_events_v1alpha1_StorageGridEventSource(ref common.Reference
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"topicArn", "bucket", "authToken", "apiURL"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.StorageGridFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.StorageGridFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -8069,11 +11684,37 @@ func schema_pkg_apis_events_v1alpha1_StripeEventSource(ref common.ReferenceCallb
 							},
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookContext", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -8676,30 +12317,95 @@ func schema_pkg_apis_events_v1alpha1_WatchPathConfig(ref common.ReferenceCallbac
 				Properties: map[string]spec.Schema{
 					"directory": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Directory to watch for events",
-							Default:     "",
+							Description: "Directory to watch for events",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is relative path of object to watch with respect to the directory",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pathRegexp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathRegexp is regexp of relative path of object to watch with respect to the directory",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"directory"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_WebhookChallenge(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookChallenge is a challenge request, identified by its challenge found in the query parameter, the header or the body path, and by the values of its JSON body.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryParameter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParameter holding the challenge, e.g. validationToken",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"path": {
+					"header": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is relative path of object to watch with respect to the directory",
+							Description: "Header holding the challenge",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"pathRegexp": {
+					"bodyPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PathRegexp is regexp of relative path of object to watch with respect to the directory",
+							Description: "BodyPath is the path of the challenge in the JSON body, in the gjson syntax, e.g. challenge",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"bodyValues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BodyValues are the values the JSON body must have at the paths, e.g. type: url_verification",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Response to the challenge requests. Defaults to 200 with the challenge as a text/plain body.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookResponse"),
+						},
+					},
+					"unauthenticated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unauthenticated challenge requests are answered before the AuthSecret is checked, for the senders which do not authenticate them. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"directory"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookResponse"},
 	}
 }
 
@@ -8783,12 +12489,59 @@ func schema_pkg_apis_events_v1alpha1_WebhookContext(ref common.ReferenceCallback
 							Format:      "int64",
 						},
 					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Response shapes the response to the requests whose events are dispatched, instead of the default \"success\".",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookResponse"),
+						},
+					},
+					"challenges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Challenges are the challenge requests, e.g. the validation requests of Microsoft Graph subscriptions, answered by echoing their challenge without dispatching an event. Challenge requests are answered before the AuthSecret is checked.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookChallenge"),
+									},
+								},
+							},
+						},
+					},
+					"async": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Async responds 202 Accepted with the event ids as soon as the request is received, and dispatches the events to the EventBus afterwards. The sender is not notified when the dispatch fails.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits the rate of the requests of each client, the requests over the limit are answered 429 Too Many Requests with a Retry-After header.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookRateLimit"),
+						},
+					},
+					"maxInFlight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxInFlight is the maximum number of requests processed at the same time, the other requests are answered 429 Too Many Requests with a Retry-After header.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"requestTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestTimeout is the maximum duration to process a request, e.g. 30s, a request which is not processed in time is answered 503 Service Unavailable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"endpoint", "method", "port", "url"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookChallenge", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookRateLimit", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookResponse", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -8872,17 +12625,273 @@ func schema_pkg_apis_events_v1alpha1_WebhookEventSource(ref common.ReferenceCall
 							Format:      "int64",
 						},
 					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Response shapes the response to the requests whose events are dispatched, instead of the default \"success\".",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookResponse"),
+						},
+					},
+					"challenges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Challenges are the challenge requests, e.g. the validation requests of Microsoft Graph subscriptions, answered by echoing their challenge without dispatching an event. Challenge requests are answered before the AuthSecret is checked.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookChallenge"),
+									},
+								},
+							},
+						},
+					},
+					"async": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Async responds 202 Accepted with the event ids as soon as the request is received, and dispatches the events to the EventBus afterwards. The sender is not notified when the dispatch fails.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits the rate of the requests of each client, the requests over the limit are answered 429 Too Many Requests with a Retry-After header.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookRateLimit"),
+						},
+					},
+					"maxInFlight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxInFlight is the maximum number of requests processed at the same time, the other requests are answered 429 Too Many Requests with a Retry-After header.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"requestTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestTimeout is the maximum duration to process a request, e.g. 30s, a request which is not processed in time is answered 503 Service Unavailable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"filter": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Filter",
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter"),
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema validates the event data, the invalid events are dropped or published to a dead letter event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform shapes and redacts the event data before it is published",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes publish the matching events with other event names, each matching route publishes an event, the events matching no route are published with the name of this event",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute"),
+									},
+								},
+							},
+						},
+					},
+					"cloudEvents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudEvents accepts CloudEvents in the binary, structured or batched HTTP mode, and publishes them as they are instead of wrapping the request. The id, type, time and extensions of the CloudEvents are kept, the source and the subject are kept in the \"originsource\" and \"originsubject\" extensions. The method must be POST.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"split": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Split publishes an event for each element of the body of a request, e.g. a batch of events, instead of an event for the request.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookSplit"),
+						},
+					},
 				},
 				Required: []string{"endpoint", "method", "port", "url"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceFilter", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceRoute", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceSchema", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.EventSourceTransform", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookChallenge", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookRateLimit", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookResponse", "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookSplit", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_WebhookMultipartSplit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookMultipartSplit publishes an event for each part of a multipart/form-data body",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"s3": {
+						SchemaProps: spec.SchemaProps{
+							Description: "S3 is the bucket the file parts are streamed to, the bucket key is the prefix of their objects. The events reference the objects by URL. Without it, the content of the file parts is in the events, base64 encoded.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.S3Artifact"),
+						},
+					},
+					"maxFileSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFileSize is the maximum size in bytes of a file part streamed to the S3 bucket, the request fails if a file is larger. The maxPayloadSize of the webhook applies to the bodies of the events, the other parts included. Defaults to 100MB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.S3Artifact"},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_WebhookRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookRateLimit limits the rate of the requests of each client, identified by its source IP or a header",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit of the rate, Second, Minute or Hour. Defaults to Second.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestsPerUnit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestsPerUnit is the number of requests allowed per unit for each client",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of requests a client can send at once. Defaults to RequestsPerUnit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Header identifying the clients, e.g. Authorization or X-Api-Key, instead of their source IP. The requests without the header share a limit.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"trustForwardedFor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrustForwardedFor identifies the clients by the X-Forwarded-For header, when the requests go through proxies or an ingress: the client is the address appended by the first trusted proxy, i.e. the address at the TrustedProxies position from the right of the header.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"trustedProxies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrustedProxies is the number of proxies in front of the event source appending to the X-Forwarded-For header. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"requestsPerUnit"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_WebhookResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookResponse is the response to a request. The body and the values of the headers are go templates, with sprig functions, rendered with: .EventID, the id of the first event dispatched, .EventIDs, the ids of all the events dispatched, .Challenge, the challenge of a challenge request, .Method, .Path, .Query and .Header of the request, and .Body, the body of the request, decoded if it is JSON.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"statusCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StatusCode of the response. Defaults to 200, or 202 in the async mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers of the response",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body of the response",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_events_v1alpha1_WebhookSplit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookSplit splits the body of the requests into the bodies of several events. One of jsonPath, ndjson and multipart must be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jsonPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONPath is the path of an array in the JSON body, in the gjson syntax, or \"@this\" if the body is an array. Each element of the array is the body of an event.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ndjson": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NDJSON splits a newline delimited JSON body, each line is the body of an event. The empty lines are skipped.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"multipart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Multipart publishes an event for each part of a multipart/form-data body.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookMultipartSplit"),
+						},
+					},
+					"bestEffort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BestEffort publishes all the events it can, and the request succeeds unless none of its events is published. By default, the request fails as soon as an event can't be published, so the sender retries the whole request.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1.WebhookMultipartSplit"},
 	}
 }
@@ -44,6 +44,13 @@ var (
 	GenericEvent         EventSourceType = "generic"
 	BitbucketServerEvent EventSourceType = "bitbucketserver"
 	BitbucketEvent       EventSourceType = "bitbucket"
+	PollEvent            EventSourceType = "poll"
+	PostgresEvent        EventSourceType = "postgres"
+	GRPCEvent            EventSourceType = "grpc"
+	KubernetesEvent      EventSourceType = "kubernetes"
+	GiteaEvent           EventSourceType = "gitea"
+	AzureDevOpsEvent     EventSourceType = "azureDevOps"
+	ObjectStoreEvent     EventSourceType = "objectStore"
 )
 
 var (
@@ -69,6 +76,10 @@ var (
 		FileEvent,
 		SFTPEvent,
 		GenericEvent,
+		PollEvent,
+		PostgresEvent,
+		KubernetesEvent,
+		ObjectStoreEvent,
 	}
 )
 
@@ -101,6 +112,9 @@ var (
 	EventBusNATS      EventBusType = "nats"
 	EventBusJetStream EventBusType = "jetstream"
 	EventBusKafka     EventBusType = "kafka"
+	EventBusRedis     EventBusType = "redis"
+	EventBusPulsar    EventBusType = "pulsar"
+	EventBusEmbedded  EventBusType = "embedded"
 )
 
 // Environment variables
@@ -127,8 +141,18 @@ const (
 	EnvVarEventBusConfig = "EVENTBUS_CONFIG"
 	// EnvVarEventBusSubject refers to the eventbus subject env
 	EnvVarEventBusSubject = "EVENTBUS_SUBJECT"
+	// EnvVarEventBusSuccessorConfig refers to the config env of the eventbus being migrated to
+	EnvVarEventBusSuccessorConfig = "EVENTBUS_SUCCESSOR_CONFIG"
+	// EnvVarEventBusReplaySince refers to the env of the start time of the events to be replayed by a sensor
+	// after it switched to a new eventbus
+	EnvVarEventBusReplaySince = "EVENTBUS_REPLAY_SINCE"
+	// EnvVarEventBusReplayUntil refers to the env of the time until which the replayed events only restore
+	// the dependency state of a sensor without firing triggers
+	EnvVarEventBusReplayUntil = "EVENTBUS_REPLAY_UNTIL"
 	// volumeMount path for eventbus auth file
 	EventBusAuthFileMountPath = "/etc/eventbus/auth"
+	// volumeMount path for the auth file of the eventbus being migrated to
+	EventBusSuccessorAuthFileMountPath = "/etc/eventbus/successor-auth"
 	// Default NATS Streaming messages max age
 	STANMaxAge = "72h"
 	// Default NATS Streaming max messages per channel
@@ -157,6 +181,10 @@ const (
 	JetStreamServerSecretEncryptionKey = "encryption"
 	// key of client auth secret
 	JetStreamClientAuthSecretKey = "client-auth"
+	// key of the password of the system account in the server secret
+	JetStreamServerSecretSysPasswordKey = "sys-password"
+	// key of the namespaces the EventBus is shared with in the server secret
+	JetStreamServerSecretSharedNamespacesKey = "shared-namespaces"
 	// key for server private key
 	JetStreamServerPrivateKeyKey = "private-key"
 	// key for server TLS certificate
@@ -205,8 +233,19 @@ var (
 const (
 	// LabelOwnerName is the label for resource owner name
 	LabelOwnerName = "owner-name"
+	// LabelEventBusName is the label for the name of the EventBus a resource belongs to
+	LabelEventBusName = "eventbus-name"
+	// LabelEventBusNamespace is the label for the namespace of the EventBus a resource belongs to
+	LabelEventBusNamespace = "eventbus-namespace"
 	// AnnotationResourceSpecHash is the annotation of a K8s resource spec hash
 	AnnotationResourceSpecHash = "resource-spec-hash"
+	// AnnotationEventBus is the annotation of a Deployment for the EventBus it uses
+	AnnotationEventBus = "events.argoproj.io/eventbus"
+	// AnnotationSuccessorEventBus is the annotation of a Deployment for the EventBus it additionally publishes to during a migration
+	AnnotationSuccessorEventBus = "events.argoproj.io/successor-eventbus"
+	// AnnotationEventBusReplayUntil is the annotation of a Sensor Deployment switched over to a new EventBus
+	// for the time the Deployment stopped consuming from the old EventBus
+	AnnotationEventBusReplayUntil = "events.argoproj.io/eventbus-replay-until"
 	// AnnotationLeaderElection is the annotation for leader election
 	AnnotationLeaderElection = "events.argoproj.io/leader-election"
 )
@@ -226,6 +265,9 @@ const (
 	ControllerHealthPort   = 8081
 )
 
+// SensorReadinessPath is the path of the readiness endpoint of a Sensor, served on the metrics port.
+const SensorReadinessPath = "/ready"
+
 var (
 	SecretKeySelectorType    = reflect.TypeOf(&corev1.SecretKeySelector{})
 	ConfigMapKeySelectorType = reflect.TypeOf(&corev1.ConfigMapKeySelector{})
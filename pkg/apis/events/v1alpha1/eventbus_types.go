@@ -1,6 +1,9 @@
 package v1alpha1
 
 import (
+	"slices"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -41,6 +44,67 @@ type EventBusSpec struct {
 	// Exotic JetStream
 	// +optional
 	JetStreamExotic *JetStreamConfig `json:"jetstreamExotic,omitempty" protobuf:"bytes,4,opt,name=jetstreamExotic"`
+	// Sharing allows EventSources and Sensors in other namespaces to use this EventBus.
+	// Only supported by "jetstream".
+	// +optional
+	Sharing *EventBusSharing `json:"sharing,omitempty" protobuf:"bytes,5,opt,name=sharing"`
+	// Redis eventbus, backed by a Redis Stream
+	// +optional
+	Redis *RedisBus `json:"redis,omitempty" protobuf:"bytes,7,opt,name=redis"`
+	// Pulsar eventbus
+	// +optional
+	Pulsar *PulsarBus `json:"pulsar,omitempty" protobuf:"bytes,8,opt,name=pulsar"`
+	// Migration marks this EventBus as the successor of another EventBus in the same namespace,
+	// the EventSources and Sensors using the old EventBus are moved to this one by the controller.
+	// It is only supported by a JetStream or Kafka EventBus, which are able to replay the events to the Sensors switching over.
+	// +optional
+	Migration *EventBusMigration `json:"migration,omitempty" protobuf:"bytes,6,opt,name=migration"`
+}
+
+// EventBusMigration defines the migration from another EventBus.
+type EventBusMigration struct {
+	// From is the name of the EventBus to migrate from, it must be in the same namespace.
+	From string `json:"from" protobuf:"bytes,1,opt,name=from"`
+	// TransitionWindow is how long the EventSources publish to both of the EventBuses before the Sensors
+	// switch over, it should be longer than the time the Sensors need to catch up with the events in the old EventBus.
+	// The migration completes once the switched Sensors have caught up with the events replayed from the new EventBus.
+	// Defaults to 10m.
+	// +optional
+	TransitionWindow *string `json:"transitionWindow,omitempty" protobuf:"bytes,2,opt,name=transitionWindow"`
+}
+
+// GetTransitionWindow returns the transition window of the migration.
+func (m *EventBusMigration) GetTransitionWindow() time.Duration {
+	if m.TransitionWindow != nil {
+		if d, err := time.ParseDuration(*m.TransitionWindow); err == nil {
+			return d
+		}
+	}
+	return 10 * time.Minute
+}
+
+// EventBusMigrationPhase is the phase of an EventBus migration.
+type EventBusMigrationPhase string
+
+const (
+	// EventBusMigrationPending means the successor EventBus is not ready yet.
+	EventBusMigrationPending EventBusMigrationPhase = "Pending"
+	// EventBusMigrationDualPublishing means the EventSources publish to both of the EventBuses,
+	// while the Sensors still consume from the old one.
+	EventBusMigrationDualPublishing EventBusMigrationPhase = "DualPublishing"
+	// EventBusMigrationSwitchingSensors means the Sensors are being moved to the successor EventBus,
+	// the EventSources keep publishing to both of the EventBuses.
+	EventBusMigrationSwitchingSensors EventBusMigrationPhase = "SwitchingSensors"
+	// EventBusMigrationCompleted means both the EventSources and the Sensors use the successor EventBus only.
+	EventBusMigrationCompleted EventBusMigrationPhase = "Completed"
+)
+
+// EventBusSharing defines the namespaces an EventBus is shared with.
+type EventBusSharing struct {
+	// AllowedNamespaces is the list of namespaces whose EventSources and Sensors are allowed to use the EventBus.
+	// Each of the namespaces gets an isolated NATS account, with the credentials provisioned by the controller
+	// in a Secret in that namespace, so that namespaces can not read the events of each other.
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty" protobuf:"bytes,1,rep,name=allowedNamespaces"`
 }
 
 // EventBusStatus holds the status of the eventbus resource
@@ -48,6 +112,48 @@ type EventBusStatus struct {
 	Status `json:",inline" protobuf:"bytes,1,opt,name=status"`
 	// Config holds the fininalized configuration of EventBus
 	Config BusConfig `json:"config,omitempty" protobuf:"bytes,2,opt,name=config"`
+	// Migration holds the progress of the migration from another EventBus
+	// +optional
+	Migration *EventBusMigrationStatus `json:"migration,omitempty" protobuf:"bytes,3,opt,name=migration"`
+}
+
+// EventBusMigrationStatus holds the progress of an EventBus migration.
+type EventBusMigrationStatus struct {
+	// From is the name of the EventBus migrating from.
+	From string `json:"from" protobuf:"bytes,1,opt,name=from"`
+	// Phase of the migration.
+	Phase EventBusMigrationPhase `json:"phase" protobuf:"bytes,2,opt,name=phase,casttype=EventBusMigrationPhase"`
+	// DualPublishingSince is the time since when all the EventSources publish to both of the EventBuses.
+	// +optional
+	DualPublishingSince *metav1.Time `json:"dualPublishingSince,omitempty" protobuf:"bytes,3,opt,name=dualPublishingSince"`
+	// SwitchingSensorsSince is the time the Sensors started to switch over to the successor EventBus.
+	// +optional
+	SwitchingSensorsSince *metav1.Time `json:"switchingSensorsSince,omitempty" protobuf:"bytes,4,opt,name=switchingSensorsSince"`
+	// CompletedAt is the time the migration completed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty" protobuf:"bytes,5,opt,name=completedAt"`
+	// EventSources is the progress of the EventSources in the current phase.
+	// +optional
+	EventSources EventBusMigrationProgress `json:"eventSources,omitempty" protobuf:"bytes,6,opt,name=eventSources"`
+	// Sensors is the progress of the Sensors in the current phase.
+	// +optional
+	Sensors EventBusMigrationProgress `json:"sensors,omitempty" protobuf:"bytes,7,opt,name=sensors"`
+	// Message is a human readable description of the migration state.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,8,opt,name=message"`
+}
+
+// EventBusMigrationProgress is the number of the objects that are done with the current phase of a migration.
+type EventBusMigrationProgress struct {
+	// Total number of the objects using the EventBus migrating from.
+	Total int32 `json:"total" protobuf:"varint,1,opt,name=total"`
+	// Migrated is the number of the objects whose Deployment has rolled out with the configuration of the current phase.
+	Migrated int32 `json:"migrated" protobuf:"varint,2,opt,name=migrated"`
+}
+
+// Done tells if all of the objects are migrated.
+func (p EventBusMigrationProgress) Done() bool {
+	return p.Migrated >= p.Total
 }
 
 // BusConfig has the finalized configuration for EventBus
@@ -58,6 +164,53 @@ type BusConfig struct {
 	JetStream *JetStreamConfig `json:"jetstream,omitempty" protobuf:"bytes,2,opt,name=jetstream"`
 	// +optional
 	Kafka *KafkaBus `json:"kafka,omitempty" protobuf:"bytes,3,opt,name=kafka"`
+	// +optional
+	Redis *RedisBus `json:"redis,omitempty" protobuf:"bytes,5,opt,name=redis"`
+	// +optional
+	Pulsar *PulsarBus `json:"pulsar,omitempty" protobuf:"bytes,6,opt,name=pulsar"`
+	// Embedded is an in-process EventBus for local development and testing,
+	// it is never set by the controller.
+	// +optional
+	Embedded *EmbeddedBusConfig `json:"embedded,omitempty" protobuf:"bytes,4,opt,name=embedded"`
+}
+
+// EmbeddedBusConfig is the configuration of an in-process EventBus, the events are kept in memory
+// and are only delivered to the Sensors running in the same process.
+type EmbeddedBusConfig struct {
+	// Name of the in-process EventBus, EventSources and Sensors using the same name share the events.
+	// +optional
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+}
+
+// IsSharedWith tells if EventSources and Sensors in the namespace are allowed to use the EventBus.
+func (eb *EventBus) IsSharedWith(namespace string) bool {
+	if namespace == eb.Namespace {
+		return true
+	}
+	if eb.Spec.Sharing == nil {
+		return false
+	}
+	for _, ns := range eb.Spec.Sharing.AllowedNamespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// SharedNamespaces returns the sorted list of other namespaces the EventBus is shared with.
+func (eb *EventBus) SharedNamespaces() []string {
+	result := []string{}
+	if eb.Spec.Sharing == nil {
+		return result
+	}
+	for _, ns := range eb.Spec.Sharing.AllowedNamespaces {
+		if ns != eb.Namespace && !slices.Contains(result, ns) {
+			result = append(result, ns)
+		}
+	}
+	slices.Sort(result)
+	return result
 }
 
 const (
@@ -165,3 +165,27 @@ func TestEventBusStatusGetCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestEventBusSharing(t *testing.T) {
+	eb := &EventBus{}
+	eb.Namespace = "bus"
+	if !eb.IsSharedWith("bus") {
+		t.Error("expected the EventBus to be usable in its own namespace")
+	}
+	if eb.IsSharedWith("team-a") {
+		t.Error("expected the EventBus not to be shared")
+	}
+	if len(eb.SharedNamespaces()) != 0 {
+		t.Errorf("expected no shared namespaces, got %v", eb.SharedNamespaces())
+	}
+	eb.Spec.Sharing = &EventBusSharing{AllowedNamespaces: []string{"team-b", "bus", "team-a", "team-b"}}
+	if !eb.IsSharedWith("team-a") {
+		t.Error("expected the EventBus to be shared with team-a")
+	}
+	if eb.IsSharedWith("team-c") {
+		t.Error("expected the EventBus not to be shared with team-c")
+	}
+	if diff := cmp.Diff([]string{"team-a", "team-b"}, eb.SharedNamespaces()); diff != "" {
+		t.Errorf("unexpected shared namespaces (-want +got):\n%s", diff)
+	}
+}
@@ -32,6 +32,128 @@ type EventSourceFilter struct {
 	Expression string `json:"expression,omitempty" protobuf:"bytes,1,opt,name=expression"`
 }
 
+// EventSourceSchema is the JSON Schema the event data is validated with before it is published to the EventBus.
+// Exactly one of Inline, ConfigMap, URL and SchemaRegistry is required.
+type EventSourceSchema struct {
+	// Inline JSON Schema
+	// +optional
+	Inline string `json:"inline,omitempty" protobuf:"bytes,1,opt,name=inline"`
+	// ConfigMap key holding the JSON Schema
+	// +optional
+	ConfigMap *corev1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,2,opt,name=configMap"`
+	// URL of the JSON Schema, fetched when the event source starts
+	// +optional
+	URL string `json:"url,omitempty" protobuf:"bytes,3,opt,name=url"`
+	// SchemaRegistry to get the JSON Schema from, by its schema ID, or the latest version of Subject
+	// +optional
+	SchemaRegistry *SchemaRegistryConfig `json:"schemaRegistry,omitempty" protobuf:"bytes,4,opt,name=schemaRegistry"`
+	// Subject of the schema registry, the latest version of its schema is used instead of the schema ID
+	// +optional
+	Subject string `json:"subject,omitempty" protobuf:"bytes,5,opt,name=subject"`
+	// DeadLetter publishes the invalid events with another event name, instead of dropping them
+	// +optional
+	DeadLetter *EventSourceDeadLetter `json:"deadLetter,omitempty" protobuf:"bytes,6,opt,name=deadLetter"`
+}
+
+// EventSourceDeadLetter is where the invalid events are published to
+type EventSourceDeadLetter struct {
+	// EventName the invalid events are published with, sensors depend on it like on the other events of the event source.
+	// It must not be the name of another event of the event source.
+	EventName string `json:"eventName" protobuf:"bytes,1,opt,name=eventName"`
+}
+
+// EventSourceTransform shapes the event data before it is published to the EventBus, e.g. to trim large payloads
+// once instead of in every sensor, and redacts its fields, e.g. secrets and PII.
+// At most one of JQ, Script and Expression is allowed, the redaction applies to their output.
+type EventSourceTransform struct {
+	// JQ holds the jq command applied to the event data, its output must be a JSON object
+	// +optional
+	JQ string `json:"jq,omitempty" protobuf:"bytes,1,opt,name=jq"`
+	// Script refers to a Lua script transforming the event data, available as the global "event",
+	// it must return a table
+	// +optional
+	Script string `json:"script,omitempty" protobuf:"bytes,2,opt,name=script"`
+	// Expression is an expr expression evaluated on the fields of the event data like the filter,
+	// it must return a map
+	// +optional
+	Expression string `json:"expression,omitempty" protobuf:"bytes,3,opt,name=expression"`
+	// Drop is the list of the JSONPaths of the fields removed from the event data, e.g. $.body.password
+	// +optional
+	Drop []string `json:"drop,omitempty" protobuf:"bytes,4,rep,name=drop"`
+	// Mask is the list of the JSONPaths of the fields whose values are replaced with "******", e.g. $..email
+	// +optional
+	Mask []string `json:"mask,omitempty" protobuf:"bytes,5,rep,name=mask"`
+}
+
+// EventSourceRoute publishes the events matching a condition with another event name, so the sensors can depend on
+// them directly. Exactly one of Expression and JSONPath is required.
+type EventSourceRoute struct {
+	// EventName the matching events are published with.
+	// It must not be the name of another event of the event source.
+	EventName string `json:"eventName" protobuf:"bytes,1,opt,name=eventName"`
+	// Expression is an expr expression evaluated on the fields of the event data like the filter,
+	// the event matches if it is true
+	// +optional
+	Expression string `json:"expression,omitempty" protobuf:"bytes,2,opt,name=expression"`
+	// JSONPath selects a value of the event data, the event matches if the value exists, and equals Value if any,
+	// e.g. $.header['X-Github-Event']
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty" protobuf:"bytes,3,opt,name=jsonPath"`
+	// Value the value selected by JSONPath must be equal to, or one of the values if it is a list
+	// +optional
+	Value string `json:"value,omitempty" protobuf:"bytes,4,opt,name=value"`
+	// Type is the CloudEvent type of the matching events, the type of the event source by default
+	// +optional
+	Type string `json:"type,omitempty" protobuf:"bytes,5,opt,name=type"`
+}
+
+// PayloadDecoder decodes the binary payloads of the messages into JSON before the events are published, so the
+// filters and the parameters of the sensors can address their fields.
+// Exactly one of Avro, Protobuf, Confluent and Pulsar is required.
+type PayloadDecoder struct {
+	// Avro decodes the payloads encoded with an Avro schema
+	// +optional
+	Avro *AvroDecoder `json:"avro,omitempty" protobuf:"bytes,1,opt,name=avro"`
+	// Protobuf decodes the payloads encoded as a Protobuf message
+	// +optional
+	Protobuf *ProtobufDecoder `json:"protobuf,omitempty" protobuf:"bytes,2,opt,name=protobuf"`
+	// Confluent decodes the payloads in the Confluent wire format, with the Avro, Protobuf or JSON Schema of the
+	// schema registry
+	// +optional
+	Confluent *SchemaRegistryConfig `json:"confluent,omitempty" protobuf:"bytes,3,opt,name=confluent"`
+	// Pulsar decodes the payloads with the Avro, Protobuf native or JSON schema of the topic in the Pulsar schema registry
+	// +optional
+	Pulsar *PulsarSchemaRegistry `json:"pulsar,omitempty" protobuf:"bytes,4,opt,name=pulsar"`
+}
+
+// AvroDecoder is the Avro schema of the payloads, exactly one of Schema and ConfigMap is required
+type AvroDecoder struct {
+	// Schema is the inline Avro schema
+	// +optional
+	Schema string `json:"schema,omitempty" protobuf:"bytes,1,opt,name=schema"`
+	// ConfigMap key holding the Avro schema
+	// +optional
+	ConfigMap *corev1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,2,opt,name=configMap"`
+}
+
+// ProtobufDecoder is the Protobuf message of the payloads
+type ProtobufDecoder struct {
+	// DescriptorSet is the ConfigMap key holding the serialized FileDescriptorSet of the message, including its
+	// imports, e.g. generated with "protoc --include_imports --descriptor_set_out".
+	DescriptorSet *corev1.ConfigMapKeySelector `json:"descriptorSet" protobuf:"bytes,1,opt,name=descriptorSet"`
+	// MessageType is the full name of the message, e.g. acme.orders.v1.Order
+	MessageType string `json:"messageType" protobuf:"bytes,2,opt,name=messageType"`
+}
+
+// PulsarSchemaRegistry is the Pulsar admin API the schemas of the topics are read from
+type PulsarSchemaRegistry struct {
+	// AdminURL is the URL of the Pulsar admin API, e.g. http://pulsar-broker:8080
+	AdminURL string `json:"adminURL" protobuf:"bytes,1,opt,name=adminURL"`
+	// AuthTokenSecret is the secret selector to the token authenticating the requests to the admin API
+	// +optional
+	AuthTokenSecret *corev1.SecretKeySelector `json:"authTokenSecret,omitempty" protobuf:"bytes,2,opt,name=authTokenSecret"`
+}
+
 // EventSourceSpec refers to specification of event-source resource
 type EventSourceSpec struct {
 	// EventBusName references to a EventBus name. By default the value is "default"
@@ -108,6 +230,24 @@ type EventSourceSpec struct {
 	Gerrit map[string]GerritEventSource `json:"gerrit,omitempty" protobuf:"bytes,35,rep,name=gerrit"`
 	// MNS event sources
 	MNS map[string]MNSEventSource `json:"mns,omitempty" protobuf:"bytes,36,rep,name=mns"`
+	// EventBusNamespace references to the namespace of the EventBus, defaults to the namespace of the EventSource.
+	// An EventBus in another namespace needs to have this namespace in its "spec.sharing.allowedNamespaces".
+	// +optional
+	EventBusNamespace string `json:"eventBusNamespace,omitempty" protobuf:"bytes,37,opt,name=eventBusNamespace"`
+	// Poll event sources
+	Poll map[string]PollEventSource `json:"poll,omitempty" protobuf:"bytes,38,rep,name=poll"`
+	// Postgres event sources
+	Postgres map[string]PostgresEventSource `json:"postgres,omitempty" protobuf:"bytes,39,rep,name=postgres"`
+	// GRPC event sources
+	GRPC map[string]GRPCEventSource `json:"grpc,omitempty" protobuf:"bytes,40,rep,name=grpc"`
+	// Kubernetes event sources
+	Kubernetes map[string]KubernetesEventSource `json:"kubernetes,omitempty" protobuf:"bytes,41,rep,name=kubernetes"`
+	// Gitea event sources, for Gitea and Forgejo
+	Gitea map[string]GiteaEventSource `json:"gitea,omitempty" protobuf:"bytes,42,rep,name=gitea"`
+	// Azure DevOps event sources
+	AzureDevOps map[string]AzureDevOpsEventSource `json:"azureDevOps,omitempty" protobuf:"bytes,43,rep,name=azureDevOps"`
+	// ObjectStore event sources, for any S3 compatible object store
+	ObjectStore map[string]ObjectStoreEventSource `json:"objectStore,omitempty" protobuf:"bytes,44,rep,name=objectStore"`
 }
 
 func (e EventSourceSpec) GetReplicas() int32 {
@@ -153,6 +293,66 @@ type WebhookEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,2,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,5,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,6,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,7,rep,name=routes"`
+	// CloudEvents accepts CloudEvents in the binary, structured or batched HTTP mode, and publishes them
+	// as they are instead of wrapping the request. The id, type, time and extensions of the CloudEvents
+	// are kept, the source and the subject are kept in the "originsource" and "originsubject" extensions.
+	// The method must be POST.
+	// +optional
+	CloudEvents bool `json:"cloudEvents,omitempty" protobuf:"varint,3,opt,name=cloudEvents"`
+	// Split publishes an event for each element of the body of a request, e.g. a batch of events,
+	// instead of an event for the request.
+	// +optional
+	Split *WebhookSplit `json:"split,omitempty" protobuf:"bytes,4,opt,name=split"`
+}
+
+// WebhookSplit splits the body of the requests into the bodies of several events.
+// One of jsonPath, ndjson and multipart must be specified.
+type WebhookSplit struct {
+	// JSONPath is the path of an array in the JSON body, in the gjson syntax, or "@this" if the body is an array.
+	// Each element of the array is the body of an event.
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty" protobuf:"bytes,1,opt,name=jsonPath"`
+	// NDJSON splits a newline delimited JSON body, each line is the body of an event. The empty lines are skipped.
+	// +optional
+	NDJSON bool `json:"ndjson,omitempty" protobuf:"varint,2,opt,name=ndjson"`
+	// Multipart publishes an event for each part of a multipart/form-data body.
+	// +optional
+	Multipart *WebhookMultipartSplit `json:"multipart,omitempty" protobuf:"bytes,3,opt,name=multipart"`
+	// BestEffort publishes all the events it can, and the request succeeds unless none of its events is published.
+	// By default, the request fails as soon as an event can't be published, so the sender retries the whole request.
+	// +optional
+	BestEffort bool `json:"bestEffort,omitempty" protobuf:"varint,4,opt,name=bestEffort"`
+}
+
+// WebhookMultipartSplit publishes an event for each part of a multipart/form-data body
+type WebhookMultipartSplit struct {
+	// S3 is the bucket the file parts are streamed to, the bucket key is the prefix of their objects.
+	// The events reference the objects by URL. Without it, the content of the file parts is in the events, base64 encoded.
+	// +optional
+	S3 *S3Artifact `json:"s3,omitempty" protobuf:"bytes,1,opt,name=s3"`
+	// MaxFileSize is the maximum size in bytes of a file part streamed to the S3 bucket, the request fails if a file is larger.
+	// The maxPayloadSize of the webhook applies to the bodies of the events, the other parts included. Defaults to 100MB.
+	// +optional
+	MaxFileSize *int64 `json:"maxFileSize,omitempty" protobuf:"varint,2,opt,name=maxFileSize"`
+}
+
+const DefaultMaxWebhookFileSize int64 = 104857600 // 100MB
+
+func (s *WebhookMultipartSplit) GetMaxFileSize() int64 {
+	if s != nil && s.MaxFileSize != nil {
+		return *s.MaxFileSize
+	}
+	return DefaultMaxWebhookFileSize
 }
 
 // CalendarEventSource describes a time based dependency. One of the fields (schedule, interval, or recurrence) must be passed.
@@ -177,6 +377,44 @@ type CalendarEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,8,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,14,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,15,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,16,rep,name=routes"`
+	// Schedules is a list of additional cron-like expressions, the event is fired at the times of all the schedules.
+	// +optional
+	Schedules []string `json:"schedules,omitempty" protobuf:"bytes,9,rep,name=schedules"`
+	// At is a list of one-shot times in RFC3339 format, e.g. 2026-12-31T23:00:00Z, the event is fired once at each of them.
+	// +optional
+	At []string `json:"at,omitempty" protobuf:"bytes,10,rep,name=at"`
+	// BusinessDaysOnly skips the times on Saturdays and Sundays in the timezone.
+	// +optional
+	BusinessDaysOnly bool `json:"businessDaysOnly,omitempty" protobuf:"varint,11,opt,name=businessDaysOnly"`
+	// Holidays is a calendar of the dates to skip, in addition to the exclusion dates.
+	// +optional
+	Holidays *HolidayCalendar `json:"holidays,omitempty" protobuf:"bytes,12,opt,name=holidays"`
+	// Jitter is the maximum random delay added to each time, e.g. 30s, to spread the events of many event sources.
+	// +optional
+	Jitter string `json:"jitter,omitempty" protobuf:"bytes,13,opt,name=jitter"`
+}
+
+// HolidayCalendar is a calendar in the iCalendar format, the dates of its events are skipped.
+type HolidayCalendar struct {
+	// URL to load the calendar from
+	// +optional
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// ConfigMap refers to the key of the ConfigMap holding the calendar
+	// +optional
+	ConfigMap *corev1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,2,opt,name=configMap"`
+	// RefreshInterval is the interval to reload the calendar, defaults to 24h
+	// +optional
+	RefreshInterval string `json:"refreshInterval,omitempty" protobuf:"bytes,3,opt,name=refreshInterval"`
 }
 
 type EventPersistence struct {
@@ -219,6 +457,16 @@ type FileEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,5,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,6,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,7,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,8,rep,name=routes"`
 }
 
 // SFTPEventSource describes an event-source for sftp related events.
@@ -242,12 +490,304 @@ type SFTPEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,8,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,10,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,11,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,12,rep,name=routes"`
 	// PollIntervalDuration the interval at which to poll the SFTP server
 	// defaults to 10 seconds
 	// +optional
 	PollIntervalDuration string `json:"pollIntervalDuration" protobuf:"varint,9,opt,name=pollIntervalDuration"`
 }
 
+// PollEventSource describes an event source polling an HTTP endpoint, one event is emitted for each new or changed item.
+type PollEventSource struct {
+	// URL of the endpoint to poll
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Method of the request, defaults to GET
+	// +optional
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Headers of the request
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// SecureHeaders holds the headers of the request whose values are read from secrets or configmaps
+	// +optional
+	SecureHeaders []*SecureHeader `json:"secureHeaders,omitempty" protobuf:"bytes,4,rep,name=secureHeaders"`
+	// Body of the request
+	// +optional
+	Body string `json:"body,omitempty" protobuf:"bytes,5,opt,name=body"`
+	// BasicAuth configuration for the request
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,6,opt,name=basicAuth"`
+	// OAuth2 gets an access token with the client credentials grant for the request
+	// +optional
+	OAuth2 *OAuth2ClientCredentials `json:"oauth2,omitempty" protobuf:"bytes,7,opt,name=oauth2"`
+	// TLS configuration for the HTTP client
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty" protobuf:"bytes,8,opt,name=tls"`
+	// Interval is a string that describes the interval between two polls, e.g. 30s, 5m...
+	// Either interval or schedule must be specified.
+	// +optional
+	Interval string `json:"interval,omitempty" protobuf:"bytes,9,opt,name=interval"`
+	// Schedule is a cron-like expression of the polls. For reference, see: https://en.wikipedia.org/wiki/Cron
+	// +optional
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,10,opt,name=schedule"`
+	// Timeout of a request, defaults to 30s
+	// +optional
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,11,opt,name=timeout"`
+	// ItemsPath is the JSONPath of the item array in the response body, e.g. "$.data.items".
+	// If it is not specified, the whole response body is treated as a single item.
+	// +optional
+	ItemsPath string `json:"itemsPath,omitempty" protobuf:"bytes,12,opt,name=itemsPath"`
+	// IDPath is the JSONPath of the ID of an item, relative to the item, e.g. "$.id".
+	// With an ID, an event is emitted when an item is new or its content changed,
+	// otherwise the items are identified by the hash of their content, and an event is emitted for each new content.
+	// +optional
+	IDPath string `json:"idPath,omitempty" protobuf:"bytes,13,opt,name=idPath"`
+	// Pagination configures how to get the following pages of the response
+	// +optional
+	Pagination *PollPagination `json:"pagination,omitempty" protobuf:"bytes,14,opt,name=pagination"`
+	// Cursor configures an incremental cursor, which is read from a response and sent with the request of the next poll
+	// +optional
+	Cursor *PollCursor `json:"cursor,omitempty" protobuf:"bytes,15,opt,name=cursor"`
+	// Persistence holds the configuration to store the ETag, cursor and the hash of the items seen,
+	// so the items are not emitted again after the event source restarts.
+	// +optional
+	Persistence *EventPersistence `json:"persistence,omitempty" protobuf:"bytes,16,opt,name=persistence"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,17,rep,name=metadata"`
+	// Filter
+	// +optional
+	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,18,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,19,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,20,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,21,rep,name=routes"`
+}
+
+// PollPagination describes how to get the following pages of a response
+type PollPagination struct {
+	// NextURLPath is the JSONPath of the URL of the next page in the response body
+	// +optional
+	NextURLPath string `json:"nextURLPath,omitempty" protobuf:"bytes,1,opt,name=nextURLPath"`
+	// CursorPath is the JSONPath of the cursor of the next page in the response body, the cursor is sent as the cursorParam query parameter
+	// +optional
+	CursorPath string `json:"cursorPath,omitempty" protobuf:"bytes,2,opt,name=cursorPath"`
+	// CursorParam is the query parameter to send the cursor of the next page with
+	// +optional
+	CursorParam string `json:"cursorParam,omitempty" protobuf:"bytes,3,opt,name=cursorParam"`
+	// LinkHeader follows the "next" relation of the Link response header
+	// +optional
+	LinkHeader bool `json:"linkHeader,omitempty" protobuf:"varint,4,opt,name=linkHeader"`
+	// MaxPages is the maximum number of pages to get in a poll, defaults to 10
+	// +optional
+	MaxPages int32 `json:"maxPages,omitempty" protobuf:"varint,5,opt,name=maxPages"`
+}
+
+// PollCursor describes an incremental cursor sent with the request of the next poll
+type PollCursor struct {
+	// Path is the JSONPath of the cursor in the response body, the value of the last page is used
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// Param is the query parameter to send the cursor with
+	Param string `json:"param" protobuf:"bytes,2,opt,name=param"`
+}
+
+// PostgresEventSource describes an event source consuming the row changes of PostgreSQL tables
+// from a logical replication slot with the pgoutput plugin.
+type PostgresEventSource struct {
+	// Host of the PostgreSQL server
+	Host string `json:"host" protobuf:"bytes,1,opt,name=host"`
+	// Port of the PostgreSQL server, defaults to 5432
+	// +optional
+	Port int32 `json:"port,omitempty" protobuf:"varint,2,opt,name=port"`
+	// Database to replicate the changes of
+	Database string `json:"database" protobuf:"bytes,3,opt,name=database"`
+	// Username refers to the K8s secret that holds the username, the user needs the REPLICATION attribute.
+	Username *corev1.SecretKeySelector `json:"username,omitempty" protobuf:"bytes,4,opt,name=username"`
+	// Password refers to the K8s secret that holds the password
+	// +optional
+	Password *corev1.SecretKeySelector `json:"password,omitempty" protobuf:"bytes,5,opt,name=password"`
+	// TLS configuration for the connection
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty" protobuf:"bytes,6,opt,name=tls"`
+	// Slot is the name of the logical replication slot
+	Slot string `json:"slot" protobuf:"bytes,7,opt,name=slot"`
+	// CreateSlot creates the replication slot with the pgoutput plugin if it does not exist
+	// +optional
+	CreateSlot bool `json:"createSlot,omitempty" protobuf:"varint,8,opt,name=createSlot"`
+	// Publications to replicate the changes of, the publications need to exist.
+	Publications []string `json:"publications" protobuf:"bytes,9,rep,name=publications"`
+	// Tables filters the changes by table, in the form of "schema.table", or "table" for the public schema.
+	// All the tables of the publications are included if it is not specified.
+	// +optional
+	Tables []string `json:"tables,omitempty" protobuf:"bytes,10,rep,name=tables"`
+	// Operations filters the changes by operation, one or more of "insert", "update" and "delete".
+	// All the operations are included if it is not specified.
+	// +optional
+	Operations []string `json:"operations,omitempty" protobuf:"bytes,11,rep,name=operations"`
+	// StatusInterval is the interval to report the confirmed position to the server, defaults to 10s
+	// +optional
+	StatusInterval string `json:"statusInterval,omitempty" protobuf:"bytes,12,opt,name=statusInterval"`
+	// ConnectionBackoff holds backoff applied to connection.
+	// +optional
+	ConnectionBackoff *Backoff `json:"connectionBackoff,omitempty" protobuf:"bytes,13,opt,name=connectionBackoff"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,14,rep,name=metadata"`
+	// Filter
+	// +optional
+	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,15,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,16,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,17,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,18,rep,name=routes"`
+}
+
+// GRPCEventSource describes an event source serving a gRPC and Connect endpoint, clients push
+// events in the CloudEvents protobuf format to it.
+type GRPCEventSource struct {
+	// Port on which the server listens for the gRPC and Connect requests.
+	Port string `json:"port" protobuf:"bytes,1,opt,name=port"`
+	// ServerCertSecret refers to the secret that contains the TLS certificate of the server.
+	// +optional
+	ServerCertSecret *corev1.SecretKeySelector `json:"serverCertSecret,omitempty" protobuf:"bytes,2,opt,name=serverCertSecret"`
+	// ServerKeySecret refers to the secret that contains the TLS private key of the server.
+	// +optional
+	ServerKeySecret *corev1.SecretKeySelector `json:"serverKeySecret,omitempty" protobuf:"bytes,3,opt,name=serverKeySecret"`
+	// AuthSecret refers to the secret that contains the bearer token the clients need to send.
+	// +optional
+	AuthSecret *corev1.SecretKeySelector `json:"authSecret,omitempty" protobuf:"bytes,4,opt,name=authSecret"`
+	// MaxMessageSize is the maximum size in bytes of a received message, defaults to 4194304 (4MB).
+	// +optional
+	MaxMessageSize *int64 `json:"maxMessageSize,omitempty" protobuf:"varint,5,opt,name=maxMessageSize"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,6,rep,name=metadata"`
+	// Filter
+	// +optional
+	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,7,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,8,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,9,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,10,rep,name=routes"`
+}
+
+// KubernetesEventSource describes an event source for the K8s Events (core/v1 Event) reporting what
+// happens to the objects in the cluster, and the audit events sent by the API server.
+type KubernetesEventSource struct {
+	// Events watches the core/v1 Events.
+	// +optional
+	Events *KubernetesEventsWatch `json:"events,omitempty" protobuf:"bytes,1,opt,name=events"`
+	// Audit receives the batches of audit events sent by the API server audit webhook backend.
+	// +optional
+	Audit *KubernetesAuditWebhook `json:"audit,omitempty" protobuf:"bytes,2,opt,name=audit"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,3,rep,name=metadata"`
+	// Filter
+	// +optional
+	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,4,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,5,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,6,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,7,rep,name=routes"`
+}
+
+// KubernetesEventsWatch describes the core/v1 Events to watch. An event is dispatched when a K8s Event
+// is created, or updated with a different reason, type or message. The updates only bumping the count
+// of a recurring K8s Event are not dispatched.
+type KubernetesEventsWatch struct {
+	// Namespace to watch the K8s Events in, all the namespaces if it is not specified.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// Reasons filters the K8s Events by reason, e.g. BackOff, FailedScheduling.
+	// +optional
+	Reasons []string `json:"reasons,omitempty" protobuf:"bytes,2,rep,name=reasons"`
+	// Types filters the K8s Events by type, Normal or Warning.
+	// +optional
+	Types []string `json:"types,omitempty" protobuf:"bytes,3,rep,name=types"`
+	// InvolvedObject filters the K8s Events by the object they are about.
+	// +optional
+	InvolvedObject *InvolvedObjectFilter `json:"involvedObject,omitempty" protobuf:"bytes,4,opt,name=involvedObject"`
+	// IncludeInvolvedObject adds the metadata of the involved object to the event payload.
+	// It needs the permissions to get the involved objects.
+	// +optional
+	IncludeInvolvedObject bool `json:"includeInvolvedObject,omitempty" protobuf:"varint,5,opt,name=includeInvolvedObject"`
+	// DeduplicationWindow is the duration during which the K8s Events with the same involved object,
+	// reason, type and message are dispatched only once, e.g. 10m. By default, only the updates of the
+	// same K8s Event are deduplicated.
+	// +optional
+	DeduplicationWindow string `json:"deduplicationWindow,omitempty" protobuf:"bytes,6,opt,name=deduplicationWindow"`
+}
+
+// InvolvedObjectFilter filters the K8s Events by their involved object.
+type InvolvedObjectFilter struct {
+	// Kinds of the involved object, e.g. Pod.
+	// +optional
+	Kinds []string `json:"kinds,omitempty" protobuf:"bytes,1,rep,name=kinds"`
+	// Names of the involved object, regular expressions are supported.
+	// +optional
+	Names []string `json:"names,omitempty" protobuf:"bytes,2,rep,name=names"`
+	// Labels of the involved object, same as the label filter of the resource event source.
+	// It needs the permissions to get the involved objects.
+	// +optional
+	Labels []Selector `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
+}
+
+// KubernetesAuditWebhook describes the endpoint receiving the audit events from the API server
+// audit webhook backend, each audit event of a batch is dispatched as an event.
+type KubernetesAuditWebhook struct {
+	// Webhook holds configuration for the endpoint the audit webhook backend sends the batches to.
+	Webhook *WebhookContext `json:"webhook" protobuf:"bytes,1,opt,name=webhook"`
+	// Verbs filters the audit events by verb, e.g. create, delete.
+	// +optional
+	Verbs []string `json:"verbs,omitempty" protobuf:"bytes,2,rep,name=verbs"`
+	// Resources filters the audit events by resource, in the form of "resource" for the core group,
+	// or "group/resource", e.g. secrets, apps/deployments.
+	// +optional
+	Resources []string `json:"resources,omitempty" protobuf:"bytes,3,rep,name=resources"`
+	// Namespaces filters the audit events by the namespace of the object.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,4,rep,name=namespaces"`
+	// Users filters the audit events by the name of the user making the request.
+	// +optional
+	Users []string `json:"users,omitempty" protobuf:"bytes,5,rep,name=users"`
+	// Stages filters the audit events by stage, defaults to ResponseComplete.
+	// +optional
+	Stages []string `json:"stages,omitempty" protobuf:"bytes,6,rep,name=stages"`
+}
+
 // ResourceEventType is the type of event for the K8s resource mutation
 type ResourceEventType string
 
@@ -274,6 +814,24 @@ type ResourceEventSource struct {
 	// Metadata holds the user defined metadata which will passed along the event payload.
 	// +optional
 	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,5,rep,name=metadata"`
+	// Resources is the list of additional resources to watch in the same event.
+	// The group, version and resource above are optional if it is specified.
+	// +optional
+	Resources []metav1.GroupVersionResource `json:"resources,omitempty" protobuf:"bytes,6,rep,name=resources"`
+	// NamespaceSelector selects the namespaces to watch by their labels, when the namespace is not specified.
+	// It needs the permissions to list and watch namespaces. Cluster scoped resources are not filtered by it.
+	// +optional
+	NamespaceSelector []Selector `json:"namespaceSelector,omitempty" protobuf:"bytes,7,rep,name=namespaceSelector"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,8,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,9,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,10,rep,name=routes"`
 }
 
 // ResourceFilter contains K8s ObjectMeta information to further filter resource event objects
@@ -302,6 +860,24 @@ type ResourceFilter struct {
 	// If the resource is created after the start time then the event is treated as valid.
 	// +optional
 	AfterStart bool `json:"afterStart,omitempty" protobuf:"varint,5,opt,name=afterStart"`
+	// ChangedFields only lets the UPDATE events pass if any of the fields changed, e.g. "status.phase".
+	// The fields are paths in the GJSON syntax, see https://github.com/tidwall/gjson/blob/master/SYNTAX.md.
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty" protobuf:"bytes,6,rep,name=changedFields"`
+	// Conditions only lets the UPDATE events pass if any of the status conditions transitioned.
+	// An UPDATE event passes if it matches either the changed fields or the conditions.
+	// +optional
+	Conditions []ResourceConditionFilter `json:"conditions,omitempty" protobuf:"bytes,7,rep,name=conditions"`
+}
+
+// ResourceConditionFilter selects the transitions of a condition in "status.conditions" of a resource
+type ResourceConditionFilter struct {
+	// Type of the condition, e.g. Ready
+	Type string `json:"type" protobuf:"bytes,1,opt,name=type"`
+	// Status the condition transitions to, one of "True", "False" and "Unknown".
+	// Any transition of the status passes if it is not specified.
+	// +optional
+	Status string `json:"status,omitempty" protobuf:"bytes,2,opt,name=status"`
 }
 
 // Selector represents conditional operation to select K8s objects.
@@ -369,6 +945,16 @@ type AMQPEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,15,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,16,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,17,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,18,rep,name=routes"`
 }
 
 // AMQPExchangeDeclareConfig holds the configuration for the exchange on the server
@@ -430,7 +1016,9 @@ type AMQPConsumeConfig struct {
 	// +optional
 	ConsumerTag string `json:"consumerTag,omitempty" protobuf:"bytes,1,opt,name=consumerTag"`
 	// AutoAck when true, the server will acknowledge deliveries to this consumer prior to writing
-	// the delivery to the network
+	// the delivery to the network. When false, the deliveries are acknowledged after the events are
+	// published to the EventBus, and requeued when publishing fails. Defaults to true when the consume
+	// settings are not specified.
 	// +optional
 	AutoAck bool `json:"autoAck,omitempty" protobuf:"varint,2,opt,name=autoAck"`
 	// Exclusive when true, the server will ensure that this is the sole consumer from this queue
@@ -483,6 +1071,16 @@ type KafkaEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,12,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,16,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,18,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,19,rep,name=routes"`
 	// Yaml format Sarama config for Kafka connection.
 	// It follows the struct of sarama.Config. See https://github.com/IBM/sarama/blob/main/config.go
 	// e.g.
@@ -503,6 +1101,9 @@ type KafkaEventSource struct {
 	// When set, TLS is enabled automatically and the SASL config field is ignored.
 	// +optional
 	AWSMSKIAMAuth *AWSMSKIAMConfig `json:"awsMskIamAuth,omitempty" protobuf:"bytes,15,opt,name=awsMskIamAuth"`
+	// Decoder decodes the binary payloads into JSON, instead of SchemaRegistry
+	// +optional
+	Decoder *PayloadDecoder `json:"decoder,omitempty" protobuf:"bytes,17,opt,name=decoder"`
 }
 
 type KafkaConsumerGroup struct {
@@ -520,7 +1121,8 @@ type KafkaConsumerGroup struct {
 type MQTTEventSource struct {
 	// URL to connect to broker
 	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
-	// Topic name
+	// Topic name, it is subscribed with QoS 0, use topics to subscribe with a different QoS.
+	// +optional
 	Topic string `json:"topic" protobuf:"bytes,2,opt,name=topic"`
 	// ClientID is the id of the client
 	ClientID string `json:"clientId" protobuf:"bytes,3,opt,name=clientId"`
@@ -539,16 +1141,50 @@ type MQTTEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,8,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,13,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,14,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,15,rep,name=routes"`
 	// Auth hosts secret selectors for username and password
 	// +optional
 	Auth *BasicAuth `json:"auth,omitempty" protobuf:"bytes,9,opt,name=auth"`
+	// Topics is the list of topic filters to subscribe to, each with its own QoS.
+	// +optional
+	Topics []MQTTTopic `json:"topics,omitempty" protobuf:"bytes,10,rep,name=topics"`
+	// CleanSession specifies if the broker discards the session when the client disconnects, defaults to true.
+	// Set it to false to have a persistent session, the broker keeps the subscriptions and the unacknowledged
+	// QoS 1 and 2 messages for the client ID, and delivers them once the client reconnects.
+	// +optional
+	CleanSession *bool `json:"cleanSession,omitempty" protobuf:"varint,11,opt,name=cleanSession"`
+	// SharedGroup subscribes to all the topics as shared subscriptions of the group, i.e. "$share/{group}/{topic}",
+	// the broker distributes the messages among the subscribers of the group.
+	// +optional
+	SharedGroup string `json:"sharedGroup,omitempty" protobuf:"bytes,12,opt,name=sharedGroup"`
+}
+
+// MQTTTopic is a topic filter to subscribe to
+type MQTTTopic struct {
+	// Topic filter, wildcards and shared subscriptions like "$share/{group}/{topic}" are supported.
+	Topic string `json:"topic" protobuf:"bytes,1,opt,name=topic"`
+	// QoS of the subscription, one of 0, 1 or 2, defaults to 0.
+	// Messages with QoS 1 and 2 are acknowledged after the events are published to the EventBus.
+	// +optional
+	QoS int32 `json:"qos,omitempty" protobuf:"varint,2,opt,name=qos"`
 }
 
 // NATSEventsSource refers to event-source for NATS related events
 type NATSEventsSource struct {
 	// URL to connect to NATS cluster
 	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
-	// Subject holds the name of the subject onto which messages are published
+	// Subject holds the name of the subject onto which messages are published.
+	// With JetStream, it is used as the filter subject of the consumer if filterSubjects is not specified.
+	// +optional
 	Subject string `json:"subject" protobuf:"bytes,2,opt,name=subject"`
 	// ConnectionBackoff holds backoff applied to connection.
 	ConnectionBackoff *Backoff `json:"connectionBackoff,omitempty" protobuf:"bytes,3,opt,name=connectionBackoff"`
@@ -568,10 +1204,83 @@ type NATSEventsSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,8,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,11,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,13,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,14,rep,name=routes"`
 	// Queue is the name of the queue group to subscribe as if specified. Uses QueueSubscribe
 	// logic to subscribe as queue group. If the queue is empty, uses default Subscribe logic.
 	// +optional
 	Queue *string `json:"queue" protobuf:"bytes,9,opt,name=queue"`
+	// JetStream consumes the messages from a JetStream stream with a durable consumer instead of the core NATS subscription,
+	// the messages are acknowledged after the events are published to the EventBus.
+	// +optional
+	JetStream *NATSJetStreamConsumer `json:"jetStream,omitempty" protobuf:"bytes,10,opt,name=jetStream"`
+	// Decoder decodes the binary payloads into JSON
+	// +optional
+	Decoder *PayloadDecoder `json:"decoder,omitempty" protobuf:"bytes,12,opt,name=decoder"`
+}
+
+// NATSJetStreamConsumerMode is the mode of a JetStream consumer.
+type NATSJetStreamConsumerMode string
+
+const (
+	NATSJetStreamConsumerPull NATSJetStreamConsumerMode = "Pull"
+	NATSJetStreamConsumerPush NATSJetStreamConsumerMode = "Push"
+)
+
+// NATSJetStreamDeliverPolicy is where a JetStream consumer starts to deliver messages from.
+type NATSJetStreamDeliverPolicy string
+
+const (
+	NATSJetStreamDeliverAll             NATSJetStreamDeliverPolicy = "All"
+	NATSJetStreamDeliverNew             NATSJetStreamDeliverPolicy = "New"
+	NATSJetStreamDeliverByStartTime     NATSJetStreamDeliverPolicy = "ByStartTime"
+	NATSJetStreamDeliverByStartSequence NATSJetStreamDeliverPolicy = "ByStartSequence"
+)
+
+// NATSJetStreamConsumer refers to the durable consumer to consume a JetStream stream with.
+type NATSJetStreamConsumer struct {
+	// Stream is the name of the stream to consume, the stream must exist.
+	Stream string `json:"stream" protobuf:"bytes,1,opt,name=stream"`
+	// Consumer is the name of the durable consumer, it is created if it does not exist.
+	// Defaults to {eventsource_name}-{event_name}.
+	// +optional
+	Consumer string `json:"consumer,omitempty" protobuf:"bytes,2,opt,name=consumer"`
+	// Mode of the consumer, "Pull" or "Push", defaults to "Pull".
+	// A push consumer uses the queue as its deliver group if it is specified.
+	// +optional
+	Mode NATSJetStreamConsumerMode `json:"mode,omitempty" protobuf:"bytes,3,opt,name=mode,casttype=NATSJetStreamConsumerMode"`
+	// DeliverPolicy is where the consumer starts to deliver messages from when it is created,
+	// "All", "New", "ByStartTime" or "ByStartSequence", defaults to "All".
+	// +optional
+	DeliverPolicy NATSJetStreamDeliverPolicy `json:"deliverPolicy,omitempty" protobuf:"bytes,4,opt,name=deliverPolicy,casttype=NATSJetStreamDeliverPolicy"`
+	// StartTime is the time in RFC3339 format to start from, required by the "ByStartTime" deliver policy.
+	// +optional
+	StartTime string `json:"startTime,omitempty" protobuf:"bytes,5,opt,name=startTime"`
+	// StartSequence is the stream sequence to start from, required by the "ByStartSequence" deliver policy.
+	// +optional
+	StartSequence uint64 `json:"startSequence,omitempty" protobuf:"varint,6,opt,name=startSequence"`
+	// FilterSubjects are the subjects of the stream to consume, defaults to the subject if it is specified,
+	// otherwise all the subjects of the stream.
+	// +optional
+	FilterSubjects []string `json:"filterSubjects,omitempty" protobuf:"bytes,7,rep,name=filterSubjects"`
+	// MaxAckPending is the max number of the messages delivered but not acknowledged yet.
+	// Defaults to the server default.
+	// +optional
+	MaxAckPending int32 `json:"maxAckPending,omitempty" protobuf:"varint,8,opt,name=maxAckPending"`
+	// AckWait is how long the server waits for the acknowledgement before redelivering a message, defaults to 30s.
+	// +optional
+	AckWait *string `json:"ackWait,omitempty" protobuf:"bytes,9,opt,name=ackWait"`
+	// FetchBatchSize is the max number of the messages a pull consumer fetches at a time, defaults to 10.
+	// +optional
+	FetchBatchSize int32 `json:"fetchBatchSize,omitempty" protobuf:"varint,10,opt,name=fetchBatchSize"`
 }
 
 // NATSAuth refers to the auth info for NATS EventSource
@@ -614,6 +1323,16 @@ type SNSEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,9,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,11,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,12,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,13,rep,name=routes"`
 	// Endpoint configures connection to a specific SNS endpoint instead of Amazons servers
 	// +optional
 	Endpoint string `json:"endpoint" protobuf:"bytes,10,opt,name=endpoint"`
@@ -653,6 +1372,16 @@ type SQSEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,11,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,14,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,15,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,16,rep,name=routes"`
 	// Endpoint configures connection to a specific SQS endpoint instead of Amazons servers
 	// +optional
 	Endpoint string `json:"endpoint" protobuf:"bytes,12,opt,name=endpoint"`
@@ -702,6 +1431,16 @@ type PubSubEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,9,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,10,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,11,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,12,rep,name=routes"`
 }
 
 // GerritEventSource refers to event-source related to gerrit events
@@ -732,6 +1471,16 @@ type GerritEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,10,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,12,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,13,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,14,rep,name=routes"`
 	// MaxTries is number of attempts when posting an event to the target url
 	// +optional
 	MaxTries int64 `json:"maxTries" protobuf:"varint,11,opt,name=maxTries"`
@@ -816,6 +1565,43 @@ type GithubEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,18,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,21,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,22,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,23,rep,name=routes"`
+	// HookReconcileInterval is the interval between the reconciliations of the hooks, which recreate the deleted
+	// hooks and fix the events, the content type, the TLS verification and the secret of the hooks, e.g. 5m.
+	// By default, the hooks are reconciled every minute for 10 minutes after the event source starts.
+	// +optional
+	HookReconcileInterval string `json:"hookReconcileInterval,omitempty" protobuf:"bytes,19,opt,name=hookReconcileInterval"`
+	// Backfill redelivers the failed deliveries of the hooks, e.g. the ones made while the event source was down
+	// +optional
+	Backfill *GithubDeliveryBackfill `json:"backfill,omitempty" protobuf:"bytes,20,opt,name=backfill"`
+}
+
+// GithubDeliveryBackfill configures the redelivery of the failed deliveries of the GitHub hooks
+type GithubDeliveryBackfill struct {
+	// Interval between the checks of the deliveries, defaults to 5m
+	// +optional
+	Interval string `json:"interval,omitempty" protobuf:"bytes,1,opt,name=interval"`
+	// MaxAge is the maximum age of the failed deliveries to redeliver, defaults to 24h.
+	// GitHub keeps the deliveries for 3 days.
+	// +optional
+	MaxAge string `json:"maxAge,omitempty" protobuf:"bytes,2,opt,name=maxAge"`
+	// AppHook redelivers the failed deliveries of the webhook of the GitHub App, instead of the ones of the
+	// repository and organization hooks. It requires githubApp.
+	// +optional
+	AppHook bool `json:"appHook,omitempty" protobuf:"varint,3,opt,name=appHook"`
+	// Persistence holds the configuration to store the ID of the last delivery seen of each hook,
+	// so the deliveries are not checked again after the event source restarts.
+	// +optional
+	Persistence *EventPersistence `json:"persistence,omitempty" protobuf:"bytes,4,opt,name=persistence"`
 }
 
 func (g GithubEventSource) GetOwnedRepositories() []OwnedRepositories {
@@ -884,6 +1670,16 @@ type GitlabEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,12,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,14,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,15,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,16,rep,name=routes"`
 	// List of group IDs or group name like "test".
 	// Group level hook available in Premium and Ultimate Gitlab.
 	// +optional
@@ -911,6 +1707,203 @@ func (g GitlabEventSource) NeedToCreateHooks() bool {
 	return g.AccessToken != nil && g.Webhook != nil && g.Webhook.URL != ""
 }
 
+// GiteaEventSource refers to event-source for Gitea and Forgejo events
+type GiteaEventSource struct {
+	// Webhook refers to the configuration required to run a http server
+	Webhook *WebhookContext `json:"webhook,omitempty" protobuf:"bytes,1,opt,name=webhook"`
+	// GiteaBaseURL is the base URL of the Gitea or Forgejo server, e.g. https://gitea.example.com
+	GiteaBaseURL string `json:"giteaBaseURL" protobuf:"bytes,2,opt,name=giteaBaseURL"`
+	// Events are the Gitea events the hooks are subscribed to, e.g. push, pull_request or issues.
+	// Refer https://docs.gitea.com/usage/webhooks#event-information.
+	Events []string `json:"events" protobuf:"bytes,3,rep,name=events"`
+	// AccessToken refers to a K8s secret containing the Gitea API access token, used to manage the hooks
+	// +optional
+	AccessToken *corev1.SecretKeySelector `json:"accessToken,omitempty" protobuf:"bytes,4,opt,name=accessToken"`
+	// WebhookSecret refers to a K8s secret containing the secret the deliveries are signed with
+	// +optional
+	WebhookSecret *corev1.SecretKeySelector `json:"webhookSecret,omitempty" protobuf:"bytes,5,opt,name=webhookSecret"`
+	// Repositories holds the information of repositories, which uses repo owner as the key,
+	// and list of repo names as the value.
+	// +optional
+	Repositories []OwnedRepositories `json:"repositories,omitempty" protobuf:"bytes,6,rep,name=repositories"`
+	// Organizations holds the names of organizations (used for organization level hooks).
+	// +optional
+	Organizations []string `json:"organizations,omitempty" protobuf:"bytes,7,rep,name=organizations"`
+	// Insecure skips the TLS verification of the Gitea server when managing the hooks
+	// +optional
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,8,opt,name=insecure"`
+	// DeleteHookOnFinish determines whether to delete the Gitea hooks once the event source is stopped.
+	// +optional
+	DeleteHookOnFinish bool `json:"deleteHookOnFinish,omitempty" protobuf:"varint,9,opt,name=deleteHookOnFinish"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,10,rep,name=metadata"`
+	// Filter
+	// +optional
+	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,11,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,12,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,13,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,14,rep,name=routes"`
+}
+
+func (g GiteaEventSource) NeedToCreateHooks() bool {
+	return g.AccessToken != nil && g.Webhook != nil && g.Webhook.URL != ""
+}
+
+// AzureDevOpsEventSource refers to event-source for Azure DevOps Repos events
+type AzureDevOpsEventSource struct {
+	// Webhook refers to the configuration required to run a http server
+	Webhook *WebhookContext `json:"webhook,omitempty" protobuf:"bytes,1,opt,name=webhook"`
+	// OrganizationURL is the URL of the Azure DevOps organization, e.g. https://dev.azure.com/example,
+	// or of the collection for Azure DevOps Server, e.g. https://devops.example.com/tfs/DefaultCollection
+	OrganizationURL string `json:"organizationURL" protobuf:"bytes,2,opt,name=organizationURL"`
+	// Events are the Azure DevOps event types the service hooks are subscribed to, e.g. git.push or git.pullrequest.created.
+	// Refer https://learn.microsoft.com/en-us/azure/devops/service-hooks/events.
+	Events []string `json:"events" protobuf:"bytes,3,rep,name=events"`
+	// AccessToken refers to a K8s secret containing the personal access token, used to manage the service hooks
+	// +optional
+	AccessToken *corev1.SecretKeySelector `json:"accessToken,omitempty" protobuf:"bytes,4,opt,name=accessToken"`
+	// Auth holds the basic auth credentials the service hooks send the events with
+	// +optional
+	Auth *BasicAuth `json:"auth,omitempty" protobuf:"bytes,5,opt,name=auth"`
+	// Repositories holds the repositories the service hooks are created for
+	// +optional
+	Repositories []AzureDevOpsRepositories `json:"repositories,omitempty" protobuf:"bytes,6,rep,name=repositories"`
+	// DeleteHookOnFinish determines whether to delete the Azure DevOps service hooks once the event source is stopped.
+	// +optional
+	DeleteHookOnFinish bool `json:"deleteHookOnFinish,omitempty" protobuf:"varint,7,opt,name=deleteHookOnFinish"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,8,rep,name=metadata"`
+	// Filter
+	// +optional
+	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,9,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,10,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,11,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,12,rep,name=routes"`
+}
+
+// AzureDevOpsRepositories refers to the repositories of an Azure DevOps project
+type AzureDevOpsRepositories struct {
+	// Project name
+	Project string `json:"project" protobuf:"bytes,1,opt,name=project"`
+	// Repository names, all the repositories of the project if empty
+	// +optional
+	Names []string `json:"names,omitempty" protobuf:"bytes,2,rep,name=names"`
+}
+
+func (a AzureDevOpsEventSource) NeedToCreateHooks() bool {
+	return a.AccessToken != nil && a.Webhook != nil && a.Webhook.URL != ""
+}
+
+// ObjectStoreEventSource describes an event source for the objects created and removed in a bucket of an S3 compatible
+// object store, either from the S3 event notifications delivered to an SQS queue, or by listing the objects periodically.
+// One of sqs and poll must be specified.
+type ObjectStoreEventSource struct {
+	// Endpoint of the object store, e.g. s3.amazonaws.com or minio.argo-events:9000. Required to poll.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,1,opt,name=endpoint"`
+	// Bucket of the objects. Required to poll, the notifications of the other buckets are ignored if it is specified.
+	// +optional
+	Bucket string `json:"bucket,omitempty" protobuf:"bytes,2,opt,name=bucket"`
+	// Region of the bucket and the queue
+	// +optional
+	Region string `json:"region,omitempty" protobuf:"bytes,3,opt,name=region"`
+	// Insecure connects to the object store over HTTP instead of HTTPS
+	// +optional
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,4,opt,name=insecure"`
+	// AccessKey refers to the K8s secret containing the access key. The AWS credentials of the environment,
+	// or of the EC2 instance or the service account, are used if it is not specified.
+	// +optional
+	AccessKey *corev1.SecretKeySelector `json:"accessKey,omitempty" protobuf:"bytes,5,opt,name=accessKey"`
+	// SecretKey refers to the K8s secret containing the secret key
+	// +optional
+	SecretKey *corev1.SecretKeySelector `json:"secretKey,omitempty" protobuf:"bytes,6,opt,name=secretKey"`
+	// CACertificate refers to the K8s secret containing the CA certificate of the object store
+	// +optional
+	CACertificate *corev1.SecretKeySelector `json:"caCertificate,omitempty" protobuf:"bytes,7,opt,name=caCertificate"`
+	// Prefix of the keys of the objects
+	// +optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,8,opt,name=prefix"`
+	// Suffix of the keys of the objects
+	// +optional
+	Suffix string `json:"suffix,omitempty" protobuf:"bytes,9,opt,name=suffix"`
+	// SQS receives the S3 event notifications from an SQS queue, sent directly by S3 or through an SNS topic
+	// +optional
+	SQS *ObjectStoreSQS `json:"sqs,omitempty" protobuf:"bytes,10,opt,name=sqs"`
+	// Poll lists the objects of the bucket periodically
+	// +optional
+	Poll *ObjectStorePoll `json:"poll,omitempty" protobuf:"bytes,11,opt,name=poll"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,12,rep,name=metadata"`
+	// Filter
+	// +optional
+	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,13,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,14,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,15,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,16,rep,name=routes"`
+}
+
+// ObjectStoreSQS describes the SQS queue the S3 event notifications are delivered to
+type ObjectStoreSQS struct {
+	// Queue is the name of the queue
+	Queue string `json:"queue" protobuf:"bytes,1,opt,name=queue"`
+	// QueueAccountID is the ID of the account that created the queue
+	// +optional
+	QueueAccountID string `json:"queueAccountId,omitempty" protobuf:"bytes,2,opt,name=queueAccountId"`
+	// Endpoint of SQS, instead of the AWS one
+	// +optional
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,3,opt,name=endpoint"`
+	// RoleARN is the Amazon Resource Name (ARN) of the role to assume
+	// +optional
+	RoleARN string `json:"roleARN,omitempty" protobuf:"bytes,4,opt,name=roleARN"`
+	// WaitTimeSeconds is the duration (in seconds) for which a receive call waits for a message. Defaults to 20.
+	// +optional
+	WaitTimeSeconds int64 `json:"waitTimeSeconds,omitempty" protobuf:"varint,5,opt,name=waitTimeSeconds"`
+}
+
+// ObjectStorePoll describes how the objects of the bucket are listed
+type ObjectStorePoll struct {
+	// Interval between two listings, e.g. 30s, 5m... Defaults to 1m.
+	// +optional
+	Interval string `json:"interval,omitempty" protobuf:"bytes,1,opt,name=interval"`
+	// EmitExisting emits an event for each object existing when the bucket is listed for the first time,
+	// otherwise only the objects created afterwards are.
+	// +optional
+	EmitExisting bool `json:"emitExisting,omitempty" protobuf:"varint,2,opt,name=emitExisting"`
+	// DetectRemovals emits an event for each object removed between two listings. The keys of all the objects
+	// are kept in the state, so it is meant for buckets with a moderate number of objects: the removals are not
+	// detected while the keys and the ETags of the objects exceed 512 KiB.
+	// +optional
+	DetectRemovals bool `json:"detectRemovals,omitempty" protobuf:"varint,3,opt,name=detectRemovals"`
+	// Persistence holds the configuration to store the watermark of the listings, so the objects are not
+	// emitted again after the event source restarts.
+	// +optional
+	Persistence *EventPersistence `json:"persistence,omitempty" protobuf:"bytes,4,opt,name=persistence"`
+}
+
 // BitbucketEventSource describes the event source for Bitbucket
 type BitbucketEventSource struct {
 	// DeleteHookOnFinish determines whether to delete the defined Bitbucket hook once the event source is stopped.
@@ -946,6 +1939,16 @@ type BitbucketEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,10,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,11,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,12,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,13,rep,name=routes"`
 }
 
 func (b BitbucketEventSource) HasBitbucketBasicAuth() bool {
@@ -1062,6 +2065,16 @@ type BitbucketServerEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,14,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,17,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,18,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,19,rep,name=routes"`
 	// TLS configuration for the bitbucketserver client.
 	// +optional
 	TLS *TLSConfig `json:"tls,omitempty" protobuf:"bytes,15,opt,name=tls"`
@@ -1138,6 +2151,16 @@ type HDFSEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,13,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,14,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,15,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,16,rep,name=routes"`
 }
 
 // SlackEventSource refers to event-source for Slack related events
@@ -1154,6 +2177,16 @@ type SlackEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,5,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,6,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,7,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,8,rep,name=routes"`
 }
 
 // StorageGridEventSource refers to event-source for StorageGrid related events
@@ -1185,6 +2218,16 @@ type StorageGridEventSource struct {
 	// TLS configuration for the service bus client
 	// +optional
 	TLS *TLSConfig `json:"tls,omitempty" protobuf:"bytes,10,opt,name=tls"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,11,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,12,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,13,rep,name=routes"`
 }
 
 // StorageGridFilter represents filters to apply to bucket notifications for specifying constraints on objects
@@ -1216,6 +2259,16 @@ type AzureEventsHubEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,6,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,7,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,8,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,9,rep,name=routes"`
 }
 
 // AzureServiceBusEventSource describes the event source for azure service bus
@@ -1244,6 +2297,16 @@ type AzureServiceBusEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,8,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,11,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,12,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,13,rep,name=routes"`
 	// FullyQualifiedNamespace is the Service Bus namespace name (ex: myservicebus.servicebus.windows.net). This field is necessary to
 	// access via Azure AD (managed identity) and it is ignored if ConnectionString is set.
 	// +optional
@@ -1282,6 +2345,16 @@ type AzureQueueStorageEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,7,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,10,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,11,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,12,rep,name=routes"`
 	// DecodeMessage specifies if all the messages should be base64 decoded.
 	// If set to true the decoding is done before the evaluation of JSONBody
 	// +optional
@@ -1310,6 +2383,16 @@ type StripeEventSource struct {
 	// Metadata holds the user defined metadata which will passed along the event payload.
 	// +optional
 	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,5,rep,name=metadata"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,6,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,7,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,8,rep,name=routes"`
 }
 
 // EmitterEventSource describes the event source for emitter
@@ -1343,6 +2426,16 @@ type EmitterEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,10,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,11,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,12,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,13,rep,name=routes"`
 }
 
 // RedisEventSource describes an event source for the Redis PubSub.
@@ -1371,6 +2464,16 @@ type RedisEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,8,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,11,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,12,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,13,rep,name=routes"`
 	// JSONBody specifies that all event body payload coming from this
 	// source will be JSON
 	// +optional
@@ -1411,6 +2514,16 @@ type RedisStreamEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,9,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,11,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,12,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,13,rep,name=routes"`
 	// Username required for ACL style authentication if any.
 	// +optional
 	Username string `json:"username,omitempty" protobuf:"bytes,10,opt,name=username"`
@@ -1441,6 +2554,16 @@ type NSQEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,8,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,9,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,10,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,11,rep,name=routes"`
 }
 
 // PulsarEventSource describes the event source for Apache Pulsar
@@ -1485,6 +2608,16 @@ type PulsarEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,12,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,15,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,17,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,18,rep,name=routes"`
 	// Authentication athenz parameters for the pulsar client.
 	// Refer https://github.com/apache/pulsar-client-go/blob/master/pulsar/auth/athenz.go
 	// Either token or athenz can be set to use auth.
@@ -1494,6 +2627,9 @@ type PulsarEventSource struct {
 	// AuthAthenzSecret must be set if AuthAthenzParams is used.
 	// +optional
 	AuthAthenzSecret *corev1.SecretKeySelector `json:"authAthenzSecret,omitempty" protobuf:"bytes,14,opt,name=authAthenzSecret"`
+	// Decoder decodes the binary payloads into JSON
+	// +optional
+	Decoder *PayloadDecoder `json:"decoder,omitempty" protobuf:"bytes,16,opt,name=decoder"`
 }
 
 // MNSEventSource refers to event-source for AlibabaCloud MNS related events
@@ -1512,6 +2648,16 @@ type MNSEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,6,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,7,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,8,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,9,rep,name=routes"`
 }
 
 // GenericEventSource refers to a generic event source. It can be used to implement a custom event source.
@@ -1535,6 +2681,16 @@ type GenericEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,7,opt,name=filter"`
+	// Schema validates the event data, the invalid events are dropped or published to a dead letter event
+	// +optional
+	Schema *EventSourceSchema `json:"schema,omitempty" protobuf:"bytes,8,opt,name=schema"`
+	// Transform shapes and redacts the event data before it is published
+	// +optional
+	Transform *EventSourceTransform `json:"transform,omitempty" protobuf:"bytes,9,opt,name=transform"`
+	// Routes publish the matching events with other event names, each matching route publishes an event,
+	// the events matching no route are published with the name of this event
+	// +optional
+	Routes []EventSourceRoute `json:"routes,omitempty" protobuf:"bytes,10,rep,name=routes"`
 }
 
 const (
@@ -1549,6 +2705,17 @@ const (
 // EventSourceStatus holds the status of the event-source resource
 type EventSourceStatus struct {
 	Status `json:",inline" protobuf:"bytes,1,opt,name=status"`
+	// Calendars holds the status of the calendar event sources by event name.
+	// It is updated by the event source pod if its service account is allowed to patch eventsources/status.
+	// +optional
+	Calendars map[string]CalendarStatus `json:"calendars,omitempty" protobuf:"bytes,2,rep,name=calendars"`
+}
+
+// CalendarStatus is the status of a calendar event source
+type CalendarStatus struct {
+	// NextFireTimes are the next planned times of the events, without the jitter.
+	// +optional
+	NextFireTimes []metav1.Time `json:"nextFireTimes,omitempty" protobuf:"bytes,1,rep,name=nextFireTimes"`
 }
 
 // InitConditions sets conditions to Unknown state.
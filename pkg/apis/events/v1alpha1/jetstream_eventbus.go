@@ -85,6 +85,11 @@ type JetStreamBus struct {
 	// Maximum number of bytes in a message payload, 0 means unlimited. Defaults to 1MB
 	// +optional
 	MaxPayload *string `json:"maxPayload,omitempty" protobuf:"bytes,19,opt,name=maxPayload"`
+	// Stream is the typed configuration of the stream used by this EventBus.
+	// Fields specified here take precedence over "streamConfig" and the global settings in controller-config,
+	// and unlike "streamConfig", they can be updated after the EventBus is created.
+	// +optional
+	Stream *JetStreamStreamConfig `json:"stream,omitempty" protobuf:"bytes,20,opt,name=stream"`
 }
 
 func (j JetStreamBus) GetReplicas() int {
@@ -105,4 +110,84 @@ type JetStreamConfig struct {
 	// SSL/TLS settings for the NATS client
 	// +optional
 	TLS *TLSConfig `json:"tls,omitempty" protobuf:"bytes,4,opt,name=tls"`
+	// Stream is the typed configuration of the stream, fields specified here take precedence over "streamConfig".
+	// +optional
+	Stream *JetStreamStreamConfig `json:"stream,omitempty" protobuf:"bytes,5,opt,name=stream"`
+}
+
+// JetStreamRetentionPolicy determines how messages in the stream are retained.
+type JetStreamRetentionPolicy string
+
+const (
+	// JetStreamRetentionLimits retains messages until any of the limits is reached
+	JetStreamRetentionLimits JetStreamRetentionPolicy = "Limits"
+	// JetStreamRetentionInterest retains messages until all the known consumers have acknowledged them
+	JetStreamRetentionInterest JetStreamRetentionPolicy = "Interest"
+	// JetStreamRetentionWorkQueue retains messages until the first consumer acknowledges them
+	JetStreamRetentionWorkQueue JetStreamRetentionPolicy = "WorkQueue"
+)
+
+// JetStreamDiscardPolicy determines how to proceed when the limits of the stream are reached.
+type JetStreamDiscardPolicy string
+
+const (
+	// JetStreamDiscardOld removes older messages to make room for new ones
+	JetStreamDiscardOld JetStreamDiscardPolicy = "Old"
+	// JetStreamDiscardNew rejects new messages
+	JetStreamDiscardNew JetStreamDiscardPolicy = "New"
+)
+
+// JetStreamCompression is the compression algorithm applied to the stream storage.
+type JetStreamCompression string
+
+const (
+	// JetStreamCompressionNone stores messages uncompressed
+	JetStreamCompressionNone JetStreamCompression = "None"
+	// JetStreamCompressionS2 compresses messages with the S2 algorithm
+	JetStreamCompressionS2 JetStreamCompression = "S2"
+)
+
+// JetStreamStreamConfig is the typed configuration of a JetStream stream.
+// See https://docs.nats.io/nats-concepts/jetstream/streams#configuration.
+type JetStreamStreamConfig struct {
+	// Retention policy of the stream, "Limits" (default), "Interest" or "WorkQueue".
+	// +optional
+	Retention JetStreamRetentionPolicy `json:"retention,omitempty" protobuf:"bytes,1,opt,name=retention,casttype=JetStreamRetentionPolicy"`
+	// Maximum number of messages in the stream, -1 means unlimited.
+	// +optional
+	MaxMsgs *int64 `json:"maxMsgs,omitempty" protobuf:"varint,2,opt,name=maxMsgs"`
+	// Maximum total size of the stream in bytes, -1 means unlimited.
+	// +optional
+	MaxBytes *int64 `json:"maxBytes,omitempty" protobuf:"varint,3,opt,name=maxBytes"`
+	// Maximum age of the messages in the stream, e.g. "72h". "0s" means unlimited.
+	// +optional
+	MaxAge *string `json:"maxAge,omitempty" protobuf:"bytes,4,opt,name=maxAge"`
+	// Maximum size of a single message in the stream in bytes, -1 means unlimited.
+	// +optional
+	MaxMsgSize *int32 `json:"maxMsgSize,omitempty" protobuf:"varint,5,opt,name=maxMsgSize"`
+	// Number of replicas of the stream, 1, 3 or 5.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty" protobuf:"varint,6,opt,name=replicas"`
+	// Discard policy of the stream when the limits are reached, "Old" (default) or "New".
+	// +optional
+	Discard JetStreamDiscardPolicy `json:"discard,omitempty" protobuf:"bytes,7,opt,name=discard,casttype=JetStreamDiscardPolicy"`
+	// Duplicates is the window to track duplicate messages in, e.g. "5m".
+	// +optional
+	Duplicates *string `json:"duplicates,omitempty" protobuf:"bytes,8,opt,name=duplicates"`
+	// Compression of the stream storage, "None" (default) or "S2". Requires NATS server 2.10 or later.
+	// +optional
+	Compression JetStreamCompression `json:"compression,omitempty" protobuf:"bytes,9,opt,name=compression,casttype=JetStreamCompression"`
+	// Placement of the stream replicas.
+	// +optional
+	Placement *JetStreamPlacement `json:"placement,omitempty" protobuf:"bytes,10,opt,name=placement"`
+}
+
+// JetStreamPlacement is used to guide the placement of the stream replicas in clustered JetStream.
+type JetStreamPlacement struct {
+	// Cluster is the name of the cluster to place the stream in.
+	// +optional
+	Cluster string `json:"cluster,omitempty" protobuf:"bytes,1,opt,name=cluster"`
+	// Tags are the server tags required for the servers to place the stream on.
+	// +optional
+	Tags []string `json:"tags,omitempty" protobuf:"bytes,2,rep,name=tags"`
 }
@@ -0,0 +1,19 @@
+package v1alpha1
+
+import corev1 "k8s.io/api/core/v1"
+
+// OAuth2ClientCredentials contains the configuration to get an access token with the OAuth2 client credentials grant
+type OAuth2ClientCredentials struct {
+	// TokenURL is the URL of the token endpoint of the authorization server.
+	TokenURL string `json:"tokenURL" protobuf:"bytes,1,opt,name=tokenURL"`
+	// ClientID refers to the Kubernetes secret that holds the client id.
+	ClientID *corev1.SecretKeySelector `json:"clientID,omitempty" protobuf:"bytes,2,opt,name=clientID"`
+	// ClientSecret refers to the Kubernetes secret that holds the client secret.
+	ClientSecret *corev1.SecretKeySelector `json:"clientSecret,omitempty" protobuf:"bytes,3,opt,name=clientSecret"`
+	// Scopes to request.
+	// +optional
+	Scopes []string `json:"scopes,omitempty" protobuf:"bytes,4,rep,name=scopes"`
+	// EndpointParams holds the additional parameters of the token request, e.g. "audience".
+	// +optional
+	EndpointParams map[string]string `json:"endpointParams,omitempty" protobuf:"bytes,5,rep,name=endpointParams"`
+}
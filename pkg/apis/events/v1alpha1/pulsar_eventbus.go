@@ -0,0 +1,43 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PulsarSubscriptionType is the type of the subscriptions the Sensors use to consume from a Pulsar EventBus.
+type PulsarSubscriptionType string
+
+const (
+	// PulsarSubscriptionShared delivers the events to the consumers in a round-robin way.
+	PulsarSubscriptionShared PulsarSubscriptionType = "Shared"
+	// PulsarSubscriptionKeyShared delivers the events of the same EventSource event to the same consumer,
+	// so that the events correlated by a Sensor dependency are processed in order.
+	PulsarSubscriptionKeyShared PulsarSubscriptionType = "KeyShared"
+)
+
+// PulsarBus holds the PulsarBus EventBus information
+type PulsarBus struct {
+	// URL of the Pulsar service, e.g. pulsar://pulsar.pulsar.svc:6650
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// Topic name, defaults to {namespace_name}-{eventbus_name}
+	// +optional
+	Topic string `json:"topic,omitempty" protobuf:"bytes,2,opt,name=topic"`
+	// SubscriptionType of the Sensor subscriptions, "Shared" or "KeyShared", defaults to "KeyShared".
+	// +optional
+	SubscriptionType PulsarSubscriptionType `json:"subscriptionType,omitempty" protobuf:"bytes,3,opt,name=subscriptionType,casttype=PulsarSubscriptionType"`
+	// Trusted TLS certs secret.
+	// +optional
+	TLSTrustCertsSecret *corev1.SecretKeySelector `json:"tlsTrustCertsSecret,omitempty" protobuf:"bytes,4,opt,name=tlsTrustCertsSecret"`
+	// Whether the Pulsar client accept untrusted TLS certificate from broker.
+	// +optional
+	TLSAllowInsecureConnection bool `json:"tlsAllowInsecureConnection,omitempty" protobuf:"varint,5,opt,name=tlsAllowInsecureConnection"`
+	// Whether the Pulsar client verify the validity of the host name from broker.
+	// +optional
+	TLSValidateHostname bool `json:"tlsValidateHostname,omitempty" protobuf:"varint,6,opt,name=tlsValidateHostname"`
+	// TLS configuration for the pulsar client, the client cert and key are used for TLS authentication.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty" protobuf:"bytes,7,opt,name=tls"`
+	// AuthTokenSecret is token authentication.
+	// +optional
+	AuthTokenSecret *corev1.SecretKeySelector `json:"authTokenSecret,omitempty" protobuf:"bytes,8,opt,name=authTokenSecret"`
+}
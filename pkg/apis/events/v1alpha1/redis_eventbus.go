@@ -0,0 +1,30 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// RedisBus holds the RedisBus EventBus information, the events are stored in a Redis Stream.
+type RedisBus struct {
+	// URL is the address of the Redis server, in the form of host:port.
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// Stream name, defaults to {namespace_name}-{eventbus_name}
+	// +optional
+	Stream string `json:"stream,omitempty" protobuf:"bytes,2,opt,name=stream"`
+	// DB to use, defaults to 0
+	// +optional
+	DB int32 `json:"db,omitempty" protobuf:"varint,3,opt,name=db"`
+	// Username required for ACL style authentication if any.
+	// +optional
+	Username string `json:"username,omitempty" protobuf:"bytes,4,opt,name=username"`
+	// Password refers to the K8s secret that holds the password.
+	// +optional
+	Password *corev1.SecretKeySelector `json:"password,omitempty" protobuf:"bytes,5,opt,name=password"`
+	// TLS configuration for the redis client.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty" protobuf:"bytes,6,opt,name=tls"`
+	// MaxLen is the approximate maximum number of the events kept in the stream, older events are trimmed
+	// when new events are added. Defaults to 0, which means the stream is not trimmed.
+	// +optional
+	MaxLen int64 `json:"maxLen,omitempty" protobuf:"varint,7,opt,name=maxLen"`
+}
@@ -95,6 +95,10 @@ type SensorSpec struct {
 	// LoggingFields add additional key-value pairs when logging happens
 	// +optional
 	LoggingFields map[string]string `json:"loggingFields" protobuf:"bytes,8,rep,name=loggingFields"`
+	// EventBusNamespace references to the namespace of the EventBus, defaults to the namespace of the Sensor.
+	// An EventBus in another namespace needs to have this namespace in its "spec.sharing.allowedNamespaces".
+	// +optional
+	EventBusNamespace string `json:"eventBusNamespace,omitempty" protobuf:"bytes,9,opt,name=eventBusNamespace"`
 }
 
 func (s SensorSpec) GetReplicas() int32 {
@@ -583,6 +587,10 @@ type SchemaRegistryConfig struct {
 	// SchemaRegistry - basic authentication
 	// +optional
 	Auth BasicAuth `json:"auth,omitempty" protobuf:"bytes,3,opt,name=auth"`
+	// TLS configuration for the schema registry client of the event sources.
+	// If omitted, the event sources don't verify the certificate of the schema registry.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty" protobuf:"bytes,4,opt,name=tls"`
 }
 
 // PulsarTrigger refers to the specification of the Pulsar trigger.
@@ -32,6 +32,97 @@ type WebhookContext struct {
 	// Default value: 1048576 (1MB).
 	// +optional
 	MaxPayloadSize *int64 `json:"maxPayloadSize,omitempty" protobuf:"bytes,9,opt,name=maxPayloadSize"`
+	// Response shapes the response to the requests whose events are dispatched,
+	// instead of the default "success".
+	// +optional
+	Response *WebhookResponse `json:"response,omitempty" protobuf:"bytes,10,opt,name=response"`
+	// Challenges are the challenge requests, e.g. the validation requests of Microsoft Graph subscriptions,
+	// answered by echoing their challenge without dispatching an event.
+	// Challenge requests are answered before the AuthSecret is checked.
+	// +optional
+	Challenges []WebhookChallenge `json:"challenges,omitempty" protobuf:"bytes,11,rep,name=challenges"`
+	// Async responds 202 Accepted with the event ids as soon as the request is received, and dispatches the
+	// events to the EventBus afterwards. The sender is not notified when the dispatch fails.
+	// +optional
+	Async bool `json:"async,omitempty" protobuf:"varint,12,opt,name=async"`
+	// RateLimit limits the rate of the requests of each client,
+	// the requests over the limit are answered 429 Too Many Requests with a Retry-After header.
+	// +optional
+	RateLimit *WebhookRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,13,opt,name=rateLimit"`
+	// MaxInFlight is the maximum number of requests processed at the same time,
+	// the other requests are answered 429 Too Many Requests with a Retry-After header.
+	// +optional
+	MaxInFlight *int32 `json:"maxInFlight,omitempty" protobuf:"varint,14,opt,name=maxInFlight"`
+	// RequestTimeout is the maximum duration to process a request, e.g. 30s,
+	// a request which is not processed in time is answered 503 Service Unavailable.
+	// +optional
+	RequestTimeout string `json:"requestTimeout,omitempty" protobuf:"bytes,15,opt,name=requestTimeout"`
+}
+
+// WebhookRateLimit limits the rate of the requests of each client, identified by its source IP or a header
+type WebhookRateLimit struct {
+	// Unit of the rate, Second, Minute or Hour. Defaults to Second.
+	// +optional
+	Unit RateLimiteUnit `json:"unit,omitempty" protobuf:"bytes,1,opt,name=unit"`
+	// RequestsPerUnit is the number of requests allowed per unit for each client
+	RequestsPerUnit int32 `json:"requestsPerUnit" protobuf:"varint,2,opt,name=requestsPerUnit"`
+	// Burst is the number of requests a client can send at once. Defaults to RequestsPerUnit.
+	// +optional
+	Burst *int32 `json:"burst,omitempty" protobuf:"varint,3,opt,name=burst"`
+	// Header identifying the clients, e.g. Authorization or X-Api-Key, instead of their source IP.
+	// The requests without the header share a limit.
+	// +optional
+	Header string `json:"header,omitempty" protobuf:"bytes,4,opt,name=header"`
+	// TrustForwardedFor identifies the clients by the X-Forwarded-For header, when the requests go through
+	// proxies or an ingress: the client is the address appended by the first trusted proxy, i.e. the address
+	// at the TrustedProxies position from the right of the header.
+	// +optional
+	TrustForwardedFor bool `json:"trustForwardedFor,omitempty" protobuf:"varint,5,opt,name=trustForwardedFor"`
+	// TrustedProxies is the number of proxies in front of the event source appending to the X-Forwarded-For header.
+	// Defaults to 1.
+	// +optional
+	TrustedProxies *int32 `json:"trustedProxies,omitempty" protobuf:"varint,6,opt,name=trustedProxies"`
+}
+
+// WebhookResponse is the response to a request.
+// The body and the values of the headers are go templates, with sprig functions, rendered with:
+// .EventID, the id of the first event dispatched, .EventIDs, the ids of all the events dispatched,
+// .Challenge, the challenge of a challenge request, .Method, .Path, .Query and .Header of the request, and
+// .Body, the body of the request, decoded if it is JSON.
+type WebhookResponse struct {
+	// StatusCode of the response. Defaults to 200, or 202 in the async mode.
+	// +optional
+	StatusCode *int32 `json:"statusCode,omitempty" protobuf:"varint,1,opt,name=statusCode"`
+	// Headers of the response
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,2,rep,name=headers"`
+	// Body of the response
+	// +optional
+	Body string `json:"body,omitempty" protobuf:"bytes,3,opt,name=body"`
+}
+
+// WebhookChallenge is a challenge request, identified by its challenge found in the query parameter,
+// the header or the body path, and by the values of its JSON body.
+type WebhookChallenge struct {
+	// QueryParameter holding the challenge, e.g. validationToken
+	// +optional
+	QueryParameter string `json:"queryParameter,omitempty" protobuf:"bytes,1,opt,name=queryParameter"`
+	// Header holding the challenge
+	// +optional
+	Header string `json:"header,omitempty" protobuf:"bytes,2,opt,name=header"`
+	// BodyPath is the path of the challenge in the JSON body, in the gjson syntax, e.g. challenge
+	// +optional
+	BodyPath string `json:"bodyPath,omitempty" protobuf:"bytes,3,opt,name=bodyPath"`
+	// BodyValues are the values the JSON body must have at the paths, e.g. type: url_verification
+	// +optional
+	BodyValues map[string]string `json:"bodyValues,omitempty" protobuf:"bytes,4,rep,name=bodyValues"`
+	// Response to the challenge requests. Defaults to 200 with the challenge as a text/plain body.
+	// +optional
+	Response *WebhookResponse `json:"response,omitempty" protobuf:"bytes,5,opt,name=response"`
+	// Unauthenticated challenge requests are answered before the AuthSecret is checked, for the
+	// senders which do not authenticate them. Defaults to false.
+	// +optional
+	Unauthenticated bool `json:"unauthenticated,omitempty" protobuf:"varint,6,opt,name=unauthenticated"`
 }
 
 func (wc *WebhookContext) GetMaxPayloadSize() int64 {
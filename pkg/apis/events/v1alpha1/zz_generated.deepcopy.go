@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -97,6 +98,21 @@ func (in *AMQPEventSource) DeepCopyInto(out *AMQPEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -324,6 +340,118 @@ func (in *ArtifactLocation) DeepCopy() *ArtifactLocation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvroDecoder) DeepCopyInto(out *AvroDecoder) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvroDecoder.
+func (in *AvroDecoder) DeepCopy() *AvroDecoder {
+	if in == nil {
+		return nil
+	}
+	out := new(AvroDecoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureDevOpsEventSource) DeepCopyInto(out *AzureDevOpsEventSource) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessToken != nil {
+		in, out := &in.AccessToken, &out.AccessToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]AzureDevOpsRepositories, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureDevOpsEventSource.
+func (in *AzureDevOpsEventSource) DeepCopy() *AzureDevOpsEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureDevOpsEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureDevOpsRepositories) DeepCopyInto(out *AzureDevOpsRepositories) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureDevOpsRepositories.
+func (in *AzureDevOpsRepositories) DeepCopy() *AzureDevOpsRepositories {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureDevOpsRepositories)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureEventHubsTrigger) DeepCopyInto(out *AzureEventHubsTrigger) {
 	*out = *in
@@ -389,6 +517,21 @@ func (in *AzureEventsHubEventSource) DeepCopyInto(out *AzureEventsHubEventSource
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -422,6 +565,21 @@ func (in *AzureQueueStorageEventSource) DeepCopyInto(out *AzureQueueStorageEvent
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	if in.WaitTimeInSeconds != nil {
 		in, out := &in.WaitTimeInSeconds, &out.WaitTimeInSeconds
 		*out = new(int32)
@@ -465,6 +623,21 @@ func (in *AzureServiceBusEventSource) DeepCopyInto(out *AzureServiceBusEventSour
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -662,6 +835,21 @@ func (in *BitbucketEventSource) DeepCopyInto(out *BitbucketEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -736,6 +924,21 @@ func (in *BitbucketServerEventSource) DeepCopyInto(out *BitbucketServerEventSour
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(TLSConfig)
@@ -788,6 +991,21 @@ func (in *BusConfig) DeepCopyInto(out *BusConfig) {
 		*out = new(KafkaBus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisBus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pulsar != nil {
+		in, out := &in.Pulsar, &out.Pulsar
+		*out = new(PulsarBus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Embedded != nil {
+		in, out := &in.Embedded, &out.Embedded
+		*out = new(EmbeddedBusConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -826,6 +1044,36 @@ func (in *CalendarEventSource) DeepCopyInto(out *CalendarEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.At != nil {
+		in, out := &in.At, &out.At
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Holidays != nil {
+		in, out := &in.Holidays, &out.Holidays
+		*out = new(HolidayCalendar)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -839,6 +1087,29 @@ func (in *CalendarEventSource) DeepCopy() *CalendarEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalendarStatus) DeepCopyInto(out *CalendarStatus) {
+	*out = *in
+	if in.NextFireTimes != nil {
+		in, out := &in.NextFireTimes, &out.NextFireTimes
+		*out = make([]metav1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalendarStatus.
+func (in *CalendarStatus) DeepCopy() *CalendarStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CalendarStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CatchupConfiguration) DeepCopyInto(out *CatchupConfiguration) {
 	*out = *in
@@ -1086,6 +1357,22 @@ func (in *EmailTrigger) DeepCopy() *EmailTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmbeddedBusConfig) DeepCopyInto(out *EmbeddedBusConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmbeddedBusConfig.
+func (in *EmbeddedBusConfig) DeepCopy() *EmbeddedBusConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EmbeddedBusConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmitterEventSource) DeepCopyInto(out *EmitterEventSource) {
 	*out = *in
@@ -1121,6 +1408,21 @@ func (in *EmitterEventSource) DeepCopyInto(out *EmitterEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1222,98 +1524,211 @@ func (in *EventBusList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventBusSpec) DeepCopyInto(out *EventBusSpec) {
+func (in *EventBusMigration) DeepCopyInto(out *EventBusMigration) {
 	*out = *in
-	if in.NATS != nil {
-		in, out := &in.NATS, &out.NATS
-		*out = new(NATSBus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.JetStream != nil {
-		in, out := &in.JetStream, &out.JetStream
-		*out = new(JetStreamBus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Kafka != nil {
-		in, out := &in.Kafka, &out.Kafka
-		*out = new(KafkaBus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.JetStreamExotic != nil {
-		in, out := &in.JetStreamExotic, &out.JetStreamExotic
-		*out = new(JetStreamConfig)
-		(*in).DeepCopyInto(*out)
+	if in.TransitionWindow != nil {
+		in, out := &in.TransitionWindow, &out.TransitionWindow
+		*out = new(string)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusSpec.
-func (in *EventBusSpec) DeepCopy() *EventBusSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusMigration.
+func (in *EventBusMigration) DeepCopy() *EventBusMigration {
 	if in == nil {
 		return nil
 	}
-	out := new(EventBusSpec)
+	out := new(EventBusMigration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventBusStatus) DeepCopyInto(out *EventBusStatus) {
+func (in *EventBusMigrationProgress) DeepCopyInto(out *EventBusMigrationProgress) {
 	*out = *in
-	in.Status.DeepCopyInto(&out.Status)
-	in.Config.DeepCopyInto(&out.Config)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusStatus.
-func (in *EventBusStatus) DeepCopy() *EventBusStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusMigrationProgress.
+func (in *EventBusMigrationProgress) DeepCopy() *EventBusMigrationProgress {
 	if in == nil {
 		return nil
 	}
-	out := new(EventBusStatus)
+	out := new(EventBusMigrationProgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventContext) DeepCopyInto(out *EventContext) {
+func (in *EventBusMigrationStatus) DeepCopyInto(out *EventBusMigrationStatus) {
 	*out = *in
-	in.Time.DeepCopyInto(&out.Time)
+	if in.DualPublishingSince != nil {
+		in, out := &in.DualPublishingSince, &out.DualPublishingSince
+		*out = (*in).DeepCopy()
+	}
+	if in.SwitchingSensorsSince != nil {
+		in, out := &in.SwitchingSensorsSince, &out.SwitchingSensorsSince
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	out.EventSources = in.EventSources
+	out.Sensors = in.Sensors
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventContext.
-func (in *EventContext) DeepCopy() *EventContext {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusMigrationStatus.
+func (in *EventBusMigrationStatus) DeepCopy() *EventBusMigrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EventContext)
+	out := new(EventBusMigrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventDependency) DeepCopyInto(out *EventDependency) {
+func (in *EventBusSharing) DeepCopyInto(out *EventBusSharing) {
 	*out = *in
-	if in.Filters != nil {
-		in, out := &in.Filters, &out.Filters
-		*out = new(EventDependencyFilter)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Transform != nil {
-		in, out := &in.Transform, &out.Transform
-		*out = new(EventDependencyTransformer)
-		**out = **in
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventDependency.
-func (in *EventDependency) DeepCopy() *EventDependency {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusSharing.
+func (in *EventBusSharing) DeepCopy() *EventBusSharing {
 	if in == nil {
 		return nil
 	}
-	out := new(EventDependency)
+	out := new(EventBusSharing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBusSpec) DeepCopyInto(out *EventBusSpec) {
+	*out = *in
+	if in.NATS != nil {
+		in, out := &in.NATS, &out.NATS
+		*out = new(NATSBus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JetStream != nil {
+		in, out := &in.JetStream, &out.JetStream
+		*out = new(JetStreamBus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaBus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JetStreamExotic != nil {
+		in, out := &in.JetStreamExotic, &out.JetStreamExotic
+		*out = new(JetStreamConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sharing != nil {
+		in, out := &in.Sharing, &out.Sharing
+		*out = new(EventBusSharing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisBus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pulsar != nil {
+		in, out := &in.Pulsar, &out.Pulsar
+		*out = new(PulsarBus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(EventBusMigration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusSpec.
+func (in *EventBusSpec) DeepCopy() *EventBusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBusStatus) DeepCopyInto(out *EventBusStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	in.Config.DeepCopyInto(&out.Config)
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(EventBusMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusStatus.
+func (in *EventBusStatus) DeepCopy() *EventBusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventContext) DeepCopyInto(out *EventContext) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventContext.
+func (in *EventContext) DeepCopy() *EventContext {
+	if in == nil {
+		return nil
+	}
+	out := new(EventContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventDependency) DeepCopyInto(out *EventDependency) {
+	*out = *in
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(EventDependencyFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventDependencyTransformer)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventDependency.
+func (in *EventDependency) DeepCopy() *EventDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(EventDependency)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1428,6 +1843,22 @@ func (in *EventSource) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceDeadLetter) DeepCopyInto(out *EventSourceDeadLetter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceDeadLetter.
+func (in *EventSourceDeadLetter) DeepCopy() *EventSourceDeadLetter {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceDeadLetter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventSourceFilter) DeepCopyInto(out *EventSourceFilter) {
 	*out = *in
@@ -1477,6 +1908,53 @@ func (in *EventSourceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceRoute) DeepCopyInto(out *EventSourceRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceRoute.
+func (in *EventSourceRoute) DeepCopy() *EventSourceRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceSchema) DeepCopyInto(out *EventSourceSchema) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaRegistry != nil {
+		in, out := &in.SchemaRegistry, &out.SchemaRegistry
+		*out = new(SchemaRegistryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(EventSourceDeadLetter)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceSchema.
+func (in *EventSourceSchema) DeepCopy() *EventSourceSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventSourceSpec) DeepCopyInto(out *EventSourceSpec) {
 	*out = *in
@@ -1719,6 +2197,55 @@ func (in *EventSourceSpec) DeepCopyInto(out *EventSourceSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Poll != nil {
+		in, out := &in.Poll, &out.Poll
+		*out = make(map[string]PollEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Postgres != nil {
+		in, out := &in.Postgres, &out.Postgres
+		*out = make(map[string]PostgresEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = make(map[string]GRPCEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = make(map[string]KubernetesEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Gitea != nil {
+		in, out := &in.Gitea, &out.Gitea
+		*out = make(map[string]GiteaEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.AzureDevOps != nil {
+		in, out := &in.AzureDevOps, &out.AzureDevOps
+		*out = make(map[string]AzureDevOpsEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ObjectStore != nil {
+		in, out := &in.ObjectStore, &out.ObjectStore
+		*out = make(map[string]ObjectStoreEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -1736,6 +2263,13 @@ func (in *EventSourceSpec) DeepCopy() *EventSourceSpec {
 func (in *EventSourceStatus) DeepCopyInto(out *EventSourceStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.Calendars != nil {
+		in, out := &in.Calendars, &out.Calendars
+		*out = make(map[string]CalendarStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -1749,6 +2283,32 @@ func (in *EventSourceStatus) DeepCopy() *EventSourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceTransform) DeepCopyInto(out *EventSourceTransform) {
+	*out = *in
+	if in.Drop != nil {
+		in, out := &in.Drop, &out.Drop
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mask != nil {
+		in, out := &in.Mask, &out.Mask
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceTransform.
+func (in *EventSourceTransform) DeepCopy() *EventSourceTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExprFilter) DeepCopyInto(out *ExprFilter) {
 	*out = *in
@@ -1802,6 +2362,21 @@ func (in *FileEventSource) DeepCopyInto(out *FileEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1816,8 +2391,28 @@ func (in *FileEventSource) DeepCopy() *FileEventSource {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GenericEventSource) DeepCopyInto(out *GenericEventSource) {
+func (in *GRPCEventSource) DeepCopyInto(out *GRPCEventSource) {
 	*out = *in
+	if in.ServerCertSecret != nil {
+		in, out := &in.ServerCertSecret, &out.ServerCertSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerKeySecret != nil {
+		in, out := &in.ServerKeySecret, &out.ServerKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxMessageSize != nil {
+		in, out := &in.MaxMessageSize, &out.MaxMessageSize
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = make(map[string]string, len(*in))
@@ -1825,34 +2420,92 @@ func (in *GenericEventSource) DeepCopyInto(out *GenericEventSource) {
 			(*out)[key] = val
 		}
 	}
-	if in.AuthSecret != nil {
-		in, out := &in.AuthSecret, &out.AuthSecret
-		*out = new(v1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.Filter != nil {
 		in, out := &in.Filter, &out.Filter
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericEventSource.
-func (in *GenericEventSource) DeepCopy() *GenericEventSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCEventSource.
+func (in *GRPCEventSource) DeepCopy() *GRPCEventSource {
 	if in == nil {
 		return nil
 	}
-	out := new(GenericEventSource)
+	out := new(GRPCEventSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GerritEventSource) DeepCopyInto(out *GerritEventSource) {
+func (in *GenericEventSource) DeepCopyInto(out *GenericEventSource) {
 	*out = *in
-	if in.Webhook != nil {
-		in, out := &in.Webhook, &out.Webhook
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericEventSource.
+func (in *GenericEventSource) DeepCopy() *GenericEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GenericEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GerritEventSource) DeepCopyInto(out *GerritEventSource) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
 		*out = new(WebhookContext)
 		(*in).DeepCopyInto(*out)
 	}
@@ -1883,6 +2536,21 @@ func (in *GerritEventSource) DeepCopyInto(out *GerritEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1979,6 +2647,81 @@ func (in *GitRemoteConfig) DeepCopy() *GitRemoteConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GiteaEventSource) DeepCopyInto(out *GiteaEventSource) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessToken != nil {
+		in, out := &in.AccessToken, &out.AccessToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebhookSecret != nil {
+		in, out := &in.WebhookSecret, &out.WebhookSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]OwnedRepositories, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Organizations != nil {
+		in, out := &in.Organizations, &out.Organizations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GiteaEventSource.
+func (in *GiteaEventSource) DeepCopy() *GiteaEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GiteaEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GithubAppCreds) DeepCopyInto(out *GithubAppCreds) {
 	*out = *in
@@ -2000,6 +2743,27 @@ func (in *GithubAppCreds) DeepCopy() *GithubAppCreds {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GithubDeliveryBackfill) DeepCopyInto(out *GithubDeliveryBackfill) {
+	*out = *in
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(EventPersistence)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubDeliveryBackfill.
+func (in *GithubDeliveryBackfill) DeepCopy() *GithubDeliveryBackfill {
+	if in == nil {
+		return nil
+	}
+	out := new(GithubDeliveryBackfill)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GithubEventSource) DeepCopyInto(out *GithubEventSource) {
 	*out = *in
@@ -2052,6 +2816,26 @@ func (in *GithubEventSource) DeepCopyInto(out *GithubEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Backfill != nil {
+		in, out := &in.Backfill, &out.Backfill
+		*out = new(GithubDeliveryBackfill)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2105,6 +2889,21 @@ func (in *GitlabEventSource) DeepCopyInto(out *GitlabEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	if in.Groups != nil {
 		in, out := &in.Groups, &out.Groups
 		*out = make([]string, len(*in))
@@ -2159,6 +2958,21 @@ func (in *HDFSEventSource) DeepCopyInto(out *HDFSEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2237,6 +3051,27 @@ func (in *HTTPTrigger) DeepCopy() *HTTPTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HolidayCalendar) DeepCopyInto(out *HolidayCalendar) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HolidayCalendar.
+func (in *HolidayCalendar) DeepCopy() *HolidayCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(HolidayCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Int64OrString) DeepCopyInto(out *Int64OrString) {
 	*out = *in
@@ -2253,6 +3088,37 @@ func (in *Int64OrString) DeepCopy() *Int64OrString {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvolvedObjectFilter) DeepCopyInto(out *InvolvedObjectFilter) {
+	*out = *in
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]Selector, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvolvedObjectFilter.
+func (in *InvolvedObjectFilter) DeepCopy() *InvolvedObjectFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(InvolvedObjectFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JetStreamBus) DeepCopyInto(out *JetStreamBus) {
 	*out = *in
@@ -2340,6 +3206,11 @@ func (in *JetStreamBus) DeepCopyInto(out *JetStreamBus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(JetStreamStreamConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2366,6 +3237,11 @@ func (in *JetStreamConfig) DeepCopyInto(out *JetStreamConfig) {
 		*out = new(TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(JetStreamStreamConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2380,61 +3256,133 @@ func (in *JetStreamConfig) DeepCopy() *JetStreamConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *K8SResource) DeepCopyInto(out *K8SResource) {
+func (in *JetStreamPlacement) DeepCopyInto(out *JetStreamPlacement) {
 	*out = *in
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
-		*out = make([]byte, len(*in))
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SResource.
-func (in *K8SResource) DeepCopy() *K8SResource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JetStreamPlacement.
+func (in *JetStreamPlacement) DeepCopy() *JetStreamPlacement {
 	if in == nil {
 		return nil
 	}
-	out := new(K8SResource)
+	out := new(JetStreamPlacement)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *K8SResourcePolicy) DeepCopyInto(out *K8SResourcePolicy) {
+func (in *JetStreamStreamConfig) DeepCopyInto(out *JetStreamStreamConfig) {
 	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.MaxMsgs != nil {
+		in, out := &in.MaxMsgs, &out.MaxMsgs
+		*out = new(int64)
+		**out = **in
 	}
-	if in.Backoff != nil {
-		in, out := &in.Backoff, &out.Backoff
-		*out = new(Backoff)
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxMsgSize != nil {
+		in, out := &in.MaxMsgSize, &out.MaxMsgSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Duplicates != nil {
+		in, out := &in.Duplicates, &out.Duplicates
+		*out = new(string)
+		**out = **in
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(JetStreamPlacement)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SResourcePolicy.
-func (in *K8SResourcePolicy) DeepCopy() *K8SResourcePolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JetStreamStreamConfig.
+func (in *JetStreamStreamConfig) DeepCopy() *JetStreamStreamConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(K8SResourcePolicy)
+	out := new(JetStreamStreamConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaBus) DeepCopyInto(out *KafkaBus) {
+func (in *K8SResource) DeepCopyInto(out *K8SResource) {
 	*out = *in
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(TLSConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SResource.
+func (in *K8SResource) DeepCopy() *K8SResource {
+	if in == nil {
+		return nil
+	}
+	out := new(K8SResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8SResourcePolicy) DeepCopyInto(out *K8SResourcePolicy) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SResourcePolicy.
+func (in *K8SResourcePolicy) DeepCopy() *K8SResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(K8SResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBus) DeepCopyInto(out *KafkaBus) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.SASL != nil {
 		in, out := &in.SASL, &out.SASL
@@ -2510,6 +3458,21 @@ func (in *KafkaEventSource) DeepCopyInto(out *KafkaEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	if in.SchemaRegistry != nil {
 		in, out := &in.SchemaRegistry, &out.SchemaRegistry
 		*out = new(SchemaRegistryConfig)
@@ -2520,6 +3483,11 @@ func (in *KafkaEventSource) DeepCopyInto(out *KafkaEventSource) {
 		*out = new(AWSMSKIAMConfig)
 		**out = **in
 	}
+	if in.Decoder != nil {
+		in, out := &in.Decoder, &out.Decoder
+		*out = new(PayloadDecoder)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2601,6 +3569,136 @@ func (in *KafkaTrigger) DeepCopy() *KafkaTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuditWebhook) DeepCopyInto(out *KubernetesAuditWebhook) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuditWebhook.
+func (in *KubernetesAuditWebhook) DeepCopy() *KubernetesAuditWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuditWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesEventSource) DeepCopyInto(out *KubernetesEventSource) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(KubernetesEventsWatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(KubernetesAuditWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesEventSource.
+func (in *KubernetesEventSource) DeepCopy() *KubernetesEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesEventsWatch) DeepCopyInto(out *KubernetesEventsWatch) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InvolvedObject != nil {
+		in, out := &in.InvolvedObject, &out.InvolvedObject
+		*out = new(InvolvedObjectFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesEventsWatch.
+func (in *KubernetesEventsWatch) DeepCopy() *KubernetesEventsWatch {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesEventsWatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogTrigger) DeepCopyInto(out *LogTrigger) {
 	*out = *in
@@ -2635,6 +3733,21 @@ func (in *MNSEventSource) DeepCopyInto(out *MNSEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2673,11 +3786,36 @@ func (in *MQTTEventSource) DeepCopyInto(out *MQTTEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	if in.Auth != nil {
 		in, out := &in.Auth, &out.Auth
 		*out = new(BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]MQTTTopic, len(*in))
+		copy(*out, *in)
+	}
+	if in.CleanSession != nil {
+		in, out := &in.CleanSession, &out.CleanSession
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2691,6 +3829,22 @@ func (in *MQTTEventSource) DeepCopy() *MQTTEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTTopic) DeepCopyInto(out *MQTTTopic) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTTopic.
+func (in *MQTTTopic) DeepCopy() *MQTTTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTTopic)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -2844,11 +3998,36 @@ func (in *NATSEventsSource) DeepCopyInto(out *NATSEventsSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	if in.Queue != nil {
 		in, out := &in.Queue, &out.Queue
 		*out = new(string)
 		**out = **in
 	}
+	if in.JetStream != nil {
+		in, out := &in.JetStream, &out.JetStream
+		*out = new(NATSJetStreamConsumer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Decoder != nil {
+		in, out := &in.Decoder, &out.Decoder
+		*out = new(PayloadDecoder)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2862,6 +4041,32 @@ func (in *NATSEventsSource) DeepCopy() *NATSEventsSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATSJetStreamConsumer) DeepCopyInto(out *NATSJetStreamConsumer) {
+	*out = *in
+	if in.FilterSubjects != nil {
+		in, out := &in.FilterSubjects, &out.FilterSubjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AckWait != nil {
+		in, out := &in.AckWait, &out.AckWait
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSJetStreamConsumer.
+func (in *NATSJetStreamConsumer) DeepCopy() *NATSJetStreamConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(NATSJetStreamConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATSTrigger) DeepCopyInto(out *NATSTrigger) {
 	*out = *in
@@ -2927,6 +4132,21 @@ func (in *NSQEventSource) DeepCopyInto(out *NSQEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3061,46 +4281,189 @@ func (in *NativeStrategy) DeepCopy() *NativeStrategy {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenWhiskTrigger) DeepCopyInto(out *OpenWhiskTrigger) {
+func (in *OAuth2ClientCredentials) DeepCopyInto(out *OAuth2ClientCredentials) {
 	*out = *in
-	if in.AuthToken != nil {
-		in, out := &in.AuthToken, &out.AuthToken
+	if in.ClientID != nil {
+		in, out := &in.ClientID, &out.ClientID
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Payload != nil {
-		in, out := &in.Payload, &out.Payload
-		*out = make([]TriggerParameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]TriggerParameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EndpointParams != nil {
+		in, out := &in.EndpointParams, &out.EndpointParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenWhiskTrigger.
-func (in *OpenWhiskTrigger) DeepCopy() *OpenWhiskTrigger {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ClientCredentials.
+func (in *OAuth2ClientCredentials) DeepCopy() *OAuth2ClientCredentials {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenWhiskTrigger)
+	out := new(OAuth2ClientCredentials)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OwnedRepositories) DeepCopyInto(out *OwnedRepositories) {
+func (in *ObjectStoreEventSource) DeepCopyInto(out *ObjectStoreEventSource) {
 	*out = *in
-	if in.Names != nil {
-		in, out := &in.Names, &out.Names
-		*out = make([]string, len(*in))
+	if in.AccessKey != nil {
+		in, out := &in.AccessKey, &out.AccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKey != nil {
+		in, out := &in.SecretKey, &out.SecretKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CACertificate != nil {
+		in, out := &in.CACertificate, &out.CACertificate
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQS != nil {
+		in, out := &in.SQS, &out.SQS
+		*out = new(ObjectStoreSQS)
+		**out = **in
+	}
+	if in.Poll != nil {
+		in, out := &in.Poll, &out.Poll
+		*out = new(ObjectStorePoll)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreEventSource.
+func (in *ObjectStoreEventSource) DeepCopy() *ObjectStoreEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorePoll) DeepCopyInto(out *ObjectStorePoll) {
+	*out = *in
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(EventPersistence)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorePoll.
+func (in *ObjectStorePoll) DeepCopy() *ObjectStorePoll {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorePoll)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStoreSQS) DeepCopyInto(out *ObjectStoreSQS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreSQS.
+func (in *ObjectStoreSQS) DeepCopy() *ObjectStoreSQS {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreSQS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenWhiskTrigger) DeepCopyInto(out *OpenWhiskTrigger) {
+	*out = *in
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenWhiskTrigger.
+func (in *OpenWhiskTrigger) DeepCopy() *OpenWhiskTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenWhiskTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnedRepositories) DeepCopyInto(out *OwnedRepositories) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
@@ -3116,6 +4479,42 @@ func (in *OwnedRepositories) DeepCopy() *OwnedRepositories {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PayloadDecoder) DeepCopyInto(out *PayloadDecoder) {
+	*out = *in
+	if in.Avro != nil {
+		in, out := &in.Avro, &out.Avro
+		*out = new(AvroDecoder)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Protobuf != nil {
+		in, out := &in.Protobuf, &out.Protobuf
+		*out = new(ProtobufDecoder)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Confluent != nil {
+		in, out := &in.Confluent, &out.Confluent
+		*out = new(SchemaRegistryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pulsar != nil {
+		in, out := &in.Pulsar, &out.Pulsar
+		*out = new(PulsarSchemaRegistry)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PayloadDecoder.
+func (in *PayloadDecoder) DeepCopy() *PayloadDecoder {
+	if in == nil {
+		return nil
+	}
+	out := new(PayloadDecoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PayloadField) DeepCopyInto(out *PayloadField) {
 	*out = *in
@@ -3163,6 +4562,228 @@ func (in *PersistenceStrategy) DeepCopy() *PersistenceStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PollCursor) DeepCopyInto(out *PollCursor) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PollCursor.
+func (in *PollCursor) DeepCopy() *PollCursor {
+	if in == nil {
+		return nil
+	}
+	out := new(PollCursor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PollEventSource) DeepCopyInto(out *PollEventSource) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecureHeaders != nil {
+		in, out := &in.SecureHeaders, &out.SecureHeaders
+		*out = make([]*SecureHeader, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SecureHeader)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(OAuth2ClientCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pagination != nil {
+		in, out := &in.Pagination, &out.Pagination
+		*out = new(PollPagination)
+		**out = **in
+	}
+	if in.Cursor != nil {
+		in, out := &in.Cursor, &out.Cursor
+		*out = new(PollCursor)
+		**out = **in
+	}
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(EventPersistence)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PollEventSource.
+func (in *PollEventSource) DeepCopy() *PollEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PollEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PollPagination) DeepCopyInto(out *PollPagination) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PollPagination.
+func (in *PollPagination) DeepCopy() *PollPagination {
+	if in == nil {
+		return nil
+	}
+	out := new(PollPagination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresEventSource) DeepCopyInto(out *PostgresEventSource) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Publications != nil {
+		in, out := &in.Publications, &out.Publications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConnectionBackoff != nil {
+		in, out := &in.ConnectionBackoff, &out.ConnectionBackoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresEventSource.
+func (in *PostgresEventSource) DeepCopy() *PostgresEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtobufDecoder) DeepCopyInto(out *ProtobufDecoder) {
+	*out = *in
+	if in.DescriptorSet != nil {
+		in, out := &in.DescriptorSet, &out.DescriptorSet
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtobufDecoder.
+func (in *ProtobufDecoder) DeepCopy() *ProtobufDecoder {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtobufDecoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PubSubEventSource) DeepCopyInto(out *PubSubEventSource) {
 	*out = *in
@@ -3183,6 +4804,21 @@ func (in *PubSubEventSource) DeepCopyInto(out *PubSubEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3196,6 +4832,37 @@ func (in *PubSubEventSource) DeepCopy() *PubSubEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PulsarBus) DeepCopyInto(out *PulsarBus) {
+	*out = *in
+	if in.TLSTrustCertsSecret != nil {
+		in, out := &in.TLSTrustCertsSecret, &out.TLSTrustCertsSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthTokenSecret != nil {
+		in, out := &in.AuthTokenSecret, &out.AuthTokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PulsarBus.
+func (in *PulsarBus) DeepCopy() *PulsarBus {
+	if in == nil {
+		return nil
+	}
+	out := new(PulsarBus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PulsarEventSource) DeepCopyInto(out *PulsarEventSource) {
 	*out = *in
@@ -3236,6 +4903,21 @@ func (in *PulsarEventSource) DeepCopyInto(out *PulsarEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	if in.AuthAthenzParams != nil {
 		in, out := &in.AuthAthenzParams, &out.AuthAthenzParams
 		*out = make(map[string]string, len(*in))
@@ -3248,6 +4930,11 @@ func (in *PulsarEventSource) DeepCopyInto(out *PulsarEventSource) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Decoder != nil {
+		in, out := &in.Decoder, &out.Decoder
+		*out = new(PayloadDecoder)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3261,6 +4948,27 @@ func (in *PulsarEventSource) DeepCopy() *PulsarEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PulsarSchemaRegistry) DeepCopyInto(out *PulsarSchemaRegistry) {
+	*out = *in
+	if in.AuthTokenSecret != nil {
+		in, out := &in.AuthTokenSecret, &out.AuthTokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PulsarSchemaRegistry.
+func (in *PulsarSchemaRegistry) DeepCopy() *PulsarSchemaRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(PulsarSchemaRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PulsarTrigger) DeepCopyInto(out *PulsarTrigger) {
 	*out = *in
@@ -3339,6 +5047,32 @@ func (in *RateLimit) DeepCopy() *RateLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisBus) DeepCopyInto(out *RedisBus) {
+	*out = *in
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisBus.
+func (in *RedisBus) DeepCopy() *RedisBus {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisBus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisEventSource) DeepCopyInto(out *RedisEventSource) {
 	*out = *in
@@ -3369,6 +5103,21 @@ func (in *RedisEventSource) DeepCopyInto(out *RedisEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3412,6 +5161,21 @@ func (in *RedisStreamEventSource) DeepCopyInto(out *RedisStreamEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3425,6 +5189,22 @@ func (in *RedisStreamEventSource) DeepCopy() *RedisStreamEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceConditionFilter) DeepCopyInto(out *ResourceConditionFilter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceConditionFilter.
+func (in *ResourceConditionFilter) DeepCopy() *ResourceConditionFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceConditionFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceEventSource) DeepCopyInto(out *ResourceEventSource) {
 	*out = *in
@@ -3446,6 +5226,31 @@ func (in *ResourceEventSource) DeepCopyInto(out *ResourceEventSource) {
 			(*out)[key] = val
 		}
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]metav1.GroupVersionResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = make([]Selector, len(*in))
+		copy(*out, *in)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3473,6 +5278,16 @@ func (in *ResourceFilter) DeepCopyInto(out *ResourceFilter) {
 		copy(*out, *in)
 	}
 	in.CreatedBy.DeepCopyInto(&out.CreatedBy)
+	if in.ChangedFields != nil {
+		in, out := &in.ChangedFields, &out.ChangedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ResourceConditionFilter, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3633,6 +5448,21 @@ func (in *SFTPEventSource) DeepCopyInto(out *SFTPEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3676,6 +5506,21 @@ func (in *SNSEventSource) DeepCopyInto(out *SNSEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3714,6 +5559,21 @@ func (in *SQSEventSource) DeepCopyInto(out *SQSEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	if in.SessionToken != nil {
 		in, out := &in.SessionToken, &out.SessionToken
 		*out = new(v1.SecretKeySelector)
@@ -3736,6 +5596,11 @@ func (in *SQSEventSource) DeepCopy() *SQSEventSource {
 func (in *SchemaRegistryConfig) DeepCopyInto(out *SchemaRegistryConfig) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3974,6 +5839,21 @@ func (in *SlackEventSource) DeepCopyInto(out *SlackEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4156,6 +6036,21 @@ func (in *StorageGridEventSource) DeepCopyInto(out *StorageGridEventSource) {
 		*out = new(TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4210,6 +6105,21 @@ func (in *StripeEventSource) DeepCopyInto(out *StripeEventSource) {
 			(*out)[key] = val
 		}
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4604,6 +6514,34 @@ func (in *WatchPathConfig) DeepCopy() *WatchPathConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookChallenge) DeepCopyInto(out *WebhookChallenge) {
+	*out = *in
+	if in.BodyValues != nil {
+		in, out := &in.BodyValues, &out.BodyValues
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(WebhookResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookChallenge.
+func (in *WebhookChallenge) DeepCopy() *WebhookChallenge {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookChallenge)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookContext) DeepCopyInto(out *WebhookContext) {
 	*out = *in
@@ -4634,6 +6572,28 @@ func (in *WebhookContext) DeepCopyInto(out *WebhookContext) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(WebhookResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Challenges != nil {
+		in, out := &in.Challenges, &out.Challenges
+		*out = make([]WebhookChallenge, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(WebhookRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxInFlight != nil {
+		in, out := &in.MaxInFlight, &out.MaxInFlight
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -4656,6 +6616,26 @@ func (in *WebhookEventSource) DeepCopyInto(out *WebhookEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(EventSourceSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]EventSourceRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Split != nil {
+		in, out := &in.Split, &out.Split
+		*out = new(WebhookSplit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4668,3 +6648,104 @@ func (in *WebhookEventSource) DeepCopy() *WebhookEventSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookMultipartSplit) DeepCopyInto(out *WebhookMultipartSplit) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Artifact)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxFileSize != nil {
+		in, out := &in.MaxFileSize, &out.MaxFileSize
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookMultipartSplit.
+func (in *WebhookMultipartSplit) DeepCopy() *WebhookMultipartSplit {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookMultipartSplit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookRateLimit) DeepCopyInto(out *WebhookRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TrustedProxies != nil {
+		in, out := &in.TrustedProxies, &out.TrustedProxies
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookRateLimit.
+func (in *WebhookRateLimit) DeepCopy() *WebhookRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookResponse) DeepCopyInto(out *WebhookResponse) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookResponse.
+func (in *WebhookResponse) DeepCopy() *WebhookResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSplit) DeepCopyInto(out *WebhookSplit) {
+	*out = *in
+	if in.Multipart != nil {
+		in, out := &in.Multipart, &out.Multipart
+		*out = new(WebhookMultipartSplit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSplit.
+func (in *WebhookSplit) DeepCopy() *WebhookSplit {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSplit)
+	in.DeepCopyInto(out)
+	return out
+}
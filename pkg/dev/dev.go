@@ -0,0 +1,231 @@
+// Package dev runs EventSources and Sensors in a single process with an embedded EventBus,
+// for local development and testing without a Kubernetes cluster.
+package dev
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/client/clientset/versioned/scheme"
+	"github.com/argoproj/argo-events/pkg/eventsources"
+	"github.com/argoproj/argo-events/pkg/metrics"
+	eventsourcecontroller "github.com/argoproj/argo-events/pkg/reconciler/eventsource"
+	sensorcontroller "github.com/argoproj/argo-events/pkg/reconciler/sensor"
+	"github.com/argoproj/argo-events/pkg/sensors"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	// DefaultBusName is the name of the embedded EventBus used by the dev mode.
+	DefaultBusName = "dev"
+	// defaultNamespace is the namespace of the objects without one.
+	defaultNamespace = "default"
+)
+
+// Options of running EventSources and Sensors in the dev mode.
+type Options struct {
+	// EventSources to run.
+	EventSources []*v1alpha1.EventSource
+	// Sensors to run.
+	Sensors []*v1alpha1.Sensor
+	// BusName is the name of the embedded EventBus, defaults to DefaultBusName.
+	BusName string
+	// KubeConfig is the path to the kubeconfig file, it is required by the Kubernetes and Argo Workflow triggers.
+	KubeConfig string
+}
+
+// LoadObjects reads the EventSources and Sensors in the YAML files, directories are read non-recursively.
+func LoadObjects(paths []string) ([]*v1alpha1.EventSource, []*v1alpha1.Sensor, error) {
+	eventSources := []*v1alpha1.EventSource{}
+	sensorObjs := []*v1alpha1.Sensor{}
+	files := []string{}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, entry := range entries {
+			ext := strings.ToLower(filepath.Ext(entry.Name()))
+			if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	for _, file := range files {
+		ess, sensors, err := loadFile(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		eventSources = append(eventSources, ess...)
+		sensorObjs = append(sensorObjs, sensors...)
+	}
+	return eventSources, sensorObjs, nil
+}
+
+// loadFile reads the EventSources and Sensors in the documents of a YAML file.
+func loadFile(file string) ([]*v1alpha1.EventSource, []*v1alpha1.Sensor, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	eventSources := []*v1alpha1.EventSource{}
+	sensorObjs := []*v1alpha1.Sensor{}
+	reader := yaml.NewYAMLReader(bufio.NewReader(f))
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read %s, %w", file, err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		var obj unstructured.Unstructured
+		if err := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(doc), 4096).Decode(&obj); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode %s, %w", file, err)
+		}
+		if kind := obj.GetKind(); kind != "EventSource" && kind != "Sensor" {
+			continue
+		}
+		runtimeObj, _, err := scheme.Codecs.UniversalDeserializer().Decode(doc, nil, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode %s, %w", file, err)
+		}
+		switch o := runtimeObj.(type) {
+		case *v1alpha1.EventSource:
+			eventSources = append(eventSources, o)
+		case *v1alpha1.Sensor:
+			sensorObjs = append(sensorObjs, o)
+		}
+	}
+	return eventSources, sensorObjs, nil
+}
+
+// Run validates and starts the EventSources and Sensors, it blocks until the context is done.
+func Run(ctx context.Context, opts Options) error {
+	logger := logging.FromContext(ctx)
+	if len(opts.EventSources) == 0 && len(opts.Sensors) == 0 {
+		return fmt.Errorf("no EventSource or Sensor found")
+	}
+	busName := opts.BusName
+	if busName == "" {
+		busName = DefaultBusName
+	}
+	busConfig := &v1alpha1.BusConfig{Embedded: &v1alpha1.EmbeddedBusConfig{Name: busName}}
+
+	var kubeClient kubernetes.Interface
+	var dynamicClient dynamic.Interface
+	if opts.KubeConfig != "" {
+		restConfig, err := sharedutil.GetClientConfig(opts.KubeConfig)
+		if err != nil {
+			return fmt.Errorf("failed to get kubeconfig, %w", err)
+		}
+		kubeClient = kubernetes.NewForConfigOrDie(restConfig)
+		dynamicClient = dynamic.NewForConfigOrDie(restConfig)
+	}
+
+	for _, es := range opts.EventSources {
+		if es.Namespace == "" {
+			es.Namespace = defaultNamespace
+		}
+		if err := eventsourcecontroller.ValidateEventSource(es); err != nil {
+			return fmt.Errorf("invalid EventSource %q, %w", es.Name, err)
+		}
+	}
+	embeddedBus := &v1alpha1.EventBus{Spec: v1alpha1.EventBusSpec{JetStream: &v1alpha1.JetStreamBus{}}}
+	for _, s := range opts.Sensors {
+		if s.Namespace == "" {
+			s.Namespace = defaultNamespace
+		}
+		if err := sensorcontroller.ValidateSensor(s, embeddedBus); err != nil {
+			return fmt.Errorf("invalid Sensor %q, %w", s.Name, err)
+		}
+		if kubeClient == nil {
+			for _, t := range s.Spec.Triggers {
+				if t.Template != nil && (t.Template.K8s != nil || t.Template.ArgoWorkflow != nil) {
+					return fmt.Errorf("trigger %q of Sensor %q requires a kubeconfig", t.Template.Name, s.Name)
+				}
+			}
+		}
+	}
+
+	wg := &sync.WaitGroup{}
+	errCh := make(chan error, len(opts.EventSources)+len(opts.Sensors))
+	// Sensors are started first, the embedded EventBus only delivers the events to the existing subscriptions,
+	// so the EventSources are started once all of the Sensors have subscribed.
+	sensorCtxs := []*sensors.SensorContext{}
+	for _, s := range opts.Sensors {
+		log := logger.With("sensorName", s.Name)
+		sensorCtx := sensors.NewSensorContext(kubeClient, dynamicClient, s, busConfig, fmt.Sprintf("eventbus-%s", s.Namespace), fmt.Sprintf("%s-dev", s.Name), metrics.NewMetrics(s.Namespace))
+		sensorCtxs = append(sensorCtxs, sensorCtx)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Info("starting sensor")
+			if err := sensorCtx.Start(logging.WithLogger(ctx, log)); err != nil {
+				errCh <- fmt.Errorf("failed to start Sensor %q, %w", s.Name, err)
+			}
+		}()
+	}
+	for _, sensorCtx := range sensorCtxs {
+		select {
+		case <-sensorCtx.Ready():
+		case err := <-errCh:
+			logger.Errorw("failed to run", zap.Error(err))
+			return err
+		case <-ctx.Done():
+			wg.Wait()
+			return nil
+		}
+	}
+	if len(sensorCtxs) > 0 {
+		logger.Info("all of the sensors have subscribed to the eventbus")
+	}
+	for _, es := range opts.EventSources {
+		log := logger.With(logging.LabelEventSourceName, es.Name)
+		adaptor := eventsources.NewEventSourceAdaptor(es, busConfig, nil, fmt.Sprintf("eventbus-%s", es.Namespace), fmt.Sprintf("%s-dev", es.Name), metrics.NewMetrics(es.Namespace))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Info("starting eventsource")
+			if err := adaptor.Start(logging.WithLogger(ctx, log)); err != nil {
+				errCh <- fmt.Errorf("failed to start EventSource %q, %w", es.Name, err)
+			}
+		}()
+	}
+
+	select {
+	case err := <-errCh:
+		logger.Errorw("failed to run", zap.Error(err))
+		return err
+	case <-ctx.Done():
+	}
+	wg.Wait()
+	return nil
+}
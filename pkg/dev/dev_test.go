@@ -0,0 +1,57 @@
+package dev
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadObjects(t *testing.T) {
+	dir := t.TempDir()
+	content := `---
+apiVersion: argoproj.io/v1alpha1
+kind: EventSource
+metadata:
+  name: webhook
+spec:
+  webhook:
+    example:
+      port: "12000"
+      endpoint: /example
+      method: POST
+--- # the sensor
+apiVersion: argoproj.io/v1alpha1
+kind: Sensor
+metadata:
+  name: webhook
+spec:
+  dependencies:
+    - name: dep
+      eventSourceName: webhook
+      eventName: example
+  triggers:
+    - template:
+        name: log
+        log: {}
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ignored
+---
+`
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "objects.yaml"), []byte(content), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("---\nkind: Sensor\n"), 0o600))
+
+	eventSources, sensors, err := LoadObjects([]string{dir})
+	assert.NoError(t, err)
+	assert.Len(t, eventSources, 1)
+	assert.Equal(t, "webhook", eventSources[0].Name)
+	assert.Len(t, sensors, 1)
+	assert.Equal(t, "webhook", sensors[0].Name)
+
+	_, _, err = LoadObjects([]string{filepath.Join(dir, "missing.yaml")})
+	assert.Error(t, err)
+}
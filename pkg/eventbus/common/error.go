@@ -1,5 +1,7 @@
 package common
 
+import "errors"
+
 // EventBusError is a particular EventBus related error.
 type EventBusError struct {
 	err error
@@ -9,7 +11,18 @@ func (e *EventBusError) Error() string {
 	return e.err.Error()
 }
 
+func (e *EventBusError) Unwrap() error {
+	return e.err
+}
+
 // NewEventBusError returns an EventBusError.
 func NewEventBusError(err error) error {
 	return &EventBusError{err: err}
 }
+
+// IsEventBusError tells if the error is caused by failing to publish to the EventBus,
+// in which case the upstream message should not be acknowledged, so that it is redelivered.
+func IsEventBusError(err error) bool {
+	var ebErr *EventBusError
+	return errors.As(err, &ebErr)
+}
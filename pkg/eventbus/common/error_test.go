@@ -23,3 +23,13 @@ func Test_error(t *testing.T) {
 	err = fmt.Errorf("err5, %w", err)
 	assert.True(t, errors.As(err, &ebErr))
 }
+
+func Test_IsEventBusError(t *testing.T) {
+	err := fmt.Errorf("error")
+	assert.False(t, IsEventBusError(err))
+	assert.False(t, IsEventBusError(nil))
+	ebErr := NewEventBusError(err)
+	assert.True(t, IsEventBusError(ebErr))
+	assert.True(t, IsEventBusError(fmt.Errorf("failed to dispatch, %w", ebErr)))
+	assert.True(t, errors.Is(ebErr, err))
+}
@@ -35,6 +35,14 @@ type TriggerConnection interface {
 		defaultSubject *string) error
 }
 
+// ReadyTriggerConnection is implemented by the trigger connections able to tell when they are ready to process
+// the new events, i.e. the subscriptions are created and the events replayed from the EventBus are processed.
+type ReadyTriggerConnection interface {
+	TriggerConnection
+	// Ready returns a channel which is closed once the connection is ready.
+	Ready() <-chan struct{}
+}
+
 type EventSourceDriver interface {
 	Initialize() error
 	Connect(clientID string) (EventSourceConnection, error)
@@ -48,3 +56,12 @@ type SensorDriver interface {
 		deps []Dependency,
 		atLeastOnce bool) (TriggerConnection, error)
 }
+
+// ReplayableSensorDriver is implemented by the sensor drivers able to replay the events published since a given time,
+// it is used when a Sensor switches to a new EventBus during a migration to restore its dependency state.
+type ReplayableSensorDriver interface {
+	SensorDriver
+	// SetReplayWindow makes the driver replay the events published since the since time,
+	// the events before the until time only restore the dependency state without firing triggers.
+	SetReplayWindow(since, until time.Time)
+}
@@ -9,10 +9,16 @@ import (
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	embeddedsource "github.com/argoproj/argo-events/pkg/eventbus/embedded/eventsource"
+	embeddedsensor "github.com/argoproj/argo-events/pkg/eventbus/embedded/sensor"
 	jetstreamsource "github.com/argoproj/argo-events/pkg/eventbus/jetstream/eventsource"
 	jetstreamsensor "github.com/argoproj/argo-events/pkg/eventbus/jetstream/sensor"
 	kafkasource "github.com/argoproj/argo-events/pkg/eventbus/kafka/eventsource"
 	kafkasensor "github.com/argoproj/argo-events/pkg/eventbus/kafka/sensor"
+	pulsarsource "github.com/argoproj/argo-events/pkg/eventbus/pulsar/eventsource"
+	pulsarsensor "github.com/argoproj/argo-events/pkg/eventbus/pulsar/sensor"
+	redissource "github.com/argoproj/argo-events/pkg/eventbus/redis/eventsource"
+	redissensor "github.com/argoproj/argo-events/pkg/eventbus/redis/sensor"
 	stansource "github.com/argoproj/argo-events/pkg/eventbus/stan/eventsource"
 	stansensor "github.com/argoproj/argo-events/pkg/eventbus/stan/sensor"
 	"github.com/argoproj/argo-events/pkg/shared/logging"
@@ -20,7 +26,19 @@ import (
 )
 
 func GetEventSourceDriver(ctx context.Context, eventBusConfig v1alpha1.BusConfig, eventSourceName string, defaultSubject string) (eventbuscommon.EventSourceDriver, error) {
-	auth, err := GetAuth(ctx, eventBusConfig)
+	return getEventSourceDriver(ctx, eventBusConfig, eventSourceName, defaultSubject, v1alpha1.EventBusAuthFileMountPath)
+}
+
+// GetSuccessorEventSourceDriver returns the driver of the EventBus being migrated to, whose auth file is mounted separately.
+func GetSuccessorEventSourceDriver(ctx context.Context, eventBusConfig v1alpha1.BusConfig, eventSourceName string, defaultSubject string) (eventbuscommon.EventSourceDriver, error) {
+	if eventBusConfig.NATS != nil {
+		return nil, fmt.Errorf("migrating to NATS Streaming eventbus is not supported")
+	}
+	return getEventSourceDriver(ctx, eventBusConfig, eventSourceName, defaultSubject, v1alpha1.EventBusSuccessorAuthFileMountPath)
+}
+
+func getEventSourceDriver(ctx context.Context, eventBusConfig v1alpha1.BusConfig, eventSourceName string, defaultSubject string, authFilePath string) (eventbuscommon.EventSourceDriver, error) {
+	auth, err := getAuth(ctx, eventBusConfig, authFilePath)
 	if err != nil {
 		return nil, err
 	}
@@ -40,6 +58,12 @@ func GetEventSourceDriver(ctx context.Context, eventBusConfig v1alpha1.BusConfig
 		eventBusType = v1alpha1.EventBusJetStream
 	case eventBusConfig.Kafka != nil:
 		eventBusType = v1alpha1.EventBusKafka
+	case eventBusConfig.Redis != nil:
+		eventBusType = v1alpha1.EventBusRedis
+	case eventBusConfig.Pulsar != nil:
+		eventBusType = v1alpha1.EventBusPulsar
+	case eventBusConfig.Embedded != nil:
+		eventBusType = v1alpha1.EventBusEmbedded
 	default:
 		return nil, fmt.Errorf("invalid event bus")
 	}
@@ -58,6 +82,12 @@ func GetEventSourceDriver(ctx context.Context, eventBusConfig v1alpha1.BusConfig
 		}
 	case v1alpha1.EventBusKafka:
 		dvr = kafkasource.NewKafkaSource(eventBusConfig.Kafka, logger)
+	case v1alpha1.EventBusRedis:
+		dvr = redissource.NewRedisSource(eventBusConfig.Redis, logger)
+	case v1alpha1.EventBusPulsar:
+		dvr = pulsarsource.NewPulsarSource(eventBusConfig.Pulsar, logger)
+	case v1alpha1.EventBusEmbedded:
+		dvr = embeddedsource.NewSourceEmbedded(eventBusConfig.Embedded.Name, eventSourceName, logger)
 	default:
 		return nil, fmt.Errorf("invalid eventbus type")
 	}
@@ -86,6 +116,12 @@ func GetSensorDriver(ctx context.Context, eventBusConfig v1alpha1.BusConfig, sen
 		eventBusType = v1alpha1.EventBusJetStream
 	case eventBusConfig.Kafka != nil:
 		eventBusType = v1alpha1.EventBusKafka
+	case eventBusConfig.Redis != nil:
+		eventBusType = v1alpha1.EventBusRedis
+	case eventBusConfig.Pulsar != nil:
+		eventBusType = v1alpha1.EventBusPulsar
+	case eventBusConfig.Embedded != nil:
+		eventBusType = v1alpha1.EventBusEmbedded
 	default:
 		return nil, fmt.Errorf("invalid event bus")
 	}
@@ -101,12 +137,25 @@ func GetSensorDriver(ctx context.Context, eventBusConfig v1alpha1.BusConfig, sen
 	case v1alpha1.EventBusKafka:
 		dvr = kafkasensor.NewKafkaSensor(eventBusConfig.Kafka, sensorSpec, hostname, logger)
 		return dvr, nil
+	case v1alpha1.EventBusRedis:
+		dvr = redissensor.NewRedisSensor(eventBusConfig.Redis, sensorSpec, hostname, logger)
+		return dvr, nil
+	case v1alpha1.EventBusPulsar:
+		dvr = pulsarsensor.NewPulsarSensor(eventBusConfig.Pulsar, sensorSpec, hostname, logger)
+		return dvr, nil
+	case v1alpha1.EventBusEmbedded:
+		dvr = embeddedsensor.NewSensorEmbedded(eventBusConfig.Embedded.Name, sensorSpec.Name, logger)
+		return dvr, nil
 	default:
 		return nil, fmt.Errorf("invalid eventbus type")
 	}
 }
 
 func GetAuth(ctx context.Context, eventBusConfig v1alpha1.BusConfig) (*eventbuscommon.Auth, error) {
+	return getAuth(ctx, eventBusConfig, v1alpha1.EventBusAuthFileMountPath)
+}
+
+func getAuth(ctx context.Context, eventBusConfig v1alpha1.BusConfig, authFilePath string) (*eventbuscommon.Auth, error) {
 	logger := logging.FromContext(ctx)
 
 	var eventBusAuth *v1alpha1.AuthStrategy
@@ -119,7 +168,7 @@ func GetAuth(ctx context.Context, eventBusConfig v1alpha1.BusConfig) (*eventbusc
 		} else {
 			eventBusAuth = nil
 		}
-	case eventBusConfig.Kafka != nil:
+	case eventBusConfig.Kafka != nil, eventBusConfig.Redis != nil, eventBusConfig.Pulsar != nil, eventBusConfig.Embedded != nil:
 		eventBusAuth = nil
 	default:
 		return nil, fmt.Errorf("invalid event bus")
@@ -134,7 +183,7 @@ func GetAuth(ctx context.Context, eventBusConfig v1alpha1.BusConfig) (*eventbusc
 		v := sharedutil.ViperWithLogging()
 		v.SetConfigName("auth")
 		v.SetConfigType("yaml")
-		v.AddConfigPath(v1alpha1.EventBusAuthFileMountPath)
+		v.AddConfigPath(authFilePath)
 		err := v.ReadInConfig()
 		if err != nil {
 			return nil, fmt.Errorf("failed to load auth.yaml. err: %w", err)
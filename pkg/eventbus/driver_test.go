@@ -89,3 +89,35 @@ func TestGetSourceDriver(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestGetSuccessorSourceDriver(t *testing.T) {
+	t.Run("get NATS Streaming successor driver", func(t *testing.T) {
+		_, err := GetSuccessorEventSourceDriver(context.Background(), testBusConfig, testEventSourceName, testSubject)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not supported")
+	})
+}
+
+func TestGetEmbeddedDriver(t *testing.T) {
+	embeddedBusConfig := v1alpha1.BusConfig{Embedded: &v1alpha1.EmbeddedBusConfig{Name: "test"}}
+	sourceDriver, err := GetEventSourceDriver(context.Background(), embeddedBusConfig, testEventSourceName, testSubject)
+	assert.NoError(t, err)
+	assert.NotNil(t, sourceDriver)
+	sensorDriver, err := GetSensorDriver(context.Background(), embeddedBusConfig, testValidSensorSpec, testHostname)
+	assert.NoError(t, err)
+	assert.NotNil(t, sensorDriver)
+}
+
+func TestGetRedisAndPulsarDriver(t *testing.T) {
+	for _, busConfig := range []v1alpha1.BusConfig{
+		{Redis: &v1alpha1.RedisBus{URL: "redis:6379", Stream: "test"}},
+		{Pulsar: &v1alpha1.PulsarBus{URL: "pulsar://pulsar:6650", Topic: "test"}},
+	} {
+		sourceDriver, err := GetEventSourceDriver(context.Background(), busConfig, testEventSourceName, testSubject)
+		assert.NoError(t, err)
+		assert.NotNil(t, sourceDriver)
+		sensorDriver, err := GetSensorDriver(context.Background(), busConfig, testValidSensorSpec, testHostname)
+		assert.NoError(t, err)
+		assert.NotNil(t, sensorDriver)
+	}
+}
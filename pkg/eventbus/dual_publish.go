@@ -0,0 +1,70 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+)
+
+// dualPublishDriver is an EventSource driver publishing the events to two EventBuses,
+// it is used while migrating to a new EventBus.
+type dualPublishDriver struct {
+	primary   eventbuscommon.EventSourceDriver
+	successor eventbuscommon.EventSourceDriver
+}
+
+// NewDualPublishEventSourceDriver returns a driver publishing the events to both of the primary and the successor EventBus.
+func NewDualPublishEventSourceDriver(primary, successor eventbuscommon.EventSourceDriver) eventbuscommon.EventSourceDriver {
+	return &dualPublishDriver{primary: primary, successor: successor}
+}
+
+func (d *dualPublishDriver) Initialize() error {
+	if err := d.primary.Initialize(); err != nil {
+		return err
+	}
+	if err := d.successor.Initialize(); err != nil {
+		return fmt.Errorf("failed to initialize successor eventbus driver, %w", err)
+	}
+	return nil
+}
+
+func (d *dualPublishDriver) Connect(clientID string) (eventbuscommon.EventSourceConnection, error) {
+	primaryConn, err := d.primary.Connect(clientID)
+	if err != nil {
+		return nil, err
+	}
+	successorConn, err := d.successor.Connect(clientID)
+	if err != nil {
+		_ = primaryConn.Close()
+		return nil, fmt.Errorf("failed to connect to successor eventbus, %w", err)
+	}
+	return &dualPublishConnection{primary: primaryConn, successor: successorConn}, nil
+}
+
+type dualPublishConnection struct {
+	primary   eventbuscommon.EventSourceConnection
+	successor eventbuscommon.EventSourceConnection
+}
+
+// Publish publishes the message to the primary EventBus first, a failure of either of them fails the publishing,
+// so that it gets retried and none of the EventBuses misses the message.
+func (c *dualPublishConnection) Publish(ctx context.Context, msg eventbuscommon.Message) error {
+	if err := c.primary.Publish(ctx, msg); err != nil {
+		return err
+	}
+	if err := c.successor.Publish(ctx, msg); err != nil {
+		return fmt.Errorf("failed to publish to successor eventbus, %w", err)
+	}
+	return nil
+}
+
+func (c *dualPublishConnection) Close() error {
+	return errors.Join(c.primary.Close(), c.successor.Close())
+}
+
+// IsClosed returns true if either of the connections is closed, to get both of them reconnected.
+func (c *dualPublishConnection) IsClosed() bool {
+	return c.primary.IsClosed() || c.successor.IsClosed()
+}
@@ -0,0 +1,83 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+)
+
+type fakeSourceDriver struct {
+	connectErr error
+	conn       *fakeSourceConn
+}
+
+func (d *fakeSourceDriver) Initialize() error {
+	return nil
+}
+
+func (d *fakeSourceDriver) Connect(clientID string) (eventbuscommon.EventSourceConnection, error) {
+	if d.connectErr != nil {
+		return nil, d.connectErr
+	}
+	d.conn = &fakeSourceConn{}
+	return d.conn, nil
+}
+
+type fakeSourceConn struct {
+	published  []eventbuscommon.Message
+	publishErr error
+	closed     bool
+}
+
+func (c *fakeSourceConn) Publish(ctx context.Context, msg eventbuscommon.Message) error {
+	if c.publishErr != nil {
+		return c.publishErr
+	}
+	c.published = append(c.published, msg)
+	return nil
+}
+
+func (c *fakeSourceConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *fakeSourceConn) IsClosed() bool {
+	return c.closed
+}
+
+func TestDualPublishDriver(t *testing.T) {
+	msg := eventbuscommon.Message{Body: []byte("hello")}
+
+	t.Run("publish to both of the eventbuses", func(t *testing.T) {
+		primary, successor := &fakeSourceDriver{}, &fakeSourceDriver{}
+		driver := NewDualPublishEventSourceDriver(primary, successor)
+		assert.NoError(t, driver.Initialize())
+		conn, err := driver.Connect("client")
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Publish(context.Background(), msg))
+		assert.Equal(t, []eventbuscommon.Message{msg}, primary.conn.published)
+		assert.Equal(t, []eventbuscommon.Message{msg}, successor.conn.published)
+
+		successor.conn.publishErr = fmt.Errorf("boom")
+		assert.Error(t, conn.Publish(context.Background(), msg))
+
+		assert.False(t, conn.IsClosed())
+		successor.conn.closed = true
+		assert.True(t, conn.IsClosed())
+		assert.NoError(t, conn.Close())
+		assert.True(t, primary.conn.closed)
+	})
+
+	t.Run("close primary connection if successor fails to connect", func(t *testing.T) {
+		primary, successor := &fakeSourceDriver{}, &fakeSourceDriver{connectErr: fmt.Errorf("boom")}
+		driver := NewDualPublishEventSourceDriver(primary, successor)
+		_, err := driver.Connect("client")
+		assert.Error(t, err)
+		assert.True(t, primary.conn.closed)
+	})
+}
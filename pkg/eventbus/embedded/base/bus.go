@@ -0,0 +1,141 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+)
+
+const (
+	// subscriptionBufferSize is the number of the messages a subscription holds before the publishing blocks.
+	subscriptionBufferSize = 1024
+)
+
+var (
+	busesLock sync.Mutex
+	buses     = map[string]*Bus{}
+)
+
+// Bus is an in-process EventBus, messages are kept in memory and delivered
+// to the subscriptions of the subject at the time they are published.
+type Bus struct {
+	name          string
+	lock          sync.RWMutex
+	subscriptions map[string]map[*Subscription]struct{}
+}
+
+// GetBus returns the in-process EventBus with the name, it is created on first use.
+func GetBus(name string) *Bus {
+	busesLock.Lock()
+	defer busesLock.Unlock()
+	if b, ok := buses[name]; ok {
+		return b
+	}
+	b := &Bus{
+		name:          name,
+		subscriptions: make(map[string]map[*Subscription]struct{}),
+	}
+	buses[name] = b
+	return b
+}
+
+// Subject returns the subject of the events of an EventSource.
+func Subject(eventSourceName, eventName string) string {
+	return fmt.Sprintf("%s.%s", eventSourceName, eventName)
+}
+
+func (b *Bus) String() string {
+	return fmt.Sprintf("EmbeddedBus{Name:%s}", b.name)
+}
+
+// Publish delivers the message to all of the subscriptions of the subject, it blocks
+// when a subscription is full until the message is accepted or the context is done.
+func (b *Bus) Publish(ctx context.Context, subject string, msg eventbuscommon.Message) error {
+	b.lock.RLock()
+	subs := make([]*Subscription, 0, len(b.subscriptions[subject]))
+	for s := range b.subscriptions[subject] {
+		subs = append(subs, s)
+	}
+	b.lock.RUnlock()
+
+	for _, s := range subs {
+		select {
+		case s.ch <- msg:
+		case <-s.done:
+		case <-ctx.Done():
+			return fmt.Errorf("failed to publish to subject %s, %w", subject, ctx.Err())
+		}
+	}
+	return nil
+}
+
+// Subscribe starts receiving the messages published to the subject.
+func (b *Bus) Subscribe(subject string) *Subscription {
+	s := &Subscription{
+		bus:     b,
+		subject: subject,
+		ch:      make(chan eventbuscommon.Message, subscriptionBufferSize),
+		done:    make(chan struct{}),
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if _, ok := b.subscriptions[subject]; !ok {
+		b.subscriptions[subject] = make(map[*Subscription]struct{})
+	}
+	b.subscriptions[subject][s] = struct{}{}
+	return s
+}
+
+// Subscription is a subscription to a subject of the in-process EventBus.
+type Subscription struct {
+	bus     *Bus
+	subject string
+	ch      chan eventbuscommon.Message
+	done    chan struct{}
+	once    sync.Once
+}
+
+// C returns the channel of the messages.
+func (s *Subscription) C() <-chan eventbuscommon.Message {
+	return s.ch
+}
+
+// Unsubscribe stops receiving the messages.
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		s.bus.lock.Lock()
+		defer s.bus.lock.Unlock()
+		delete(s.bus.subscriptions[s.subject], s)
+		if len(s.bus.subscriptions[s.subject]) == 0 {
+			delete(s.bus.subscriptions, s.subject)
+		}
+		close(s.done)
+	})
+}
+
+// Connection is a connection to the in-process EventBus.
+type Connection struct {
+	Bus    *Bus
+	lock   sync.RWMutex
+	closed bool
+}
+
+// NewConnection returns a connection to the in-process EventBus with the name.
+func NewConnection(name string) *Connection {
+	return &Connection{Bus: GetBus(name)}
+}
+
+func (c *Connection) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *Connection) IsClosed() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.closed
+}
@@ -0,0 +1,63 @@
+package base
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+)
+
+func TestBus(t *testing.T) {
+	ctx := context.Background()
+	msg := eventbuscommon.Message{MsgHeader: eventbuscommon.MsgHeader{EventSourceName: "es", EventName: "e", ID: "1"}, Body: []byte("hello")}
+
+	t.Run("same bus by name", func(t *testing.T) {
+		assert.Same(t, GetBus("same"), GetBus("same"))
+		assert.NotSame(t, GetBus("same"), GetBus("other"))
+	})
+
+	t.Run("publish to subscriptions", func(t *testing.T) {
+		b := GetBus(t.Name())
+		sub1 := b.Subscribe(Subject("es", "e"))
+		sub2 := b.Subscribe(Subject("es", "e"))
+		other := b.Subscribe(Subject("es", "other"))
+		assert.NoError(t, b.Publish(ctx, Subject("es", "e"), msg))
+		assert.Equal(t, msg, <-sub1.C())
+		assert.Equal(t, msg, <-sub2.C())
+		assert.Empty(t, other.C())
+
+		sub1.Unsubscribe()
+		sub1.Unsubscribe()
+		assert.NoError(t, b.Publish(ctx, Subject("es", "e"), msg))
+		assert.Empty(t, sub1.C())
+		assert.Equal(t, msg, <-sub2.C())
+	})
+
+	t.Run("publish without subscriptions", func(t *testing.T) {
+		b := GetBus(t.Name())
+		assert.NoError(t, b.Publish(ctx, Subject("es", "e"), msg))
+	})
+
+	t.Run("publish to a full subscription", func(t *testing.T) {
+		b := GetBus(t.Name())
+		sub := b.Subscribe(Subject("es", "e"))
+		for i := 0; i < subscriptionBufferSize; i++ {
+			assert.NoError(t, b.Publish(ctx, Subject("es", "e"), msg))
+		}
+		timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		assert.Error(t, b.Publish(timeoutCtx, Subject("es", "e"), msg))
+		sub.Unsubscribe()
+		assert.NoError(t, b.Publish(ctx, Subject("es", "e"), msg))
+	})
+
+	t.Run("connection", func(t *testing.T) {
+		conn := NewConnection(t.Name())
+		assert.False(t, conn.IsClosed())
+		assert.NoError(t, conn.Close())
+		assert.True(t, conn.IsClosed())
+	})
+}
@@ -0,0 +1,58 @@
+package eventsource
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	embeddedbase "github.com/argoproj/argo-events/pkg/eventbus/embedded/base"
+)
+
+type SourceEmbedded struct {
+	busName         string
+	eventSourceName string
+	logger          *zap.SugaredLogger
+}
+
+func NewSourceEmbedded(busName, eventSourceName string, logger *zap.SugaredLogger) *SourceEmbedded {
+	return &SourceEmbedded{
+		busName:         busName,
+		eventSourceName: eventSourceName,
+		logger:          logger,
+	}
+}
+
+func (n *SourceEmbedded) Initialize() error {
+	return nil
+}
+
+func (n *SourceEmbedded) Connect(clientID string) (eventbuscommon.EventSourceConnection, error) {
+	return &EmbeddedSourceConn{
+		Connection:      embeddedbase.NewConnection(n.busName),
+		eventSourceName: n.eventSourceName,
+		logger:          n.logger,
+	}, nil
+}
+
+type EmbeddedSourceConn struct {
+	*embeddedbase.Connection
+	eventSourceName string
+	logger          *zap.SugaredLogger
+}
+
+func (conn *EmbeddedSourceConn) Publish(ctx context.Context, msg eventbuscommon.Message) error {
+	if conn == nil {
+		return fmt.Errorf("Publish() failed; EmbeddedSourceConn is nil")
+	}
+	if conn.IsClosed() {
+		return fmt.Errorf("Publish() failed; connection to %s is closed", conn.Bus)
+	}
+	subject := embeddedbase.Subject(msg.EventSourceName, msg.EventName)
+	if err := conn.Bus.Publish(ctx, subject, msg); err != nil {
+		return err
+	}
+	conn.logger.Debugf("published message to subject %s", subject)
+	return nil
+}
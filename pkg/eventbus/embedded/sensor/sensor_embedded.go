@@ -0,0 +1,32 @@
+package sensor
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	embeddedbase "github.com/argoproj/argo-events/pkg/eventbus/embedded/base"
+)
+
+type SensorEmbedded struct {
+	busName    string
+	sensorName string
+	logger     *zap.SugaredLogger
+}
+
+func NewSensorEmbedded(busName, sensorName string, logger *zap.SugaredLogger) *SensorEmbedded {
+	return &SensorEmbedded{
+		busName:    busName,
+		sensorName: sensorName,
+		logger:     logger,
+	}
+}
+
+func (n *SensorEmbedded) Initialize() error {
+	return nil
+}
+
+func (n *SensorEmbedded) Connect(ctx context.Context, triggerName string, dependencyExpression string, deps []eventbuscommon.Dependency, atLeastOnce bool) (eventbuscommon.TriggerConnection, error) {
+	return NewEmbeddedTriggerConn(embeddedbase.NewConnection(n.busName), n.sensorName, triggerName, dependencyExpression, deps, n.logger)
+}
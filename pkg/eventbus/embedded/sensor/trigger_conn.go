@@ -0,0 +1,246 @@
+package sensor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Knetic/govaluate"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	embeddedbase "github.com/argoproj/argo-events/pkg/eventbus/embedded/base"
+)
+
+type EmbeddedTriggerConn struct {
+	*embeddedbase.Connection
+	sensorName           string
+	triggerName          string
+	dependencyExpression string
+	requiresANDLogic     bool
+	evaluableExpression  *govaluate.EvaluableExpression
+	deps                 []eventbuscommon.Dependency
+	sourceDepMap         map[string][]string           // maps EventSource and EventName to dependency name
+	savedDeps            map[string]*cloudevents.Event // events of the dependencies received before the expression is satisfied
+	recentMsgIDs         map[string]time.Time          // prevent re-processing the same message as before
+	logger               *zap.SugaredLogger
+	ready                chan struct{}
+	readyOnce            sync.Once
+}
+
+func NewEmbeddedTriggerConn(conn *embeddedbase.Connection,
+	sensorName string,
+	triggerName string,
+	dependencyExpression string,
+	deps []eventbuscommon.Dependency,
+	logger *zap.SugaredLogger) (*EmbeddedTriggerConn, error) {
+	sourceDepMap := make(map[string][]string)
+	for _, d := range deps {
+		key := embeddedbase.Subject(d.EventSourceName, d.EventName)
+		sourceDepMap[key] = append(sourceDepMap[key], d.Name)
+	}
+	expr, err := govaluate.NewEvaluableExpression(strings.ReplaceAll(dependencyExpression, "-", "\\-"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate expression %s: %w", dependencyExpression, err)
+	}
+	return &EmbeddedTriggerConn{
+		Connection:           conn,
+		sensorName:           sensorName,
+		triggerName:          triggerName,
+		dependencyExpression: dependencyExpression,
+		requiresANDLogic:     strings.Contains(dependencyExpression, "&"),
+		evaluableExpression:  expr,
+		deps:                 deps,
+		sourceDepMap:         sourceDepMap,
+		savedDeps:            make(map[string]*cloudevents.Event),
+		recentMsgIDs:         make(map[string]time.Time),
+		logger:               logger.With("triggerName", triggerName, "sensorName", sensorName),
+		ready:                make(chan struct{}),
+	}, nil
+}
+
+func (conn *EmbeddedTriggerConn) String() string {
+	if conn == nil {
+		return ""
+	}
+	return fmt.Sprintf("EmbeddedTriggerConn{Sensor:%s,Trigger:%s}", conn.sensorName, conn.triggerName)
+}
+
+// Ready returns a channel closed once the subscriptions are registered to the EventBus,
+// the events published before then are not delivered.
+func (conn *EmbeddedTriggerConn) Ready() <-chan struct{} {
+	return conn.ready
+}
+
+func (conn *EmbeddedTriggerConn) IsClosed() bool {
+	return conn == nil || conn.Connection.IsClosed()
+}
+
+func (conn *EmbeddedTriggerConn) Close() error {
+	if conn == nil {
+		return fmt.Errorf("can't close embedded trigger connection, EmbeddedTriggerConn is nil")
+	}
+	return conn.Connection.Close()
+}
+
+// Subscribe delivers the events of the dependencies published from now on, the dependency state is kept
+// in memory, so it does not survive restarts.
+func (conn *EmbeddedTriggerConn) Subscribe(ctx context.Context,
+	closeCh <-chan struct{},
+	resetConditionsCh <-chan struct{},
+	lastResetTime time.Time,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event),
+	defaultSubject *string) error {
+	if conn == nil {
+		return fmt.Errorf("Subscribe() failed; EmbeddedTriggerConn is nil")
+	}
+	log := conn.logger
+
+	ch := make(chan eventbuscommon.Message)
+	stopCh := make(chan struct{})
+	subs := []*embeddedbase.Subscription{}
+	for subject := range conn.sourceDepMap {
+		sub := conn.Bus.Subscribe(subject)
+		subs = append(subs, sub)
+		log.Infof("Subscribed to subject %s of %s", subject, conn.Bus)
+		go func() {
+			for {
+				select {
+				case m := <-sub.C():
+					select {
+					case ch <- m:
+					case <-stopCh:
+						return
+					}
+				case <-stopCh:
+					return
+				}
+			}
+		}()
+	}
+	conn.readyOnce.Do(func() { close(conn.ready) })
+	defer func() {
+		for _, sub := range subs {
+			sub.Unsubscribe()
+		}
+		close(stopCh)
+		_ = conn.Close()
+	}()
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case m := <-ch:
+			conn.processMsg(m.Body, transform, filter, action)
+		case <-resetConditionsCh:
+			log.Info("reset conditions")
+			conn.savedDeps = make(map[string]*cloudevents.Event)
+		case <-ticker.C:
+			conn.purgeOldMsgs()
+		case <-ctx.Done():
+			log.Info("exiting, closing connection...")
+			return nil
+		case <-closeCh:
+			log.Info("closing connection...")
+			return nil
+		}
+	}
+}
+
+func (conn *EmbeddedTriggerConn) processMsg(
+	data []byte,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event)) {
+	log := conn.logger
+
+	var event *cloudevents.Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		log.Errorf("Failed to convert to a cloudevent, discarding it... err: %v", err)
+		return
+	}
+
+	// De-duplication
+	if _, ok := conn.recentMsgIDs[event.ID()]; ok {
+		log.Debugf("already received message of ID %s, ignore this", event.ID())
+		return
+	}
+	conn.recentMsgIDs[event.ID()] = time.Now()
+
+	depNames, ok := conn.sourceDepMap[embeddedbase.Subject(event.Source(), event.Subject())]
+	if !ok {
+		log.Errorf("incoming event source and event not associated with any dependencies, event source=%s, event=%s", event.Source(), event.Subject())
+		return
+	}
+	for _, depName := range depNames {
+		conn.processDependency(event, depName, transform, filter, action)
+	}
+}
+
+func (conn *EmbeddedTriggerConn) processDependency(
+	event *cloudevents.Event,
+	depName string,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event)) {
+	log := conn.logger
+	event, err := transform(depName, *event)
+	if err != nil {
+		log.Errorw("failed to apply event transformation", zap.Error(err))
+		return
+	}
+	if !filter(depName, *event) {
+		log.Infof("not interested in dependency %s (didn't pass filter)", depName)
+		return
+	}
+
+	if !conn.requiresANDLogic {
+		log.Infof("Triggering actions after receiving dependency %s", depName)
+		action(map[string]cloudevents.Event{depName: *event})
+		return
+	}
+
+	parameters := make(map[string]interface{}, len(conn.deps))
+	for _, dep := range conn.deps {
+		_, ok := conn.savedDeps[dep.Name]
+		parameters[dep.Name] = ok
+	}
+	parameters[depName] = true
+	log.Infof("Current state of dependencies: %v", parameters)
+
+	result, err := conn.evaluableExpression.Evaluate(parameters)
+	if err != nil {
+		log.Errorf("failed to evaluate dependency expression: %v", err)
+		return
+	}
+	if result != true {
+		log.Debugf("dependency expression false: %s", conn.dependencyExpression)
+		conn.savedDeps[depName] = event
+		return
+	}
+
+	messages := make(map[string]cloudevents.Event, len(conn.savedDeps)+1)
+	for prevDep, saved := range conn.savedDeps {
+		messages[prevDep] = *saved
+	}
+	messages[depName] = *event
+	log.Infof("Triggering actions after receiving dependency %s", depName)
+	action(messages)
+	conn.savedDeps = make(map[string]*cloudevents.Event)
+}
+
+// purgeOldMsgs evicts the message IDs received more than 1 minute ago.
+func (conn *EmbeddedTriggerConn) purgeOldMsgs() {
+	for id, t := range conn.recentMsgIDs {
+		if time.Since(t) > time.Minute {
+			delete(conn.recentMsgIDs, id)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package sensor
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	embeddedsource "github.com/argoproj/argo-events/pkg/eventbus/embedded/eventsource"
+)
+
+func publish(t *testing.T, conn eventbuscommon.EventSourceConnection, eventSourceName, eventName, id string) {
+	t.Helper()
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource(eventSourceName)
+	event.SetSubject(eventName)
+	event.SetType("webhook")
+	body, err := json.Marshal(event)
+	require.NoError(t, err)
+	require.NoError(t, conn.Publish(context.Background(), eventbuscommon.Message{
+		MsgHeader: eventbuscommon.MsgHeader{EventSourceName: eventSourceName, EventName: eventName, ID: id},
+		Body:      body,
+	}))
+}
+
+func TestEmbeddedTriggerConn(t *testing.T) {
+	logger := zaptest.NewLogger(t).Sugar()
+	deps := []eventbuscommon.Dependency{
+		{Name: "dep-a", EventSourceName: "es", EventName: "a"},
+		{Name: "dep-b", EventSourceName: "es", EventName: "b"},
+	}
+	noTransform := func(depName string, event cloudevents.Event) (*cloudevents.Event, error) { return &event, nil }
+	noFilter := func(string, cloudevents.Event) bool { return true }
+
+	tests := []struct {
+		name       string
+		expression string
+		events     []string
+		triggered  []int
+	}{
+		{name: "or", expression: "dep-a || dep-b", events: []string{"a", "b"}, triggered: []int{1, 1}},
+		{name: "and", expression: "dep-a && dep-b", events: []string{"a", "a", "b", "b"}, triggered: []int{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			busName := t.Name()
+			source, err := embeddedsource.NewSourceEmbedded(busName, "es", logger).Connect("client")
+			require.NoError(t, err)
+			driver := NewSensorEmbedded(busName, "sensor", logger)
+			require.NoError(t, driver.Initialize())
+			conn, err := driver.Connect(context.Background(), "trigger", tt.expression, deps, false)
+			require.NoError(t, err)
+
+			triggered := make(chan map[string]cloudevents.Event, 10)
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_ = conn.Subscribe(ctx, make(chan struct{}), make(chan struct{}), time.Time{}, noTransform, noFilter, func(m map[string]cloudevents.Event) {
+					triggered <- m
+				}, nil)
+			}()
+			select {
+			case <-conn.(eventbuscommon.ReadyTriggerConnection).Ready():
+			case <-time.After(5 * time.Second):
+				t.Fatal("not subscribed")
+			}
+			for i, e := range tt.events {
+				publish(t, source, "es", e, string(rune('0'+i)))
+			}
+			for _, n := range tt.triggered {
+				select {
+				case m := <-triggered:
+					assert.Len(t, m, n)
+				case <-time.After(5 * time.Second):
+					t.Fatal("not triggered")
+				}
+			}
+			assert.Empty(t, triggered)
+			cancel()
+			<-done
+			assert.True(t, conn.IsClosed())
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"crypto/tls"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
@@ -119,9 +121,23 @@ func (stream *Jetstream) CreateStream(conn *JetstreamConnection) error {
 	}
 	var err error
 
+	streamConfig, err := stream.buildStreamConfig()
+	if err != nil {
+		return err
+	}
+
 	// before we add the Stream first let's check to make sure it doesn't already exist
 	streamInfo, err := conn.JSContext.StreamInfo(v1alpha1.JetStreamStreamName)
 	if streamInfo != nil && err == nil {
+		if streamConfigChanged(&streamInfo.Config, streamConfig) {
+			stream.Logger.Infof("Updating Stream '%s' with config:\n '%v'", v1alpha1.JetStreamStreamName, *streamConfig)
+			if _, err := conn.JSContext.UpdateStream(streamConfig); err != nil {
+				// Some of the settings such as retention policy can not be changed on an existing stream,
+				// keep using the existing one in that case.
+				stream.Logger.Warnw("Failed to update Stream, continue with the existing configuration", "stream", v1alpha1.JetStreamStreamName, zap.Error(err))
+			}
+			return nil
+		}
 		stream.Logger.Infof("No need to create Stream '%s' as it already exists", v1alpha1.JetStreamStreamName)
 		return nil
 	}
@@ -130,11 +146,31 @@ func (stream *Jetstream) CreateStream(conn *JetstreamConnection) error {
 		is trying to create the Stream at the same time): %v`, v1alpha1.JetStreamStreamName, err)
 	}
 
+	stream.Logger.Infof("Will use this stream config:\n '%v'", *streamConfig)
+
+	connectErr := sharedutil.DoWithRetry(nil, func() error { // exponential backoff if it fails the first time
+		_, err = conn.JSContext.AddStream(streamConfig)
+		if err != nil {
+			return fmt.Errorf("failed to add Jetstream stream %q for connection %+v: %w", v1alpha1.JetStreamStreamName, conn, err)
+		} else {
+			return nil
+		}
+	})
+	if connectErr != nil {
+		return connectErr
+	}
+
+	stream.Logger.Infof("Created Jetstream stream '%s' for connection %+v", v1alpha1.JetStreamStreamName, conn)
+	return nil
+}
+
+// buildStreamConfig builds the stream configuration from the stream settings.
+func (stream *Jetstream) buildStreamConfig() (*nats.StreamConfig, error) {
 	// unmarshal settings
 	v := viper.New()
 	v.SetConfigType("yaml")
 	if err := v.ReadConfig(bytes.NewBufferString(stream.streamSettings)); err != nil {
-		return err
+		return nil, err
 	}
 
 	v.SetDefault("retention", 0) // Limits
@@ -143,42 +179,67 @@ func (stream *Jetstream) CreateStream(conn *JetstreamConnection) error {
 	retentionPolicy, err := intToRetentionPolicy(v.GetInt("retention"))
 	if err != nil {
 		stream.Logger.Errorf("invalid retention policy: %s, error: %v", retentionPolicy, err)
-		return err
+		return nil, err
 	}
 
 	discardPolicy, err := intToDiscardPolicy(v.GetInt("discard"))
 	if err != nil {
 		stream.Logger.Errorf("invalid discard policy: %s, error: %v", discardPolicy, err)
-		return err
+		return nil, err
 	}
-	streamConfig := nats.StreamConfig{
-		Name:       v1alpha1.JetStreamStreamName,
-		Subjects:   []string{v1alpha1.JetStreamStreamName + ".*.*"},
-		Retention:  retentionPolicy,
-		Discard:    discardPolicy,
-		MaxMsgs:    v.GetInt64("maxMsgs"),
-		MaxAge:     v.GetDuration("maxAge"),
-		MaxBytes:   v.GetInt64("maxBytes"),
-		Storage:    nats.FileStorage,
-		Replicas:   v.GetInt("replicas"),
-		Duplicates: v.GetDuration("duplicates"),
+
+	compression, err := stringToCompression(v.GetString("compression"))
+	if err != nil {
+		stream.Logger.Errorf("invalid compression: %s, error: %v", v.GetString("compression"), err)
+		return nil, err
 	}
-	stream.Logger.Infof("Will use this stream config:\n '%v'", streamConfig)
 
-	connectErr := sharedutil.DoWithRetry(nil, func() error { // exponential backoff if it fails the first time
-		_, err = conn.JSContext.AddStream(&streamConfig)
-		if err != nil {
-			return fmt.Errorf("failed to add Jetstream stream %q for connection %+v: %w", v1alpha1.JetStreamStreamName, conn, err)
-		} else {
-			return nil
+	streamConfig := &nats.StreamConfig{
+		Name:        v1alpha1.JetStreamStreamName,
+		Subjects:    []string{v1alpha1.JetStreamStreamName + ".*.*"},
+		Retention:   retentionPolicy,
+		Discard:     discardPolicy,
+		MaxMsgs:     v.GetInt64("maxMsgs"),
+		MaxAge:      v.GetDuration("maxAge"),
+		MaxBytes:    v.GetInt64("maxBytes"),
+		MaxMsgSize:  v.GetInt32("maxMsgSize"),
+		Storage:     nats.FileStorage,
+		Replicas:    v.GetInt("replicas"),
+		Duplicates:  v.GetDuration("duplicates"),
+		Compression: compression,
+	}
+	if cluster, tags := v.GetString("placementCluster"), v.GetStringSlice("placementTags"); cluster != "" || len(tags) > 0 {
+		streamConfig.Placement = &nats.Placement{
+			Cluster: cluster,
+			Tags:    tags,
 		}
-	})
-	if connectErr != nil {
-		return connectErr
 	}
+	return streamConfig, nil
+}
 
-	stream.Logger.Infof("Created Jetstream stream '%s' for connection %+v", v1alpha1.JetStreamStreamName, conn)
-	return nil
+// streamConfigChanged tells if any of the settings managed by the stream config has been changed.
+func streamConfigChanged(existing, desired *nats.StreamConfig) bool {
+	if existing.Retention != desired.Retention ||
+		existing.Discard != desired.Discard ||
+		existing.Compression != desired.Compression ||
+		existing.MaxAge != desired.MaxAge {
+		return true
+	}
+	// The server fills in the defaults for the unset limits, only compare the ones explicitly configured
+	if (desired.MaxMsgs != 0 && existing.MaxMsgs != desired.MaxMsgs) ||
+		(desired.MaxBytes != 0 && existing.MaxBytes != desired.MaxBytes) ||
+		(desired.MaxMsgSize != 0 && existing.MaxMsgSize != desired.MaxMsgSize) ||
+		(desired.Replicas != 0 && existing.Replicas != desired.Replicas) ||
+		(desired.Duplicates != 0 && existing.Duplicates != desired.Duplicates) {
+		return true
+	}
+	if desired.Placement == nil {
+		return false
+	}
+	if existing.Placement == nil || existing.Placement.Cluster != desired.Placement.Cluster {
+		return true
+	}
+	return !slices.Equal(existing.Placement.Tags, desired.Placement.Tags)
 }
 
 func intToRetentionPolicy(i int) (nats.RetentionPolicy, error) {
@@ -195,3 +256,14 @@ func intToDiscardPolicy(i int) (nats.DiscardPolicy, error) {
 	}
 	return nats.DiscardPolicy(i), nil
 }
+
+func stringToCompression(s string) (nats.StoreCompression, error) {
+	switch strings.ToLower(s) {
+	case "", "none":
+		return nats.NoCompression, nil
+	case "s2":
+		return nats.S2Compression, nil
+	default:
+		return nats.NoCompression, fmt.Errorf("invalid string for StoreCompression: %s", s)
+	}
+}
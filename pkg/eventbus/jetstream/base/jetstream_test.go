@@ -0,0 +1,67 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+)
+
+func TestBuildStreamConfig(t *testing.T) {
+	settings := `
+maxmsgs: 1000
+maxage: 72h
+retention: 1
+discard: 1
+replicas: 3
+duplicates: 5m
+maxmsgsize: 1024
+compression: s2
+placementtags:
+- ssd
+`
+	js, err := NewJetstream("nats://127.0.0.1:4222", settings, &eventbuscommon.Auth{}, logging.NewArgoEventsLogger(), nil)
+	assert.NoError(t, err)
+	c, err := js.buildStreamConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), c.MaxMsgs)
+	assert.Equal(t, 72*time.Hour, c.MaxAge)
+	assert.Equal(t, nats.InterestPolicy, c.Retention)
+	assert.Equal(t, nats.DiscardNew, c.Discard)
+	assert.Equal(t, 3, c.Replicas)
+	assert.Equal(t, 5*time.Minute, c.Duplicates)
+	assert.Equal(t, int32(1024), c.MaxMsgSize)
+	assert.Equal(t, nats.S2Compression, c.Compression)
+	assert.NotNil(t, c.Placement)
+	assert.Equal(t, []string{"ssd"}, c.Placement.Tags)
+
+	js, err = NewJetstream("nats://127.0.0.1:4222", "compression: zstd", &eventbuscommon.Auth{}, logging.NewArgoEventsLogger(), nil)
+	assert.NoError(t, err)
+	_, err = js.buildStreamConfig()
+	assert.Error(t, err)
+}
+
+func TestStreamConfigChanged(t *testing.T) {
+	existing := &nats.StreamConfig{
+		MaxMsgs:    -1,
+		MaxBytes:   -1,
+		MaxAge:     72 * time.Hour,
+		Replicas:   3,
+		Duplicates: 2 * time.Minute,
+	}
+	desired := &nats.StreamConfig{
+		MaxAge: 72 * time.Hour,
+	}
+	assert.False(t, streamConfigChanged(existing, desired))
+	desired.MaxMsgs = 1000
+	assert.True(t, streamConfigChanged(existing, desired))
+	desired.MaxMsgs = 0
+	desired.Placement = &nats.Placement{Tags: []string{"ssd"}}
+	assert.True(t, streamConfigChanged(existing, desired))
+	existing.Placement = &nats.Placement{Tags: []string{"ssd"}}
+	assert.False(t, streamConfigChanged(existing, desired))
+}
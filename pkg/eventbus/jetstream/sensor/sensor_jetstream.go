@@ -28,6 +28,9 @@ type SensorJetstream struct {
 	sensorName    string
 	sensorSpec    *v1alpha1.Sensor
 	keyValueStore nats.KeyValue
+
+	replaySince time.Time
+	replayUntil time.Time
 }
 
 func NewSensorJetstream(url string, sensorSpec *v1alpha1.Sensor, streamConfig string, auth *eventbuscommon.Auth, logger *zap.SugaredLogger, tls *v1alpha1.TLSConfig) (*SensorJetstream, error) {
@@ -40,10 +43,16 @@ func NewSensorJetstream(url string, sensorSpec *v1alpha1.Sensor, streamConfig st
 		return nil, err
 	}
 	return &SensorJetstream{
-		baseJetstream,
-		sensorSpec.Name,
-		sensorSpec,
-		nil}, nil
+		Jetstream:  baseJetstream,
+		sensorName: sensorSpec.Name,
+		sensorSpec: sensorSpec}, nil
+}
+
+// SetReplayWindow makes the new consumers replay the events since the given time, and the events
+// before the until time only restore the dependency state without firing triggers.
+func (stream *SensorJetstream) SetReplayWindow(since, until time.Time) {
+	stream.replaySince = since
+	stream.replayUntil = until
 }
 
 func (stream *SensorJetstream) Initialize() error {
@@ -77,7 +86,13 @@ func (stream *SensorJetstream) Connect(ctx context.Context, triggerName string,
 		return nil, err
 	}
 
-	return NewJetstreamTriggerConn(conn, stream.sensorName, triggerName, dependencyExpression, deps)
+	triggerConn, err := NewJetstreamTriggerConn(conn, stream.sensorName, triggerName, dependencyExpression, deps)
+	if err != nil {
+		return nil, err
+	}
+	triggerConn.replaySince = stream.replaySince
+	triggerConn.replayUntil = stream.replayUntil
+	return triggerConn, nil
 }
 
 // Update the K/V store to reflect the current Spec:
@@ -12,7 +12,9 @@ import (
 	"github.com/Knetic/govaluate"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	nats "github.com/nats-io/nats.go"
+	"go.uber.org/zap"
 
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
 	jetstreambase "github.com/argoproj/argo-events/pkg/eventbus/jetstream/base"
 )
@@ -29,6 +31,10 @@ type JetstreamTriggerConn struct {
 	sourceDepMap         map[string][]string // maps EventSource and EventName to dependency name
 	recentMsgsByID       map[string]*msg     // prevent re-processing the same message as before (map of msg ID to time)
 	recentMsgsByTime     []*msg
+	replaySince          time.Time // start time of new consumers, replays the events since then
+	replayUntil          time.Time // events before it only restore the dependency state without triggering
+	ready                chan struct{}
+	readyOnce            sync.Once
 }
 
 type msg struct {
@@ -62,7 +68,8 @@ func NewJetstreamTriggerConn(conn *jetstreambase.JetstreamConnection,
 		deps:                 deps,
 		sourceDepMap:         sourceDepMap,
 		recentMsgsByID:       make(map[string]*msg),
-		recentMsgsByTime:     make([]*msg, 0)}
+		recentMsgsByTime:     make([]*msg, 0),
+		ready:                make(chan struct{})}
 	connection.Logger = connection.Logger.With("triggerName", connection.triggerName, "sensorName", connection.sensorName)
 
 	connection.evaluableExpression, err = govaluate.NewEvaluableExpression(strings.ReplaceAll(dependencyExpression, "-", "\\-"))
@@ -90,6 +97,16 @@ func (conn *JetstreamTriggerConn) Close() error {
 	return conn.JetstreamConnection.Close()
 }
 
+// Ready returns a channel closed once the subscriptions are created, and the consumers replaying
+// the events have caught up with the stream.
+func (conn *JetstreamTriggerConn) Ready() <-chan struct{} {
+	return conn.ready
+}
+
+func (conn *JetstreamTriggerConn) markReady() {
+	conn.readyOnce.Do(func() { close(conn.ready) })
+}
+
 func (conn *JetstreamTriggerConn) String() string {
 	if conn == nil {
 		return ""
@@ -140,7 +157,7 @@ func (conn *JetstreamTriggerConn) Subscribe(ctx context.Context,
 
 		conn.Logger.Debugf("durable name for sensor='%s', trigger='%s', dep='%s': '%s'", conn.sensorName, conn.triggerName, dependency.Name, durableName)
 		log.Infof("Subscribing to subject %s with durable name %s", subject, durableName)
-		subscriptions[subscriptionIndex], err = conn.JSContext.PullSubscribe(subject, durableName, nats.AckExplicit(), nats.DeliverNew())
+		subscriptions[subscriptionIndex], err = conn.JSContext.PullSubscribe(subject, durableName, conn.subscribeOptions(durableName)...)
 		if err != nil {
 			return fmt.Errorf("failed to subscribe to subject %s using group %s: %w", subject, durableName, err)
 		} else {
@@ -155,6 +172,14 @@ func (conn *JetstreamTriggerConn) Subscribe(ctx context.Context,
 		subscriptionIndex++
 	}
 
+	if conn.replaySince.IsZero() {
+		conn.markReady()
+	} else {
+		stopCh := make(chan struct{})
+		defer close(stopCh)
+		go conn.waitForReplay(subscriptions, stopCh)
+	}
+
 	// create a single goroutine which which handle receiving messages to ensure that all of the processing is occurring on that
 	// one goroutine and we don't need to worry about race conditions
 	go conn.processMsgs(ch, processMsgsCloseCh, resetConditionsCh, transform, filter, action, &wg)
@@ -175,6 +200,63 @@ func (conn *JetstreamTriggerConn) Subscribe(ctx context.Context,
 	}
 }
 
+// subscribeOptions returns the options of subscribing with the durable name, the deliver policy is only
+// specified when the consumer does not exist, it can not be changed on an existing consumer.
+func (conn *JetstreamTriggerConn) subscribeOptions(durableName string) []nats.SubOpt {
+	if _, err := conn.JSContext.ConsumerInfo(v1alpha1.JetStreamStreamName, durableName); err == nil {
+		return []nats.SubOpt{nats.AckExplicit()}
+	}
+	if !conn.replaySince.IsZero() {
+		conn.Logger.Infof("replaying the events since %v for durable name %s", conn.replaySince, durableName)
+		return []nats.SubOpt{nats.AckExplicit(), nats.StartTime(conn.replaySince)}
+	}
+	return []nats.SubOpt{nats.AckExplicit(), nats.DeliverNew()}
+}
+
+// waitForReplay marks the connection ready once all of the consumers have caught up,
+// that is all of the messages in the stream are delivered and acknowledged.
+func (conn *JetstreamTriggerConn) waitForReplay(subscriptions []*nats.Subscription, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+		caughtUp := true
+		for _, sub := range subscriptions {
+			info, err := sub.ConsumerInfo()
+			if err != nil {
+				conn.Logger.Warnw("failed to get the consumer info", zap.Error(err))
+				caughtUp = false
+				break
+			}
+			if info.NumPending > 0 || info.NumAckPending > 0 {
+				caughtUp = false
+				break
+			}
+		}
+		if caughtUp {
+			conn.Logger.Info("caught up with the replayed events")
+			conn.markReady()
+			return
+		}
+	}
+}
+
+// isReplayed tells if the message is replayed only to restore the dependency state.
+func (conn *JetstreamTriggerConn) isReplayed(m *nats.Msg) bool {
+	if conn.replayUntil.IsZero() {
+		return false
+	}
+	meta, err := m.Metadata()
+	if err != nil {
+		return false
+	}
+	return meta.Timestamp.Before(conn.replayUntil)
+}
+
 func (conn *JetstreamTriggerConn) shutdownSubscriptions(processMsgsCloseCh chan struct{}, pullSubscribeCloseCh map[string]chan struct{}, wg *sync.WaitGroup) {
 	processMsgsCloseCh <- struct{}{}
 	for _, ch := range pullSubscribeCloseCh {
@@ -348,6 +430,10 @@ func (conn *JetstreamTriggerConn) processDependency(
 
 	if !conn.requiresANDLogic {
 		// this is the simple case: we can just perform the trigger
+		if conn.isReplayed(m) {
+			log.Infof("skip triggering actions for replayed dependency %s", depName)
+			return
+		}
 		messages := make(map[string]cloudevents.Event)
 		messages[depName] = *event
 		log.Infof("Triggering actions after receiving dependency %s", depName)
@@ -385,6 +471,13 @@ func (conn *JetstreamTriggerConn) processDependency(
 		if result == true {
 			log.Debugf("dependency expression successfully evaluated to true: '%s'", conn.dependencyExpression)
 
+			if conn.isReplayed(m) {
+				// the actions have been triggered by the sensor consuming from the previous eventbus
+				log.Infof("skip triggering actions for replayed dependency %s", depName)
+				_ = conn.clearAllDependencies(nil)
+				return
+			}
+
 			messages := make(map[string]cloudevents.Event, len(prevMsgs)+1)
 			for prevDep, msgInfo := range prevMsgs {
 				messages[prevDep] = *msgInfo.Event
@@ -31,9 +31,23 @@ type KafkaHandler struct {
 	// used to clear state when consumer group is rebalanced
 	Reset func() error
 
+	// replay details, only set when the sensor switches over to
+	// a new eventbus during a migration, the partitions of the
+	// event topic without committed offset are consumed from the
+	// first message published since ReplaySince, and CaughtUp is
+	// called once the messages published before the consumer
+	// group session started are processed
+	Client      sarama.Client
+	EventTopic  string
+	ReplaySince time.Time
+	CaughtUp    func()
+
 	// maintains a mapping of keys (which correspond to triggers)
 	// to offsets, used to ensure triggers aren't invoked twice
 	checkpoints Checkpoints
+
+	// offsets of the event topic partitions to reach to catch up
+	catchUp map[int32]int64
 }
 
 type Checkpoints map[string]map[int32]*Checkpoint
@@ -78,6 +92,7 @@ func (h *KafkaHandler) Setup(session sarama.ConsumerGroupSession) error {
 	// instantiates checkpoints for all topic/partitions managed by
 	// this claim
 	h.checkpoints = Checkpoints{}
+	h.catchUp = map[int32]int64{}
 
 	for topic, partitions := range session.Claims() {
 		h.checkpoints[topic] = map[int32]*Checkpoint{}
@@ -88,11 +103,13 @@ func (h *KafkaHandler) Setup(session sarama.ConsumerGroupSession) error {
 				return err
 			}
 
+			var next int64
 			func() {
 				var offsets map[string]int64
 
 				defer partitionOffsetManager.AsyncClose()
 				offset, metadata := partitionOffsetManager.NextOffset()
+				next = offset
 
 				// only need to manage the offsets for each trigger
 				// with respect to the trigger topic
@@ -115,12 +132,72 @@ func (h *KafkaHandler) Setup(session sarama.ConsumerGroupSession) error {
 			if err := partitionOffsetManager.Close(); err != nil {
 				return err
 			}
+
+			if topic == h.EventTopic && h.CaughtUp != nil {
+				if err := h.replay(session, partition, next); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
+	if h.CaughtUp != nil && len(h.catchUp) == 0 {
+		h.CaughtUp()
+	}
+
 	return nil
 }
 
+// replay starts consuming an event topic partition without committed
+// offset from the first message published since the replay time, and
+// records the offset to reach to catch up with the partition
+func (h *KafkaHandler) replay(session sarama.ConsumerGroupSession, partition int32, next int64) error {
+	latest, err := h.Client.GetOffset(h.EventTopic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return err
+	}
+
+	if next < 0 && !h.ReplaySince.IsZero() {
+		offset, err := h.Client.GetOffset(h.EventTopic, partition, h.ReplaySince.UnixMilli())
+		if err != nil {
+			return err
+		}
+		// -1 means there is no message published since then
+		if offset < 0 {
+			offset = latest
+		}
+		h.Logger.Infow("Replaying events",
+			zap.Int32("partition", partition),
+			zap.Int64("offset", offset),
+			zap.Time("since", h.ReplaySince))
+		session.MarkOffset(h.EventTopic, partition, offset, "")
+		next = offset
+	}
+
+	if next >= 0 && next < latest {
+		h.catchUp[partition] = latest
+	}
+
+	return nil
+}
+
+// processed records the offset of the next message to process of an
+// event topic partition, and calls CaughtUp once all of the event topic
+// partitions have caught up
+func (h *KafkaHandler) processed(topic string, partition int32, offset int64) {
+	if topic != h.EventTopic || h.CaughtUp == nil {
+		return
+	}
+	latest, ok := h.catchUp[partition]
+	if !ok || offset < latest {
+		return
+	}
+	delete(h.catchUp, partition)
+	if len(h.catchUp) == 0 {
+		h.CaughtUp()
+	}
+}
+
 func (h *KafkaHandler) Cleanup(session sarama.ConsumerGroupSession) error {
 	h.Logger.Infow("Kafka cleanup", zap.Any("claims", session.Claims()))
 	return h.Reset()
@@ -208,7 +285,9 @@ func (h *KafkaHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim s
 				defer h.Unlock()
 				if err := transaction.Commit(session, messages, offset, checkpoint.Metadata()); err != nil {
 					h.Logger.Errorw("Transaction error", zap.Error(err))
+					return
 				}
+				h.processed(claim.Topic(), claim.Partition(), offset)
 			}()
 
 			// invoke (action) functions asynchronously
@@ -38,6 +38,13 @@ type KafkaSensor struct {
 	// handles consuming from kafka, offsets, and transactions
 	kafkaHandler *KafkaHandler
 	connected    bool
+
+	// replay window of a sensor switching over to a new eventbus
+	// during a migration
+	replaySince time.Time
+	replayUntil time.Time
+	ready       chan struct{}
+	readyOnce   sync.Once
 }
 
 func NewKafkaSensor(kafkaConfig *v1alpha1.KafkaBus, sensor *v1alpha1.Sensor, hostname string, logger *zap.SugaredLogger) *KafkaSensor {
@@ -62,9 +69,22 @@ func NewKafkaSensor(kafkaConfig *v1alpha1.KafkaBus, sensor *v1alpha1.Sensor, hos
 		hostname:  hostname,
 		groupName: groupName,
 		triggers:  Triggers{},
+		ready:     make(chan struct{}),
 	}
 }
 
+// SetReplayWindow makes the sensor replay the events published since the
+// since time, the events published before the until time only restore the
+// state of the triggers without invoking them.
+func (s *KafkaSensor) SetReplayWindow(since, until time.Time) {
+	s.replaySince = since
+	s.replayUntil = until
+}
+
+func (s *KafkaSensor) markReady() {
+	s.readyOnce.Do(func() { close(s.ready) })
+}
+
 type Topics struct {
 	event   string
 	trigger string
@@ -84,6 +104,7 @@ type TriggerWithDepName struct {
 
 const (
 	dependencyNameHeader = "dependencyName"
+	replayedHeader       = "replayed"
 )
 
 func (t Triggers) List(event *cloudevents.Event) []*TriggerWithDepName {
@@ -102,9 +123,9 @@ func (t Triggers) List(event *cloudevents.Event) []*TriggerWithDepName {
 	return triggers
 }
 
-func (t Triggers) Ready() bool {
+func (t Triggers) Subscribed() bool {
 	for _, trigger := range t {
-		if !trigger.Ready() {
+		if !trigger.Subscribed() {
 			return false
 		}
 	}
@@ -157,12 +178,23 @@ func (s *KafkaSensor) Initialize() error {
 		OffsetManager: offsetManager,
 		TriggerTopic:  s.topics.trigger,
 		Reset:         s.Reset,
+		Client:        client,
+		EventTopic:    s.topics.event,
+		ReplaySince:   s.replaySince,
 		Handlers: map[string]func(*sarama.ConsumerMessage) ([]*sarama.ProducerMessage, int64, func()){
 			s.topics.event:   s.Event,
 			s.topics.trigger: s.Trigger,
 			s.topics.action:  s.Action,
 		},
 	}
+	if s.replayUntil.IsZero() {
+		s.markReady()
+	} else {
+		s.kafkaHandler.CaughtUp = func() {
+			s.Logger.Info("Caught up with the replayed events")
+			s.markReady()
+		}
+	}
 
 	return nil
 }
@@ -204,6 +236,7 @@ func (s *KafkaSensor) Connect(ctx context.Context, triggerName string, depExpres
 			atLeastOnce:     atLeastOnce,
 			close:           s.Close,
 			isClosed:        s.IsClosed,
+			ready:           s.ready,
 		}
 	}
 
@@ -214,7 +247,7 @@ func (s *KafkaSensor) Listen(ctx context.Context) {
 	defer s.Disconnect()
 
 	for {
-		if len(s.triggers) != len(s.sensor.Spec.Triggers) || !s.triggers.Ready() {
+		if len(s.triggers) != len(s.sensor.Spec.Triggers) || !s.triggers.Subscribed() {
 			s.Logger.Info("Not ready to consume, waiting...")
 			time.Sleep(3 * time.Second)
 			continue
@@ -286,8 +319,16 @@ func (s *KafkaSensor) Event(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMess
 		return nil, msg.Offset + 1, nil
 	}
 
+	replayed := s.isReplayed(msg)
 	messages := []*sarama.ProducerMessage{}
 	for _, trigger := range s.triggers.List(event) {
+		// a replayed event only restores the state of the triggers
+		// depending on multiple events
+		if replayed && trigger.OneAndDone() {
+			s.Logger.Infof("Skip invoking trigger '%s' for replayed dependency '%s'", trigger.Name(), trigger.depName)
+			continue
+		}
+
 		event, err := trigger.Transform(trigger.depName, event)
 		if err != nil {
 			s.Logger.Errorw("Failed to transform cloudevent, skipping", zap.Error(err))
@@ -319,20 +360,34 @@ func (s *KafkaSensor) Event(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMess
 			continue
 		}
 
+		headers := []sarama.RecordHeader{{
+			Key:   []byte(dependencyNameHeader),
+			Value: []byte(trigger.depName),
+		}}
+		if replayed {
+			headers = append(headers, sarama.RecordHeader{
+				Key:   []byte(replayedHeader),
+				Value: []byte("true"),
+			})
+		}
+
 		messages = append(messages, &sarama.ProducerMessage{
-			Topic: topic,
-			Key:   sarama.StringEncoder(trigger.Name()),
-			Value: sarama.ByteEncoder(value),
-			Headers: []sarama.RecordHeader{{
-				Key:   []byte(dependencyNameHeader),
-				Value: []byte(trigger.depName),
-			}},
+			Topic:   topic,
+			Key:     sarama.StringEncoder(trigger.Name()),
+			Value:   sarama.ByteEncoder(value),
+			Headers: headers,
 		})
 	}
 
 	return messages, msg.Offset + 1, nil
 }
 
+// isReplayed tells if the event is replayed only to restore the state of
+// the triggers.
+func (s *KafkaSensor) isReplayed(msg *sarama.ConsumerMessage) bool {
+	return !s.replayUntil.IsZero() && msg.Timestamp.Before(s.replayUntil)
+}
+
 func (s *KafkaSensor) Trigger(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMessage, int64, func()) {
 	var event *cloudevents.Event
 	if err := json.Unmarshal(msg.Value, &event); err != nil {
@@ -344,11 +399,14 @@ func (s *KafkaSensor) Trigger(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMe
 	messages := []*sarama.ProducerMessage{}
 	offset := msg.Offset + 1
 	var dependencyName string
+	var replayed bool
 	if event != nil && len(msg.Headers) > 0 {
 		for _, header := range msg.Headers {
-			if string(header.Key) == dependencyNameHeader {
+			switch string(header.Key) {
+			case dependencyNameHeader:
 				dependencyName = string(header.Value)
-				break
+			case replayedHeader:
+				replayed = true
 			}
 		}
 	}
@@ -367,6 +425,11 @@ func (s *KafkaSensor) Trigger(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMe
 				return
 			}
 
+			if replayed {
+				s.Logger.Infof("Skip invoking trigger '%s' for replayed dependency '%s'", trigger.Name(), dependencyName)
+				return
+			}
+
 			value, err := json.Marshal(events)
 			if err != nil {
 				s.Logger.Errorw("Failed to serialize cloudevent, skipping", zap.Error(err))
@@ -0,0 +1,115 @@
+package kafka
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/Knetic/govaluate"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventbus/kafka/base"
+)
+
+func newTestSensor(t *testing.T, expressions map[string]string) *KafkaSensor {
+	t.Helper()
+	logger := zap.NewNop().Sugar()
+	sensor := &v1alpha1.Sensor{}
+	sensor.Name = "test-sensor"
+	s := NewKafkaSensor(&v1alpha1.KafkaBus{URL: "localhost:9092", Topic: "events"}, sensor, "test-host", logger)
+	for name, expression := range expressions {
+		expr, err := govaluate.NewEvaluableExpression(expression)
+		assert.NoError(t, err)
+		s.triggers[name] = &KafkaTriggerConnection{
+			KafkaConnection: base.NewKafkaConnection(logger),
+			sensorName:      sensor.Name,
+			triggerName:     name,
+			depExpression:   expr,
+			sourceDepMap: map[string][]string{
+				base.EventKey("source", "a"): {"a"},
+				base.EventKey("source", "b"): {"b"},
+			},
+			transform: func(_ string, e cloudevents.Event) (*cloudevents.Event, error) { return &e, nil },
+			filter:    func(string, cloudevents.Event) bool { return true },
+			action:    func(map[string]cloudevents.Event) {},
+		}
+	}
+	return s
+}
+
+func newTestEventMessage(t *testing.T, subject string, timestamp time.Time) *sarama.ConsumerMessage {
+	t.Helper()
+	event := cloudevents.NewEvent()
+	event.SetID("1")
+	event.SetSource("source")
+	event.SetSubject(subject)
+	event.SetType("test")
+	value, err := json.Marshal(event)
+	assert.NoError(t, err)
+	return &sarama.ConsumerMessage{Topic: "events", Value: value, Timestamp: timestamp}
+}
+
+func TestKafkaSensorReplayedEvents(t *testing.T) {
+	now := time.Now()
+	s := newTestSensor(t, map[string]string{"one": "a", "all": "a && b"})
+	s.SetReplayWindow(now.Add(-time.Hour), now)
+
+	t.Run("replayed event", func(t *testing.T) {
+		messages, _, _ := s.Event(newTestEventMessage(t, "a", now.Add(-time.Minute)))
+		// the trigger depending on a single event is not invoked
+		assert.Len(t, messages, 1)
+		assert.Equal(t, s.topics.trigger, messages[0].Topic)
+		assert.Contains(t, messages[0].Headers, sarama.RecordHeader{Key: []byte(replayedHeader), Value: []byte("true")})
+	})
+
+	t.Run("new event", func(t *testing.T) {
+		messages, _, _ := s.Event(newTestEventMessage(t, "a", now.Add(time.Minute)))
+		assert.Len(t, messages, 2)
+		for _, m := range messages {
+			assert.NotContains(t, m.Headers, sarama.RecordHeader{Key: []byte(replayedHeader), Value: []byte("true")})
+		}
+	})
+
+	t.Run("trigger satisfied by replayed events", func(t *testing.T) {
+		for _, dep := range []string{"a", "b"} {
+			messages, _, _ := s.Event(newTestEventMessage(t, dep, now.Add(-time.Minute)))
+			assert.Len(t, messages, 1)
+			msg := &sarama.ConsumerMessage{
+				Topic:     s.topics.trigger,
+				Key:       []byte("all"),
+				Value:     []byte(messages[0].Value.(sarama.ByteEncoder)),
+				Timestamp: now,
+			}
+			for _, h := range messages[0].Headers {
+				msg.Headers = append(msg.Headers, &sarama.RecordHeader{Key: h.Key, Value: h.Value})
+			}
+			actions, _, _ := s.Trigger(msg)
+			assert.Empty(t, actions)
+		}
+		// the state is reset
+		assert.Empty(t, s.triggers["all"].(*KafkaTriggerConnection).events)
+	})
+}
+
+func TestKafkaHandlerCaughtUp(t *testing.T) {
+	caughtUp := 0
+	h := &KafkaHandler{
+		Mutex:      &sync.Mutex{},
+		Logger:     zap.NewNop().Sugar(),
+		EventTopic: "events",
+		CaughtUp:   func() { caughtUp++ },
+		catchUp:    map[int32]int64{0: 10, 1: 5},
+	}
+	h.processed("events", 0, 10)
+	h.processed("trigger", 1, 5)
+	assert.Equal(t, 0, caughtUp)
+	h.processed("events", 1, 4)
+	assert.Equal(t, 0, caughtUp)
+	h.processed("events", 1, 5)
+	assert.Equal(t, 1, caughtUp)
+}
@@ -31,6 +31,9 @@ type KafkaTriggerConnection struct {
 	// state
 	events        []*eventWithMetadata
 	lastResetTime time.Time
+
+	// closed once the sensor caught up with the replayed events
+	ready <-chan struct{}
 }
 
 type eventWithMetadata struct {
@@ -64,6 +67,13 @@ func (c *KafkaTriggerConnection) IsClosed() bool {
 	return c.isClosed == nil || c.isClosed()
 }
 
+// Ready returns a channel closed once the sensor is ready to process the
+// new events, that is it caught up with the events replayed during a
+// migration.
+func (c *KafkaTriggerConnection) Ready() <-chan struct{} {
+	return c.ready
+}
+
 func (c *KafkaTriggerConnection) Subscribe(
 	ctx context.Context,
 	closeCh <-chan struct{},
@@ -14,7 +14,7 @@ import (
 type KafkaTriggerHandler interface {
 	common.TriggerConnection
 	Name() string
-	Ready() bool
+	Subscribed() bool
 	Reset()
 	OneAndDone() bool
 	DependsOn(*cloudevents.Event) ([]string, bool)
@@ -29,7 +29,7 @@ func (c *KafkaTriggerConnection) Name() string {
 	return c.triggerName
 }
 
-func (c *KafkaTriggerConnection) Ready() bool {
+func (c *KafkaTriggerConnection) Subscribed() bool {
 	// cannot process events until the subscribe function has been
 	// called, which is when these functions are set
 	return c.transform != nil && c.filter != nil && c.action != nil
@@ -0,0 +1,114 @@
+package base
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	// Property names of the messages published to the topic.
+	PropertyID = "id"
+)
+
+type Pulsar struct {
+	Logger *zap.SugaredLogger
+	config *v1alpha1.PulsarBus
+}
+
+func NewPulsar(config *v1alpha1.PulsarBus, logger *zap.SugaredLogger) *Pulsar {
+	return &Pulsar{
+		Logger: logger,
+		config: config,
+	}
+}
+
+func (p *Pulsar) Topic() string {
+	return p.config.Topic
+}
+
+// SubscriptionType returns the type of the Sensor subscriptions, defaults to KeyShared.
+func (p *Pulsar) SubscriptionType() pulsar.SubscriptionType {
+	if p.config.SubscriptionType == v1alpha1.PulsarSubscriptionShared {
+		return pulsar.Shared
+	}
+	return pulsar.KeyShared
+}
+
+// ClientOptions returns the options to create a client of the EventBus.
+func (p *Pulsar) ClientOptions() (pulsar.ClientOptions, error) {
+	opt := pulsar.ClientOptions{
+		URL:                        p.config.URL,
+		TLSAllowInsecureConnection: p.config.TLSAllowInsecureConnection,
+		TLSValidateHostname:        p.config.TLSValidateHostname,
+	}
+	if p.config.TLSTrustCertsSecret != nil {
+		path, err := sharedutil.GetSecretVolumePath(p.config.TLSTrustCertsSecret)
+		if err != nil {
+			return opt, fmt.Errorf("failed to get the trusted TLS certs, %w", err)
+		}
+		opt.TLSTrustCertsFilePath = path
+	}
+	if p.config.AuthTokenSecret != nil {
+		token, err := sharedutil.GetSecretFromVolume(p.config.AuthTokenSecret)
+		if err != nil {
+			return opt, fmt.Errorf("failed to get the auth token, %w", err)
+		}
+		opt.Authentication = pulsar.NewAuthenticationToken(token)
+	}
+	if tls := p.config.TLS; tls != nil {
+		if tls.ClientCertSecret == nil || tls.ClientKeySecret == nil {
+			return opt, fmt.Errorf("invalid TLS config, both client cert and key are required")
+		}
+		certPath, err := sharedutil.GetSecretVolumePath(tls.ClientCertSecret)
+		if err != nil {
+			return opt, fmt.Errorf("failed to get the client cert, %w", err)
+		}
+		keyPath, err := sharedutil.GetSecretVolumePath(tls.ClientKeySecret)
+		if err != nil {
+			return opt, fmt.Errorf("failed to get the client key, %w", err)
+		}
+		opt.Authentication = pulsar.NewAuthenticationTLS(certPath, keyPath)
+	}
+	return opt, nil
+}
+
+type PulsarConnection struct {
+	Client pulsar.Client
+	Logger *zap.SugaredLogger
+	closed atomic.Bool
+}
+
+func NewPulsarConnection(client pulsar.Client, logger *zap.SugaredLogger) *PulsarConnection {
+	return &PulsarConnection{
+		Client: client,
+		Logger: logger,
+	}
+}
+
+func (c *PulsarConnection) Close() error {
+	if !c.closed.Swap(true) {
+		c.Client.Close()
+	}
+	return nil
+}
+
+func (c *PulsarConnection) IsClosed() bool {
+	return c.closed.Load()
+}
+
+// EventKey is the key of the messages of an EventSource event, the messages with the same key
+// are delivered to the same consumer of a KeyShared subscription.
+func EventKey(source string, subject string) string {
+	return fmt.Sprintf("%s.%s", source, subject)
+}
+
+// SubscriptionName returns the name of the subscription a Sensor trigger consumes the topic with.
+func SubscriptionName(sensorName, triggerName string) string {
+	return fmt.Sprintf("%s-%s", sensorName, triggerName)
+}
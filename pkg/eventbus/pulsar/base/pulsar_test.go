@@ -0,0 +1,46 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+func TestSubscriptionType(t *testing.T) {
+	logger := zaptest.NewLogger(t).Sugar()
+	assert.Equal(t, pulsar.KeyShared, NewPulsar(&v1alpha1.PulsarBus{}, logger).SubscriptionType())
+	assert.Equal(t, pulsar.KeyShared, NewPulsar(&v1alpha1.PulsarBus{SubscriptionType: v1alpha1.PulsarSubscriptionKeyShared}, logger).SubscriptionType())
+	assert.Equal(t, pulsar.Shared, NewPulsar(&v1alpha1.PulsarBus{SubscriptionType: v1alpha1.PulsarSubscriptionShared}, logger).SubscriptionType())
+}
+
+func TestClientOptions(t *testing.T) {
+	logger := zaptest.NewLogger(t).Sugar()
+
+	t.Run("plain", func(t *testing.T) {
+		opt, err := NewPulsar(&v1alpha1.PulsarBus{URL: "pulsar://pulsar:6650", TLSValidateHostname: true}, logger).ClientOptions()
+		assert.NoError(t, err)
+		assert.Equal(t, "pulsar://pulsar:6650", opt.URL)
+		assert.True(t, opt.TLSValidateHostname)
+		assert.Nil(t, opt.Authentication)
+	})
+
+	t.Run("tls without client key", func(t *testing.T) {
+		_, err := NewPulsar(&v1alpha1.PulsarBus{
+			URL: "pulsar+ssl://pulsar:6651",
+			TLS: &v1alpha1.TLSConfig{
+				ClientCertSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tls"}, Key: "cert"},
+			},
+		}, logger).ClientOptions()
+		assert.Error(t, err)
+	})
+}
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, "es.event", EventKey("es", "event"))
+	assert.Equal(t, "sensor-trigger", SubscriptionName("sensor", "trigger"))
+}
@@ -0,0 +1,81 @@
+package eventsource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/eventbus/pulsar/base"
+)
+
+type PulsarSource struct {
+	*base.Pulsar
+}
+
+func NewPulsarSource(config *v1alpha1.PulsarBus, logger *zap.SugaredLogger) *PulsarSource {
+	return &PulsarSource{
+		Pulsar: base.NewPulsar(config, logger),
+	}
+}
+
+func (s *PulsarSource) Initialize() error {
+	return nil
+}
+
+func (s *PulsarSource) Connect(clientID string) (eventbuscommon.EventSourceConnection, error) {
+	opt, err := s.ClientOptions()
+	if err != nil {
+		return nil, err
+	}
+	client, err := pulsar.NewClient(opt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pulsar client, %w", err)
+	}
+	producer, err := client.CreateProducer(pulsar.ProducerOptions{
+		Topic: s.Topic(),
+		Name:  clientID,
+	})
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to create producer of topic %s, %w", s.Topic(), err)
+	}
+	return &PulsarSourceConnection{
+		PulsarConnection: base.NewPulsarConnection(client, s.Logger),
+		Topic:            s.Topic(),
+		Producer:         producer,
+	}, nil
+}
+
+type PulsarSourceConnection struct {
+	*base.PulsarConnection
+	Topic    string
+	Producer pulsar.Producer
+}
+
+func (c *PulsarSourceConnection) Publish(ctx context.Context, msg eventbuscommon.Message) error {
+	key := base.EventKey(msg.EventSourceName, msg.EventName)
+	id, err := c.Producer.Send(ctx, &pulsar.ProducerMessage{
+		Key:     key,
+		Payload: msg.Body,
+		Properties: map[string]string{
+			base.PropertyID: msg.ID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	c.Logger.Infow("Published message to pulsar", zap.String("topic", c.Topic), zap.String("key", key), zap.String("messageID", id.String()))
+	return nil
+}
+
+func (c *PulsarSourceConnection) Close() error {
+	if c.IsClosed() {
+		return nil
+	}
+	c.Producer.Close()
+	return c.PulsarConnection.Close()
+}
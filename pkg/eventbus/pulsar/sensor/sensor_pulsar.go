@@ -0,0 +1,60 @@
+package sensor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/eventbus/pulsar/base"
+)
+
+type PulsarSensor struct {
+	*base.Pulsar
+	sensorName string
+	hostname   string
+}
+
+func NewPulsarSensor(config *v1alpha1.PulsarBus, sensor *v1alpha1.Sensor, hostname string, logger *zap.SugaredLogger) *PulsarSensor {
+	return &PulsarSensor{
+		Pulsar:     base.NewPulsar(config, logger),
+		sensorName: sensor.Name,
+		hostname:   hostname,
+	}
+}
+
+func (s *PulsarSensor) Initialize() error {
+	return nil
+}
+
+func (s *PulsarSensor) Connect(ctx context.Context, triggerName string, dependencyExpression string, deps []eventbuscommon.Dependency, atLeastOnce bool) (eventbuscommon.TriggerConnection, error) {
+	opt, err := s.ClientOptions()
+	if err != nil {
+		return nil, err
+	}
+	client, err := pulsar.NewClient(opt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pulsar client, %w", err)
+	}
+	subscription := base.SubscriptionName(s.sensorName, triggerName)
+	consumer, err := client.Subscribe(pulsar.ConsumerOptions{
+		Topic:            s.Topic(),
+		SubscriptionName: subscription,
+		Type:             s.SubscriptionType(),
+		Name:             s.hostname,
+	})
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to subscribe to topic %s with subscription %s, %w", s.Topic(), subscription, err)
+	}
+	conn, err := NewPulsarTriggerConn(base.NewPulsarConnection(client, s.Logger), consumer, s.sensorName, triggerName, dependencyExpression, deps)
+	if err != nil {
+		consumer.Close()
+		client.Close()
+		return nil, err
+	}
+	return conn, nil
+}
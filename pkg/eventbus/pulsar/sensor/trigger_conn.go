@@ -0,0 +1,235 @@
+package sensor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Knetic/govaluate"
+	"github.com/apache/pulsar-client-go/pulsar"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/eventbus/pulsar/base"
+)
+
+// consumer is the part of the pulsar consumer used by the trigger connection.
+type consumer interface {
+	Chan() <-chan pulsar.ConsumerMessage
+	Ack(pulsar.Message) error
+	Close()
+}
+
+// savedDependency is the event of a dependency received before the expression is satisfied,
+// its message is not acknowledged until the trigger fires, so that it is redelivered after a restart.
+type savedDependency struct {
+	event *cloudevents.Event
+	msg   pulsar.Message
+}
+
+type PulsarTriggerConn struct {
+	*base.PulsarConnection
+	consumer             consumer
+	sensorName           string
+	triggerName          string
+	dependencyExpression string
+	requiresANDLogic     bool
+	evaluableExpression  *govaluate.EvaluableExpression
+	deps                 []eventbuscommon.Dependency
+	sourceDepMap         map[string][]string // maps EventSource and EventName to dependency name
+	savedDeps            map[string]*savedDependency
+}
+
+func NewPulsarTriggerConn(conn *base.PulsarConnection,
+	consumer consumer,
+	sensorName string,
+	triggerName string,
+	dependencyExpression string,
+	deps []eventbuscommon.Dependency) (*PulsarTriggerConn, error) {
+	sourceDepMap := make(map[string][]string)
+	for _, d := range deps {
+		key := base.EventKey(d.EventSourceName, d.EventName)
+		sourceDepMap[key] = append(sourceDepMap[key], d.Name)
+	}
+	expr, err := govaluate.NewEvaluableExpression(strings.ReplaceAll(dependencyExpression, "-", "\\-"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate expression %s: %w", dependencyExpression, err)
+	}
+	conn.Logger = conn.Logger.With("triggerName", triggerName, "sensorName", sensorName)
+	return &PulsarTriggerConn{
+		PulsarConnection:     conn,
+		consumer:             consumer,
+		sensorName:           sensorName,
+		triggerName:          triggerName,
+		dependencyExpression: dependencyExpression,
+		requiresANDLogic:     strings.Contains(dependencyExpression, "&"),
+		evaluableExpression:  expr,
+		deps:                 deps,
+		sourceDepMap:         sourceDepMap,
+		savedDeps:            make(map[string]*savedDependency),
+	}, nil
+}
+
+func (conn *PulsarTriggerConn) String() string {
+	if conn == nil {
+		return ""
+	}
+	return fmt.Sprintf("PulsarTriggerConn{Sensor:%s,Trigger:%s}", conn.sensorName, conn.triggerName)
+}
+
+func (conn *PulsarTriggerConn) Close() error {
+	if conn.IsClosed() {
+		return nil
+	}
+	conn.consumer.Close()
+	return conn.PulsarConnection.Close()
+}
+
+// Subscribe consumes the messages of the subscription of the trigger, the messages of the dependencies
+// waiting for the expression to be satisfied are acknowledged only after the trigger fires or the
+// conditions are reset.
+func (conn *PulsarTriggerConn) Subscribe(ctx context.Context,
+	closeCh <-chan struct{},
+	resetConditionsCh <-chan struct{},
+	lastResetTime time.Time,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event),
+	defaultSubject *string) error {
+	if conn == nil {
+		return fmt.Errorf("Subscribe() failed; PulsarTriggerConn is nil")
+	}
+	log := conn.Logger
+	defer func() {
+		_ = conn.Close()
+	}()
+	log.Info("Subscribed to the pulsar eventbus")
+
+	for {
+		select {
+		case m, ok := <-conn.consumer.Chan():
+			if !ok {
+				return fmt.Errorf("consumer channel is closed")
+			}
+			conn.processMsg(m.Message, lastResetTime, transform, filter, action)
+		case <-resetConditionsCh:
+			log.Info("reset conditions")
+			conn.reset()
+		case <-ctx.Done():
+			log.Info("exiting, closing connection...")
+			return nil
+		case <-closeCh:
+			log.Info("closing connection...")
+			return nil
+		}
+	}
+}
+
+func (conn *PulsarTriggerConn) processMsg(
+	msg pulsar.Message,
+	lastResetTime time.Time,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event)) {
+	log := conn.Logger
+	// the message is acknowledged unless it is held as a saved dependency
+	defer conn.release(msg)
+
+	depNames, ok := conn.sourceDepMap[msg.Key()]
+	if !ok {
+		return
+	}
+	if msg.PublishTime().Before(lastResetTime) {
+		log.Infof("message was published before last reset time: %v, ignoring", lastResetTime)
+		return
+	}
+	var event *cloudevents.Event
+	if err := json.Unmarshal(msg.Payload(), &event); err != nil {
+		log.Errorf("Failed to convert to a cloudevent, discarding it... err: %v", err)
+		return
+	}
+	for _, depName := range depNames {
+		conn.processDependency(msg, event, depName, transform, filter, action)
+	}
+}
+
+func (conn *PulsarTriggerConn) processDependency(
+	msg pulsar.Message,
+	event *cloudevents.Event,
+	depName string,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event)) {
+	log := conn.Logger
+	event, err := transform(depName, *event)
+	if err != nil {
+		log.Errorw("failed to apply event transformation", zap.Error(err))
+		return
+	}
+	if !filter(depName, *event) {
+		log.Infof("not interested in dependency %s (didn't pass filter)", depName)
+		return
+	}
+
+	if !conn.requiresANDLogic {
+		log.Infof("Triggering actions after receiving dependency %s", depName)
+		action(map[string]cloudevents.Event{depName: *event})
+		return
+	}
+
+	parameters := make(map[string]interface{}, len(conn.deps))
+	for _, dep := range conn.deps {
+		_, ok := conn.savedDeps[dep.Name]
+		parameters[dep.Name] = ok
+	}
+	parameters[depName] = true
+	log.Infof("Current state of dependencies: %v", parameters)
+
+	result, err := conn.evaluableExpression.Evaluate(parameters)
+	if err != nil {
+		log.Errorf("failed to evaluate dependency expression: %v", err)
+		return
+	}
+	if result != true {
+		log.Debugf("dependency expression false: %s", conn.dependencyExpression)
+		prev := conn.savedDeps[depName]
+		conn.savedDeps[depName] = &savedDependency{event: event, msg: msg}
+		if prev != nil {
+			conn.release(prev.msg)
+		}
+		return
+	}
+
+	messages := make(map[string]cloudevents.Event, len(conn.savedDeps)+1)
+	for prevDep, saved := range conn.savedDeps {
+		messages[prevDep] = *saved.event
+	}
+	messages[depName] = *event
+	log.Infof("Triggering actions after receiving dependency %s", depName)
+	action(messages)
+	conn.reset()
+}
+
+// reset clears the saved dependencies and acknowledges their messages.
+func (conn *PulsarTriggerConn) reset() {
+	saved := conn.savedDeps
+	conn.savedDeps = make(map[string]*savedDependency)
+	for _, s := range saved {
+		conn.release(s.msg)
+	}
+}
+
+// release acknowledges the message if it is not held by any of the saved dependencies.
+func (conn *PulsarTriggerConn) release(msg pulsar.Message) {
+	for _, s := range conn.savedDeps {
+		if s.msg == msg {
+			return
+		}
+	}
+	if err := conn.consumer.Ack(msg); err != nil {
+		conn.Logger.Errorw("failed to acknowledge the message", zap.String("messageID", msg.ID().String()), zap.Error(err))
+	}
+}
@@ -0,0 +1,117 @@
+package sensor
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/eventbus/pulsar/base"
+)
+
+type fakeMessage struct {
+	pulsar.Message
+	key         string
+	payload     []byte
+	publishTime time.Time
+}
+
+func (m *fakeMessage) Key() string            { return m.key }
+func (m *fakeMessage) Payload() []byte        { return m.payload }
+func (m *fakeMessage) PublishTime() time.Time { return m.publishTime }
+
+type fakeConsumer struct {
+	acked []pulsar.Message
+}
+
+func (c *fakeConsumer) Chan() <-chan pulsar.ConsumerMessage { return nil }
+func (c *fakeConsumer) Ack(m pulsar.Message) error {
+	c.acked = append(c.acked, m)
+	return nil
+}
+func (c *fakeConsumer) Close() {}
+
+func newMessage(t *testing.T, source, subject, id string) *fakeMessage {
+	t.Helper()
+	e := cloudevents.NewEvent()
+	e.SetID(id)
+	e.SetSource(source)
+	e.SetSubject(subject)
+	e.SetType("webhook")
+	b, err := json.Marshal(e)
+	require.NoError(t, err)
+	return &fakeMessage{key: base.EventKey(source, subject), payload: b, publishTime: time.Now()}
+}
+
+func newTestConn(t *testing.T, expr string) (*PulsarTriggerConn, *fakeConsumer) {
+	t.Helper()
+	c := &fakeConsumer{}
+	conn, err := NewPulsarTriggerConn(base.NewPulsarConnection(nil, zaptest.NewLogger(t).Sugar()), c, "sensor", "trigger", expr, []eventbuscommon.Dependency{
+		{Name: "dep-a", EventSourceName: "es", EventName: "a"},
+		{Name: "dep-b", EventSourceName: "es", EventName: "b"},
+	})
+	require.NoError(t, err)
+	return conn, c
+}
+
+func TestPulsarTriggerConnProcessMsg(t *testing.T) {
+	noTransform := func(_ string, e cloudevents.Event) (*cloudevents.Event, error) { return &e, nil }
+	passAll := func(string, cloudevents.Event) bool { return true }
+
+	t.Run("messages are held until the trigger fires", func(t *testing.T) {
+		conn, c := newTestConn(t, "dep-a && dep-b")
+		fired := []map[string]cloudevents.Event{}
+		action := func(m map[string]cloudevents.Event) { fired = append(fired, m) }
+
+		a1 := newMessage(t, "es", "a", "1")
+		conn.processMsg(a1, time.Time{}, noTransform, passAll, action)
+		assert.Empty(t, c.acked)
+
+		// a newer event of the same dependency replaces the saved one
+		a2 := newMessage(t, "es", "a", "2")
+		conn.processMsg(a2, time.Time{}, noTransform, passAll, action)
+		assert.Equal(t, []pulsar.Message{a1}, c.acked)
+
+		b1 := newMessage(t, "es", "b", "3")
+		conn.processMsg(b1, time.Time{}, noTransform, passAll, action)
+		require.Len(t, fired, 1)
+		assert.Equal(t, "2", fired[0]["dep-a"].ID())
+		assert.Equal(t, "3", fired[0]["dep-b"].ID())
+		assert.ElementsMatch(t, []pulsar.Message{a1, a2, b1}, c.acked)
+		assert.Empty(t, conn.savedDeps)
+	})
+
+	t.Run("reset acknowledges the saved messages", func(t *testing.T) {
+		conn, c := newTestConn(t, "dep-a && dep-b")
+		a1 := newMessage(t, "es", "a", "1")
+		conn.processMsg(a1, time.Time{}, noTransform, passAll, func(map[string]cloudevents.Event) {})
+		conn.reset()
+		assert.Equal(t, []pulsar.Message{a1}, c.acked)
+		assert.Empty(t, conn.savedDeps)
+	})
+
+	t.Run("messages before the last reset time are skipped", func(t *testing.T) {
+		conn, c := newTestConn(t, "dep-a || dep-b")
+		a1 := newMessage(t, "es", "a", "1")
+		a1.publishTime = time.Now().Add(-time.Hour)
+		conn.processMsg(a1, time.Now(), noTransform, passAll, func(map[string]cloudevents.Event) {
+			t.Fatal("should not fire")
+		})
+		assert.Equal(t, []pulsar.Message{a1}, c.acked)
+	})
+
+	t.Run("unrelated and filtered out messages are acknowledged", func(t *testing.T) {
+		conn, c := newTestConn(t, "dep-a && dep-b")
+		other := newMessage(t, "es", "other", "1")
+		conn.processMsg(other, time.Time{}, noTransform, passAll, func(map[string]cloudevents.Event) {})
+		a1 := newMessage(t, "es", "a", "2")
+		conn.processMsg(a1, time.Time{}, noTransform, func(string, cloudevents.Event) bool { return false }, func(map[string]cloudevents.Event) {})
+		assert.Equal(t, []pulsar.Message{other, a1}, c.acked)
+	})
+}
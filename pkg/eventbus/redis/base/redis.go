@@ -0,0 +1,114 @@
+package base
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	// Field names of the entries added to the stream.
+	FieldKey  = "key"
+	FieldID   = "id"
+	FieldBody = "body"
+)
+
+type Redis struct {
+	Logger *zap.SugaredLogger
+	config *v1alpha1.RedisBus
+}
+
+func NewRedis(config *v1alpha1.RedisBus, logger *zap.SugaredLogger) *Redis {
+	return &Redis{
+		Logger: logger,
+		config: config,
+	}
+}
+
+func (r *Redis) Stream() string {
+	return r.config.Stream
+}
+
+func (r *Redis) MaxLen() int64 {
+	return r.config.MaxLen
+}
+
+// Client returns a new redis client of the EventBus.
+func (r *Redis) Client() (*redis.Client, error) {
+	opt := &redis.Options{
+		Addr:     r.config.URL,
+		DB:       int(r.config.DB),
+		Username: r.config.Username,
+	}
+	if r.config.Password != nil {
+		password, err := sharedutil.GetSecretFromVolume(r.config.Password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the redis password, %w", err)
+		}
+		opt.Password = password
+	}
+	if r.config.TLS != nil {
+		tlsConfig, err := sharedutil.GetTLSConfig(r.config.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+		}
+		opt.TLSConfig = tlsConfig
+	}
+	return redis.NewClient(opt), nil
+}
+
+type RedisConnection struct {
+	Client *redis.Client
+	Logger *zap.SugaredLogger
+	closed atomic.Bool
+}
+
+func NewRedisConnection(client *redis.Client, logger *zap.SugaredLogger) *RedisConnection {
+	return &RedisConnection{
+		Client: client,
+		Logger: logger,
+	}
+}
+
+func (c *RedisConnection) Close() error {
+	if c.closed.Swap(true) {
+		return nil
+	}
+	return c.Client.Close()
+}
+
+func (c *RedisConnection) IsClosed() bool {
+	return c.closed.Load()
+}
+
+func EventKey(source string, subject string) string {
+	return fmt.Sprintf("%s.%s", source, subject)
+}
+
+// ConsumerGroup returns the name of the consumer group a Sensor trigger reads the stream with.
+func ConsumerGroup(sensorName, triggerName string) string {
+	return fmt.Sprintf("%s-%s", sensorName, triggerName)
+}
+
+// StateKey returns the key of the hash holding the dependency state of a Sensor trigger.
+func StateKey(stream, sensorName, triggerName string) string {
+	return fmt.Sprintf("%s:%s:%s", stream, sensorName, triggerName)
+}
+
+// EntryTime returns the time an entry was added to the stream, which is encoded in its ID.
+func EntryTime(id string) (time.Time, error) {
+	ms, _, _ := strings.Cut(id, "-")
+	v, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid stream entry ID %q", id)
+	}
+	return time.UnixMilli(v), nil
+}
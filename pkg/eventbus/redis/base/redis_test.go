@@ -0,0 +1,34 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryTime(t *testing.T) {
+	t.Run("valid ID", func(t *testing.T) {
+		tm, err := EntryTime("1700000000123-4")
+		require.NoError(t, err)
+		assert.Equal(t, time.UnixMilli(1700000000123), tm)
+	})
+
+	t.Run("ID without sequence", func(t *testing.T) {
+		tm, err := EntryTime("1700000000123")
+		require.NoError(t, err)
+		assert.Equal(t, time.UnixMilli(1700000000123), tm)
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		_, err := EntryTime("abc-1")
+		assert.Error(t, err)
+	})
+}
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, "es.event", EventKey("es", "event"))
+	assert.Equal(t, "sensor-trigger", ConsumerGroup("sensor", "trigger"))
+	assert.Equal(t, "ns-default:sensor:trigger", StateKey("ns-default", "sensor", "trigger"))
+}
@@ -0,0 +1,71 @@
+package eventsource
+
+import (
+	"context"
+	"fmt"
+
+	redis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/eventbus/redis/base"
+)
+
+type RedisSource struct {
+	*base.Redis
+}
+
+func NewRedisSource(config *v1alpha1.RedisBus, logger *zap.SugaredLogger) *RedisSource {
+	return &RedisSource{
+		Redis: base.NewRedis(config, logger),
+	}
+}
+
+func (s *RedisSource) Initialize() error {
+	return nil
+}
+
+func (s *RedisSource) Connect(string) (eventbuscommon.EventSourceConnection, error) {
+	client, err := s.Client()
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to connect to redis, %w", err)
+	}
+	return &RedisSourceConnection{
+		RedisConnection: base.NewRedisConnection(client, s.Logger),
+		Stream:          s.Stream(),
+		MaxLen:          s.MaxLen(),
+	}, nil
+}
+
+type RedisSourceConnection struct {
+	*base.RedisConnection
+	Stream string
+	MaxLen int64
+}
+
+func (c *RedisSourceConnection) Publish(ctx context.Context, msg eventbuscommon.Message) error {
+	key := base.EventKey(msg.EventSourceName, msg.EventName)
+	args := &redis.XAddArgs{
+		Stream: c.Stream,
+		Values: map[string]interface{}{
+			base.FieldKey:  key,
+			base.FieldID:   msg.ID,
+			base.FieldBody: msg.Body,
+		},
+	}
+	if c.MaxLen > 0 {
+		args.MaxLen = c.MaxLen
+		args.Approx = true
+	}
+	id, err := c.Client.XAdd(ctx, args).Result()
+	if err != nil {
+		return err
+	}
+	c.Logger.Infow("Published message to redis", zap.String("stream", c.Stream), zap.String("key", key), zap.String("entryID", id))
+	return nil
+}
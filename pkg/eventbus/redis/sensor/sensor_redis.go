@@ -0,0 +1,47 @@
+package sensor
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/eventbus/redis/base"
+)
+
+type RedisSensor struct {
+	*base.Redis
+	sensorName string
+	hostname   string
+}
+
+func NewRedisSensor(config *v1alpha1.RedisBus, sensor *v1alpha1.Sensor, hostname string, logger *zap.SugaredLogger) *RedisSensor {
+	return &RedisSensor{
+		Redis:      base.NewRedis(config, logger),
+		sensorName: sensor.Name,
+		hostname:   hostname,
+	}
+}
+
+func (s *RedisSensor) Initialize() error {
+	return nil
+}
+
+func (s *RedisSensor) Connect(ctx context.Context, triggerName string, dependencyExpression string, deps []eventbuscommon.Dependency, atLeastOnce bool) (eventbuscommon.TriggerConnection, error) {
+	client, err := s.Client()
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to connect to redis, %w", err)
+	}
+	conn, err := NewRedisTriggerConn(base.NewRedisConnection(client, s.Logger), s.Stream(), s.sensorName, triggerName, s.hostname, dependencyExpression, deps)
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+	return conn, nil
+}
@@ -0,0 +1,296 @@
+package sensor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Knetic/govaluate"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	redis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/eventbus/redis/base"
+)
+
+const (
+	// readCount is the max number of the entries read from the stream at a time.
+	readCount = 10
+	// readBlock is how long a read waits for new entries before checking the connection state.
+	readBlock = time.Second
+)
+
+// savedDependency is the event of a dependency received before the expression is satisfied,
+// it is kept in the state hash of the trigger to survive restarts.
+type savedDependency struct {
+	Time  time.Time          `json:"time"`
+	Event *cloudevents.Event `json:"event"`
+}
+
+type RedisTriggerConn struct {
+	*base.RedisConnection
+	stream               string
+	group                string
+	consumer             string
+	stateKey             string
+	sensorName           string
+	triggerName          string
+	dependencyExpression string
+	requiresANDLogic     bool
+	evaluableExpression  *govaluate.EvaluableExpression
+	deps                 []eventbuscommon.Dependency
+	sourceDepMap         map[string][]string // maps EventSource and EventName to dependency name
+	savedDeps            map[string]*savedDependency
+}
+
+func NewRedisTriggerConn(conn *base.RedisConnection,
+	stream string,
+	sensorName string,
+	triggerName string,
+	consumer string,
+	dependencyExpression string,
+	deps []eventbuscommon.Dependency) (*RedisTriggerConn, error) {
+	sourceDepMap := make(map[string][]string)
+	for _, d := range deps {
+		key := base.EventKey(d.EventSourceName, d.EventName)
+		sourceDepMap[key] = append(sourceDepMap[key], d.Name)
+	}
+	expr, err := govaluate.NewEvaluableExpression(strings.ReplaceAll(dependencyExpression, "-", "\\-"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate expression %s: %w", dependencyExpression, err)
+	}
+	conn.Logger = conn.Logger.With("triggerName", triggerName, "sensorName", sensorName)
+	return &RedisTriggerConn{
+		RedisConnection:      conn,
+		stream:               stream,
+		group:                base.ConsumerGroup(sensorName, triggerName),
+		consumer:             consumer,
+		stateKey:             base.StateKey(stream, sensorName, triggerName),
+		sensorName:           sensorName,
+		triggerName:          triggerName,
+		dependencyExpression: dependencyExpression,
+		requiresANDLogic:     strings.Contains(dependencyExpression, "&"),
+		evaluableExpression:  expr,
+		deps:                 deps,
+		sourceDepMap:         sourceDepMap,
+		savedDeps:            make(map[string]*savedDependency),
+	}, nil
+}
+
+func (conn *RedisTriggerConn) String() string {
+	if conn == nil {
+		return ""
+	}
+	return fmt.Sprintf("RedisTriggerConn{Sensor:%s,Trigger:%s,Stream:%s}", conn.sensorName, conn.triggerName, conn.stream)
+}
+
+// Subscribe reads the stream with the consumer group of the trigger, the entries are acknowledged once
+// they are processed, the events of the dependencies waiting for the expression to be satisfied are kept
+// in a hash, so that the dependency state survives restarts.
+func (conn *RedisTriggerConn) Subscribe(ctx context.Context,
+	closeCh <-chan struct{},
+	resetConditionsCh <-chan struct{},
+	lastResetTime time.Time,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event),
+	defaultSubject *string) error {
+	if conn == nil {
+		return fmt.Errorf("Subscribe() failed; RedisTriggerConn is nil")
+	}
+	log := conn.Logger
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	if err := conn.Client.XGroupCreateMkStream(ctx, conn.stream, conn.group, "$").Err(); err != nil {
+		// redis package doesn't expose concrete error types
+		if !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return fmt.Errorf("failed to create consumer group %s on stream %s, %w", conn.group, conn.stream, err)
+		}
+	}
+	if err := conn.loadState(ctx, lastResetTime); err != nil {
+		return err
+	}
+	log.Infof("Subscribed to stream %s with consumer group %s", conn.stream, conn.group)
+
+	// Start by reading the pending entries, which were delivered but not acknowledged before a restart.
+	lastID := "0"
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("exiting, closing connection...")
+			return nil
+		case <-closeCh:
+			log.Info("closing connection...")
+			return nil
+		case <-resetConditionsCh:
+			log.Info("reset conditions")
+			if err := conn.resetState(ctx); err != nil {
+				log.Errorw("failed to reset conditions", zap.Error(err))
+			}
+			continue
+		default:
+		}
+
+		streams, err := conn.Client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    conn.group,
+			Consumer: conn.consumer,
+			Streams:  []string{conn.stream, lastID},
+			Count:    readCount,
+			Block:    readBlock,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to read from stream %s, %w", conn.stream, err)
+		}
+		count := 0
+		for _, s := range streams {
+			for _, m := range s.Messages {
+				count++
+				if lastID != ">" {
+					lastID = m.ID
+				}
+				conn.processEntry(ctx, m, lastResetTime, transform, filter, action)
+				if err := conn.Client.XAck(ctx, conn.stream, conn.group, m.ID).Err(); err != nil {
+					log.Errorw("failed to acknowledge the entry", zap.String("entryID", m.ID), zap.Error(err))
+				}
+			}
+		}
+		if count == 0 && lastID != ">" {
+			// no more pending entries, read the new ones
+			lastID = ">"
+		}
+	}
+}
+
+func (conn *RedisTriggerConn) processEntry(ctx context.Context,
+	m redis.XMessage,
+	lastResetTime time.Time,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event)) {
+	log := conn.Logger.With("entryID", m.ID)
+
+	key, _ := m.Values[base.FieldKey].(string)
+	depNames, ok := conn.sourceDepMap[key]
+	if !ok {
+		return
+	}
+	if t, err := base.EntryTime(m.ID); err == nil && t.Before(lastResetTime) {
+		log.Infof("entry was added before last reset time: %v, ignoring", lastResetTime)
+		return
+	}
+	body, _ := m.Values[base.FieldBody].(string)
+	var event *cloudevents.Event
+	if err := json.Unmarshal([]byte(body), &event); err != nil {
+		log.Errorf("Failed to convert to a cloudevent, discarding it... err: %v", err)
+		return
+	}
+	for _, depName := range depNames {
+		conn.processDependency(ctx, event, depName, transform, filter, action)
+	}
+}
+
+func (conn *RedisTriggerConn) processDependency(ctx context.Context,
+	event *cloudevents.Event,
+	depName string,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event)) {
+	log := conn.Logger
+	event, err := transform(depName, *event)
+	if err != nil {
+		log.Errorw("failed to apply event transformation", zap.Error(err))
+		return
+	}
+	if !filter(depName, *event) {
+		log.Infof("not interested in dependency %s (didn't pass filter)", depName)
+		return
+	}
+
+	if !conn.requiresANDLogic {
+		log.Infof("Triggering actions after receiving dependency %s", depName)
+		action(map[string]cloudevents.Event{depName: *event})
+		return
+	}
+
+	parameters := make(map[string]interface{}, len(conn.deps))
+	for _, dep := range conn.deps {
+		_, ok := conn.savedDeps[dep.Name]
+		parameters[dep.Name] = ok
+	}
+	parameters[depName] = true
+	log.Infof("Current state of dependencies: %v", parameters)
+
+	result, err := conn.evaluableExpression.Evaluate(parameters)
+	if err != nil {
+		log.Errorf("failed to evaluate dependency expression: %v", err)
+		return
+	}
+	if result != true {
+		log.Debugf("dependency expression false: %s", conn.dependencyExpression)
+		if err := conn.saveDependency(ctx, depName, event); err != nil {
+			log.Errorw("failed to save the dependency state", zap.String("dependency", depName), zap.Error(err))
+		}
+		return
+	}
+
+	messages := make(map[string]cloudevents.Event, len(conn.savedDeps)+1)
+	for prevDep, saved := range conn.savedDeps {
+		messages[prevDep] = *saved.Event
+	}
+	messages[depName] = *event
+	log.Infof("Triggering actions after receiving dependency %s", depName)
+	action(messages)
+	if err := conn.resetState(ctx); err != nil {
+		log.Errorw("failed to reset the dependency state", zap.Error(err))
+	}
+}
+
+// loadState restores the saved dependencies from the state hash, dropping the ones saved before the last reset time.
+func (conn *RedisTriggerConn) loadState(ctx context.Context, lastResetTime time.Time) error {
+	values, err := conn.Client.HGetAll(ctx, conn.stateKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to load the dependency state from %s, %w", conn.stateKey, err)
+	}
+	expired := []string{}
+	for depName, v := range values {
+		saved := &savedDependency{}
+		if err := json.Unmarshal([]byte(v), saved); err != nil || saved.Event == nil || saved.Time.Before(lastResetTime) {
+			expired = append(expired, depName)
+			continue
+		}
+		conn.savedDeps[depName] = saved
+	}
+	if len(expired) > 0 {
+		if err := conn.Client.HDel(ctx, conn.stateKey, expired...).Err(); err != nil {
+			return fmt.Errorf("failed to delete the expired dependency state from %s, %w", conn.stateKey, err)
+		}
+	}
+	return nil
+}
+
+func (conn *RedisTriggerConn) saveDependency(ctx context.Context, depName string, event *cloudevents.Event) error {
+	saved := &savedDependency{Time: time.Now(), Event: event}
+	b, err := json.Marshal(saved)
+	if err != nil {
+		return err
+	}
+	conn.savedDeps[depName] = saved
+	return conn.Client.HSet(ctx, conn.stateKey, depName, b).Err()
+}
+
+func (conn *RedisTriggerConn) resetState(ctx context.Context) error {
+	conn.savedDeps = make(map[string]*savedDependency)
+	return conn.Client.Del(ctx, conn.stateKey).Err()
+}
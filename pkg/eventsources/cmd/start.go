@@ -43,6 +43,18 @@ func Start() {
 		}
 	}
 
+	var successorBusConfig *v1alpha1.BusConfig
+	if encodedSuccessorBusConfigSpec := os.Getenv(v1alpha1.EnvVarEventBusSuccessorConfig); len(encodedSuccessorBusConfigSpec) > 0 {
+		successorBusConfigSpec, err := base64.StdEncoding.DecodeString(encodedSuccessorBusConfigSpec)
+		if err != nil {
+			logger.Fatalw("failed to decode successor bus config string", zap.Error(err))
+		}
+		successorBusConfig = &v1alpha1.BusConfig{}
+		if err = json.Unmarshal(successorBusConfigSpec, successorBusConfig); err != nil {
+			logger.Fatalw("failed to unmarshal successor bus config object", zap.Error(err))
+		}
+	}
+
 	ebSubject, defined := os.LookupEnv(v1alpha1.EnvVarEventBusSubject)
 	if !defined {
 		logger.Fatalf("required environment variable '%s' not defined", v1alpha1.EnvVarEventBusSubject)
@@ -59,7 +71,7 @@ func Start() {
 	go m.Run(ctx, fmt.Sprintf(":%d", v1alpha1.EventSourceMetricsPort))
 
 	logger.Infow("starting eventsource server", "version", argoevents.GetVersion())
-	adaptor := eventsources.NewEventSourceAdaptor(eventSource, busConfig, ebSubject, hostname, m)
+	adaptor := eventsources.NewEventSourceAdaptor(eventSource, busConfig, successorBusConfig, ebSubject, hostname, m)
 
 	if err := adaptor.Start(ctx); err != nil {
 		logger.Fatalw("failed to start eventsource server", zap.Error(err))
@@ -2,6 +2,13 @@ package common
 
 import "github.com/cloudevents/sdk-go/v2/event"
 
+const (
+	// OriginSourceExtension is the extension keeping the source of a received CloudEvent
+	OriginSourceExtension = "originsource"
+	// OriginSubjectExtension is the extension keeping the subject of a received CloudEvent
+	OriginSubjectExtension = "originsubject"
+)
+
 type Option func(*event.Event) error
 
 // Option to set different ID for event
@@ -11,3 +18,30 @@ func WithID(id string) Option {
 		return nil
 	}
 }
+
+// Option to keep the attributes of a CloudEvent received by the event source. The source and
+// the subject identify the event source and the event on the EventBus, so the ones of the received
+// CloudEvent are kept in the OriginSourceExtension and OriginSubjectExtension extensions.
+func WithCloudEvent(in event.Event) Option {
+	return func(e *event.Event) error {
+		e.SetID(in.ID())
+		e.SetType(in.Type())
+		if !in.Time().IsZero() {
+			e.SetTime(in.Time())
+		}
+		if in.DataContentType() != "" {
+			e.SetDataContentType(in.DataContentType())
+		}
+		if in.DataSchema() != "" {
+			e.SetDataSchema(in.DataSchema())
+		}
+		for name, value := range in.Extensions() {
+			e.SetExtension(name, value)
+		}
+		e.SetExtension(OriginSourceExtension, in.Source())
+		if in.Subject() != "" {
+			e.SetExtension(OriginSubjectExtension, in.Subject())
+		}
+		return nil
+	}
+}
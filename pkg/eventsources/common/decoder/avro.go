@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decoder
+
+import (
+	"fmt"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+// avroDecoder decodes the payloads encoded with an Avro schema, into the Avro JSON encoding
+type avroDecoder struct {
+	codec *goavro.Codec
+}
+
+func newAvroDecoder(schema string) (*avroDecoder, error) {
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid avro schema, %w", err)
+	}
+	return &avroDecoder{codec: codec}, nil
+}
+
+func (d *avroDecoder) Decode(payload []byte, _ Metadata) ([]byte, error) {
+	return decodeAvro(d.codec, payload)
+}
+
+func decodeAvro(codec *goavro.Codec, payload []byte) ([]byte, error) {
+	native, _, err := codec.NativeFromBinary(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the avro payload, %w", err)
+	}
+	value, err := codec.TextualFromNative(nil, native)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the avro payload to json, %w", err)
+	}
+	return value, nil
+}
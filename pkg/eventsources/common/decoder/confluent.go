@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decoder
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/riferrei/srclient"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+// maxReferenceDepth is the max depth of the references of a Protobuf schema
+const maxReferenceDepth = 16
+
+// NewSchemaRegistryClient returns a client of the Confluent schema registry. Without a TLS configuration,
+// the client keeps skipping the verification of the server certificate, as the Kafka event source always did.
+func NewSchemaRegistryClient(registry *aev1.SchemaRegistryConfig) (*srclient.SchemaRegistryClient, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	if registry.TLS != nil {
+		var err error
+		tlsConfig, err = sharedutil.GetTLSConfig(registry.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the schema registry tls configuration, %w", err)
+		}
+	}
+	httpClient := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		Timeout:   5 * time.Second,
+	}
+	client := srclient.NewSchemaRegistryClient(registry.URL, srclient.WithClient(httpClient), srclient.WithSemaphoreWeight(16))
+	if registry.Auth.Username != nil && registry.Auth.Password != nil {
+		user, err := sharedutil.GetSecretFromVolume(registry.Auth.Username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the schema registry username, %w", err)
+		}
+		password, err := sharedutil.GetSecretFromVolume(registry.Auth.Password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the schema registry password, %w", err)
+		}
+		client.SetCredentials(user, password)
+	}
+	return client, nil
+}
+
+// confluentDecoder decodes the payloads in the Confluent wire format: a zero byte, the ID of the schema in the
+// schema registry on 4 bytes, the indexes of the message type for the Protobuf schemas, and the encoded payload.
+type confluentDecoder struct {
+	client *srclient.SchemaRegistryClient
+
+	lock sync.Mutex
+	// files are the compiled Protobuf schemas by schema ID
+	files map[int]protoreflect.FileDescriptor
+}
+
+func newConfluentDecoder(registry *aev1.SchemaRegistryConfig) (*confluentDecoder, error) {
+	client, err := NewSchemaRegistryClient(registry)
+	if err != nil {
+		return nil, err
+	}
+	return &confluentDecoder{client: client, files: map[int]protoreflect.FileDescriptor{}}, nil
+}
+
+func (d *confluentDecoder) Decode(payload []byte, _ Metadata) ([]byte, error) {
+	if len(payload) < 5 || payload[0] != 0 {
+		return nil, fmt.Errorf("the payload is not in the confluent wire format")
+	}
+	schemaID := int(binary.BigEndian.Uint32(payload[1:5]))
+	schema, err := d.client.GetSchema(schemaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the schema with id %d, %w", schemaID, err)
+	}
+	payload = payload[5:]
+	schemaType := srclient.Avro
+	if schema.SchemaType() != nil {
+		schemaType = *schema.SchemaType()
+	}
+	switch schemaType {
+	case srclient.Avro:
+		codec := schema.Codec()
+		if codec == nil {
+			return nil, fmt.Errorf("invalid avro schema with id %d", schemaID)
+		}
+		return decodeAvro(codec, payload)
+	case srclient.Json:
+		if !json.Valid(payload) {
+			return nil, fmt.Errorf("invalid json payload")
+		}
+		return payload, nil
+	case srclient.Protobuf:
+		indexes, n, err := readMessageIndexes(payload)
+		if err != nil {
+			return nil, err
+		}
+		file, err := d.protobufFile(schema)
+		if err != nil {
+			return nil, err
+		}
+		md, err := messageByIndexes(file, indexes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message indexes of schema with id %d, %w", schemaID, err)
+		}
+		return decodeProtobuf(md, nil, payload[n:])
+	default:
+		return nil, fmt.Errorf("unsupported schema type %s", schemaType)
+	}
+}
+
+// protobufFile compiles a Protobuf schema, with the schemas it references
+func (d *confluentDecoder) protobufFile(schema *srclient.Schema) (protoreflect.FileDescriptor, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if file, ok := d.files[schema.ID()]; ok {
+		return file, nil
+	}
+	name := fmt.Sprintf("schema-%d.proto", schema.ID())
+	sources := map[string]string{name: schema.Schema()}
+	if err := d.addReferences(sources, schema.References(), 0); err != nil {
+		return nil, err
+	}
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{Accessor: protocompile.SourceAccessorFromMap(sources)}),
+	}
+	files, err := compiler.Compile(context.Background(), name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the protobuf schema with id %d, %w", schema.ID(), err)
+	}
+	d.files[schema.ID()] = files[0]
+	return files[0], nil
+}
+
+// addReferences adds the sources of the referenced schemas by their import name
+func (d *confluentDecoder) addReferences(sources map[string]string, references []srclient.Reference, depth int) error {
+	if depth > maxReferenceDepth {
+		return fmt.Errorf("the references of the protobuf schema are nested too deep")
+	}
+	for _, ref := range references {
+		if _, ok := sources[ref.Name]; ok {
+			continue
+		}
+		schema, err := d.client.GetSchemaByVersion(ref.Subject, ref.Version)
+		if err != nil {
+			return fmt.Errorf("failed to get the referenced schema %s version %d, %w", ref.Subject, ref.Version, err)
+		}
+		sources[ref.Name] = schema.Schema()
+		if err := d.addReferences(sources, schema.References(), depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readMessageIndexes reads the indexes of the message type in the schema, which are the number of indexes and the
+// indexes as zigzag varints, or a single 0 for the first message. It returns the number of bytes read.
+func readMessageIndexes(payload []byte) ([]int, int, error) {
+	count, n := binary.Varint(payload)
+	if n <= 0 || count < 0 || count > int64(len(payload)) {
+		return nil, 0, fmt.Errorf("invalid message indexes")
+	}
+	if count == 0 {
+		return []int{0}, n, nil
+	}
+	indexes := make([]int, 0, count)
+	for i := int64(0); i < count; i++ {
+		index, m := binary.Varint(payload[n:])
+		if m <= 0 || index < 0 {
+			return nil, 0, fmt.Errorf("invalid message indexes")
+		}
+		indexes = append(indexes, int(index))
+		n += m
+	}
+	return indexes, n, nil
+}
+
+// messageByIndexes returns the message type of the indexes, the first one is the index of a top level message of the
+// file, the next ones are the indexes of the nested messages
+func messageByIndexes(file protoreflect.FileDescriptor, indexes []int) (protoreflect.MessageDescriptor, error) {
+	messages := file.Messages()
+	var md protoreflect.MessageDescriptor
+	for _, index := range indexes {
+		if index >= messages.Len() {
+			return nil, fmt.Errorf("no message at index %d", index)
+		}
+		md = messages.Get(index)
+		messages = md.Messages()
+	}
+	return md, nil
+}
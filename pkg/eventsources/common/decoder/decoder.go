@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package decoder decodes the binary payloads of the messages, e.g. Avro or Protobuf, into JSON.
+package decoder
+
+import (
+	"fmt"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+// Metadata is the metadata of a message some decoders need to find its schema
+type Metadata struct {
+	// Topic of the message, for the pulsar decoder
+	Topic string
+	// SchemaVersion of the message, for the pulsar decoder
+	SchemaVersion []byte
+}
+
+// Decoder decodes a binary payload into JSON
+type Decoder interface {
+	Decode(payload []byte, meta Metadata) ([]byte, error)
+}
+
+// Validate validates that exactly one decoder is specified, and that it is complete
+func Validate(spec *aev1.PayloadDecoder) error {
+	decoders := 0
+	for _, set := range []bool{spec.Avro != nil, spec.Protobuf != nil, spec.Confluent != nil, spec.Pulsar != nil} {
+		if set {
+			decoders++
+		}
+	}
+	if decoders != 1 {
+		return fmt.Errorf("exactly one of avro, protobuf, confluent and pulsar is required")
+	}
+	switch {
+	case spec.Avro != nil:
+		if (spec.Avro.Schema == "") == (spec.Avro.ConfigMap == nil) {
+			return fmt.Errorf("exactly one of avro schema and configMap is required")
+		}
+		if spec.Avro.Schema != "" {
+			if _, err := newAvroDecoder(spec.Avro.Schema); err != nil {
+				return err
+			}
+		}
+	case spec.Protobuf != nil:
+		if spec.Protobuf.DescriptorSet == nil {
+			return fmt.Errorf("protobuf descriptorSet is required")
+		}
+		if spec.Protobuf.MessageType == "" {
+			return fmt.Errorf("protobuf messageType can't be empty")
+		}
+	case spec.Confluent != nil:
+		if spec.Confluent.URL == "" {
+			return fmt.Errorf("confluent schema registry url can't be empty")
+		}
+	case spec.Pulsar != nil:
+		if spec.Pulsar.AdminURL == "" {
+			return fmt.Errorf("pulsar adminURL can't be empty")
+		}
+	}
+	return nil
+}
+
+// New returns the decoder of the spec
+func New(spec *aev1.PayloadDecoder) (Decoder, error) {
+	if err := Validate(spec); err != nil {
+		return nil, err
+	}
+	switch {
+	case spec.Avro != nil:
+		schema := spec.Avro.Schema
+		if spec.Avro.ConfigMap != nil {
+			var err error
+			if schema, err = sharedutil.GetConfigMapFromVolume(spec.Avro.ConfigMap); err != nil {
+				return nil, fmt.Errorf("failed to get the avro schema, %w", err)
+			}
+		}
+		return newAvroDecoder(schema)
+	case spec.Protobuf != nil:
+		descriptorSet, err := sharedutil.GetConfigMapFromVolume(spec.Protobuf.DescriptorSet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the protobuf descriptor set, %w", err)
+		}
+		return newProtobufDecoder([]byte(descriptorSet), spec.Protobuf.MessageType)
+	case spec.Confluent != nil:
+		return newConfluentDecoder(spec.Confluent)
+	default:
+		return newPulsarDecoder(spec.Pulsar)
+	}
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decoder
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	corev1 "k8s.io/api/core/v1"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+const (
+	avroSchema = `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "string"}, {"name": "amount", "type": "double"}]}`
+
+	commonProto = `syntax = "proto3";
+package acme.common.v1;
+message Money {
+  string currency = 1;
+  int64 units = 2;
+}`
+
+	orderProto = `syntax = "proto3";
+package acme.orders.v1;
+import "common.proto";
+message Order {
+  string order_id = 1;
+  acme.common.v1.Money total = 2;
+  message Line {
+    string sku = 1;
+  }
+}`
+)
+
+var corev1ConfigMap = corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "schemas"}, Key: "orders.desc"}
+
+func encodeAvro(t *testing.T, native map[string]interface{}) []byte {
+	t.Helper()
+	codec, err := goavro.NewCodec(avroSchema)
+	require.NoError(t, err)
+	payload, err := codec.BinaryFromNative(nil, native)
+	require.NoError(t, err)
+	return payload
+}
+
+// compileProto compiles the order proto, and returns its file
+func compileProto(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+	compiler := protocompile.Compiler{
+		Resolver: &protocompile.SourceResolver{Accessor: protocompile.SourceAccessorFromMap(map[string]string{
+			"common.proto": commonProto,
+			"order.proto":  orderProto,
+		})},
+	}
+	files, err := compiler.Compile(context.Background(), "order.proto")
+	require.NoError(t, err)
+	return files[0]
+}
+
+func descriptorSet(t *testing.T, file protoreflect.FileDescriptor) []byte {
+	t.Helper()
+	fds := &descriptorpb.FileDescriptorSet{}
+	for i := 0; i < file.Imports().Len(); i++ {
+		fds.File = append(fds.File, protodesc.ToFileDescriptorProto(file.Imports().Get(i).FileDescriptor))
+	}
+	fds.File = append(fds.File, protodesc.ToFileDescriptorProto(file))
+	data, err := proto.Marshal(fds)
+	require.NoError(t, err)
+	return data
+}
+
+func encodeOrder(t *testing.T, file protoreflect.FileDescriptor) []byte {
+	t.Helper()
+	order := dynamicpb.NewMessage(file.Messages().ByName("Order"))
+	order.Set(order.Descriptor().Fields().ByName("order_id"), protoreflect.ValueOfString("o-1"))
+	total := dynamicpb.NewMessage(order.Descriptor().Fields().ByName("total").Message())
+	total.Set(total.Descriptor().Fields().ByName("currency"), protoreflect.ValueOfString("EUR"))
+	total.Set(total.Descriptor().Fields().ByName("units"), protoreflect.ValueOfInt64(12))
+	order.Set(order.Descriptor().Fields().ByName("total"), protoreflect.ValueOfMessage(total))
+	payload, err := proto.Marshal(order)
+	require.NoError(t, err)
+	return payload
+}
+
+func wire(schemaID uint32, indexes []byte, payload []byte) []byte {
+	result := []byte{0, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(result[1:], schemaID)
+	result = append(result, indexes...)
+	return append(result, payload...)
+}
+
+func TestAvroDecoder(t *testing.T) {
+	d, err := New(&aev1.PayloadDecoder{Avro: &aev1.AvroDecoder{Schema: avroSchema}})
+	require.NoError(t, err)
+	value, err := d.Decode(encodeAvro(t, map[string]interface{}{"id": "o-1", "amount": 12.5}), Metadata{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": "o-1", "amount": 12.5}`, string(value))
+
+	_, err = d.Decode([]byte{0xff}, Metadata{})
+	assert.Error(t, err)
+}
+
+func TestProtobufDecoder(t *testing.T) {
+	file := compileProto(t)
+	d, err := newProtobufDecoder(descriptorSet(t, file), "acme.orders.v1.Order")
+	require.NoError(t, err)
+	value, err := d.Decode(encodeOrder(t, file), Metadata{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"order_id": "o-1", "total": {"currency": "EUR", "units": "12"}}`, string(value))
+
+	_, err = newProtobufDecoder(descriptorSet(t, file), "acme.orders.v1.Missing")
+	assert.Error(t, err)
+	_, err = newProtobufDecoder([]byte("not a descriptor set"), "acme.orders.v1.Order")
+	assert.Error(t, err)
+}
+
+func TestConfluentDecoder(t *testing.T) {
+	file := compileProto(t)
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+		switch r.URL.Path {
+		case "/schemas/ids/1":
+			fmt.Fprintf(w, `{"schema": %q}`, avroSchema)
+		case "/schemas/ids/2":
+			fmt.Fprintf(w, `{"schemaType": "PROTOBUF", "schema": %q, "references": [{"name": "common.proto", "subject": "common", "version": 1}]}`, orderProto)
+		case "/subjects/common/versions/1":
+			fmt.Fprintf(w, `{"subject": "common", "version": 1, "id": 4, "schemaType": "PROTOBUF", "schema": %q}`, commonProto)
+		case "/schemas/ids/3":
+			fmt.Fprint(w, `{"schemaType": "JSON", "schema": "{\"type\": \"object\"}"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer registry.Close()
+
+	d, err := New(&aev1.PayloadDecoder{Confluent: &aev1.SchemaRegistryConfig{URL: registry.URL}})
+	require.NoError(t, err)
+
+	value, err := d.Decode(wire(1, nil, encodeAvro(t, map[string]interface{}{"id": "o-1", "amount": 1.0})), Metadata{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": "o-1", "amount": 1}`, string(value))
+
+	// a single 0 is the index of the first message.
+	value, err = d.Decode(wire(2, []byte{0}, encodeOrder(t, file)), Metadata{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"order_id": "o-1", "total": {"currency": "EUR", "units": "12"}}`, string(value))
+
+	line := dynamicpb.NewMessage(file.Messages().ByName("Order").Messages().ByName("Line"))
+	line.Set(line.Descriptor().Fields().ByName("sku"), protoreflect.ValueOfString("s-1"))
+	payload, err := proto.Marshal(line)
+	require.NoError(t, err)
+	// 2 indexes, 0 and 0, zigzag encoded.
+	value, err = d.Decode(wire(2, []byte{4, 0, 0}, payload), Metadata{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"sku": "s-1"}`, string(value))
+
+	value, err = d.Decode(wire(3, nil, []byte(`{"id": "o-1"}`)), Metadata{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": "o-1"}`, string(value))
+
+	_, err = d.Decode([]byte(`{"id": "o-1"}`), Metadata{})
+	assert.EqualError(t, err, "the payload is not in the confluent wire format")
+	_, err = d.Decode(wire(5, nil, nil), Metadata{})
+	assert.Error(t, err)
+}
+
+func TestNewSchemaRegistryClientTLS(t *testing.T) {
+	registry := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+		fmt.Fprintf(w, `{"schema": %q}`, avroSchema)
+	}))
+	defer registry.Close()
+
+	// the certificate of the registry is not verified by default.
+	client, err := NewSchemaRegistryClient(&aev1.SchemaRegistryConfig{URL: registry.URL})
+	require.NoError(t, err)
+	_, err = client.GetSchema(1)
+	assert.NoError(t, err)
+
+	client, err = NewSchemaRegistryClient(&aev1.SchemaRegistryConfig{URL: registry.URL, TLS: &aev1.TLSConfig{Enabled: true}})
+	require.NoError(t, err)
+	_, err = client.GetSchema(1)
+	assert.ErrorContains(t, err, "certificate")
+
+	_, err = NewSchemaRegistryClient(&aev1.SchemaRegistryConfig{URL: registry.URL, TLS: &aev1.TLSConfig{}})
+	assert.Error(t, err)
+}
+
+func TestReadMessageIndexes(t *testing.T) {
+	indexes, n, err := readMessageIndexes([]byte{0, 0xff})
+	require.NoError(t, err)
+	assert.Equal(t, []int{0}, indexes)
+	assert.Equal(t, 1, n)
+
+	indexes, n, err = readMessageIndexes([]byte{4, 2, 6, 0xff})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, indexes)
+	assert.Equal(t, 3, n)
+
+	_, _, err = readMessageIndexes([]byte{4, 2})
+	assert.Error(t, err)
+	_, _, err = readMessageIndexes(nil)
+	assert.Error(t, err)
+}
+
+func TestPulsarDecoder(t *testing.T) {
+	file := compileProto(t)
+	native, err := json.Marshal(map[string]string{
+		"fileDescriptorSet":      base64.StdEncoding.EncodeToString(descriptorSet(t, file)),
+		"rootMessageTypeName":    "acme.orders.v1.Order",
+		"rootFileDescriptorName": "order.proto",
+	})
+	require.NoError(t, err)
+	requests := map[string]int{}
+	admin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		if r.Header.Get("Authorization") != "" {
+			http.Error(w, "unexpected token", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/admin/v2/schemas/public/default/orders/schema/0":
+			fmt.Fprintf(w, `{"version": 0, "type": "AVRO", "data": %q}`, avroSchema)
+		case "/admin/v2/schemas/public/default/orders/schema/1":
+			fmt.Fprintf(w, `{"version": 1, "type": "PROTOBUF_NATIVE", "data": %q}`, native)
+		case "/admin/v2/schemas/public/default/events/schema":
+			fmt.Fprint(w, `{"version": 3, "type": "JSON", "data": "{}"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer admin.Close()
+
+	d, err := New(&aev1.PayloadDecoder{Pulsar: &aev1.PulsarSchemaRegistry{AdminURL: admin.URL + "/"}})
+	require.NoError(t, err)
+
+	version := func(v uint64) []byte {
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, v)
+		return b
+	}
+	for i := 0; i < 2; i++ {
+		value, err := d.Decode(encodeAvro(t, map[string]interface{}{"id": "o-1", "amount": 2.0}), Metadata{Topic: "persistent://public/default/orders-partition-1", SchemaVersion: version(0)})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"id": "o-1", "amount": 2}`, string(value))
+	}
+	assert.Equal(t, 1, requests["/admin/v2/schemas/public/default/orders/schema/0"])
+
+	value, err := d.Decode(encodeOrder(t, file), Metadata{Topic: "persistent://public/default/orders", SchemaVersion: version(1)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"order_id": "o-1", "total": {"currency": "EUR", "units": "12"}}`, string(value))
+
+	value, err = d.Decode([]byte(`{"id": 1}`), Metadata{Topic: "persistent://public/default/events"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": 1}`, string(value))
+
+	_, err = d.Decode([]byte(`{}`), Metadata{Topic: "persistent://public/default/missing"})
+	assert.ErrorContains(t, err, "404")
+	_, err = d.Decode([]byte(`{}`), Metadata{Topic: "orders"})
+	assert.ErrorContains(t, err, "invalid topic")
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		spec aev1.PayloadDecoder
+		err  string
+	}{
+		{name: "avro", spec: aev1.PayloadDecoder{Avro: &aev1.AvroDecoder{Schema: avroSchema}}},
+		{name: "none", spec: aev1.PayloadDecoder{}, err: "exactly one of avro, protobuf, confluent and pulsar is required"},
+		{name: "two", spec: aev1.PayloadDecoder{Avro: &aev1.AvroDecoder{Schema: avroSchema}, Confluent: &aev1.SchemaRegistryConfig{URL: "http://registry"}}, err: "exactly one of avro, protobuf, confluent and pulsar is required"},
+		{name: "avro without schema", spec: aev1.PayloadDecoder{Avro: &aev1.AvroDecoder{}}, err: "exactly one of avro schema and configMap is required"},
+		{name: "invalid avro schema", spec: aev1.PayloadDecoder{Avro: &aev1.AvroDecoder{Schema: `{"type": "nope"}`}}, err: "invalid avro schema"},
+		{name: "protobuf without message type", spec: aev1.PayloadDecoder{Protobuf: &aev1.ProtobufDecoder{DescriptorSet: &corev1ConfigMap}}, err: "protobuf messageType can't be empty"},
+		{name: "protobuf without descriptor set", spec: aev1.PayloadDecoder{Protobuf: &aev1.ProtobufDecoder{MessageType: "acme.Order"}}, err: "protobuf descriptorSet is required"},
+		{name: "confluent without url", spec: aev1.PayloadDecoder{Confluent: &aev1.SchemaRegistryConfig{}}, err: "confluent schema registry url can't be empty"},
+		{name: "pulsar without admin url", spec: aev1.PayloadDecoder{Pulsar: &aev1.PulsarSchemaRegistry{}}, err: "pulsar adminURL can't be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&tt.spec)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// protobufDecoder decodes the payloads encoded as a Protobuf message of a descriptor set
+type protobufDecoder struct {
+	message protoreflect.MessageDescriptor
+	types   *dynamicpb.Types
+}
+
+func newProtobufDecoder(descriptorSet []byte, messageType string) (*protobufDecoder, error) {
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptorSet, fds); err != nil {
+		return nil, fmt.Errorf("invalid protobuf descriptor set, %w", err)
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid protobuf descriptor set, %w", err)
+	}
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(messageType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the message type %s, %w", messageType, err)
+	}
+	message, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message type", messageType)
+	}
+	return &protobufDecoder{message: message, types: dynamicpb.NewTypes(files)}, nil
+}
+
+func (d *protobufDecoder) Decode(payload []byte, _ Metadata) ([]byte, error) {
+	return decodeProtobuf(d.message, d.types, payload)
+}
+
+// decodeProtobuf decodes a message into its JSON mapping, with the field names of the .proto file. The types resolve
+// the extensions and the messages packed in google.protobuf.Any, if any.
+func decodeProtobuf(md protoreflect.MessageDescriptor, types *dynamicpb.Types, payload []byte) ([]byte, error) {
+	message := dynamicpb.NewMessage(md)
+	unmarshal := proto.UnmarshalOptions{}
+	marshal := protojson.MarshalOptions{UseProtoNames: true}
+	if types != nil {
+		unmarshal.Resolver = types
+		marshal.Resolver = types
+	}
+	if err := unmarshal.Unmarshal(payload, message); err != nil {
+		return nil, fmt.Errorf("failed to decode the protobuf payload, %w", err)
+	}
+	value, err := marshal.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the protobuf payload to json, %w", err)
+	}
+	return value, nil
+}
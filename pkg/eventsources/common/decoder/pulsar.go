@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decoder
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+// partitionSuffix is the suffix of the partitions of a partitioned topic, which share the schema of the topic
+var partitionSuffix = regexp.MustCompile(`-partition-\d+$`)
+
+// pulsarSchema is the schema of a topic returned by the Pulsar admin API
+type pulsarSchema struct {
+	Type string `json:"type"`
+	Data string `json:"data"`
+}
+
+// pulsarProtobufNative is the data of a PROTOBUF_NATIVE schema
+type pulsarProtobufNative struct {
+	FileDescriptorSet   string `json:"fileDescriptorSet"`
+	RootMessageTypeName string `json:"rootMessageTypeName"`
+}
+
+// pulsarDecoder decodes the payloads with the schema of their topic in the Pulsar schema registry
+type pulsarDecoder struct {
+	adminURL string
+	token    string
+	client   *http.Client
+
+	lock sync.Mutex
+	// decoders are the decoders of the schemas by topic and schema version
+	decoders map[string]func([]byte) ([]byte, error)
+}
+
+func newPulsarDecoder(registry *aev1.PulsarSchemaRegistry) (*pulsarDecoder, error) {
+	d := &pulsarDecoder{
+		adminURL: strings.TrimSuffix(registry.AdminURL, "/"),
+		client:   &http.Client{Timeout: 30 * time.Second},
+		decoders: map[string]func([]byte) ([]byte, error){},
+	}
+	if registry.AuthTokenSecret != nil {
+		token, err := sharedutil.GetSecretFromVolume(registry.AuthTokenSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the pulsar auth token, %w", err)
+		}
+		d.token = token
+	}
+	return d, nil
+}
+
+func (d *pulsarDecoder) Decode(payload []byte, meta Metadata) ([]byte, error) {
+	decode, err := d.decoder(meta)
+	if err != nil {
+		return nil, err
+	}
+	return decode(payload)
+}
+
+// decoder returns the decoder of the schema of a message, the latest schema of the topic if the message has no
+// schema version
+func (d *pulsarDecoder) decoder(meta Metadata) (func([]byte) ([]byte, error), error) {
+	path, err := schemaPath(meta.Topic)
+	if err != nil {
+		return nil, err
+	}
+	if len(meta.SchemaVersion) == 8 {
+		path += "/" + strconv.FormatInt(int64(binary.BigEndian.Uint64(meta.SchemaVersion)), 10)
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if decode, ok := d.decoders[path]; ok {
+		return decode, nil
+	}
+	schema, err := d.getSchema(path)
+	if err != nil {
+		return nil, err
+	}
+	decode, err := newPulsarSchemaDecoder(schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema of topic %s, %w", meta.Topic, err)
+	}
+	// the schema of the latest version can change, it is not cached.
+	if len(meta.SchemaVersion) == 8 {
+		d.decoders[path] = decode
+	}
+	return decode, nil
+}
+
+func (d *pulsarDecoder) getSchema(path string) (*pulsarSchema, error) {
+	req, err := http.NewRequest(http.MethodGet, d.adminURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if d.token != "" {
+		req.Header.Set("Authorization", "Bearer "+d.token)
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the schema, %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the schema, %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the schema %s, status %s", path, resp.Status)
+	}
+	schema := &pulsarSchema{}
+	if err := json.Unmarshal(body, schema); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the schema, %w", err)
+	}
+	return schema, nil
+}
+
+// schemaPath returns the path of the schema of a topic in the admin API, e.g. persistent://tenant/ns/topic-partition-0
+// is /admin/v2/schemas/tenant/ns/topic/schema
+func schemaPath(topic string) (string, error) {
+	name := topic
+	if i := strings.Index(name, "://"); i >= 0 {
+		name = name[i+3:]
+	}
+	parts := strings.Split(name, "/")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("invalid topic %q, it should be persistent://tenant/namespace/topic", topic)
+	}
+	parts[2] = partitionSuffix.ReplaceAllString(parts[2], "")
+	for i := range parts {
+		parts[i] = url.PathEscape(parts[i])
+	}
+	return "/admin/v2/schemas/" + strings.Join(parts, "/") + "/schema", nil
+}
+
+func newPulsarSchemaDecoder(schema *pulsarSchema) (func([]byte) ([]byte, error), error) {
+	switch schema.Type {
+	case "AVRO":
+		codec, err := goavro.NewCodec(schema.Data)
+		if err != nil {
+			return nil, err
+		}
+		return func(payload []byte) ([]byte, error) {
+			return decodeAvro(codec, payload)
+		}, nil
+	case "JSON":
+		return func(payload []byte) ([]byte, error) {
+			if !json.Valid(payload) {
+				return nil, fmt.Errorf("invalid json payload")
+			}
+			return payload, nil
+		}, nil
+	case "STRING":
+		return func(payload []byte) ([]byte, error) {
+			return json.Marshal(string(payload))
+		}, nil
+	case "PROTOBUF_NATIVE":
+		native := &pulsarProtobufNative{}
+		if err := json.Unmarshal([]byte(schema.Data), native); err != nil {
+			return nil, err
+		}
+		descriptorSet, err := base64.StdEncoding.DecodeString(native.FileDescriptorSet)
+		if err != nil {
+			return nil, err
+		}
+		d, err := newProtobufDecoder(descriptorSet, native.RootMessageTypeName)
+		if err != nil {
+			return nil, err
+		}
+		return func(payload []byte) ([]byte, error) {
+			return d.Decode(payload, Metadata{})
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported schema type %q", schema.Type)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+)
+
+// FakeDispatcher records the events dispatched by an event source, it is used in the tests
+// in place of the dispatch function passed to StartListening.
+type FakeDispatcher[T any] struct {
+	// Events are the dispatched event data
+	Events []T
+	// IDs are the IDs set by the options of the dispatched events
+	IDs []string
+	// Err is returned for every dispatched event when it is set
+	Err error
+	// Fail tells which events fail to be published to the EventBus
+	Fail func(T) bool
+}
+
+func (f *FakeDispatcher[T]) Dispatch(data []byte, opts ...Option) error {
+	if f.Err != nil {
+		return f.Err
+	}
+	var payload T
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	if f.Fail != nil && f.Fail(payload) {
+		return eventbuscommon.NewEventBusError(fmt.Errorf("failed to publish the event"))
+	}
+	e := event.New()
+	for _, opt := range opts {
+		if err := opt(&e); err != nil {
+			return err
+		}
+	}
+	f.Events = append(f.Events, payload)
+	f.IDs = append(f.IDs, e.ID())
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routing
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	"github.com/evilmonkeyinc/jsonpath"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedexpr "github.com/argoproj/argo-events/pkg/shared/expr"
+)
+
+// Target is the event name and the CloudEvent type an event is published with
+type Target struct {
+	EventName string
+	// Type is empty for the type of the event source
+	Type string
+}
+
+// Validate validates the routes of an event
+func Validate(routes []aev1.EventSourceRoute) error {
+	_, err := New(routes)
+	return err
+}
+
+type route struct {
+	spec     aev1.EventSourceRoute
+	program  *vm.Program
+	selector *jsonpath.Selector
+}
+
+// Router matches the events with the routes
+type Router struct {
+	routes []route
+}
+
+// New returns the router of the routes of an event
+func New(routes []aev1.EventSourceRoute) (*Router, error) {
+	router := &Router{}
+	names := map[string]bool{}
+	for i, spec := range routes {
+		if spec.EventName == "" {
+			return nil, fmt.Errorf("eventName of route %d can't be empty", i)
+		}
+		if names[spec.EventName] {
+			return nil, fmt.Errorf("more than one route to %q", spec.EventName)
+		}
+		names[spec.EventName] = true
+		r := route{spec: spec}
+		switch {
+		case (spec.Expression == "") == (spec.JSONPath == ""):
+			return nil, fmt.Errorf("exactly one of expression and jsonPath is required by the route to %q", spec.EventName)
+		case spec.Expression != "":
+			if spec.Value != "" {
+				return nil, fmt.Errorf("value of the route to %q requires jsonPath", spec.EventName)
+			}
+			program, err := expr.Compile(spec.Expression)
+			if err != nil {
+				return nil, fmt.Errorf("invalid expression of the route to %q, %w", spec.EventName, err)
+			}
+			r.program = program
+		default:
+			selector, err := jsonpath.Compile(spec.JSONPath)
+			if err != nil {
+				return nil, fmt.Errorf("invalid jsonPath of the route to %q, %w", spec.EventName, err)
+			}
+			r.selector = selector
+		}
+		router.routes = append(router.routes, r)
+	}
+	return router, nil
+}
+
+// Route returns the targets of the routes matching the event data, in the order of the routes
+func (r *Router) Route(data []byte) ([]Target, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data, %w", err)
+	}
+	var env map[string]interface{}
+	var targets []Target
+	for _, route := range r.routes {
+		matched := false
+		if route.program != nil {
+			if env == nil {
+				// the same environment as the filter of the event
+				dataMap, ok := value.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("the data is not a JSON object")
+				}
+				params := make(map[string]interface{})
+				for key, v := range dataMap {
+					params[strings.ReplaceAll(key, "-", "_")] = v
+				}
+				env = sharedexpr.GetFuncMap(params)
+			}
+			result, err := expr.Run(route.program, env)
+			if err != nil {
+				return nil, fmt.Errorf("unable to evaluate expression '%s': %w", route.spec.Expression, err)
+			}
+			var ok bool
+			if matched, ok = result.(bool); !ok {
+				return nil, fmt.Errorf("unable to cast expression result '%v' to bool", result)
+			}
+		} else {
+			// a path selecting nothing fails
+			if selected, err := route.selector.Query(value); err == nil {
+				matched = matches(selected, route.spec.Value)
+			}
+		}
+		if matched {
+			targets = append(targets, Target{EventName: route.spec.EventName, Type: route.spec.Type})
+		}
+	}
+	return targets, nil
+}
+
+// matches returns whether the selected value exists, and equals the expected value if any, or one of the values if
+// it is a list, e.g. the values of a header
+func matches(selected interface{}, expected string) bool {
+	if selected == nil {
+		return false
+	}
+	if expected == "" {
+		return true
+	}
+	if values, ok := selected.([]interface{}); ok {
+		for _, v := range values {
+			if toString(v) == expected {
+				return true
+			}
+		}
+		return false
+	}
+	return toString(selected) == expected
+}
+
+func toString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+func TestRoute(t *testing.T) {
+	router, err := New([]aev1.EventSourceRoute{
+		{EventName: "pull_request", JSONPath: "$.header['X-Github-Event']", Value: "pull_request", Type: "github.pull_request"},
+		{EventName: "push", Expression: `header["X-Github-Event"][0] == "push"`},
+		{EventName: "main", JSONPath: "$.body.ref", Value: "refs/heads/main"},
+		{EventName: "large", Expression: "body.size > 100"},
+		{EventName: "labeled", JSONPath: "$.body.label"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    string
+		targets []Target
+	}{
+		{
+			name:    "header value",
+			data:    `{"header": {"X-Github-Event": ["pull_request"]}, "body": {"size": 1}}`,
+			targets: []Target{{EventName: "pull_request", Type: "github.pull_request"}},
+		},
+		{
+			name:    "several routes",
+			data:    `{"header": {"X-Github-Event": ["push"]}, "body": {"ref": "refs/heads/main", "size": 101}}`,
+			targets: []Target{{EventName: "push"}, {EventName: "main"}, {EventName: "large"}},
+		},
+		{
+			name:    "path without value",
+			data:    `{"header": {"X-Github-Event": ["release"]}, "body": {"size": 1, "label": {"name": "bug"}}}`,
+			targets: []Target{{EventName: "labeled"}},
+		},
+		{
+			name: "no route",
+			data: `{"header": {"X-Github-Event": ["release"]}, "body": {"ref": "refs/heads/dev", "size": 1, "label": null}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets, err := router.Route([]byte(tt.data))
+			require.NoError(t, err)
+			assert.Equal(t, tt.targets, targets)
+		})
+	}
+
+	_, err = router.Route([]byte("not json"))
+	assert.Error(t, err)
+	_, err = router.Route([]byte(`{"header": {"X-Github-Event": ["push"]}, "body": {"size": "large"}}`))
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		routes []aev1.EventSourceRoute
+		err    string
+	}{
+		{name: "valid", routes: []aev1.EventSourceRoute{{EventName: "push", Expression: "true"}, {EventName: "tag", JSONPath: "$.body.ref_type", Value: "tag"}}},
+		{name: "empty event name", routes: []aev1.EventSourceRoute{{Expression: "true"}}, err: "eventName of route 0 can't be empty"},
+		{name: "duplicated event name", routes: []aev1.EventSourceRoute{{EventName: "push", Expression: "true"}, {EventName: "push", JSONPath: "$.body"}}, err: `more than one route to "push"`},
+		{name: "no condition", routes: []aev1.EventSourceRoute{{EventName: "push"}}, err: `exactly one of expression and jsonPath is required by the route to "push"`},
+		{name: "two conditions", routes: []aev1.EventSourceRoute{{EventName: "push", Expression: "true", JSONPath: "$.body"}}, err: `exactly one of expression and jsonPath is required by the route to "push"`},
+		{name: "value without path", routes: []aev1.EventSourceRoute{{EventName: "push", Expression: "true", Value: "push"}}, err: `value of the route to "push" requires jsonPath`},
+		{name: "invalid expression", routes: []aev1.EventSourceRoute{{EventName: "push", Expression: "body.("}}, err: `invalid expression of the route to "push"`},
+		{name: "invalid path", routes: []aev1.EventSourceRoute{{EventName: "push", JSONPath: "body"}}, err: `invalid jsonPath of the route to "push"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.routes)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/riferrei/srclient"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/decoder"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	// ViolationsExtension is the CloudEvent extension keeping the violations of an event published to the dead letter event
+	ViolationsExtension = "schemaviolations"
+	// schemaURL is the URL the schema is compiled as, the external references are not resolved
+	schemaURL = "event-schema.json"
+	// maxSchemaSize is the max size of a schema fetched from a URL
+	maxSchemaSize = 4 << 20
+)
+
+// Violation is a violation of the schema by the event data
+type Violation struct {
+	// Path is the JSON pointer of the violating value, "/" for the whole data
+	Path string
+	// Message describes the violation
+	Message string
+}
+
+func (v Violation) String() string {
+	return v.Path + ": " + v.Message
+}
+
+// Join joins the violations in a single line
+func Join(violations []Violation) string {
+	lines := make([]string, 0, len(violations))
+	for _, v := range violations {
+		lines = append(lines, v.String())
+	}
+	return strings.Join(lines, "; ")
+}
+
+// Validator validates the event data with a JSON Schema
+type Validator struct {
+	schema *jsonschema.Schema
+}
+
+// Validate validates that the spec is complete, and compiles the inline schema
+func Validate(spec *aev1.EventSourceSchema) error {
+	sources := 0
+	for _, set := range []bool{spec.Inline != "", spec.ConfigMap != nil, spec.URL != "", spec.SchemaRegistry != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("exactly one of inline, configMap, url and schemaRegistry is required")
+	}
+	if spec.SchemaRegistry != nil {
+		if spec.SchemaRegistry.URL == "" {
+			return fmt.Errorf("schema registry url can't be empty")
+		}
+		if spec.Subject == "" && spec.SchemaRegistry.SchemaID == 0 {
+			return fmt.Errorf("either subject or schemaRegistry.schemaId is required")
+		}
+	} else if spec.Subject != "" {
+		return fmt.Errorf("subject requires schemaRegistry")
+	}
+	if spec.DeadLetter != nil && spec.DeadLetter.EventName == "" {
+		return fmt.Errorf("dead letter event name can't be empty")
+	}
+	if spec.Inline != "" {
+		if _, err := Compile(spec.Inline); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load gets the schema of the spec, and compiles it
+func Load(ctx context.Context, spec *aev1.EventSourceSchema) (*Validator, error) {
+	doc, err := fetch(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	s, err := Compile(doc)
+	if err != nil {
+		return nil, err
+	}
+	return &Validator{schema: s}, nil
+}
+
+// Compile compiles a JSON Schema document
+func Compile(doc string) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("external reference %s is not supported", s)
+	}
+	if err := c.AddResource(schemaURL, strings.NewReader(doc)); err != nil {
+		return nil, fmt.Errorf("invalid schema, %w", err)
+	}
+	s, err := c.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the schema, %w", err)
+	}
+	return s, nil
+}
+
+func fetch(ctx context.Context, spec *aev1.EventSourceSchema) (string, error) {
+	switch {
+	case spec.Inline != "":
+		return spec.Inline, nil
+	case spec.ConfigMap != nil:
+		doc, err := sharedutil.GetConfigMapFromVolume(spec.ConfigMap)
+		if err != nil {
+			return "", fmt.Errorf("failed to get the schema from the configmap, %w", err)
+		}
+		return doc, nil
+	case spec.URL != "":
+		return fetchURL(ctx, spec.URL)
+	case spec.SchemaRegistry != nil:
+		return fetchRegistry(spec.SchemaRegistry, spec.Subject)
+	}
+	return "", fmt.Errorf("no schema specified")
+}
+
+func fetchURL(ctx context.Context, url string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create the request for the schema, %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the schema, %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch the schema, status %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSchemaSize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read the schema, %w", err)
+	}
+	if len(body) > maxSchemaSize {
+		return "", fmt.Errorf("the schema is larger than %d bytes", maxSchemaSize)
+	}
+	return string(body), nil
+}
+
+func fetchRegistry(registry *aev1.SchemaRegistryConfig, subject string) (string, error) {
+	client, err := decoder.NewSchemaRegistryClient(registry)
+	if err != nil {
+		return "", err
+	}
+	var s *srclient.Schema
+	if subject != "" {
+		s, err = client.GetLatestSchema(subject)
+	} else {
+		s, err = client.GetSchema(int(registry.SchemaID))
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get the schema from the schema registry, %w", err)
+	}
+	return s.Schema(), nil
+}
+
+// Validate validates the event data, and returns the violations of the schema sorted by path, or nil if the data
+// is valid
+func (v *Validator) Validate(data []byte) []Violation {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return []Violation{{Path: "/", Message: fmt.Sprintf("invalid JSON, %v", err)}}
+	}
+	err := v.schema.Validate(doc)
+	if err == nil {
+		return nil
+	}
+	ve, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return []Violation{{Path: "/", Message: err.Error()}}
+	}
+	var violations []Violation
+	collect(ve, &violations)
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Path < violations[j].Path })
+	return violations
+}
+
+// collect collects the causes of a validation error which have no causes themselves
+func collect(ve *jsonschema.ValidationError, violations *[]Violation) {
+	if len(ve.Causes) == 0 {
+		path := ve.InstanceLocation
+		if path == "" {
+			path = "/"
+		}
+		*violations = append(*violations, Violation{Path: path, Message: ve.Message})
+		return
+	}
+	for _, cause := range ve.Causes {
+		collect(cause, violations)
+	}
+}
+
+// MetricPath returns the path of a violation with the array indexes replaced by "*", so the number of the paths
+// recorded in the metrics is bounded by the schema
+func MetricPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if _, err := strconv.Atoi(segment); err == nil {
+			segments[i] = "*"
+		}
+	}
+	return strings.Join(segments, "/")
+}
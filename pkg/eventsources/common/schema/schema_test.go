@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+const orderSchema = `{
+  "type": "object",
+  "required": ["body"],
+  "properties": {
+    "body": {
+      "type": "object",
+      "required": ["id", "items"],
+      "properties": {
+        "id": {"type": "string"},
+        "items": {
+          "type": "array",
+          "items": {"type": "object", "properties": {"price": {"type": "number", "minimum": 0}}}
+        }
+      }
+    }
+  }
+}`
+
+func TestValidator(t *testing.T) {
+	v, err := Load(context.Background(), &aev1.EventSourceSchema{Inline: orderSchema})
+	require.NoError(t, err)
+
+	assert.Empty(t, v.Validate([]byte(`{"body": {"id": "1", "items": [{"price": 10}]}}`)))
+
+	violations := v.Validate([]byte(`{"body": {"id": 1, "items": [{"price": 10}, {"price": -1}]}}`))
+	require.Len(t, violations, 2)
+	assert.Equal(t, "/body/id", violations[0].Path)
+	assert.Equal(t, "/body/items/1/price", violations[1].Path)
+	assert.Equal(t, "/body/items/*/price", MetricPath(violations[1].Path))
+	assert.Contains(t, Join(violations), "/body/id: ")
+
+	violations = v.Validate([]byte(`{"header": {}}`))
+	require.Len(t, violations, 1)
+	assert.Equal(t, "/", violations[0].Path)
+
+	violations = v.Validate([]byte(`not json`))
+	require.Len(t, violations, 1)
+	assert.Equal(t, "/", violations[0].Path)
+	assert.Contains(t, violations[0].Message, "invalid JSON")
+}
+
+func TestLoadURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/order.json" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, orderSchema)
+	}))
+	defer server.Close()
+
+	v, err := Load(context.Background(), &aev1.EventSourceSchema{URL: server.URL + "/order.json"})
+	require.NoError(t, err)
+	assert.Len(t, v.Validate([]byte(`{}`)), 1)
+
+	_, err = Load(context.Background(), &aev1.EventSourceSchema{URL: server.URL + "/missing.json"})
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestLoadSchemaRegistry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+		switch r.URL.Path {
+		case "/subjects/orders-value/versions/latest":
+			fmt.Fprintf(w, `{"subject": "orders-value", "version": 3, "id": 7, "schemaType": "JSON", "schema": %q}`, orderSchema)
+		case "/schemas/ids/7":
+			fmt.Fprintf(w, `{"schemaType": "JSON", "schema": %q}`, orderSchema)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	for _, spec := range []*aev1.EventSourceSchema{
+		{SchemaRegistry: &aev1.SchemaRegistryConfig{URL: server.URL}, Subject: "orders-value"},
+		{SchemaRegistry: &aev1.SchemaRegistryConfig{URL: server.URL, SchemaID: 7}},
+	} {
+		v, err := Load(context.Background(), spec)
+		require.NoError(t, err)
+		assert.Len(t, v.Validate([]byte(`{}`)), 1)
+	}
+}
+
+func TestCompileExternalReference(t *testing.T) {
+	_, err := Compile(`{"$ref": "file:///etc/passwd"}`)
+	assert.ErrorContains(t, err, "not supported")
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		spec aev1.EventSourceSchema
+		err  string
+	}{
+		{name: "inline", spec: aev1.EventSourceSchema{Inline: orderSchema}},
+		{name: "none", spec: aev1.EventSourceSchema{}, err: "exactly one of inline, configMap, url and schemaRegistry is required"},
+		{name: "two", spec: aev1.EventSourceSchema{Inline: orderSchema, URL: "http://schemas"}, err: "exactly one of inline, configMap, url and schemaRegistry is required"},
+		{name: "invalid inline", spec: aev1.EventSourceSchema{Inline: `{"type": 1}`}, err: "failed to compile the schema"},
+		{name: "registry without url", spec: aev1.EventSourceSchema{SchemaRegistry: &aev1.SchemaRegistryConfig{SchemaID: 1}}, err: "schema registry url can't be empty"},
+		{name: "registry without schema", spec: aev1.EventSourceSchema{SchemaRegistry: &aev1.SchemaRegistryConfig{URL: "http://registry"}}, err: "either subject or schemaRegistry.schemaId is required"},
+		{name: "subject without registry", spec: aev1.EventSourceSchema{URL: "http://schemas", Subject: "orders"}, err: "subject requires schemaRegistry"},
+		{name: "dead letter without name", spec: aev1.EventSourceSchema{URL: "http://schemas", DeadLetter: &aev1.EventSourceDeadLetter{}}, err: "dead letter event name can't be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&tt.spec)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
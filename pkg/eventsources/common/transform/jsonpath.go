@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transform
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaskValue is the value of the masked fields
+const MaskValue = "******"
+
+type action int
+
+const (
+	drop action = iota
+	mask
+)
+
+// segment is a step of a JSONPath, a field name, an array index or a wildcard
+type segment struct {
+	name     string
+	index    int
+	isIndex  bool
+	wildcard bool
+	// recursive matches the segment at any depth, i.e. ..name
+	recursive bool
+}
+
+// path is a JSONPath, supporting $.a.b, $['a'], $.a[0], $.a[*], $.* and $..a
+type path []segment
+
+func parsePath(s string) (path, error) {
+	if !strings.HasPrefix(s, "$") {
+		return nil, fmt.Errorf("the path must start with $")
+	}
+	var result path
+	rest := s[1:]
+	for rest != "" {
+		seg := segment{}
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			seg.recursive = true
+			rest = rest[2:]
+			if strings.HasPrefix(rest, "[") {
+				break
+			}
+			fallthrough
+		case strings.HasPrefix(rest, "."):
+			rest = strings.TrimPrefix(rest, ".")
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty field name")
+			}
+			if rest[:end] == "*" {
+				seg.wildcard = true
+			} else {
+				seg.name = rest[:end]
+			}
+			rest = rest[end:]
+			result = append(result, seg)
+			continue
+		case !strings.HasPrefix(rest, "["):
+			return nil, fmt.Errorf("unexpected %q", rest)
+		}
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			return nil, fmt.Errorf("missing ]")
+		}
+		selector := rest[1:end]
+		rest = rest[end+1:]
+		switch {
+		case selector == "*":
+			seg.wildcard = true
+		case len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0]:
+			seg.name = selector[1 : len(selector)-1]
+		default:
+			index, err := strconv.Atoi(selector)
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid selector [%s]", selector)
+			}
+			seg.index, seg.isIndex = index, true
+		}
+		result = append(result, seg)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("the path must select a field")
+	}
+	return result, nil
+}
+
+// apply applies the action to the values of node matched by the path from its i-th segment, and returns the node
+func (p path) apply(node interface{}, i int, act action) interface{} {
+	seg := p[i]
+	last := i == len(p)-1
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if !seg.isIndex {
+			for key, value := range n {
+				if !seg.wildcard && key != seg.name {
+					continue
+				}
+				switch {
+				case !last:
+					n[key] = p.apply(value, i+1, act)
+				case act == drop:
+					delete(n, key)
+				default:
+					n[key] = MaskValue
+				}
+			}
+		}
+		if seg.recursive {
+			for key, value := range n {
+				n[key] = p.apply(value, i, act)
+			}
+		}
+		return n
+	case []interface{}:
+		if seg.isIndex || seg.wildcard {
+			kept := make([]interface{}, 0, len(n))
+			for j, value := range n {
+				switch {
+				case !seg.wildcard && j != seg.index:
+					kept = append(kept, value)
+				case !last:
+					kept = append(kept, p.apply(value, i+1, act))
+				case act == mask:
+					kept = append(kept, MaskValue)
+				}
+			}
+			n = kept
+		}
+		if seg.recursive {
+			for j, value := range n {
+				n[j] = p.apply(value, i, act)
+			}
+		}
+		return n
+	default:
+		return node
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transform
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	"github.com/itchyny/gojq"
+	"github.com/yuin/gopher-lua/parse"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedexpr "github.com/argoproj/argo-events/pkg/shared/expr"
+	sharedtransform "github.com/argoproj/argo-events/pkg/shared/transform"
+)
+
+// Validate validates the transform of an event
+func Validate(spec *aev1.EventSourceTransform) error {
+	n := 0
+	for _, s := range []string{spec.JQ, spec.Script, spec.Expression} {
+		if s != "" {
+			n++
+		}
+	}
+	if n > 1 {
+		return fmt.Errorf("at most one of jq, script and expression is allowed")
+	}
+	if n == 0 && len(spec.Drop) == 0 && len(spec.Mask) == 0 {
+		return fmt.Errorf("one of jq, script, expression, drop and mask is required")
+	}
+	if spec.JQ != "" {
+		if _, err := gojq.Parse(spec.JQ); err != nil {
+			return fmt.Errorf("invalid jq command, %w", err)
+		}
+	}
+	if spec.Script != "" {
+		if _, err := parse.Parse(strings.NewReader(spec.Script), "<transform>"); err != nil {
+			return fmt.Errorf("invalid script, %w", err)
+		}
+	}
+	_, err := New(spec)
+	return err
+}
+
+// Transformer transforms and redacts the event data
+type Transformer struct {
+	spec    *aev1.EventSourceTransform
+	program *vm.Program
+	drop    []path
+	mask    []path
+}
+
+// New returns the transformer of a transform
+func New(spec *aev1.EventSourceTransform) (*Transformer, error) {
+	t := &Transformer{spec: spec}
+	if spec.Expression != "" {
+		program, err := expr.Compile(spec.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression, %w", err)
+		}
+		t.program = program
+	}
+	for _, p := range spec.Drop {
+		parsed, err := parsePath(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid drop path %q, %w", p, err)
+		}
+		t.drop = append(t.drop, parsed)
+	}
+	for _, p := range spec.Mask {
+		parsed, err := parsePath(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mask path %q, %w", p, err)
+		}
+		t.mask = append(t.mask, parsed)
+	}
+	return t, nil
+}
+
+// Transform shapes the event data with the jq command, the script or the expression, and redacts the output
+func (t *Transformer) Transform(data []byte) ([]byte, error) {
+	var err error
+	switch {
+	case t.spec.JQ != "" || t.spec.Script != "":
+		// the same transformations as the ones of the sensor dependencies
+		if t.spec.JQ != "" {
+			data, err = sharedtransform.JQ(data, t.spec.JQ)
+		} else {
+			data, err = sharedtransform.Script(data, t.spec.Script)
+		}
+		if err != nil {
+			return nil, err
+		}
+	case t.program != nil:
+		if data, err = t.evaluate(data); err != nil {
+			return nil, err
+		}
+	}
+	return t.Redact(data)
+}
+
+// evaluate evaluates the expression on the fields of the event data, like the filter of the event
+func (t *Transformer) evaluate(data []byte) ([]byte, error) {
+	dataMap := make(map[string]interface{})
+	if err := json.Unmarshal(data, &dataMap); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data, %w", err)
+	}
+	params := make(map[string]interface{})
+	for key, value := range dataMap {
+		params[strings.ReplaceAll(key, "-", "_")] = value
+	}
+	result, err := expr.Run(t.program, sharedexpr.GetFuncMap(params))
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate expression '%s': %w", t.spec.Expression, err)
+	}
+	if _, ok := result.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("expression output must be a map, got %T", result)
+	}
+	return json.Marshal(result)
+}
+
+// Redact removes the fields of the drop paths, and masks the values of the mask paths
+func (t *Transformer) Redact(data []byte) ([]byte, error) {
+	if len(t.drop) == 0 && len(t.mask) == 0 {
+		return data, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data, %w", err)
+	}
+	for _, p := range t.drop {
+		value = p.apply(value, 0, drop)
+	}
+	for _, p := range t.mask {
+		value = p.apply(value, 0, mask)
+	}
+	return json.Marshal(value)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+const data = `{"header": {"Authorization": ["Bearer token"]}, "body": {"id": 12345678901234567890, "user": {"email": "a@b.c", "name": "a"}, "items": [{"sku": "s-1", "email": "x@y.z"}, {"sku": "s-2"}]}}`
+
+func TestTransform(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   aev1.EventSourceTransform
+		result string
+		err    string
+	}{
+		{
+			name:   "jq",
+			spec:   aev1.EventSourceTransform{JQ: `{id: .body.id, skus: [.body.items[].sku]}`},
+			result: `{"id": 12345678901234567890, "skus": ["s-1", "s-2"]}`,
+		},
+		{
+			name:   "script",
+			spec:   aev1.EventSourceTransform{Script: `return {name=event.body.user.name}`},
+			result: `{"name": "a"}`,
+		},
+		{
+			name:   "expression",
+			spec:   aev1.EventSourceTransform{Expression: `{"name": body.user.name, "count": len(body.items)}`},
+			result: `{"name": "a", "count": 2}`,
+		},
+		{
+			name:   "jq with redaction",
+			spec:   aev1.EventSourceTransform{JQ: `.body.user`, Mask: []string{"$.email"}},
+			result: `{"email": "******", "name": "a"}`,
+		},
+		{
+			name:   "redaction",
+			spec:   aev1.EventSourceTransform{Drop: []string{"$.header.Authorization", "$.body.items[1]"}, Mask: []string{"$..email"}},
+			result: `{"header": {}, "body": {"id": 12345678901234567890, "user": {"email": "******", "name": "a"}, "items": [{"sku": "s-1", "email": "******"}]}}`,
+		},
+		{
+			name: "jq output not an object",
+			spec: aev1.EventSourceTransform{JQ: `.body.items`},
+			err:  "jq transformation output must be a JSON object",
+		},
+		{
+			name: "expression output not a map",
+			spec: aev1.EventSourceTransform{Expression: `body.user.name`},
+			err:  "expression output must be a map, got string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transformer, err := New(&tt.spec)
+			require.NoError(t, err)
+			result, err := transformer.Transform([]byte(data))
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.result, string(result))
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name   string
+		drop   []string
+		mask   []string
+		result string
+	}{
+		{name: "bracket field", drop: []string{"$['header']"}, result: `{"body": {"a": [1, 2, {"b": 3}], "c": {"b": 4}}}`},
+		{name: "wildcard", mask: []string{"$.body.c.*"}, result: `{"header": {"x": "y"}, "body": {"a": [1, 2, {"b": 3}], "c": {"b": "******"}}}`},
+		{name: "array wildcard", mask: []string{"$.body.a[*]"}, result: `{"header": {"x": "y"}, "body": {"a": ["******", "******", "******"], "c": {"b": 4}}}`},
+		{name: "array index", drop: []string{"$.body.a[0]"}, result: `{"header": {"x": "y"}, "body": {"a": [2, {"b": 3}], "c": {"b": 4}}}`},
+		{name: "recursive", drop: []string{"$..b"}, result: `{"header": {"x": "y"}, "body": {"a": [1, 2, {}], "c": {}}}`},
+		{name: "missing", drop: []string{"$.body.missing.b", "$.header[3]"}, result: `{"header": {"x": "y"}, "body": {"a": [1, 2, {"b": 3}], "c": {"b": 4}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transformer, err := New(&aev1.EventSourceTransform{Drop: tt.drop, Mask: tt.mask})
+			require.NoError(t, err)
+			result, err := transformer.Redact([]byte(`{"header": {"x": "y"}, "body": {"a": [1, 2, {"b": 3}], "c": {"b": 4}}}`))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.result, string(result))
+		})
+	}
+
+	transformer, err := New(&aev1.EventSourceTransform{Mask: []string{"$.a"}})
+	require.NoError(t, err)
+	_, err = transformer.Redact([]byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		spec aev1.EventSourceTransform
+		err  string
+	}{
+		{name: "jq", spec: aev1.EventSourceTransform{JQ: `{id: .body.id}`, Drop: []string{"$.header"}}},
+		{name: "empty", spec: aev1.EventSourceTransform{}, err: "one of jq, script, expression, drop and mask is required"},
+		{name: "jq and script", spec: aev1.EventSourceTransform{JQ: ".", Script: "return event"}, err: "at most one of jq, script and expression is allowed"},
+		{name: "invalid jq", spec: aev1.EventSourceTransform{JQ: "{"}, err: "invalid jq command"},
+		{name: "invalid script", spec: aev1.EventSourceTransform{Script: "return {"}, err: "invalid script"},
+		{name: "invalid expression", spec: aev1.EventSourceTransform{Expression: "body.("}, err: "invalid expression"},
+		{name: "path without $", spec: aev1.EventSourceTransform{Drop: []string{"body.password"}}, err: `invalid drop path "body.password", the path must start with $`},
+		{name: "root path", spec: aev1.EventSourceTransform{Mask: []string{"$"}}, err: "the path must select a field"},
+		{name: "empty field", spec: aev1.EventSourceTransform{Mask: []string{"$.body..."}}, err: "empty field name"},
+		{name: "invalid index", spec: aev1.EventSourceTransform{Mask: []string{"$.items[-1]"}}, err: "invalid selector [-1]"},
+		{name: "missing bracket", spec: aev1.EventSourceTransform{Mask: []string{"$.items[0"}}, err: "missing ]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&tt.spec)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	rejectedRateLimited     = "rate_limited"
+	rejectedTooManyInFlight = "too_many_in_flight"
+	rejectedTimeout         = "timeout"
+	// clientLimiterTTL is the duration after which the rate limiter of an idle client is removed
+	clientLimiterTTL = 10 * time.Minute
+)
+
+// admission limits the requests of a route
+type admission struct {
+	limiters *clientLimiters
+	inFlight chan struct{}
+	timeout  time.Duration
+}
+
+// newAdmission returns the admission of the requests of a route, or nil if they are not limited
+func newAdmission(hookContext *aev1.WebhookContext) *admission {
+	a := &admission{}
+	if hookContext.RateLimit != nil {
+		a.limiters = newClientLimiters(hookContext.RateLimit)
+	}
+	if hookContext.MaxInFlight != nil {
+		a.inFlight = make(chan struct{}, *hookContext.MaxInFlight)
+	}
+	if hookContext.RequestTimeout != "" {
+		// the timeout is validated with the webhook context.
+		a.timeout, _ = time.ParseDuration(hookContext.RequestTimeout)
+	}
+	if a.limiters == nil && a.inFlight == nil && a.timeout <= 0 {
+		return nil
+	}
+	return a
+}
+
+// serve serves the request with the handler if it is admitted, or rejects it
+func (a *admission) serve(route *Route, writer http.ResponseWriter, request *http.Request, handler http.HandlerFunc) {
+	if a.limiters != nil {
+		if delay := a.limiters.reserve(a.limiters.clientOf(request), time.Now()); delay > 0 {
+			route.Logger.Info("request rejected by the rate limit")
+			route.Metrics.WebhookRequestRejected(route.EventSourceName, route.EventName, rejectedRateLimited)
+			sendTooManyRequests(writer, delay)
+			return
+		}
+	}
+	if a.inFlight != nil {
+		select {
+		case a.inFlight <- struct{}{}:
+			slot := &inFlightSlot{release: func() { <-a.inFlight }}
+			slot.holds.Store(1)
+			next := handler
+			handler = func(writer http.ResponseWriter, request *http.Request) {
+				// the slot is released when the request is processed, even after it timed out,
+				// and its events dispatched in the background are dispatched.
+				defer slot.done()
+				next(writer, request.WithContext(context.WithValue(request.Context(), inFlightSlotKey{}, slot)))
+			}
+		default:
+			route.Logger.Info("request rejected by the max in flight requests")
+			route.Metrics.WebhookRequestRejected(route.EventSourceName, route.EventName, rejectedTooManyInFlight)
+			sendTooManyRequests(writer, time.Second)
+			return
+		}
+	}
+	if a.timeout <= 0 {
+		handler(writer, request)
+		return
+	}
+	var finished atomic.Bool
+	http.TimeoutHandler(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		defer finished.Store(true)
+		handler(writer, request)
+	}), a.timeout, "request timeout").ServeHTTP(writer, request)
+	if !finished.Load() {
+		route.Logger.Infow("request timed out", "timeout", a.timeout)
+		route.Metrics.WebhookRequestRejected(route.EventSourceName, route.EventName, rejectedTimeout)
+	}
+}
+
+type inFlightSlotKey struct{}
+
+// inFlightSlot is the max in flight slot taken by a request, released once it is not held anymore
+type inFlightSlot struct {
+	holds   atomic.Int32
+	release func()
+}
+
+func (s *inFlightSlot) done() {
+	if s.holds.Add(-1) == 0 {
+		s.release()
+	}
+}
+
+// holdInFlightSlot holds the in flight slot of the request of the context, if any, until the
+// returned func is called
+func holdInFlightSlot(ctx context.Context) func() {
+	slot, ok := ctx.Value(inFlightSlotKey{}).(*inFlightSlot)
+	if !ok {
+		return func() {}
+	}
+	slot.holds.Add(1)
+	return slot.done
+}
+
+// sendTooManyRequests answers 429 with the delay to retry after, in seconds
+func sendTooManyRequests(writer http.ResponseWriter, delay time.Duration) {
+	writer.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+	sharedutil.SendResponse(writer, http.StatusTooManyRequests, "too many requests")
+}
+
+// clientLimiters are the rate limiters of the clients of a route
+type clientLimiters struct {
+	sync.Mutex
+	config    *aev1.WebhookRateLimit
+	limit     rate.Limit
+	burst     int
+	clients   map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newClientLimiters(config *aev1.WebhookRateLimit) *clientLimiters {
+	unit := time.Second
+	switch config.Unit {
+	case aev1.Minute:
+		unit = time.Minute
+	case aev1.Hour:
+		unit = time.Hour
+	}
+	burst := int(config.RequestsPerUnit)
+	if config.Burst != nil {
+		burst = int(*config.Burst)
+	}
+	return &clientLimiters{
+		config:    config,
+		limit:     rate.Every(unit / time.Duration(config.RequestsPerUnit)),
+		burst:     burst,
+		clients:   map[string]*clientLimiter{},
+		lastSweep: time.Now(),
+	}
+}
+
+// reserve takes a token of the client at now, or returns the delay until a token is available
+func (l *clientLimiters) reserve(client string, now time.Time) time.Duration {
+	l.Lock()
+	defer l.Unlock()
+	if now.Sub(l.lastSweep) > clientLimiterTTL {
+		for k, c := range l.clients {
+			if now.Sub(c.lastSeen) > clientLimiterTTL {
+				delete(l.clients, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	c, ok := l.clients[client]
+	if !ok {
+		c = &clientLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[client] = c
+	}
+	c.lastSeen = now
+	r := c.limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return clientLimiterTTL
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay
+	}
+	return 0
+}
+
+// clientOf returns the key of the client of the request, the hash of its header, or its source IP
+func (l *clientLimiters) clientOf(request *http.Request) string {
+	if l.config.Header != "" {
+		return fmt.Sprintf("%x", sha256.Sum256([]byte(request.Header.Get(l.config.Header))))
+	}
+	if l.config.TrustForwardedFor {
+		// the addresses on the left of the ones appended by the trusted proxies can be forged by the clients
+		trustedProxies := 1
+		if l.config.TrustedProxies != nil {
+			trustedProxies = int(*l.config.TrustedProxies)
+		}
+		forwardedFor := strings.Split(strings.Join(request.Header.Values("X-Forwarded-For"), ","), ",")
+		if i := len(forwardedFor) - trustedProxies; i >= 0 {
+			if ip := strings.TrimSpace(forwardedFor[i]); ip != "" {
+				return ip
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+	return host
+}
+
+// validateAdmission validates the limits of the requests of a webhook context
+func validateAdmission(hookContext *aev1.WebhookContext) error {
+	if rl := hookContext.RateLimit; rl != nil {
+		if rl.RequestsPerUnit <= 0 {
+			return fmt.Errorf("rate limit requestsPerUnit must be positive")
+		}
+		switch rl.Unit {
+		case "", aev1.Second, aev1.Minute, aev1.Hour:
+		default:
+			return fmt.Errorf("invalid rate limit unit %s, must be Second, Minute or Hour", rl.Unit)
+		}
+		if rl.Burst != nil && *rl.Burst <= 0 {
+			return fmt.Errorf("rate limit burst must be positive")
+		}
+		if rl.TrustedProxies != nil {
+			if !rl.TrustForwardedFor {
+				return fmt.Errorf("rate limit trustedProxies requires trustForwardedFor")
+			}
+			if *rl.TrustedProxies <= 0 {
+				return fmt.Errorf("rate limit trustedProxies must be positive")
+			}
+		}
+	}
+	if hookContext.MaxInFlight != nil && *hookContext.MaxInFlight <= 0 {
+		return fmt.Errorf("maxInFlight must be positive")
+	}
+	if hookContext.RequestTimeout != "" {
+		timeout, err := time.ParseDuration(hookContext.RequestTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to parse requestTimeout %s, %w", hookContext.RequestTimeout, err)
+		}
+		if timeout <= 0 {
+			return fmt.Errorf("requestTimeout must be positive")
+		}
+	}
+	return nil
+}
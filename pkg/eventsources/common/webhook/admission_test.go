@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+func TestAdmissionRateLimit(t *testing.T) {
+	route := GetFakeRoute()
+	burst := int32(2)
+	a := newAdmission(&aev1.WebhookContext{RateLimit: &aev1.WebhookRateLimit{Unit: aev1.Minute, RequestsPerUnit: 6, Burst: &burst}})
+	ok := func(writer http.ResponseWriter, request *http.Request) { writer.WriteHeader(http.StatusOK) }
+	serve := func(remoteAddr string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "/fake", nil)
+		request.RemoteAddr = remoteAddr
+		recorder := httptest.NewRecorder()
+		a.serve(route, recorder, request, ok)
+		return recorder
+	}
+
+	assert.Equal(t, http.StatusOK, serve("10.0.0.1:1234").Code)
+	assert.Equal(t, http.StatusOK, serve("10.0.0.1:1235").Code)
+	rejected := serve("10.0.0.1:1236")
+	assert.Equal(t, http.StatusTooManyRequests, rejected.Code)
+	assert.Equal(t, "10", rejected.Header().Get("Retry-After"))
+	// another client has its own limit.
+	assert.Equal(t, http.StatusOK, serve("10.0.0.2:1234").Code)
+}
+
+func TestClientLimiters(t *testing.T) {
+	l := newClientLimiters(&aev1.WebhookRateLimit{RequestsPerUnit: 1, Header: "X-Api-Key"})
+	request := httptest.NewRequest(http.MethodPost, "/fake", nil)
+	request.Header.Set("X-Api-Key", "secret")
+	client := l.clientOf(request)
+	assert.NotContains(t, client, "secret")
+
+	now := time.Now()
+	assert.Zero(t, l.reserve(client, now))
+	assert.Equal(t, time.Second, l.reserve(client, now))
+	assert.Zero(t, l.reserve(client, now.Add(time.Second)))
+
+	// the idle clients are removed.
+	l.reserve("other", now.Add(2*clientLimiterTTL))
+	assert.Len(t, l.clients, 1)
+
+	// the client is the address appended by the first trusted proxy.
+	l = newClientLimiters(&aev1.WebhookRateLimit{RequestsPerUnit: 1, TrustForwardedFor: true})
+	request.Header.Set("X-Forwarded-For", "1.2.3.4, 192.168.1.1")
+	assert.Equal(t, "192.168.1.1", l.clientOf(request))
+	request.Header.Add("X-Forwarded-For", "10.0.0.1")
+	assert.Equal(t, "10.0.0.1", l.clientOf(request))
+
+	trustedProxies := int32(2)
+	l = newClientLimiters(&aev1.WebhookRateLimit{RequestsPerUnit: 1, TrustForwardedFor: true, TrustedProxies: &trustedProxies})
+	assert.Equal(t, "192.168.1.1", l.clientOf(request))
+	// without enough addresses, the client is the source IP.
+	request.Header.Set("X-Forwarded-For", "10.0.0.1")
+	assert.Equal(t, "192.0.2.1", l.clientOf(request))
+}
+
+func TestAdmissionMaxInFlight(t *testing.T) {
+	route := GetFakeRoute()
+	maxInFlight := int32(1)
+	a := newAdmission(&aev1.WebhookContext{MaxInFlight: &maxInFlight})
+	started, release := make(chan struct{}), make(chan struct{})
+	go a.serve(route, httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/fake", nil), func(writer http.ResponseWriter, request *http.Request) {
+		close(started)
+		<-release
+	})
+	<-started
+
+	recorder := httptest.NewRecorder()
+	a.serve(route, recorder, httptest.NewRequest(http.MethodPost, "/fake", nil), func(writer http.ResponseWriter, request *http.Request) {
+		t.Error("the request should be rejected")
+	})
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.Equal(t, "1", recorder.Header().Get("Retry-After"))
+
+	close(release)
+	assert.Eventually(t, func() bool { return len(a.inFlight) == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestAdmissionMaxInFlightAsync(t *testing.T) {
+	route := GetFakeRoute()
+	route.Context = &aev1.WebhookContext{Endpoint: "/fake", Port: "12000", Async: true}
+	maxInFlight := int32(1)
+	a := newAdmission(&aev1.WebhookContext{MaxInFlight: &maxInFlight})
+	recorder := httptest.NewRecorder()
+	a.serve(route, recorder, httptest.NewRequest(http.MethodPost, "/fake", nil), func(writer http.ResponseWriter, request *http.Request) {
+		DispatchEvents(request.Context(), route, []*Dispatch{{Data: []byte("{}")}}, route.Logger, writer)
+	})
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	// the slot is held until the event is dispatched in the background.
+	assert.Len(t, a.inFlight, 1)
+
+	d := <-route.DispatchChan
+	d.SuccessChan <- true
+	assert.Eventually(t, func() bool { return len(a.inFlight) == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestAdmissionTimeout(t *testing.T) {
+	route := GetFakeRoute()
+	a := newAdmission(&aev1.WebhookContext{RequestTimeout: "50ms"})
+	release := make(chan struct{})
+	defer close(release)
+	recorder := httptest.NewRecorder()
+	a.serve(route, recorder, httptest.NewRequest(http.MethodPost, "/fake", nil), func(writer http.ResponseWriter, request *http.Request) {
+		<-release
+	})
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, "request timeout", recorder.Body.String())
+
+	assert.Nil(t, newAdmission(&aev1.WebhookContext{}))
+}
+
+func TestValidateAdmission(t *testing.T) {
+	hook := &aev1.WebhookContext{Endpoint: "/fake", Port: "12000", RateLimit: &aev1.WebhookRateLimit{}}
+	assert.ErrorContains(t, ValidateWebhookContext(hook), "requestsPerUnit must be positive")
+
+	hook.RateLimit = &aev1.WebhookRateLimit{RequestsPerUnit: 10, Unit: "Day"}
+	assert.ErrorContains(t, ValidateWebhookContext(hook), "invalid rate limit unit")
+
+	trustedProxies := int32(0)
+	hook.RateLimit = &aev1.WebhookRateLimit{RequestsPerUnit: 10, TrustedProxies: &trustedProxies}
+	assert.ErrorContains(t, ValidateWebhookContext(hook), "trustedProxies requires trustForwardedFor")
+	hook.RateLimit.TrustForwardedFor = true
+	assert.ErrorContains(t, ValidateWebhookContext(hook), "trustedProxies must be positive")
+
+	maxInFlight := int32(0)
+	hook.RateLimit = nil
+	hook.MaxInFlight = &maxInFlight
+	assert.ErrorContains(t, ValidateWebhookContext(hook), "maxInFlight must be positive")
+
+	hook.MaxInFlight = nil
+	hook.RequestTimeout = "soon"
+	assert.ErrorContains(t, ValidateWebhookContext(hook), "failed to parse requestTimeout")
+
+	hook.RequestTimeout = "30s"
+	assert.NoError(t, ValidateWebhookContext(hook))
+}
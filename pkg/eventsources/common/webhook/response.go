@@ -0,0 +1,325 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	sprig "github.com/Masterminds/sprig/v3"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+)
+
+// responseData is the data the response templates are rendered with
+type responseData struct {
+	EventID   string
+	EventIDs  []string
+	Challenge string
+	Method    string
+	Path      string
+	Query     url.Values
+	Header    http.Header
+	Body      interface{}
+}
+
+// routeWriter is the writer of the responses of a route with a response template,
+// it keeps the request and its body read by the router to render the response.
+type routeWriter struct {
+	http.ResponseWriter
+	request *http.Request
+	body    *cappedBuffer
+}
+
+func (w *routeWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// newRouteWriter returns the writer keeping the request and the first maxSize bytes of its body
+func newRouteWriter(writer http.ResponseWriter, request *http.Request, maxSize int64) *routeWriter {
+	body := &cappedBuffer{max: maxSize}
+	request.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.TeeReader(request.Body, body), request.Body}
+	return &routeWriter{ResponseWriter: writer, request: request, body: body}
+}
+
+// cappedBuffer keeps up to max bytes, and discards the others
+type cappedBuffer struct {
+	bytes.Buffer
+	max int64
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.max - int64(b.Len()); remaining > 0 {
+		if int64(len(p)) > remaining {
+			b.Buffer.Write(p[:remaining])
+		} else {
+			b.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+// newResponseData returns the data of the response to the request
+func newResponseData(request *http.Request, body []byte) *responseData {
+	data := &responseData{
+		Method: request.Method,
+		Path:   request.URL.Path,
+		Query:  request.URL.Query(),
+		Header: request.Header,
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &data.Body); err != nil {
+			data.Body = string(body)
+		}
+	}
+	return data
+}
+
+// responseFuncs are the functions of the response templates, without the ones reading the environment
+var responseFuncs = func() template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	delete(funcs, "env")
+	delete(funcs, "expandenv")
+	return funcs
+}()
+
+func parseResponseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(responseFuncs).Option("missingkey=zero").Parse(text)
+}
+
+// validateResponse checks the templates of a response
+func validateResponse(response *aev1.WebhookResponse) error {
+	if response.StatusCode != nil && (*response.StatusCode < 100 || *response.StatusCode > 599) {
+		return fmt.Errorf("invalid response status code %d", *response.StatusCode)
+	}
+	if _, err := parseResponseTemplate("body", response.Body); err != nil {
+		return fmt.Errorf("invalid response body template, %w", err)
+	}
+	for name, value := range response.Headers {
+		if _, err := parseResponseTemplate(name, value); err != nil {
+			return fmt.Errorf("invalid response header %s template, %w", name, err)
+		}
+	}
+	return nil
+}
+
+// renderResponse renders the status code, the headers and the body of a response
+func renderResponse(response *aev1.WebhookResponse, defaultStatusCode int, data *responseData) (int, http.Header, []byte, error) {
+	statusCode := defaultStatusCode
+	if response.StatusCode != nil {
+		statusCode = int(*response.StatusCode)
+	}
+	render := func(name, text string) (string, error) {
+		tpl, err := parseResponseTemplate(name, text)
+		if err != nil {
+			return "", err
+		}
+		var b strings.Builder
+		if err := tpl.Execute(&b, data); err != nil {
+			return "", err
+		}
+		return b.String(), nil
+	}
+	header := http.Header{}
+	for name, value := range response.Headers {
+		rendered, err := render(name, value)
+		if err != nil {
+			return 0, nil, nil, fmt.Errorf("failed to render the response header %s, %w", name, err)
+		}
+		header.Set(name, rendered)
+	}
+	body, err := render("body", response.Body)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("failed to render the response body, %w", err)
+	}
+	return statusCode, header, []byte(body), nil
+}
+
+// writeResponse writes the response, or the fallback response if it fails to render
+func writeResponse(writer http.ResponseWriter, response *aev1.WebhookResponse, defaultStatusCode int, data *responseData, fallback []byte, logger *zap.SugaredLogger) {
+	statusCode, header, body, err := renderResponse(response, defaultStatusCode, data)
+	if err != nil {
+		logger.Errorw("failed to render the response, sending the default response", zap.Error(err))
+		statusCode, header, body = defaultStatusCode, nil, fallback
+	}
+	for name, values := range header {
+		writer.Header()[name] = values
+	}
+	writer.WriteHeader(statusCode)
+	if _, err := writer.Write(body); err != nil {
+		logger.Errorw("failed to write the response", zap.Error(err))
+	}
+}
+
+// sendDispatchedResponse responds to a request whose events are dispatched, or accepted in the async mode
+func sendDispatchedResponse(route *Route, writer http.ResponseWriter, ids []string, logger *zap.SugaredLogger) {
+	statusCode, fallback := http.StatusOK, []byte("success")
+	if route.Context.Async {
+		statusCode, fallback = http.StatusAccepted, acceptedBody(ids)
+	}
+	if route.Context.Response == nil {
+		if route.Context.Async {
+			writer.Header().Set("Content-Type", "application/json")
+		}
+		writer.WriteHeader(statusCode)
+		if _, err := writer.Write(fallback); err != nil {
+			logger.Errorw("failed to write the response", zap.Error(err))
+		}
+		return
+	}
+	data := &responseData{}
+	if w, ok := writer.(*routeWriter); ok {
+		data = newResponseData(w.request, w.body.Bytes())
+		writer = w.ResponseWriter
+	}
+	data.EventIDs = ids
+	if len(ids) > 0 {
+		data.EventID = ids[0]
+	}
+	writeResponse(writer, route.Context.Response, statusCode, data, fallback, logger)
+}
+
+// acceptedBody is the default body of the async responses, {"id": "..."} for a single event,
+// {"ids": [...]} otherwise
+func acceptedBody(ids []string) []byte {
+	var body []byte
+	if len(ids) == 1 {
+		body, _ = json.Marshal(map[string]string{"id": ids[0]})
+	} else {
+		body, _ = json.Marshal(map[string][]string{"ids": ids})
+	}
+	return body
+}
+
+// assignEventIDs returns the ids of the events, setting the ones which are not set by their options
+func assignEventIDs(events []*Dispatch) []string {
+	ids := make([]string, 0, len(events))
+	for _, d := range events {
+		e := cloudevents.NewEvent()
+		for _, opt := range d.Options {
+			_ = opt(&e)
+		}
+		id := e.ID()
+		if id == "" {
+			uuidNew := uuid.New()
+			id = fmt.Sprintf("%x", uuidNew[:])
+			d.Options = append(d.Options, eventsourcecommon.WithID(id))
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// handleChallenge answers the request if it is a challenge request of the route, among the
+// unauthenticated challenges or the other ones
+func handleChallenge(route *Route, writer http.ResponseWriter, request *http.Request, unauthenticated bool) bool {
+	var challenges []aev1.WebhookChallenge
+	for _, c := range route.Context.Challenges {
+		if c.Unauthenticated == unauthenticated {
+			challenges = append(challenges, c)
+		}
+	}
+	if len(challenges) == 0 {
+		return false
+	}
+	var body []byte
+	for _, c := range challenges {
+		if c.BodyPath != "" || len(c.BodyValues) > 0 {
+			// the body is read once, and restored for the router which checks its size.
+			var err error
+			if body, err = io.ReadAll(io.LimitReader(request.Body, route.Context.GetMaxPayloadSize())); err != nil {
+				return false
+			}
+			request.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), request.Body), request.Body}
+			break
+		}
+	}
+	for _, c := range challenges {
+		challenge, ok := matchChallenge(&c, request, body)
+		if !ok {
+			continue
+		}
+		route.Logger.Info("answering a challenge request")
+		if c.Response == nil {
+			writer.Header().Set("Content-Type", "text/plain")
+			writer.WriteHeader(http.StatusOK)
+			_, _ = writer.Write([]byte(challenge))
+			return true
+		}
+		data := newResponseData(request, body)
+		data.Challenge = challenge
+		writeResponse(writer, c.Response, http.StatusOK, data, []byte(challenge), route.Logger)
+		return true
+	}
+	return false
+}
+
+// matchChallenge returns the challenge of the request if it is a request of the challenge
+func matchChallenge(c *aev1.WebhookChallenge, request *http.Request, body []byte) (string, bool) {
+	if len(c.BodyValues) > 0 && !gjson.ValidBytes(body) {
+		return "", false
+	}
+	for path, value := range c.BodyValues {
+		if gjson.GetBytes(body, path).String() != value {
+			return "", false
+		}
+	}
+	var challenge string
+	switch {
+	case c.QueryParameter != "":
+		challenge = request.URL.Query().Get(c.QueryParameter)
+	case c.Header != "":
+		challenge = request.Header.Get(c.Header)
+	case c.BodyPath != "":
+		challenge = gjson.GetBytes(body, c.BodyPath).String()
+	}
+	return challenge, challenge != ""
+}
+
+// validateChallenge validates a challenge
+func validateChallenge(c *aev1.WebhookChallenge) error {
+	sources := 0
+	for _, s := range []string{c.QueryParameter, c.Header, c.BodyPath} {
+		if s != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("exactly one of queryParameter, header and bodyPath must be specified")
+	}
+	if c.Response != nil {
+		return validateResponse(c.Response)
+	}
+	return nil
+}
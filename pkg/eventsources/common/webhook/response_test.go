@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+)
+
+// newResponseRoute returns a route with the webhook context, dispatching the events successfully
+func newResponseRoute(t *testing.T, hook *aev1.WebhookContext) (*Route, chan *Dispatch) {
+	t.Helper()
+	route := GetFakeRoute()
+	route.Context = hook
+	dispatched := make(chan *Dispatch, 10)
+	go func() {
+		for d := range route.DispatchChan {
+			dispatched <- d
+			d.SuccessChan <- true
+		}
+	}()
+	t.Cleanup(func() { close(route.DispatchChan) })
+	return route, dispatched
+}
+
+func eventID(t *testing.T, d *Dispatch) string {
+	t.Helper()
+	e := cloudevents.NewEvent()
+	for _, opt := range d.Options {
+		require.NoError(t, opt(&e))
+	}
+	return e.ID()
+}
+
+func TestAssignEventIDs(t *testing.T) {
+	events := []*Dispatch{{Data: []byte("{}")}, {Data: []byte("{}"), Options: []eventsourcecommon.Option{eventsourcecommon.WithID("kept")}}}
+	ids := assignEventIDs(events)
+	require.Len(t, ids, 2)
+	assert.NotEmpty(t, ids[0])
+	assert.Equal(t, ids[0], eventID(t, events[0]))
+	assert.Equal(t, "kept", ids[1])
+	assert.Len(t, events[1].Options, 1)
+}
+
+func TestDispatchEventsResponse(t *testing.T) {
+	statusCode := int32(201)
+	route, dispatched := newResponseRoute(t, &aev1.WebhookContext{
+		Endpoint: "/fake",
+		Port:     "12000",
+		Response: &aev1.WebhookResponse{
+			StatusCode: &statusCode,
+			Headers:    map[string]string{"X-Event-Id": "{{ .EventID }}"},
+			Body:       `{"ack":"{{ .EventID }}","user":"{{ .Body.user }}","q":"{{ .Query.Get "q" }}"}`,
+		},
+	})
+	request := httptest.NewRequest(http.MethodPost, "/fake?q=v", strings.NewReader(`{"user":"jane"}`))
+	recorder := httptest.NewRecorder()
+	writer := newRouteWriter(recorder, request, 1024)
+	body := make([]byte, 64)
+	n, _ := request.Body.Read(body)
+	DispatchEvents(context.Background(), route, []*Dispatch{{Data: body[:n]}}, route.Logger, writer)
+
+	id := eventID(t, <-dispatched)
+	assert.Equal(t, 201, recorder.Code)
+	assert.Equal(t, id, recorder.Header().Get("X-Event-Id"))
+	assert.JSONEq(t, `{"ack":"`+id+`","user":"jane","q":"v"}`, recorder.Body.String())
+}
+
+func TestDispatchEventsAsync(t *testing.T) {
+	route, dispatched := newResponseRoute(t, &aev1.WebhookContext{Endpoint: "/fake", Port: "12000", Async: true})
+	recorder := httptest.NewRecorder()
+	DispatchEvents(context.Background(), route, []*Dispatch{{Data: []byte("{}")}}, route.Logger, recorder)
+
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	assert.Equal(t, eventID(t, <-dispatched), body["id"])
+}
+
+func TestDispatchEventsAsyncRouteStopped(t *testing.T) {
+	route := GetFakeRoute()
+	route.Context = &aev1.WebhookContext{Endpoint: "/fake", Port: "12000", Async: true}
+	released := make(chan struct{})
+	slot := &inFlightSlot{release: func() { close(released) }}
+	slot.holds.Store(1)
+	ctx := context.WithValue(context.Background(), inFlightSlotKey{}, slot)
+	// nothing receives the events from the dispatch channel
+	recorder := httptest.NewRecorder()
+	DispatchEvents(ctx, route, []*Dispatch{{Data: []byte("{}")}, {Data: []byte("{}")}}, route.Logger, recorder)
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	slot.done()
+
+	select {
+	case <-released:
+		t.Fatal("the slot should be held until the events are dispatched")
+	case <-time.After(50 * time.Millisecond):
+	}
+	route.stop()
+	select {
+	case <-released:
+	case <-time.After(time.Second):
+		t.Fatal("the events should not be dispatched once the route is stopped")
+	}
+}
+
+func TestRenderResponseFailure(t *testing.T) {
+	route, _ := newResponseRoute(t, &aev1.WebhookContext{
+		Endpoint: "/fake",
+		Port:     "12000",
+		Response: &aev1.WebhookResponse{Body: `{{ index .EventIDs 5 }}`},
+	})
+	recorder := httptest.NewRecorder()
+	DispatchEvents(context.Background(), route, []*Dispatch{{Data: []byte("{}")}}, route.Logger, recorder)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "success", recorder.Body.String())
+}
+
+func TestDispatchEventsCanceled(t *testing.T) {
+	route := GetFakeRoute()
+	route.Context = &aev1.WebhookContext{Endpoint: "/fake", Port: "12000"}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// nothing receives the events from the dispatch channel
+	recorder := httptest.NewRecorder()
+	DispatchEvents(ctx, route, []*Dispatch{{Data: []byte("{}")}}, route.Logger, recorder)
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+}
+
+func TestHandleChallenge(t *testing.T) {
+	route := GetFakeRoute()
+	route.Context = &aev1.WebhookContext{
+		Endpoint: "/fake",
+		Port:     "12000",
+		Challenges: []aev1.WebhookChallenge{
+			{QueryParameter: "validationToken"},
+			{Header: "X-Hook-Secret", Unauthenticated: true},
+			{
+				BodyPath:   "challenge",
+				BodyValues: map[string]string{"type": "url_verification"},
+				Response: &aev1.WebhookResponse{
+					Headers: map[string]string{"Content-Type": "application/json"},
+					Body:    `{"challenge":"{{ .Challenge }}"}`,
+				},
+			},
+		},
+	}
+
+	t.Run("query parameter", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "/fake?validationToken=a%20token", nil)
+		recorder := httptest.NewRecorder()
+		assert.True(t, handleChallenge(route, recorder, request, false))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "text/plain", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "a token", recorder.Body.String())
+	})
+
+	t.Run("body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader(`{"type":"url_verification","challenge":"abc"}`))
+		recorder := httptest.NewRecorder()
+		assert.True(t, handleChallenge(route, recorder, request, false))
+		assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, `{"challenge":"abc"}`, recorder.Body.String())
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "/fake", nil)
+		request.Header.Set("X-Hook-Secret", "secret")
+		recorder := httptest.NewRecorder()
+		assert.False(t, handleChallenge(route, recorder, request, false))
+		assert.True(t, handleChallenge(route, recorder, request, true))
+		assert.Equal(t, "secret", recorder.Body.String())
+
+		request = httptest.NewRequest(http.MethodPost, "/fake?validationToken=abc", nil)
+		assert.False(t, handleChallenge(route, httptest.NewRecorder(), request, true))
+	})
+
+	t.Run("not a challenge", func(t *testing.T) {
+		payload := `{"type":"event_callback","challenge":"abc"}`
+		request := httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader(payload))
+		recorder := httptest.NewRecorder()
+		assert.False(t, handleChallenge(route, recorder, request, false))
+		body := make([]byte, 128)
+		n, _ := request.Body.Read(body)
+		assert.Equal(t, payload, string(body[:n]))
+	})
+}
+
+func TestValidateWebhookContextResponse(t *testing.T) {
+	hook := &aev1.WebhookContext{Endpoint: "/fake", Port: "12000", Response: &aev1.WebhookResponse{Body: "{{ .EventID"}}
+	assert.ErrorContains(t, ValidateWebhookContext(hook), "invalid response body template")
+
+	statusCode := int32(42)
+	hook.Response = &aev1.WebhookResponse{StatusCode: &statusCode}
+	assert.ErrorContains(t, ValidateWebhookContext(hook), "invalid response status code")
+
+	hook.Response = nil
+	hook.Challenges = []aev1.WebhookChallenge{{QueryParameter: "validationToken", Header: "X-Challenge"}}
+	assert.ErrorContains(t, ValidateWebhookContext(hook), "exactly one of")
+
+	hook.Challenges = []aev1.WebhookChallenge{{Header: "X-Challenge"}}
+	assert.NoError(t, ValidateWebhookContext(hook))
+}
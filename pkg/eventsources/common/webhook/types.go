@@ -17,6 +17,7 @@ limitations under the License.
 package webhook
 
 import (
+	"context"
 	"net/http"
 	"sync"
 
@@ -24,6 +25,7 @@ import (
 	"go.uber.org/zap"
 
 	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
 	metrics "github.com/argoproj/argo-events/pkg/metrics"
 )
 
@@ -50,6 +52,8 @@ type Router interface {
 type Dispatch struct {
 	// Data contains the webhook data to dispatch to the event bus
 	Data []byte
+	// Options contains the options of the event
+	Options []eventsourcecommon.Option
 	// SuccessChan contains true iff the dispatch of the Data was successful
 	SuccessChan chan bool
 }
@@ -75,6 +79,10 @@ type Route struct {
 	StopChan chan struct{}
 
 	Metrics *metrics.Metrics
+
+	// ctx is the lifetime of the route, it is canceled when the route is stopped
+	ctx  context.Context
+	stop context.CancelFunc
 }
 
 // Controller controls the active servers and endpoints
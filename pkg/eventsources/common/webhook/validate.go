@@ -40,6 +40,19 @@ func ValidateWebhookContext(context *aev1.WebhookContext) error {
 			return fmt.Errorf("failed to parse server port %s. err: %+v", context.Port, err)
 		}
 	}
+	if err := validateAdmission(context); err != nil {
+		return err
+	}
+	if context.Response != nil {
+		if err := validateResponse(context.Response); err != nil {
+			return err
+		}
+	}
+	for i := range context.Challenges {
+		if err := validateChallenge(&context.Challenges[i]); err != nil {
+			return fmt.Errorf("invalid challenge %d, %w", i, err)
+		}
+	}
 	return nil
 }
 
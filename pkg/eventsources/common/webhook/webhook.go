@@ -44,6 +44,7 @@ func NewController() *Controller {
 
 // NewRoute returns a vanilla route
 func NewRoute(hookContext *v1alpha1.WebhookContext, logger *zap.SugaredLogger, eventSourceName, eventName string, metrics *metrics.Metrics) *Route {
+	ctx, stop := context.WithCancel(context.Background())
 	return &Route{
 		Context:         hookContext,
 		Logger:          logger,
@@ -54,20 +55,83 @@ func NewRoute(hookContext *v1alpha1.WebhookContext, logger *zap.SugaredLogger, e
 		StartCh:         make(chan struct{}),
 		StopChan:        make(chan struct{}),
 		Metrics:         metrics,
+		ctx:             ctx,
+		stop:            stop,
 	}
 }
 
-func DispatchEvent(route *Route, data []byte, logger *zap.SugaredLogger, writer http.ResponseWriter) {
-	logger.Info("dispatching event on route's dispatch channel...")
-	successChan := make(chan bool)
-	route.DispatchChan <- &Dispatch{Data: data, SuccessChan: successChan}
-	if <-successChan {
-		logger.Info("successfully dispatched the request to the event bus")
-		sharedutil.SendSuccessResponse(writer, "success")
-	} else {
-		logger.Error("failed to dispatch the request to the event bus")
+func DispatchEvent(ctx context.Context, route *Route, data []byte, logger *zap.SugaredLogger, writer http.ResponseWriter) {
+	DispatchEvents(ctx, route, []*Dispatch{{Data: data}}, logger, writer)
+}
+
+// DispatchEvents dispatches the events received in a request in order, the request succeeds
+// only if all of them are dispatched. In the async mode, the request is accepted before the events
+// are dispatched. The events are not dispatched anymore once the context is done.
+func DispatchEvents(ctx context.Context, route *Route, events []*Dispatch, logger *zap.SugaredLogger, writer http.ResponseWriter) {
+	dispatchRequest(ctx, route, events, false, logger, writer)
+}
+
+// DispatchEventsBestEffort dispatches all the events received in a request in order, the request
+// succeeds unless none of them is dispatched.
+func DispatchEventsBestEffort(ctx context.Context, route *Route, events []*Dispatch, logger *zap.SugaredLogger, writer http.ResponseWriter) {
+	dispatchRequest(ctx, route, events, true, logger, writer)
+}
+
+func dispatchRequest(ctx context.Context, route *Route, events []*Dispatch, bestEffort bool, logger *zap.SugaredLogger, writer http.ResponseWriter) {
+	var ids []string
+	if route.Context.Response != nil || route.Context.Async {
+		ids = assignEventIDs(events)
+	}
+	if route.Context.Async {
+		sendDispatchedResponse(route, writer, ids, logger)
+		// the request is already answered, its context is done once the handler returns, so the
+		// events are dispatched until the route is stopped, holding the in flight slot of the request.
+		release := holdInFlightSlot(ctx)
+		go func() {
+			defer release()
+			dispatchEvents(route.ctx, route, events, bestEffort, logger)
+		}()
+		return
+	}
+	if !dispatchEvents(ctx, route, events, bestEffort, logger) {
 		sharedutil.SendInternalErrorResponse(writer, "failed to record event")
+		return
+	}
+	if route.Context.Response == nil {
+		sharedutil.SendSuccessResponse(writer, "success")
+		return
 	}
+	sendDispatchedResponse(route, writer, ids, logger)
+}
+
+func dispatchEvents(ctx context.Context, route *Route, events []*Dispatch, bestEffort bool, logger *zap.SugaredLogger) bool {
+	logger.Info("dispatching event on route's dispatch channel...")
+	failed := 0
+	for i, d := range events {
+		d.SuccessChan = make(chan bool)
+		select {
+		case route.DispatchChan <- d:
+		case <-ctx.Done():
+			logger.Errorw("the request is canceled before its events are dispatched", "dispatched", i-failed, "dropped", len(events)-i, zap.Error(ctx.Err()))
+			for range events[i:] {
+				route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+			}
+			return false
+		}
+		if !<-d.SuccessChan {
+			logger.Errorw("failed to dispatch the request to the event bus", "dispatched", i-failed)
+			if !bestEffort {
+				return false
+			}
+			failed++
+		}
+	}
+	if failed > 0 {
+		logger.Warnw("failed to dispatch some events of the request to the event bus", "failed", failed, "events", len(events))
+		return failed < len(events)
+	}
+	logger.Info("successfully dispatched the request to the event bus")
+	return true
 }
 
 // ProcessRouteStatus processes route status as active and inactive.
@@ -134,7 +198,16 @@ func startServer(router Router, controller *Controller) {
 	if r == nil {
 		r = handler.NewRoute().Name(routeName)
 		r = r.Path(route.Context.Endpoint)
-		r.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		admission := newAdmission(route.Context)
+		handle := func(writer http.ResponseWriter, request *http.Request) {
+			if !route.Active {
+				route.Logger.Info("endpoint is not active, won't process the request")
+				sharedutil.SendErrorResponse(writer, "endpoint is inactive")
+				return
+			}
+			if handleChallenge(route, writer, request, true) {
+				return
+			}
 			if route.Context.AuthSecret != nil {
 				token, err := sharedutil.GetSecretFromVolume(route.Context.AuthSecret)
 				if err != nil {
@@ -154,11 +227,24 @@ func startServer(router Router, controller *Controller) {
 					return
 				}
 			}
+			if handleChallenge(route, writer, request, false) {
+				return
+			}
 			if request.Header.Get("Authorization") != "" {
 				// Auth secret stops here
 				request.Header.Set("Authorization", "*** Masked Auth Secret ***")
 			}
+			if route.Context.Response != nil {
+				writer = newRouteWriter(writer, request, route.Context.GetMaxPayloadSize())
+			}
 			router.HandleRoute(writer, request)
+		}
+		r.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if admission == nil {
+				handle(writer, request)
+				return
+			}
+			admission.serve(route, writer, request, handle)
 		})
 	}
 
@@ -198,7 +284,7 @@ func manageRouteChannels(router Router, dispatch func([]byte, ...eventsourcecomm
 		select {
 		case dispatchStruct := <-route.DispatchChan:
 			logger.Info("new event received, dispatching it...")
-			if err := dispatch(dispatchStruct.Data); err != nil {
+			if err := dispatch(dispatchStruct.Data, dispatchStruct.Options...); err != nil {
 				logger.Errorw("failed to send event", zap.Error(err))
 				dispatchStruct.SuccessChan <- false
 				route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
@@ -236,6 +322,8 @@ func ManageRoute(ctx context.Context, router Router, controller *Controller, dis
 	go manageRouteChannels(router, dispatch)
 
 	defer func() {
+		// the events of the requests answered asynchronously are not dispatched anymore
+		route.stop()
 		route.StopChan <- struct{}{}
 	}()
 
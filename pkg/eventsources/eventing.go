@@ -3,6 +3,8 @@ package eventsources
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -18,10 +20,14 @@ import (
 	"github.com/argoproj/argo-events/pkg/eventbus"
 	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/routing"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/schema"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/transform"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/alibabacloudmns"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/amqp"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/awssns"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/awssqs"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources/azuredevops"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/azureeventshub"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/azurequeuestorage"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/azureservicebus"
@@ -33,14 +39,20 @@ import (
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/gcppubsub"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/generic"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/gerrit"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources/gitea"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/github"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/gitlab"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources/grpc"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/hdfs"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/kafka"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources/kubernetes"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/minio"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/mqtt"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/nats"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/nsq"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources/objectstore"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources/poll"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources/postgres"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/pulsar"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources/redis"
 	redisstream "github.com/argoproj/argo-events/pkg/eventsources/sources/redis_stream"
@@ -70,19 +82,35 @@ type EventingServer interface {
 	GetEventSourceType() aev1.EventSourceType
 
 	// Function to start listening events.
+	//
+	// The dispatch function returns after the event is confirmed by the EventBus, or filtered out.
+	// When it fails to publish the event, an EventBusError is returned, the event sources backed by
+	// a broker must not acknowledge the upstream message in that case, but negatively acknowledge it
+	// or leave it unacknowledged for redelivery.
 	StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error
 }
 
-// GetEventingServers returns the mapping of event source type and list of eventing servers
-func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetrics.Metrics) (map[aev1.EventSourceType][]EventingServer, map[string]*aev1.EventSourceFilter) {
+// EventOptions are the options applied to the events of an eventing server before they are published
+type EventOptions struct {
+	Filter    *aev1.EventSourceFilter
+	Schema    *aev1.EventSourceSchema
+	Transform *aev1.EventSourceTransform
+	Routes    []aev1.EventSourceRoute
+}
+
+func newEventOptions(filter *aev1.EventSourceFilter, schema *aev1.EventSourceSchema, transform *aev1.EventSourceTransform, routes []aev1.EventSourceRoute) EventOptions {
+	return EventOptions{Filter: filter, Schema: schema, Transform: transform, Routes: routes}
+}
+
+// GetEventingServers returns the mapping of event source type and list of eventing servers, and the options
+// of the events by event name. The Minio events have no options.
+func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetrics.Metrics) (map[aev1.EventSourceType][]EventingServer, map[string]EventOptions) {
 	result := make(map[aev1.EventSourceType][]EventingServer)
-	filters := make(map[string]*aev1.EventSourceFilter)
+	options := make(map[string]EventOptions)
 	if len(eventSource.Spec.AMQP) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.AMQP {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &amqp.EventListener{EventSourceName: eventSource.Name, EventName: k, AMQPEventSource: v, Metrics: metrics})
 		}
 		result[aev1.AMQPEvent] = servers
@@ -90,9 +118,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.AzureEventsHub) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.AzureEventsHub {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &azureeventshub.EventListener{EventSourceName: eventSource.Name, EventName: k, AzureEventsHubEventSource: v, Metrics: metrics})
 		}
 		result[aev1.AzureEventsHub] = servers
@@ -100,9 +126,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.AzureQueueStorage) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.AzureQueueStorage {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &azurequeuestorage.EventListener{EventSourceName: eventSource.Name, EventName: k, AzureQueueStorageEventSource: v, Metrics: metrics})
 		}
 		result[aev1.AzureQueueStorage] = servers
@@ -110,9 +134,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.AzureServiceBus) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.AzureServiceBus {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &azureservicebus.EventListener{EventSourceName: eventSource.Name, EventName: k, AzureServiceBusEventSource: v, Metrics: metrics})
 		}
 		result[aev1.AzureServiceBus] = servers
@@ -120,9 +142,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Bitbucket) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Bitbucket {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &bitbucket.EventListener{EventSourceName: eventSource.Name, EventName: k, BitbucketEventSource: v, Metrics: metrics})
 		}
 		result[aev1.BitbucketEvent] = servers
@@ -130,9 +150,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.BitbucketServer) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.BitbucketServer {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &bitbucketserver.EventListener{EventSourceName: eventSource.Name, EventName: k, BitbucketServerEventSource: v, Metrics: metrics})
 		}
 		result[aev1.BitbucketServerEvent] = servers
@@ -140,9 +158,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Calendar) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Calendar {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &calendar.EventListener{EventSourceName: eventSource.Name, EventName: k, CalendarEventSource: v, Namespace: eventSource.Namespace, Metrics: metrics})
 		}
 		result[aev1.CalendarEvent] = servers
@@ -150,9 +166,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Emitter) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Emitter {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &emitter.EventListener{EventSourceName: eventSource.Name, EventName: k, EmitterEventSource: v, Metrics: metrics})
 		}
 		result[aev1.EmitterEvent] = servers
@@ -160,19 +174,71 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.File) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.File {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &file.EventListener{EventSourceName: eventSource.Name, EventName: k, FileEventSource: v, Metrics: metrics})
 		}
 		result[aev1.FileEvent] = servers
 	}
+	if len(eventSource.Spec.Poll) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.Poll {
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
+			servers = append(servers, &poll.EventListener{EventSourceName: eventSource.Name, EventName: k, PollEventSource: v, Namespace: eventSource.Namespace, Metrics: metrics})
+		}
+		result[aev1.PollEvent] = servers
+	}
+	if len(eventSource.Spec.Postgres) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.Postgres {
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
+			servers = append(servers, &postgres.EventListener{EventSourceName: eventSource.Name, EventName: k, PostgresEventSource: v, Metrics: metrics})
+		}
+		result[aev1.PostgresEvent] = servers
+	}
+	if len(eventSource.Spec.GRPC) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.GRPC {
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
+			servers = append(servers, &grpc.EventListener{EventSourceName: eventSource.Name, EventName: k, GRPCEventSource: v, Metrics: metrics})
+		}
+		result[aev1.GRPCEvent] = servers
+	}
+	if len(eventSource.Spec.Kubernetes) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.Kubernetes {
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
+			servers = append(servers, &kubernetes.EventListener{EventSourceName: eventSource.Name, EventName: k, KubernetesEventSource: v, Metrics: metrics})
+		}
+		result[aev1.KubernetesEvent] = servers
+	}
+	if len(eventSource.Spec.Gitea) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.Gitea {
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
+			servers = append(servers, &gitea.EventListener{EventSourceName: eventSource.Name, EventName: k, GiteaEventSource: v, Metrics: metrics})
+		}
+		result[aev1.GiteaEvent] = servers
+	}
+	if len(eventSource.Spec.AzureDevOps) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.AzureDevOps {
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
+			servers = append(servers, &azuredevops.EventListener{EventSourceName: eventSource.Name, EventName: k, AzureDevOpsEventSource: v, Metrics: metrics})
+		}
+		result[aev1.AzureDevOpsEvent] = servers
+	}
+	if len(eventSource.Spec.ObjectStore) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.ObjectStore {
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
+			servers = append(servers, &objectstore.EventListener{EventSourceName: eventSource.Name, EventName: k, ObjectStoreEventSource: v, Namespace: eventSource.Namespace, Metrics: metrics})
+		}
+		result[aev1.ObjectStoreEvent] = servers
+	}
 	if len(eventSource.Spec.SFTP) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.SFTP {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &sftp.EventListener{EventSourceName: eventSource.Name, EventName: k, SFTPEventSource: v, Metrics: metrics})
 		}
 		result[aev1.SFTPEvent] = servers
@@ -180,9 +246,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Gerrit) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Gerrit {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &gerrit.EventListener{EventSourceName: eventSource.Name, EventName: k, GerritEventSource: v, Metrics: metrics})
 		}
 		result[aev1.GerritEvent] = servers
@@ -190,19 +254,15 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Github) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Github {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
-			servers = append(servers, &github.EventListener{EventSourceName: eventSource.Name, EventName: k, GithubEventSource: v, Metrics: metrics})
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
+			servers = append(servers, &github.EventListener{EventSourceName: eventSource.Name, EventName: k, GithubEventSource: v, Namespace: eventSource.Namespace, Metrics: metrics})
 		}
 		result[aev1.GithubEvent] = servers
 	}
 	if len(eventSource.Spec.Gitlab) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Gitlab {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &gitlab.EventListener{EventSourceName: eventSource.Name, EventName: k, GitlabEventSource: v, Metrics: metrics})
 		}
 		result[aev1.GitlabEvent] = servers
@@ -210,9 +270,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.HDFS) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.HDFS {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &hdfs.EventListener{EventSourceName: eventSource.Name, EventName: k, HDFSEventSource: v, Metrics: metrics})
 		}
 		result[aev1.HDFSEvent] = servers
@@ -220,9 +278,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Kafka) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Kafka {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &kafka.EventListener{EventSourceName: eventSource.Name, EventName: k, KafkaEventSource: v, Metrics: metrics})
 		}
 		result[aev1.KafkaEvent] = servers
@@ -230,9 +286,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.MQTT) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.MQTT {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &mqtt.EventListener{EventSourceName: eventSource.Name, EventName: k, MQTTEventSource: v, Metrics: metrics})
 		}
 		result[aev1.MQTTEvent] = servers
@@ -247,9 +301,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.NATS) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.NATS {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &nats.EventListener{EventSourceName: eventSource.Name, EventName: k, NATSEventSource: v, Metrics: metrics})
 		}
 		result[aev1.NATSEvent] = servers
@@ -257,9 +309,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.NSQ) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.NSQ {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &nsq.EventListener{EventSourceName: eventSource.Name, EventName: k, NSQEventSource: v, Metrics: metrics})
 		}
 		result[aev1.NSQEvent] = servers
@@ -267,9 +317,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.PubSub) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.PubSub {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &gcppubsub.EventListener{EventSourceName: eventSource.Name, EventName: k, PubSubEventSource: v, Metrics: metrics})
 		}
 		result[aev1.PubSubEvent] = servers
@@ -277,9 +325,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Redis) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Redis {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &redis.EventListener{EventSourceName: eventSource.Name, EventName: k, RedisEventSource: v, Metrics: metrics})
 		}
 		result[aev1.RedisEvent] = servers
@@ -287,9 +333,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.RedisStream) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.RedisStream {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &redisstream.EventListener{EventSourceName: eventSource.Name, EventName: k, EventSource: v, Metrics: metrics})
 		}
 		result[aev1.RedisStreamEvent] = servers
@@ -297,9 +341,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.SNS) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.SNS {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &awssns.EventListener{EventSourceName: eventSource.Name, EventName: k, SNSEventSource: v, Metrics: metrics})
 		}
 		result[aev1.SNSEvent] = servers
@@ -307,9 +349,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.SQS) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.SQS {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &awssqs.EventListener{EventSourceName: eventSource.Name, EventName: k, SQSEventSource: v, Metrics: metrics})
 		}
 		result[aev1.SQSEvent] = servers
@@ -317,9 +357,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Slack) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Slack {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &slack.EventListener{EventSourceName: eventSource.Name, EventName: k, SlackEventSource: v, Metrics: metrics})
 		}
 		result[aev1.SlackEvent] = servers
@@ -327,6 +365,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.StorageGrid) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.StorageGrid {
+			options[k] = newEventOptions(nil, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &storagegrid.EventListener{EventSourceName: eventSource.Name, EventName: k, StorageGridEventSource: v, Metrics: metrics})
 		}
 		result[aev1.StorageGridEvent] = servers
@@ -334,6 +373,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Stripe) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Stripe {
+			options[k] = newEventOptions(nil, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &stripe.EventListener{EventSourceName: eventSource.Name, EventName: k, StripeEventSource: v, Metrics: metrics})
 		}
 		result[aev1.StripeEvent] = servers
@@ -341,9 +381,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Webhook) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Webhook {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &webhook.EventListener{EventSourceName: eventSource.Name, EventName: k, Webhook: v, Metrics: metrics})
 		}
 		result[aev1.WebhookEvent] = servers
@@ -351,6 +389,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Resource) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Resource {
+			options[k] = newEventOptions(nil, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &resource.EventListener{EventSourceName: eventSource.Name, EventName: k, ResourceEventSource: v, Metrics: metrics})
 		}
 		result[aev1.ResourceEvent] = servers
@@ -358,9 +397,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Pulsar) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Pulsar {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &pulsar.EventListener{EventSourceName: eventSource.Name, EventName: k, PulsarEventSource: v, Metrics: metrics})
 		}
 		result[aev1.PulsarEvent] = servers
@@ -368,9 +405,7 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.Generic) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Generic {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &generic.EventListener{EventSourceName: eventSource.Name, EventName: k, GenericEventSource: v, Metrics: metrics})
 		}
 		result[aev1.GenericEvent] = servers
@@ -378,22 +413,23 @@ func GetEventingServers(eventSource *aev1.EventSource, metrics *eventsourcemetri
 	if len(eventSource.Spec.MNS) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.MNS {
-			if v.Filter != nil {
-				filters[k] = v.Filter
-			}
+			options[k] = newEventOptions(v.Filter, v.Schema, v.Transform, v.Routes)
 			servers = append(servers, &alibabacloudmns.EventListener{EventSourceName: eventSource.Name, EventName: k, MNSEventSource: v, Metrics: metrics})
 		}
 		result[aev1.MNSEvent] = servers
 	}
-	return result, filters
+	return result, options
 }
 
 // EventSourceAdaptor is the adaptor for eventsource service
 type EventSourceAdaptor struct {
-	eventSource     *aev1.EventSource
-	eventBusConfig  *aev1.BusConfig
-	eventBusSubject string
-	hostname        string
+	eventSource    *aev1.EventSource
+	eventBusConfig *aev1.BusConfig
+	// successorEventBusConfig is the configuration of the EventBus being migrated to,
+	// events are published to both of the EventBuses when it's set.
+	successorEventBusConfig *aev1.BusConfig
+	eventBusSubject         string
+	hostname                string
 
 	eventBusConn eventbuscommon.EventSourceConnection
 
@@ -401,16 +437,33 @@ type EventSourceAdaptor struct {
 }
 
 // NewEventSourceAdaptor returns a new EventSourceAdaptor
-func NewEventSourceAdaptor(eventSource *aev1.EventSource, eventBusConfig *aev1.BusConfig, eventBusSubject, hostname string, metrics *eventsourcemetrics.Metrics) *EventSourceAdaptor {
+func NewEventSourceAdaptor(eventSource *aev1.EventSource, eventBusConfig, successorEventBusConfig *aev1.BusConfig, eventBusSubject, hostname string, metrics *eventsourcemetrics.Metrics) *EventSourceAdaptor {
 	return &EventSourceAdaptor{
-		eventSource:     eventSource,
-		eventBusConfig:  eventBusConfig,
-		eventBusSubject: eventBusSubject,
-		hostname:        hostname,
-		metrics:         metrics,
+		eventSource:             eventSource,
+		eventBusConfig:          eventBusConfig,
+		successorEventBusConfig: successorEventBusConfig,
+		eventBusSubject:         eventBusSubject,
+		hostname:                hostname,
+		metrics:                 metrics,
 	}
 }
 
+// getEventBusDriver returns the driver publishing to the EventBus, and to the successor EventBus as well during a migration.
+func (e *EventSourceAdaptor) getEventBusDriver(ctx context.Context) (eventbuscommon.EventSourceDriver, error) {
+	driver, err := eventbus.GetEventSourceDriver(ctx, *e.eventBusConfig, e.eventSource.Name, e.eventBusSubject)
+	if err != nil {
+		return nil, err
+	}
+	if e.successorEventBusConfig == nil {
+		return driver, nil
+	}
+	successor, err := eventbus.GetSuccessorEventSourceDriver(ctx, *e.successorEventBusConfig, e.eventSource.Name, e.eventBusSubject)
+	if err != nil {
+		return nil, err
+	}
+	return eventbus.NewDualPublishEventSourceDriver(driver, successor), nil
+}
+
 // Start function
 func (e *EventSourceAdaptor) Start(ctx context.Context) error {
 	log := logging.FromContext(ctx)
@@ -420,7 +473,7 @@ func (e *EventSourceAdaptor) Start(ctx context.Context) error {
 		recreateTypes[esType] = true
 	}
 	isRecreateType := false
-	servers, filters := GetEventingServers(e.eventSource, e.metrics)
+	servers, options := GetEventingServers(e.eventSource, e.metrics)
 	for k := range servers {
 		if _, ok := recreateTypes[k]; ok {
 			isRecreateType = true
@@ -431,7 +484,7 @@ func (e *EventSourceAdaptor) Start(ctx context.Context) error {
 	}
 
 	if !isRecreateType {
-		return e.run(ctx, servers, filters)
+		return e.run(ctx, servers, options)
 	}
 
 	clusterName := fmt.Sprintf("%s-eventsource-%s", e.eventSource.Namespace, e.eventSource.Name)
@@ -446,7 +499,7 @@ func (e *EventSourceAdaptor) Start(ctx context.Context) error {
 
 	elector.RunOrDie(ctx, leaderelection.LeaderCallbacks{
 		OnStartedLeading: func(ctx context.Context) {
-			if err := e.run(ctx, servers, filters); err != nil {
+			if err := e.run(ctx, servers, options); err != nil {
 				log.Fatalw("failed to start", zap.Error(err))
 			}
 		},
@@ -458,11 +511,11 @@ func (e *EventSourceAdaptor) Start(ctx context.Context) error {
 	return nil
 }
 
-func (e *EventSourceAdaptor) run(ctx context.Context, servers map[aev1.EventSourceType][]EventingServer, filters map[string]*aev1.EventSourceFilter) error {
+func (e *EventSourceAdaptor) run(ctx context.Context, servers map[aev1.EventSourceType][]EventingServer, options map[string]EventOptions) error {
 	logger := logging.FromContext(ctx)
 	logger.Info("Starting event source server...")
 	clientID := generateClientID(e.hostname)
-	driver, err := eventbus.GetEventSourceDriver(ctx, *e.eventBusConfig, e.eventSource.Name, e.eventBusSubject)
+	driver, err := e.getEventBusDriver(ctx)
 	if err != nil {
 		logger.Errorw("failed to get eventbus driver", zap.Error(err))
 		return err
@@ -501,7 +554,7 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[aev1.EventSour
 					logger.Info("NATS connection lost, reconnecting...")
 					// Regenerate the client ID to avoid the issue that NAT server still thinks the client is alive.
 					clientID := generateClientID(e.hostname)
-					driver, err := eventbus.GetEventSourceDriver(ctx, *e.eventBusConfig, e.eventSource.Name, e.eventBusSubject)
+					driver, err := e.getEventBusDriver(ctx)
 					if err != nil {
 						logger.Errorw("failed to get eventbus driver during reconnection", zap.Error(err))
 						continue
@@ -545,67 +598,15 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[aev1.EventSour
 					Factor:   &factor,
 					Jitter:   &jitter,
 				}
+				h, err := newEventHandler(ctx, s, options[s.GetEventName()], &backoff, e.metrics, logger)
+				if err != nil {
+					logger.Errorw("Invalid event options", zap.Error(err), zap.String(logging.LabelEventName,
+						s.GetEventName()), zap.Any(logging.LabelEventSourceType, s.GetEventSourceType()))
+					return
+				}
 				if err = sharedutil.DoWithRetry(&backoff, func() error {
 					return s.StartListening(ctx, func(data []byte, opts ...eventsourcecommon.Option) error {
-						if filter, ok := filters[s.GetEventName()]; ok {
-							proceed, err := filterEvent(data, filter)
-							if err != nil {
-								logger.Errorw("Failed to filter event", zap.Error(err))
-								return nil
-							}
-							if !proceed {
-								logger.Debug("Filter condition not met, skip dispatching")
-								return nil
-							}
-						}
-
-						uuidNew := uuid.New()
-						event := cloudevents.NewEvent()
-						event.SetID(fmt.Sprintf("%x", uuidNew[:]))
-						event.SetType(string(s.GetEventSourceType()))
-						event.SetSource(s.GetEventSourceName())
-						event.SetSubject(s.GetEventName())
-						event.SetTime(time.Now())
-						for _, opt := range opts {
-							err := opt(&event)
-							if err != nil {
-								return err
-							}
-						}
-						err := event.SetData(cloudevents.ApplicationJSON, data)
-						if err != nil {
-							return err
-						}
-						eventBody, err := json.Marshal(event)
-						if err != nil {
-							return err
-						}
-
-						if e.eventBusConn == nil || e.eventBusConn.IsClosed() {
-							return eventbuscommon.NewEventBusError(fmt.Errorf("failed to publish event, eventbus connection closed"))
-						}
-
-						msg := eventbuscommon.Message{
-							MsgHeader: eventbuscommon.MsgHeader{
-								EventSourceName: s.GetEventSourceName(),
-								EventName:       s.GetEventName(),
-								ID:              event.ID(),
-							},
-							Body: eventBody,
-						}
-						logger.Debugw(string(data), zap.String("eventID", event.ID()))
-						if err = sharedutil.DoWithRetry(&sharedutil.DefaultBackoff, func() error {
-							return e.eventBusConn.Publish(ctx, msg)
-						}); err != nil {
-							logger.Errorw("Failed to publish an event", zap.Error(err), zap.String(logging.LabelEventName,
-								s.GetEventName()), zap.Any(logging.LabelEventSourceType, s.GetEventSourceType()), zap.String("eventID", event.ID()))
-							e.metrics.EventSentFailed(s.GetEventSourceName(), s.GetEventName())
-							return eventbuscommon.NewEventBusError(err)
-						}
-						logger.Infow("Succeeded to publish an event", zap.String(logging.LabelEventName,
-							s.GetEventName()), zap.Any(logging.LabelEventSourceType, s.GetEventSourceType()), zap.String("eventID", event.ID()))
-						e.metrics.EventSent(s.GetEventSourceName(), s.GetEventName())
-						return nil
+						return e.dispatch(ctx, h, data, opts...)
 					})
 				}); err != nil {
 					logger.Errorw("Failed to start listening eventsource", zap.Any(logging.LabelEventSourceType,
@@ -645,6 +646,239 @@ func generateClientID(hostname string) string {
 	return clientID
 }
 
+// eventHandler applies the options of the events of an eventing server before they are published
+type eventHandler struct {
+	server      EventingServer
+	options     EventOptions
+	validator   *schema.Validator
+	transformer *transform.Transformer
+	router      *routing.Router
+	metrics     *eventsourcemetrics.Metrics
+	logger      *zap.SugaredLogger
+}
+
+// newEventHandler returns the handler of the events of the eventing server, the schema is loaded with the backoff.
+func newEventHandler(ctx context.Context, s EventingServer, options EventOptions, backoff *aev1.Backoff, metrics *eventsourcemetrics.Metrics, logger *zap.SugaredLogger) (*eventHandler, error) {
+	h := &eventHandler{server: s, options: options, metrics: metrics, logger: logger}
+	if spec := options.Schema; spec != nil {
+		if err := sharedutil.DoWithRetry(backoff, func() error {
+			var err error
+			h.validator, err = schema.Load(ctx, spec)
+			return err
+		}); err != nil {
+			return nil, fmt.Errorf("failed to load the schema, %w", err)
+		}
+	}
+	if spec := options.Transform; spec != nil {
+		var err error
+		if h.transformer, err = transform.New(spec); err != nil {
+			return nil, fmt.Errorf("invalid transform, %w", err)
+		}
+	}
+	if spec := options.Routes; len(spec) != 0 {
+		var err error
+		if h.router, err = routing.New(spec); err != nil {
+			return nil, fmt.Errorf("invalid routes, %w", err)
+		}
+	}
+	return h, nil
+}
+
+// dispatch applies the options to the data of an event, and publishes it to the EventBus
+func (e *EventSourceAdaptor) dispatch(ctx context.Context, h *eventHandler, data []byte, opts ...eventsourcecommon.Option) error {
+	s := h.server
+	// the invalid events are published with the dead letter event name, if any, without being filtered.
+	eventName, violations, ok := h.validate(data)
+	if !ok {
+		return nil
+	}
+	if filter := h.options.Filter; filter != nil && len(violations) == 0 {
+		proceed, err := filterEvent(data, filter)
+		if err != nil {
+			h.logger.Errorw("Failed to filter event", zap.Error(err))
+			return nil
+		}
+		if !proceed {
+			h.logger.Debug("Filter condition not met, skip dispatching")
+			return nil
+		}
+	}
+	// the routes match the data as it is received, like the filter.
+	targets, err := h.route(data, eventName, violations)
+	if err != nil {
+		h.logger.Errorw("Failed to route event, dropping it", zap.Error(err), zap.String(logging.LabelEventName,
+			s.GetEventName()))
+		return nil
+	}
+	data, err = h.transform(data, violations)
+	if err != nil {
+		h.logger.Errorw("Failed to transform event, dropping it", zap.Error(err), zap.String(logging.LabelEventName,
+			s.GetEventName()))
+		return nil
+	}
+
+	event, generated, err := h.newEvent(data, violations, opts)
+	if err != nil {
+		return err
+	}
+
+	for _, routed := range h.routedEvents(event, generated, targets, data) {
+		if err := e.publish(ctx, h, routed, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate validates the data with the schema. It returns the name of the event to publish the data with, which is
+// the dead letter event name if the data violates the schema, the violations, and false if the event is dropped.
+func (h *eventHandler) validate(data []byte) (string, []schema.Violation, bool) {
+	s := h.server
+	if h.validator == nil {
+		return s.GetEventName(), nil, true
+	}
+	violations := h.validator.Validate(data)
+	if len(violations) == 0 {
+		return s.GetEventName(), nil, true
+	}
+	paths := map[string]bool{}
+	for _, v := range violations {
+		paths[schema.MetricPath(v.Path)] = true
+	}
+	for path := range paths {
+		h.metrics.EventSchemaViolated(s.GetEventSourceName(), s.GetEventName(), path)
+	}
+	deadLetter := h.options.Schema.DeadLetter
+	if deadLetter == nil {
+		h.logger.Warnw("Event violates the schema, dropping it", zap.String(logging.LabelEventName,
+			s.GetEventName()), zap.Stringers("violations", violations))
+		return "", violations, false
+	}
+	h.logger.Warnw("Event violates the schema, publishing it to the dead letter event", zap.String(logging.LabelEventName,
+		s.GetEventName()), zap.String("deadLetter", deadLetter.EventName), zap.Stringers("violations", violations))
+	return deadLetter.EventName, violations, true
+}
+
+// route returns the targets of the data, the targets of the matching routes, or the event name if none matches.
+// The dead letter events, which violate the schema, are not routed.
+func (h *eventHandler) route(data []byte, eventName string, violations []schema.Violation) ([]routing.Target, error) {
+	targets := []routing.Target{{EventName: eventName}}
+	if h.router == nil || len(violations) > 0 {
+		return targets, nil
+	}
+	matched, err := h.router.Route(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(matched) > 0 {
+		targets = matched
+	}
+	return targets, nil
+}
+
+// routedEvents returns the copies of the event published to the targets. The events of a message have distinct IDs,
+// they would be deduplicated by the EventBus otherwise. Without an ID from the message, they are derived from the data,
+// so the events of a redelivered message have the same IDs.
+func (h *eventHandler) routedEvents(event cloudevents.Event, generated bool, targets []routing.Target, data []byte) []cloudevents.Event {
+	s := h.server
+	baseID := event.ID()
+	if len(targets) > 1 && generated {
+		sum := sha256.Sum256([]byte(s.GetEventSourceName() + "/" + s.GetEventName() + "\n" + string(data)))
+		baseID = hex.EncodeToString(sum[:])
+	}
+	events := make([]cloudevents.Event, 0, len(targets))
+	for _, target := range targets {
+		routed := event.Clone()
+		routed.SetSubject(target.EventName)
+		if target.Type != "" {
+			routed.SetType(target.Type)
+		}
+		if len(targets) > 1 {
+			routed.SetID(baseID + ":" + target.EventName)
+		}
+		events = append(events, routed)
+	}
+	return events
+}
+
+// transform transforms the data with the transform, if any. The dead letter events, which violate the schema, are only
+// redacted, they might not be in the shape the transform expects.
+func (h *eventHandler) transform(data []byte, violations []schema.Violation) ([]byte, error) {
+	if h.transformer == nil {
+		return data, nil
+	}
+	if len(violations) > 0 {
+		return h.transformer.Redact(data)
+	}
+	return h.transformer.Transform(data)
+}
+
+// newEvent returns the CloudEvent of the data with the options applied, and whether its id is generated
+func (h *eventHandler) newEvent(data []byte, violations []schema.Violation, opts []eventsourcecommon.Option) (cloudevents.Event, bool, error) {
+	s := h.server
+	uuidNew := uuid.New()
+	generatedID := fmt.Sprintf("%x", uuidNew[:])
+	event := cloudevents.NewEvent()
+	event.SetID(generatedID)
+	event.SetType(string(s.GetEventSourceType()))
+	event.SetSource(s.GetEventSourceName())
+	event.SetSubject(s.GetEventName())
+	event.SetTime(time.Now())
+	for _, opt := range opts {
+		if err := opt(&event); err != nil {
+			return event, false, err
+		}
+	}
+	if len(violations) > 0 {
+		event.SetExtension(schema.ViolationsExtension, schema.Join(violations))
+	}
+	// the options set the content type of the data which is not necessarily JSON, e.g. received CloudEvents
+	contentType := event.DataContentType()
+	if contentType == "" || h.transformer != nil {
+		contentType = cloudevents.ApplicationJSON
+	}
+	if err := event.SetData(contentType, data); err != nil {
+		return event, false, err
+	}
+	return event, event.ID() == generatedID, nil
+}
+
+// publish publishes the event to the EventBus, with its subject as event name
+func (e *EventSourceAdaptor) publish(ctx context.Context, h *eventHandler, event cloudevents.Event, data []byte) error {
+	s := h.server
+	eventBody, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if e.eventBusConn == nil || e.eventBusConn.IsClosed() {
+		return eventbuscommon.NewEventBusError(fmt.Errorf("failed to publish event, eventbus connection closed"))
+	}
+
+	msg := eventbuscommon.Message{
+		MsgHeader: eventbuscommon.MsgHeader{
+			EventSourceName: s.GetEventSourceName(),
+			EventName:       event.Subject(),
+			ID:              event.ID(),
+		},
+		Body: eventBody,
+	}
+	h.logger.Debugw(string(data), zap.String("eventID", event.ID()))
+	if err = sharedutil.DoWithRetry(&sharedutil.DefaultBackoff, func() error {
+		return e.eventBusConn.Publish(ctx, msg)
+	}); err != nil {
+		h.logger.Errorw("Failed to publish an event", zap.Error(err), zap.String(logging.LabelEventName,
+			s.GetEventName()), zap.Any(logging.LabelEventSourceType, s.GetEventSourceType()), zap.String("eventID", event.ID()))
+		h.metrics.EventSentFailed(s.GetEventSourceName(), s.GetEventName())
+		return eventbuscommon.NewEventBusError(err)
+	}
+	h.logger.Infow("Succeeded to publish an event", zap.String(logging.LabelEventName,
+		s.GetEventName()), zap.Any(logging.LabelEventSourceType, s.GetEventSourceType()), zap.String("eventID", event.ID()),
+		zap.String("target", event.Subject()))
+	h.metrics.EventSent(s.GetEventSourceName(), s.GetEventName())
+	return nil
+}
+
 func filterEvent(data []byte, filter *aev1.EventSourceFilter) (bool, error) {
 	dataMap := make(map[string]interface{})
 	err := json.Unmarshal(data, &dataMap)
@@ -0,0 +1,269 @@
+package eventsources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/routing"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/schema"
+	"github.com/argoproj/argo-events/pkg/metrics"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+type fakeServer struct{}
+
+func (s *fakeServer) ValidateEventSource(context.Context) error { return nil }
+
+func (s *fakeServer) GetEventSourceName() string { return "es" }
+
+func (s *fakeServer) GetEventName() string { return "orders" }
+
+func (s *fakeServer) GetEventSourceType() aev1.EventSourceType { return aev1.WebhookEvent }
+
+func (s *fakeServer) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	return nil
+}
+
+type fakeConn struct {
+	messages []eventbuscommon.Message
+	closed   bool
+}
+
+func (c *fakeConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *fakeConn) IsClosed() bool { return c.closed }
+
+func (c *fakeConn) Publish(ctx context.Context, msg eventbuscommon.Message) error {
+	c.messages = append(c.messages, msg)
+	return nil
+}
+
+// events returns the CloudEvents of the published messages
+func (c *fakeConn) events(t *testing.T) []cloudevents.Event {
+	t.Helper()
+	events := []cloudevents.Event{}
+	for _, msg := range c.messages {
+		var event cloudevents.Event
+		require.NoError(t, json.Unmarshal(msg.Body, &event))
+		assert.Equal(t, "es", msg.EventSourceName)
+		assert.Equal(t, event.Subject(), msg.EventName)
+		assert.Equal(t, event.ID(), msg.ID)
+		events = append(events, event)
+	}
+	return events
+}
+
+func newTestHandler(t *testing.T, options EventOptions) (*EventSourceAdaptor, *eventHandler, *fakeConn) {
+	t.Helper()
+	conn := &fakeConn{}
+	m := metrics.NewMetrics("ns")
+	e := &EventSourceAdaptor{eventBusConn: conn, metrics: m}
+	h, err := newEventHandler(context.Background(), &fakeServer{}, options, &sharedutil.DefaultBackoff, m, zaptest.NewLogger(t).Sugar())
+	require.NoError(t, err)
+	return e, h, conn
+}
+
+const orderSchema = `{"type":"object","required":["id"],"properties":{"id":{"type":"string"}}}`
+
+func TestNewEventHandler(t *testing.T) {
+	_, h, _ := newTestHandler(t, EventOptions{})
+	assert.Nil(t, h.validator)
+
+	_, err := newEventHandler(context.Background(), &fakeServer{}, EventOptions{Schema: &aev1.EventSourceSchema{Inline: "{"}},
+		&aev1.Backoff{Steps: 1}, metrics.NewMetrics("ns"), zaptest.NewLogger(t).Sugar())
+	assert.ErrorContains(t, err, "failed to load the schema")
+}
+
+func TestEventHandlerValidate(t *testing.T) {
+	_, h, _ := newTestHandler(t, EventOptions{Schema: &aev1.EventSourceSchema{Inline: orderSchema}})
+	eventName, violations, ok := h.validate([]byte(`{"id":"1"}`))
+	assert.True(t, ok)
+	assert.Equal(t, "orders", eventName)
+	assert.Empty(t, violations)
+
+	// without a dead letter event, the invalid events are dropped.
+	_, violations, ok = h.validate([]byte(`{"id":1}`))
+	assert.False(t, ok)
+	assert.NotEmpty(t, violations)
+
+	h.options.Schema.DeadLetter = &aev1.EventSourceDeadLetter{EventName: "invalid-orders"}
+	eventName, violations, ok = h.validate([]byte(`{}`))
+	assert.True(t, ok)
+	assert.Equal(t, "invalid-orders", eventName)
+	assert.NotEmpty(t, violations)
+}
+
+func TestEventHandlerNewEvent(t *testing.T) {
+	_, h, _ := newTestHandler(t, EventOptions{})
+	event, generated, err := h.newEvent([]byte(`{"id":"1"}`), nil, nil)
+	require.NoError(t, err)
+	assert.True(t, generated)
+	assert.Equal(t, "es", event.Source())
+	assert.Equal(t, "orders", event.Subject())
+	assert.Equal(t, string(aev1.WebhookEvent), event.Type())
+	assert.Equal(t, cloudevents.ApplicationJSON, event.DataContentType())
+
+	violations := []schema.Violation{{Path: "/id", Message: "missing"}}
+	event, generated, err = h.newEvent([]byte(`a,b`), violations, []eventsourcecommon.Option{
+		eventsourcecommon.WithID("kept"),
+		func(e *cloudevents.Event) error { e.SetDataContentType("text/csv"); return nil },
+	})
+	require.NoError(t, err)
+	assert.False(t, generated)
+	assert.Equal(t, "kept", event.ID())
+	assert.Equal(t, "text/csv", event.DataContentType())
+	assert.Equal(t, schema.Join(violations), event.Extensions()[schema.ViolationsExtension])
+}
+
+func TestPublish(t *testing.T) {
+	e, h, conn := newTestHandler(t, EventOptions{})
+	event, _, err := h.newEvent([]byte(`{}`), nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, e.publish(context.Background(), h, event, []byte(`{}`)))
+	events := conn.events(t)
+	require.Len(t, events, 1)
+	assert.Equal(t, event.ID(), events[0].ID())
+
+	conn.closed = true
+	err = e.publish(context.Background(), h, event, []byte(`{}`))
+	assert.True(t, eventbuscommon.IsEventBusError(err))
+}
+
+func TestDispatch(t *testing.T) {
+	t.Run("filter", func(t *testing.T) {
+		e, h, conn := newTestHandler(t, EventOptions{Filter: &aev1.EventSourceFilter{Expression: `id == "1"`}})
+		require.NoError(t, e.dispatch(context.Background(), h, []byte(`{"id":"2"}`)))
+		assert.Empty(t, conn.messages)
+		require.NoError(t, e.dispatch(context.Background(), h, []byte(`{"id":"1"}`)))
+		assert.Len(t, conn.messages, 1)
+	})
+
+	t.Run("dead letter", func(t *testing.T) {
+		// the invalid events are not filtered.
+		e, h, conn := newTestHandler(t, EventOptions{
+			Filter: &aev1.EventSourceFilter{Expression: `id == "1"`},
+			Schema: &aev1.EventSourceSchema{Inline: orderSchema, DeadLetter: &aev1.EventSourceDeadLetter{EventName: "invalid-orders"}},
+		})
+		require.NoError(t, e.dispatch(context.Background(), h, []byte(`{"id":2}`)))
+		events := conn.events(t)
+		require.Len(t, events, 1)
+		assert.Equal(t, "invalid-orders", events[0].Subject())
+		assert.NotEmpty(t, events[0].Extensions()[schema.ViolationsExtension])
+	})
+
+	t.Run("transform", func(t *testing.T) {
+		e, h, conn := newTestHandler(t, EventOptions{Transform: &aev1.EventSourceTransform{Drop: []string{"$.secret"}}})
+		require.NoError(t, e.dispatch(context.Background(), h, []byte(`{"id":"1","secret":"s"}`), func(e *cloudevents.Event) error {
+			e.SetDataContentType("text/plain")
+			return nil
+		}))
+		events := conn.events(t)
+		require.Len(t, events, 1)
+		// the transformed data is JSON.
+		assert.Equal(t, cloudevents.ApplicationJSON, events[0].DataContentType())
+		assert.JSONEq(t, `{"id":"1"}`, string(events[0].Data()))
+
+		require.NoError(t, e.dispatch(context.Background(), h, []byte(`not json`)))
+		assert.Len(t, conn.messages, 1)
+	})
+	t.Run("routes", func(t *testing.T) {
+		e, h, conn := newTestHandler(t, EventOptions{
+			Routes:    []aev1.EventSourceRoute{{EventName: "created", JSONPath: "$.type", Value: "created"}, {EventName: "all", Expression: "true"}},
+			Transform: &aev1.EventSourceTransform{Drop: []string{"$.type"}},
+		})
+		// the routes match the data before it is transformed.
+		require.NoError(t, e.dispatch(context.Background(), h, []byte(`{"id":"1","type":"created"}`)))
+		events := conn.events(t)
+		require.Len(t, events, 2)
+		assert.Equal(t, "created", events[0].Subject())
+		assert.Equal(t, "all", events[1].Subject())
+		assert.NotEqual(t, events[0].ID(), events[1].ID())
+		assert.JSONEq(t, `{"id":"1"}`, string(events[0].Data()))
+	})
+}
+
+func TestEventHandlerTransform(t *testing.T) {
+	_, h, _ := newTestHandler(t, EventOptions{})
+	data, err := h.transform([]byte(`{"id":"1"}`), nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"1"}`, string(data))
+
+	_, h, _ = newTestHandler(t, EventOptions{Transform: &aev1.EventSourceTransform{JQ: `{order: .id, email}`, Mask: []string{"$.email"}}})
+	data, err = h.transform([]byte(`{"id":"1","email":"jane@example.com","total":3}`), nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"order":"1","email":"******"}`, string(data))
+
+	// the dead letter events are only redacted.
+	data, err = h.transform([]byte(`{"id":1,"email":"jane@example.com"}`), []schema.Violation{{Path: "/id"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1,"email":"******"}`, string(data))
+
+	_, err = h.transform([]byte(`not json`), nil)
+	assert.Error(t, err)
+}
+
+func TestEventHandlerRoute(t *testing.T) {
+	_, h, _ := newTestHandler(t, EventOptions{Routes: []aev1.EventSourceRoute{
+		{EventName: "created", JSONPath: "$.type", Value: "created", Type: "order.created"},
+		{EventName: "large", Expression: "total > 100"},
+	}})
+	targets, err := h.route([]byte(`{"type":"created","total":150}`), "orders", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []routing.Target{{EventName: "created", Type: "order.created"}, {EventName: "large"}}, targets)
+
+	// the events matching no route keep the event name.
+	targets, err = h.route([]byte(`{"type":"deleted","total":1}`), "orders", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []routing.Target{{EventName: "orders"}}, targets)
+
+	// the dead letter events are not routed.
+	targets, err = h.route([]byte(`{"type":"created"}`), "invalid-orders", []schema.Violation{{Path: "/"}})
+	require.NoError(t, err)
+	assert.Equal(t, []routing.Target{{EventName: "invalid-orders"}}, targets)
+}
+
+func TestEventHandlerRoutedEvents(t *testing.T) {
+	_, h, _ := newTestHandler(t, EventOptions{})
+	data := []byte(`{"id":"1"}`)
+	event, generated, err := h.newEvent(data, nil, nil)
+	require.NoError(t, err)
+
+	events := h.routedEvents(event, generated, []routing.Target{{EventName: "orders"}}, data)
+	require.Len(t, events, 1)
+	assert.Equal(t, event.ID(), events[0].ID())
+
+	targets := []routing.Target{{EventName: "created", Type: "order.created"}, {EventName: "large"}}
+	events = h.routedEvents(event, generated, targets, data)
+	require.Len(t, events, 2)
+	assert.Equal(t, "created", events[0].Subject())
+	assert.Equal(t, "order.created", events[0].Type())
+	assert.Equal(t, "large", events[1].Subject())
+	assert.Equal(t, string(aev1.WebhookEvent), events[1].Type())
+	// without an id from the message, the ids are derived from the data.
+	sum := sha256.Sum256([]byte("es/orders\n" + string(data)))
+	assert.Equal(t, hex.EncodeToString(sum[:])+":created", events[0].ID())
+	assert.Equal(t, hex.EncodeToString(sum[:])+":large", events[1].ID())
+	redelivered, generated, err := h.newEvent(data, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, events[0].ID(), h.routedEvents(redelivered, generated, targets, data)[0].ID())
+
+	event, generated, err = h.newEvent(data, nil, []eventsourcecommon.Option{eventsourcecommon.WithID("msg-1")})
+	require.NoError(t, err)
+	events = h.routedEvents(event, generated, targets, data)
+	assert.Equal(t, "msg-1:created", events[0].ID())
+	assert.Equal(t, "msg-1:large", events[1].ID())
+}
@@ -221,6 +221,26 @@ type GitLabEventData struct {
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
+// GiteaEventData represents the event data generated by the Gitea eventsource.
+type GiteaEventData struct {
+	// Headers from the Gitea http request.
+	Headers http.Header `json:"headers"`
+	// Body represents the message body
+	Body *json.RawMessage `json:"body"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// AzureDevOpsEventData represents the event data generated by the Azure DevOps eventsource.
+type AzureDevOpsEventData struct {
+	// Headers from the Azure DevOps http request.
+	Headers http.Header `json:"headers"`
+	// Body represents the message body
+	Body *json.RawMessage `json:"body"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
 // KafkaEventData represents the event data generated by the Kafka eventsource.
 type KafkaEventData struct {
 	// Topic refers to the Kafka topic
@@ -252,6 +272,16 @@ type MQTTEventData struct {
 	Topic string `json:"topic"`
 	// MessageID is the unique ID for the message
 	MessageID int `json:"messageId"`
+	// Subscription is the topic filter the message is received from.
+	Subscription string `json:"subscription,omitempty"`
+	// SharedGroup is the group of the shared subscription the message is received from.
+	SharedGroup string `json:"sharedGroup,omitempty"`
+	// QoS of the message.
+	QoS int `json:"qos,omitempty"`
+	// Retained is true if the message is a retained message.
+	Retained bool `json:"retained,omitempty"`
+	// Duplicate is true if the message might have been delivered before.
+	Duplicate bool `json:"duplicate,omitempty"`
 	// Body is the message payload.
 	Body interface{} `json:"body"`
 	// Metadata holds the user defined metadata which will passed along the event payload.
@@ -266,6 +296,10 @@ type NATSEventData struct {
 	Body interface{} `json:"body"`
 	// Header represents the optional Header for a NATS message, based on the implementation of http.Header.
 	Header map[string][]string `json:"header,omitempty"`
+	// Stream is the name of the JetStream stream the message is consumed from.
+	Stream string `json:"stream,omitempty"`
+	// Sequence is the sequence of the message in the JetStream stream.
+	Sequence uint64 `json:"sequence,omitempty"`
 	// Metadata holds the user defined metadata which will passed along the event payload.
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
@@ -314,6 +348,8 @@ type ResourceEventData struct {
 	Body *json.RawMessage `json:"body"`
 	// Prior state of the resource body (if it exists)
 	OldBody *json.RawMessage `json:"oldBody"`
+	// Patch is the JSON patch (RFC 6902) from the prior state to the resource body, only for UPDATE events.
+	Patch *json.RawMessage `json:"patch,omitempty"`
 	// Resource group name.
 	Group string `json:"group"`
 	// Resource version.
@@ -334,6 +370,28 @@ type WebhookEventData struct {
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
+// WebhookPartData is the body of the event of a part of a multipart/form-data request split by the Webhook eventsource.
+type WebhookPartData struct {
+	// Name is the form name of the part
+	Name string `json:"name"`
+	// FileName is the file name of a file part
+	FileName string `json:"fileName,omitempty"`
+	// ContentType is the content type of the part
+	ContentType string `json:"contentType,omitempty"`
+	// Value is the value of a form field
+	Value string `json:"value,omitempty"`
+	// Content is the content of a file part, when it is not stored in S3
+	Content []byte `json:"content,omitempty"`
+	// Size is the size of a file part
+	Size int64 `json:"size,omitempty"`
+	// Bucket is the bucket of the object of a file part stored in S3
+	Bucket string `json:"bucket,omitempty"`
+	// Key is the key of the object of a file part stored in S3
+	Key string `json:"key,omitempty"`
+	// URL is the URL of the object of a file part stored in S3
+	URL string `json:"url,omitempty"`
+}
+
 // PulsarEventData represents the event data generated by the Pulsar eventsource.
 type PulsarEventData struct {
 	// Key of the message
@@ -421,3 +479,100 @@ type MNSEventData struct {
 	// Metadata holds the user defined metadata which will passed along the event payload.
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
+
+// PollEventData represents the event data generated by the Poll eventsource.
+type PollEventData struct {
+	// URL is the URL polled.
+	URL string `json:"url"`
+	// ID is the ID of the item, or the hash of the item if no ID path is specified.
+	ID string `json:"id"`
+	// Change is "new" for an item seen for the first time, or "changed" for an item whose content changed.
+	Change string `json:"change"`
+	// Body is the item.
+	Body interface{} `json:"body"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// PostgresEventData represents the event data generated by the Postgres eventsource.
+type PostgresEventData struct {
+	// Operation is one of "insert", "update" and "delete".
+	Operation string `json:"operation"`
+	// Schema of the table.
+	Schema string `json:"schema"`
+	// Table name.
+	Table string `json:"table"`
+	// Before is the row before an update or a delete, it only has the key columns
+	// unless the replica identity of the table is FULL.
+	Before map[string]interface{} `json:"before,omitempty"`
+	// After is the row after an insert or an update.
+	After map[string]interface{} `json:"after,omitempty"`
+	// LSN is the position of the change in the WAL.
+	LSN string `json:"lsn"`
+	// CommitLSN is the position of the commit of the transaction in the WAL.
+	CommitLSN string `json:"commitLsn"`
+	// CommitTime is the commit time of the transaction.
+	CommitTime string `json:"commitTime"`
+	// XID is the id of the transaction.
+	XID uint32 `json:"xid"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// GRPCEventData represents the event data generated by the gRPC eventsource from a pushed CloudEvent.
+type GRPCEventData struct {
+	// ID of the pushed CloudEvent.
+	ID string `json:"id"`
+	// Source of the pushed CloudEvent.
+	Source string `json:"source"`
+	// SpecVersion of the pushed CloudEvent.
+	SpecVersion string `json:"specversion"`
+	// Type of the pushed CloudEvent.
+	Type string `json:"type"`
+	// Attributes holds the optional and extension attributes of the pushed CloudEvent,
+	// e.g. subject, time and datacontenttype.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	// Body is the data of the pushed CloudEvent. JSON data is kept as is, text data is a string,
+	// binary data is a base64 string, and protobuf data is an object with "@type" and base64 "value".
+	Body *json.RawMessage `json:"body,omitempty"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// KubernetesEventData represents the event data generated by the Kubernetes eventsource, from a K8s Event
+// or an audit event.
+type KubernetesEventData struct {
+	// Type is "event" for a K8s Event and "audit" for an audit event.
+	Type string `json:"type"`
+	// Event is the core/v1 Event.
+	Event *json.RawMessage `json:"event,omitempty"`
+	// InvolvedObject is the metadata of the object the K8s Event is about, when it is included.
+	InvolvedObject *json.RawMessage `json:"involvedObject,omitempty"`
+	// Audit is the audit.k8s.io/v1 Event.
+	Audit *json.RawMessage `json:"audit,omitempty"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ObjectStoreEventData represents the event data generated by the ObjectStore eventsource, the same for the
+// S3 event notifications and the listings.
+type ObjectStoreEventData struct {
+	// Type is "created" for an object created or overwritten, and "removed" for an object removed.
+	Type string `json:"type"`
+	// EventName is the name of the S3 event notification, e.g. ObjectCreated:Put. It is empty for the listings.
+	EventName string `json:"eventName,omitempty"`
+	// Bucket of the object.
+	Bucket string `json:"bucket"`
+	// Key of the object.
+	Key string `json:"key"`
+	// Size of the object.
+	Size int64 `json:"size,omitempty"`
+	// ETag of the object.
+	ETag string `json:"etag,omitempty"`
+	// VersionID of the object, in a versioned bucket.
+	VersionID string `json:"versionId,omitempty"`
+	// Time of the event, or the last modification time of a listed object.
+	Time time.Time `json:"time"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
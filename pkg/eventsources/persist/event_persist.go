@@ -3,6 +3,7 @@ package persist
 import (
 	"context"
 	"fmt"
+	"os"
 
 	v1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -66,6 +67,23 @@ func NewConfigMapPersist(ctx context.Context, client kubernetes.Interface, confi
 	return &cmp, nil
 }
 
+// NewEventPersist returns the persistence of the configuration, or a NullPersistence if it is not configured.
+func NewEventPersist(ctx context.Context, persistence *v1alpha1.EventPersistence, namespace string) (EventPersist, error) {
+	if persistence == nil || persistence.ConfigMap == nil {
+		return &NullPersistence{}, nil
+	}
+	kubeConfig, _ := os.LookupEnv(v1alpha1.EnvVarKubeConfig)
+	restConfig, err := sharedutil.GetClientConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a K8s rest config, %w", err)
+	}
+	kubeClientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up a K8s client, %w", err)
+	}
+	return NewConfigMapPersist(ctx, kubeClientset, persistence.ConfigMap, namespace)
+}
+
 func (cmp *ConfigMapPersist) IsEnabled() bool {
 	return true
 }
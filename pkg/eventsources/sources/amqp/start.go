@@ -28,6 +28,7 @@ import (
 	"go.uber.org/zap"
 
 	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
 	"github.com/argoproj/argo-events/pkg/eventsources/events"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources"
@@ -141,10 +142,16 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 				log.Error("failed to read a message, channel might have been closed")
 				return fmt.Errorf("channel might have been closed")
 			}
-			if err := el.handleOne(amqpEventSource, msg, dispatch, log); err != nil {
+			err := el.handleOne(amqpEventSource, msg, dispatch, log)
+			if err != nil {
 				log.Errorw("failed to process an AMQP message", zap.Error(err))
 				el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
 			}
+			if !amqpEventSource.Consume.AutoAck {
+				if err := acknowledge(msg, err); err != nil {
+					log.Errorw("failed to acknowledge an AMQP message", zap.Error(err))
+				}
+			}
 		case <-ctx.Done():
 			err = conn.Close()
 			if err != nil {
@@ -195,6 +202,15 @@ func (el *EventListener) handleOne(amqpEventSource *aev1.AMQPEventSource, msg am
 	return nil
 }
 
+// acknowledge acknowledges the delivery unless it failed to be published to the eventbus,
+// in which case it is requeued for redelivery.
+func acknowledge(msg amqplib.Delivery, dispatchErr error) error {
+	if eventbuscommon.IsEventBusError(dispatchErr) {
+		return msg.Nack(false, true)
+	}
+	return msg.Ack(false)
+}
+
 // setDefaults sets the default values in case the user hasn't defined them
 // helps also to keep retro-compatibility with current dpeloyments
 func setDefaults(eventSource *aev1.AMQPEventSource) {
@@ -17,11 +17,37 @@ limitations under the License.
 package amqp
 
 import (
+	"fmt"
 	"testing"
 
+	amqplib "github.com/rabbitmq/amqp091-go"
 	"github.com/stretchr/testify/assert"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
 )
 
+type fakeAcknowledger struct {
+	acked   []uint64
+	nacked  []uint64
+	requeue bool
+}
+
+func (a *fakeAcknowledger) Ack(tag uint64, multiple bool) error {
+	a.acked = append(a.acked, tag)
+	return nil
+}
+
+func (a *fakeAcknowledger) Nack(tag uint64, multiple bool, requeue bool) error {
+	a.nacked = append(a.nacked, tag)
+	a.requeue = requeue
+	return nil
+}
+
+func (a *fakeAcknowledger) Reject(tag uint64, requeue bool) error {
+	return a.Nack(tag, false, requeue)
+}
+
 func TestParseYamlTable(t *testing.T) {
 	table, err := parseYamlTable("")
 	assert.Nil(t, err)
@@ -55,3 +81,24 @@ func TestParseYamlTable(t *testing.T) {
 	assert.Equal(t, "thing1", table["key-one"].(string))
 	assert.Equal(t, "thing2", table["key-two"].(string))
 }
+
+func TestAcknowledge(t *testing.T) {
+	a := &fakeAcknowledger{}
+	assert.NoError(t, acknowledge(amqplib.Delivery{Acknowledger: a, DeliveryTag: 1}, nil))
+	assert.NoError(t, acknowledge(amqplib.Delivery{Acknowledger: a, DeliveryTag: 2}, fmt.Errorf("invalid message")))
+	assert.NoError(t, acknowledge(amqplib.Delivery{Acknowledger: a, DeliveryTag: 3}, fmt.Errorf("failed to dispatch, %w", eventbuscommon.NewEventBusError(fmt.Errorf("timeout")))))
+	assert.Equal(t, []uint64{1, 2}, a.acked)
+	assert.Equal(t, []uint64{3}, a.nacked)
+	assert.True(t, a.requeue)
+}
+
+func TestSetDefaults(t *testing.T) {
+	es := &aev1.AMQPEventSource{}
+	setDefaults(es)
+	// keeps the previous default, acknowledging after publishing is opted in by setting the consume settings
+	assert.True(t, es.Consume.AutoAck)
+
+	es = &aev1.AMQPEventSource{Consume: &aev1.AMQPConsumeConfig{}}
+	setDefaults(es)
+	assert.False(t, es.Consume.AutoAck)
+}
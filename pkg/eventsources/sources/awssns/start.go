@@ -182,7 +182,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
 			return
 		}
-		webhook.DispatchEvent(route, eventBytes, logger, writer)
+		webhook.DispatchEvent(request.Context(), route, eventBytes, logger, writer)
 	}
 
 	logger.Info("request has been successfully processed")
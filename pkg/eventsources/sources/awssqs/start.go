@@ -29,6 +29,7 @@ import (
 	"go.uber.org/zap"
 
 	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
 	awscommon "github.com/argoproj/argo-events/pkg/eventsources/common/aws"
 	"github.com/argoproj/argo-events/pkg/eventsources/events"
@@ -135,12 +136,21 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 						}
 					}
 				}
+			}, func() {
+				// make the message visible again for redelivery, instead of waiting for the visibility timeout
+				if _, err := sqsClient.ChangeMessageVisibility(&sqslib.ChangeMessageVisibilityInput{
+					QueueUrl:          queueURL.QueueUrl,
+					ReceiptHandle:     m.ReceiptHandle,
+					VisibilityTimeout: aws.Int64(0),
+				}); err != nil {
+					log.Errorw("Failed to change the visibility of the message", zap.Error(err))
+				}
 			}, log)
 		}
 	}
 }
 
-func (el *EventListener) processMessage(message *sqslib.Message, dispatch func([]byte, ...eventsourcecommon.Option) error, ack func(), log *zap.SugaredLogger) {
+func (el *EventListener) processMessage(message *sqslib.Message, dispatch func([]byte, ...eventsourcecommon.Option) error, ack func(), nack func(), log *zap.SugaredLogger) {
 	defer func(start time.Time) {
 		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
 	}(time.Now())
@@ -169,6 +179,9 @@ func (el *EventListener) processMessage(message *sqslib.Message, dispatch func([
 	if err = dispatch(eventBytes); err != nil {
 		log.Errorw("failed to dispatch SQS event", zap.Error(err))
 		el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+		if eventbuscommon.IsEventBusError(err) {
+			nack()
+		}
 	} else {
 		ack()
 	}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	apiVersion = "7.1"
+	// Service hooks sending the events with HTTP requests
+	publisherID      = "tfs"
+	consumerID       = "webHooks"
+	consumerActionID = "httpRequest"
+)
+
+// azureDevOpsClient is a client of the REST API of Azure DevOps, authenticated with a personal access token.
+// Refer https://learn.microsoft.com/en-us/rest/api/azure/devops/hooks.
+type azureDevOpsClient struct {
+	client *http.Client
+	orgURL *url.URL
+	token  string
+}
+
+// subscription is a service hook subscription
+type subscription struct {
+	ID               string            `json:"id,omitempty"`
+	PublisherID      string            `json:"publisherId"`
+	EventType        string            `json:"eventType"`
+	ConsumerID       string            `json:"consumerId"`
+	ConsumerActionID string            `json:"consumerActionId"`
+	PublisherInputs  map[string]string `json:"publisherInputs"`
+	ConsumerInputs   map[string]string `json:"consumerInputs"`
+}
+
+// resource is a project or a repository
+type resource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// list is a list of values returned by the API
+type list[T any] struct {
+	Count int `json:"count"`
+	Value []T `json:"value"`
+}
+
+func newAzureDevOpsClient(orgURL, token string) (*azureDevOpsClient, error) {
+	u, err := url.Parse(orgURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse azure devops organization url %s, %w", orgURL, err)
+	}
+	return &azureDevOpsClient{
+		client: &http.Client{Timeout: 30 * time.Second},
+		orgURL: u,
+		token:  token,
+	}, nil
+}
+
+func (c *azureDevOpsClient) do(ctx context.Context, method string, path []string, query url.Values, body, result interface{}) error {
+	u := c.orgURL.JoinPath(path...)
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("api-version", apiVersion)
+	u.RawQuery = query.Encode()
+
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth("", c.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode > 299 {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		return fmt.Errorf("calling %s %s failed: status %d: response body: %s", method, u.Path, res.StatusCode, resBody)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(result)
+}
+
+// getProject returns the project with the name
+func (c *azureDevOpsClient) getProject(ctx context.Context, project string) (*resource, error) {
+	result := &resource{}
+	if err := c.do(ctx, http.MethodGet, []string{"_apis", "projects", url.PathEscape(project)}, nil, nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// listRepositories lists the Git repositories of the project
+func (c *azureDevOpsClient) listRepositories(ctx context.Context, project string) ([]resource, error) {
+	result := &list[resource]{}
+	if err := c.do(ctx, http.MethodGet, []string{url.PathEscape(project), "_apis", "git", "repositories"}, nil, nil, result); err != nil {
+		return nil, err
+	}
+	return result.Value, nil
+}
+
+// listSubscriptions lists the service hook subscriptions sending HTTP requests
+func (c *azureDevOpsClient) listSubscriptions(ctx context.Context) ([]subscription, error) {
+	query := url.Values{}
+	query.Set("publisherId", publisherID)
+	query.Set("consumerId", consumerID)
+	query.Set("consumerActionId", consumerActionID)
+	result := &list[subscription]{}
+	if err := c.do(ctx, http.MethodGet, []string{"_apis", "hooks", "subscriptions"}, query, nil, result); err != nil {
+		return nil, err
+	}
+	return result.Value, nil
+}
+
+// createSubscription creates a service hook subscription
+func (c *azureDevOpsClient) createSubscription(ctx context.Context, s *subscription) (*subscription, error) {
+	result := &subscription{}
+	if err := c.do(ctx, http.MethodPost, []string{"_apis", "hooks", "subscriptions"}, nil, s, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// deleteSubscription deletes a service hook subscription
+func (c *azureDevOpsClient) deleteSubscription(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, []string{"_apis", "hooks", "subscriptions", url.PathEscape(id)}, nil, nil, nil)
+}
+
+// getSubscription returns the subscription matching the event type, the url, the project and the repository,
+// or nil if not found.
+func getSubscription(subscriptions []subscription, eventType, url, projectID, repositoryID string) *subscription {
+	for i := range subscriptions {
+		s := &subscriptions[i]
+		if s.EventType == eventType && s.ConsumerInputs["url"] == url &&
+			s.PublisherInputs["projectId"] == projectID && s.PublisherInputs["repository"] == repositoryID {
+			return s
+		}
+	}
+	return nil
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuredevops
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+// controller controls the webhook operations
+var (
+	controller = webhook.NewController()
+)
+
+// set up the activation and inactivation channels to control the state of routes.
+func init() {
+	go webhook.ProcessRouteStatus(controller)
+}
+
+// target is a project, or a repository of a project, the service hooks are created for
+type target struct {
+	// key is the project name, followed by the repository name for a repository
+	key          string
+	projectID    string
+	repositoryID string
+}
+
+// Implement Router
+// 1. GetRoute
+// 2. HandleRoute
+// 3. PostActivate
+// 4. PostDeactivate
+
+// GetRoute returns the route
+func (router *Router) GetRoute() *webhook.Route {
+	return router.route
+}
+
+// HandleRoute handles incoming requests on the route
+func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Request) {
+	route := router.GetRoute()
+
+	logger := route.Logger.With(
+		logging.LabelEndpoint, route.Context.Endpoint,
+		logging.LabelPort, route.Context.Port,
+		logging.LabelHTTPMethod, route.Context.Method,
+	)
+
+	logger.Info("received a request, processing it...")
+
+	if !route.Active {
+		logger.Info("endpoint is not active, won't process the request")
+		sharedutil.SendErrorResponse(writer, "inactive endpoint")
+		return
+	}
+
+	defer func(start time.Time) {
+		route.Metrics.EventProcessingDuration(route.EventSourceName, route.EventName, float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	if !router.authorized(request) {
+		logger.Info("basic auth credentials mismatch, discarding the request")
+		sharedutil.SendErrorResponse(writer, "basic auth credentials mismatch")
+		return
+	}
+
+	request.Body = http.MaxBytesReader(writer, request.Body, route.Context.GetMaxPayloadSize())
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		logger.Errorw("failed to parse request body", zap.Error(err))
+		sharedutil.SendErrorResponse(writer, err.Error())
+		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+		return
+	}
+
+	event := &events.AzureDevOpsEventData{
+		Headers:  request.Header,
+		Body:     (*json.RawMessage)(&body),
+		Metadata: router.azureDevOpsEventSource.Metadata,
+	}
+
+	eventBody, err := json.Marshal(event)
+	if err != nil {
+		logger.Info("failed to marshal event")
+		sharedutil.SendErrorResponse(writer, "invalid event")
+		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+		return
+	}
+
+	webhook.DispatchEvent(request.Context(), route, eventBody, logger, writer)
+}
+
+// authorized checks the basic auth credentials of the request, if the event source has some
+func (router *Router) authorized(request *http.Request) bool {
+	if router.username == "" && router.password == "" {
+		return true
+	}
+	username, password, ok := request.BasicAuth()
+	return ok &&
+		subtle.ConstantTimeCompare([]byte(username), []byte(router.username)) == 1 &&
+		subtle.ConstantTimeCompare([]byte(password), []byte(router.password)) == 1
+}
+
+// PostActivate performs operations once the route is activated and ready to consume requests
+func (router *Router) PostActivate() error {
+	return nil
+}
+
+// PostInactivate performs operations after the route is inactivated
+func (router *Router) PostInactivate() error {
+	azureDevOpsEventSource := router.azureDevOpsEventSource
+	if !azureDevOpsEventSource.NeedToCreateHooks() || !azureDevOpsEventSource.DeleteHookOnFinish {
+		return nil
+	}
+
+	logger := router.route.Logger
+	logger.Info("deleting Azure DevOps service hooks...")
+
+	router.subscriptionIDsLock.Lock()
+	defer router.subscriptionIDsLock.Unlock()
+	for _, key := range subscriptionKeys(azureDevOpsEventSource) {
+		id, ok := router.subscriptionIDs[key]
+		if !ok {
+			return fmt.Errorf("can not find service hook ID for %s", key)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := router.azureDevOpsClient.deleteSubscription(ctx, id)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to delete service hook for %s. err: %w", key, err)
+		}
+		logger.Infof("Azure DevOps service hook deleted for %s", key)
+	}
+	return nil
+}
+
+// StartListening starts an event source
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	logger := logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	logger.Info("started processing the Azure DevOps event source...")
+
+	defer sources.Recover(el.GetEventName())
+
+	azureDevOpsEventSource := &el.AzureDevOpsEventSource
+
+	route := webhook.NewRoute(azureDevOpsEventSource.Webhook, logger, el.GetEventSourceName(), el.GetEventName(), el.Metrics)
+	router := &Router{
+		route:                  route,
+		azureDevOpsEventSource: azureDevOpsEventSource,
+		subscriptionIDs:        make(map[string]string),
+	}
+
+	if auth := azureDevOpsEventSource.Auth; auth != nil {
+		logger.Info("retrieving the basic auth credentials...")
+		var err error
+		if router.username, err = sharedutil.GetSecretFromVolume(auth.Username); err != nil {
+			return fmt.Errorf("failed to retrieve the basic auth username. err: %w", err)
+		}
+		if router.password, err = sharedutil.GetSecretFromVolume(auth.Password); err != nil {
+			return fmt.Errorf("failed to retrieve the basic auth password. err: %w", err)
+		}
+	}
+
+	if azureDevOpsEventSource.NeedToCreateHooks() {
+		logger.Info("retrieving the access token credentials...")
+		accessToken, err := sharedutil.GetSecretFromVolume(azureDevOpsEventSource.AccessToken)
+		if err != nil {
+			return fmt.Errorf("failed to get azure devops credentials. err: %w", err)
+		}
+
+		logger.Info("setting up the client to connect to Azure DevOps...")
+		router.azureDevOpsClient, err = newAzureDevOpsClient(azureDevOpsEventSource.OrganizationURL, accessToken)
+		if err != nil {
+			return fmt.Errorf("failed to initialize client, %w", err)
+		}
+
+		formattedURL := sharedutil.FormattedURL(azureDevOpsEventSource.Webhook.URL, azureDevOpsEventSource.Webhook.Endpoint)
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		f := func() {
+			subscriptions, err := router.azureDevOpsClient.listSubscriptions(ctx)
+			if err != nil {
+				logger.Errorf("failed to list existing service hooks. err: %+v", err)
+				return
+			}
+			for _, t := range router.resolveTargets(ctx, logger) {
+				for _, eventType := range azureDevOpsEventSource.Events {
+					key := t.key + "/" + eventType
+					s := getSubscription(subscriptions, eventType, formattedURL, t.projectID, t.repositoryID)
+					if s == nil {
+						logger.Infof("service hook not found for %s, creating ...", key)
+						if s, err = router.azureDevOpsClient.createSubscription(ctx, router.newSubscription(eventType, formattedURL, t)); err != nil {
+							logger.Errorf("failed to create azure devops service hook for %s. err: %+v", key, err)
+							continue
+						}
+						time.Sleep(500 * time.Millisecond)
+					}
+					router.subscriptionIDsLock.Lock()
+					router.subscriptionIDs[key] = s.ID
+					router.subscriptionIDsLock.Unlock()
+				}
+			}
+		}
+
+		// Mitigate race conditions - it might create multiple service hooks with same config when replicas > 1
+		randomNum, _ := rand.Int(rand.Reader, big.NewInt(int64(2000)))
+		time.Sleep(time.Duration(randomNum.Int64()) * time.Millisecond)
+		f()
+
+		go func() {
+			// Another kind of race conditions might happen when pods do rolling upgrade - new pod starts
+			// and old pod terminates, if DeleteHookOnFinish is true, the service hook will be deleted from azure devops.
+			// This is a workaround to mitigate the race conditions.
+			logger.Info("starting azure devops service hooks manager daemon")
+			ticker := time.NewTicker(60 * time.Second)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					logger.Info("exiting azure devops service hooks manager daemon")
+					return
+				case <-ticker.C:
+					f()
+				}
+			}
+		}()
+	} else {
+		logger.Info("no need to create service hooks")
+	}
+
+	return webhook.ManageRoute(ctx, router, controller, dispatch)
+}
+
+// resolveTargets returns the projects and the repositories with their IDs, skipping the ones failing
+func (router *Router) resolveTargets(ctx context.Context, logger *zap.SugaredLogger) []target {
+	var result []target
+	for _, r := range router.azureDevOpsEventSource.Repositories {
+		project, err := router.azureDevOpsClient.getProject(ctx, r.Project)
+		if err != nil {
+			logger.Errorf("failed to get project %s. err: %+v", r.Project, err)
+			continue
+		}
+		if len(r.Names) == 0 {
+			result = append(result, target{key: r.Project, projectID: project.ID})
+			continue
+		}
+		repositories, err := router.azureDevOpsClient.listRepositories(ctx, r.Project)
+		if err != nil {
+			logger.Errorf("failed to list the repositories of project %s. err: %+v", r.Project, err)
+			continue
+		}
+		for _, name := range r.Names {
+			found := false
+			for _, repository := range repositories {
+				if repository.Name == name {
+					result = append(result, target{key: r.Project + "/" + name, projectID: project.ID, repositoryID: repository.ID})
+					found = true
+					break
+				}
+			}
+			if !found {
+				logger.Errorf("repository %s not found in project %s", name, r.Project)
+			}
+		}
+	}
+	return result
+}
+
+// newSubscription returns the service hook subscription of the event type for the target
+func (router *Router) newSubscription(eventType, url string, t target) *subscription {
+	s := &subscription{
+		PublisherID:      publisherID,
+		EventType:        eventType,
+		ConsumerID:       consumerID,
+		ConsumerActionID: consumerActionID,
+		PublisherInputs: map[string]string{
+			"projectId": t.projectID,
+		},
+		ConsumerInputs: map[string]string{
+			"url":                    url,
+			"resourceDetailsToSend":  "all",
+			"messagesToSend":         "none",
+			"detailedMessagesToSend": "none",
+		},
+	}
+	if t.repositoryID != "" {
+		s.PublisherInputs["repository"] = t.repositoryID
+	}
+	if router.username != "" || router.password != "" {
+		s.ConsumerInputs["basicAuthUsername"] = router.username
+		s.ConsumerInputs["basicAuthPassword"] = router.password
+	}
+	return s
+}
+
+// subscriptionKeys returns the keys of the service hook subscriptions of the event source
+func subscriptionKeys(azureDevOpsEventSource *v1alpha1.AzureDevOpsEventSource) []string {
+	var result []string
+	for _, r := range azureDevOpsEventSource.Repositories {
+		targets := []string{r.Project}
+		if len(r.Names) > 0 {
+			targets = nil
+			for _, name := range r.Names {
+				targets = append(targets, r.Project+"/"+name)
+			}
+		}
+		for _, t := range targets {
+			for _, eventType := range azureDevOpsEventSource.Events {
+				result = append(result, t+"/"+eventType)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+func TestAuthorized(t *testing.T) {
+	router := &Router{}
+	request := httptest.NewRequest(http.MethodPost, "/push", nil)
+	assert.True(t, router.authorized(request))
+
+	router = &Router{username: "argo", password: "events"}
+	assert.False(t, router.authorized(request))
+	request.SetBasicAuth("argo", "wrong")
+	assert.False(t, router.authorized(request))
+	request.SetBasicAuth("argo", "events")
+	assert.True(t, router.authorized(request))
+}
+
+func TestSubscriptionKeys(t *testing.T) {
+	assert.Equal(t, []string{
+		"platform/git.push",
+		"platform/git.pullrequest.created",
+		"payments/api/git.push",
+		"payments/api/git.pullrequest.created",
+	}, subscriptionKeys(&v1alpha1.AzureDevOpsEventSource{
+		Events: []string{"git.push", "git.pullrequest.created"},
+		Repositories: []v1alpha1.AzureDevOpsRepositories{
+			{Project: "platform"},
+			{Project: "payments", Names: []string{"api"}},
+		},
+	}))
+}
+
+func TestManageSubscriptions(t *testing.T) {
+	existing := subscription{
+		ID:              "s1",
+		EventType:       "git.push",
+		PublisherInputs: map[string]string{"projectId": "p1", "repository": "r1"},
+		ConsumerInputs:  map[string]string{"url": "https://example.com/push"},
+	}
+	var created subscription
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		assert.Equal(t, "", username)
+		assert.Equal(t, "pat", password)
+		assert.Equal(t, apiVersion, r.URL.Query().Get("api-version"))
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/example/_apis/projects/payments":
+			_ = json.NewEncoder(w).Encode(resource{ID: "p1", Name: "payments"})
+		case r.Method == http.MethodGet && r.URL.Path == "/example/payments/_apis/git/repositories":
+			_ = json.NewEncoder(w).Encode(list[resource]{Count: 2, Value: []resource{{ID: "r1", Name: "api"}, {ID: "r2", Name: "web"}}})
+		case r.Method == http.MethodGet && r.URL.Path == "/example/_apis/hooks/subscriptions":
+			assert.Equal(t, consumerID, r.URL.Query().Get("consumerId"))
+			_ = json.NewEncoder(w).Encode(list[subscription]{Count: 1, Value: []subscription{existing}})
+		case r.Method == http.MethodPost && r.URL.Path == "/example/_apis/hooks/subscriptions":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			created.ID = "s2"
+			_ = json.NewEncoder(w).Encode(created)
+		case r.Method == http.MethodDelete:
+			deleted = r.URL.Path
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := newAzureDevOpsClient(server.URL+"/example", "pat")
+	require.NoError(t, err)
+	router := &Router{
+		azureDevOpsClient: client,
+		azureDevOpsEventSource: &v1alpha1.AzureDevOpsEventSource{
+			Repositories: []v1alpha1.AzureDevOpsRepositories{
+				{Project: "payments", Names: []string{"api", "web", "missing"}},
+				{Project: "unknown"},
+			},
+		},
+		username: "argo",
+		password: "events",
+	}
+	ctx := context.Background()
+
+	targets := router.resolveTargets(ctx, zaptest.NewLogger(t).Sugar())
+	assert.Equal(t, []target{
+		{key: "payments/api", projectID: "p1", repositoryID: "r1"},
+		{key: "payments/web", projectID: "p1", repositoryID: "r2"},
+	}, targets)
+
+	subscriptions, err := client.listSubscriptions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "s1", getSubscription(subscriptions, "git.push", "https://example.com/push", "p1", "r1").ID)
+	assert.Nil(t, getSubscription(subscriptions, "git.push", "https://example.com/push", "p1", "r2"))
+	assert.Nil(t, getSubscription(subscriptions, "git.pullrequest.created", "https://example.com/push", "p1", "r1"))
+
+	s, err := client.createSubscription(ctx, router.newSubscription("git.push", "https://example.com/push", targets[1]))
+	require.NoError(t, err)
+	assert.Equal(t, "s2", s.ID)
+	assert.Equal(t, publisherID, created.PublisherID)
+	assert.Equal(t, consumerActionID, created.ConsumerActionID)
+	assert.Equal(t, map[string]string{"projectId": "p1", "repository": "r2"}, created.PublisherInputs)
+	assert.Equal(t, "https://example.com/push", created.ConsumerInputs["url"])
+	assert.Equal(t, "argo", created.ConsumerInputs["basicAuthUsername"])
+	assert.Equal(t, "events", created.ConsumerInputs["basicAuthPassword"])
+
+	require.NoError(t, client.deleteSubscription(ctx, "s2"))
+	assert.Equal(t, "/example/_apis/hooks/subscriptions/s2", deleted)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuredevops
+
+import (
+	"sync"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+	metrics "github.com/argoproj/argo-events/pkg/metrics"
+)
+
+// EventListener implements ConfigExecutor
+type EventListener struct {
+	EventSourceName        string
+	EventName              string
+	AzureDevOpsEventSource v1alpha1.AzureDevOpsEventSource
+	Metrics                *metrics.Metrics
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() v1alpha1.EventSourceType {
+	return v1alpha1.AzureDevOpsEvent
+}
+
+// Router contains the configuration information for a route
+type Router struct {
+	// route contains information about a API endpoint
+	route *webhook.Route
+	// azureDevOpsClient is the client to manage the service hooks
+	azureDevOpsClient *azureDevOpsClient
+	// azureDevOpsEventSource is the event source that contains configuration necessary to consume events from Azure DevOps
+	azureDevOpsEventSource *v1alpha1.AzureDevOpsEventSource
+	// username and password are the basic auth credentials the service hooks send the events with
+	username string
+	password string
+	// subscriptionIDsLock protects subscriptionIDs, updated by the service hooks manager daemon
+	subscriptionIDsLock sync.Mutex
+	// subscription key -> service hook subscription ID
+	subscriptionIDs map[string]string
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+)
+
+// ValidateEventSource validates azure devops event source
+func (listener *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&listener.AzureDevOpsEventSource)
+}
+
+func validate(eventSource *v1alpha1.AzureDevOpsEventSource) error {
+	if eventSource == nil {
+		return v1alpha1.ErrNilEventSource
+	}
+	if eventSource.AccessToken != nil {
+		if eventSource.OrganizationURL == "" {
+			return fmt.Errorf("organization url can't be empty")
+		}
+		if len(eventSource.Events) == 0 {
+			return fmt.Errorf("events can't be empty")
+		}
+		if len(eventSource.Repositories) == 0 {
+			return fmt.Errorf("repositories can't be empty")
+		}
+	}
+	for _, r := range eventSource.Repositories {
+		if r.Project == "" {
+			return fmt.Errorf("repositories project can't be empty")
+		}
+	}
+	if eventSource.Auth != nil && (eventSource.Auth.Username == nil || eventSource.Auth.Password == nil) {
+		return fmt.Errorf("auth requires both username and password")
+	}
+	return webhook.ValidateWebhookContext(eventSource.Webhook)
+}
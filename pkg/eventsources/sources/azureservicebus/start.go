@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 
 	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
 	"github.com/argoproj/argo-events/pkg/eventsources/events"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources"
@@ -148,6 +149,12 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 						log.With("topic", servicebusEventSource.TopicName, "subscription", servicebusEventSource.SubscriptionName, "message_id", message.MessageID).Errorw("failed to process Azure Service Bus message", zap.Error(err))
 					}
 					el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+					if servicebusEventSource.DeferDelete && eventbuscommon.IsEventBusError(err) {
+						// release the lock for redelivery
+						if err := receiver.AbandonMessage(ctx, message, nil); err != nil {
+							log.With("message_id", message.MessageID).Errorw("failed to abandon message", zap.Error(err))
+						}
+					}
 					continue
 				}
 				if servicebusEventSource.DeferDelete {
@@ -161,7 +161,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 			return
 		}
 
-		webhook.DispatchEvent(route, eventBody, logger, writer)
+		webhook.DispatchEvent(request.Context(), route, eventBody, logger, writer)
 	}
 
 	logger.Info("request successfully processed")
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calendar
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	// defaultHolidaysRefreshInterval is the interval to reload the holiday calendar if it is not specified
+	defaultHolidaysRefreshInterval = 24 * time.Hour
+	// maxHolidayCalendarSize is the maximum size of a holiday calendar loaded from a URL
+	maxHolidayCalendarSize = 10 * 1024 * 1024
+	icalDateFormat         = "20060102"
+)
+
+// holidays are the dates of the events of a holiday calendar
+type holidays struct {
+	// dates holds the dates in the "2006-01-02" format
+	dates map[string]bool
+	// yearly holds the events recurring every year
+	yearly []yearlyDate
+}
+
+// yearlyDate is a date recurring every year from a year, until a year if it is not 0
+type yearlyDate struct {
+	month       time.Month
+	day         int
+	from, until int
+}
+
+// contains checks if the date of t, in its location, is a holiday
+func (h *holidays) contains(t time.Time) bool {
+	if h == nil {
+		return false
+	}
+	if h.dates[t.Format(time.DateOnly)] {
+		return true
+	}
+	for _, y := range h.yearly {
+		if t.Month() == y.month && t.Day() == y.day && t.Year() >= y.from && (y.until == 0 || t.Year() <= y.until) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadHolidays loads the holiday calendar from the ConfigMap or the URL
+func loadHolidays(ctx context.Context, cal *v1alpha1.HolidayCalendar) (*holidays, error) {
+	var data []byte
+	if cal.ConfigMap != nil {
+		value, err := sharedutil.GetConfigMapFromVolume(cal.ConfigMap)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the holiday calendar from the configmap, %w", err)
+		}
+		data = []byte(value)
+	} else {
+		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, cal.URL, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the holiday calendar from %s, %w", cal.URL, err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to get the holiday calendar from %s, status %s", cal.URL, resp.Status)
+		}
+		if data, err = io.ReadAll(io.LimitReader(resp.Body, maxHolidayCalendarSize)); err != nil {
+			return nil, fmt.Errorf("failed to read the holiday calendar from %s, %w", cal.URL, err)
+		}
+	}
+	return parseICal(data)
+}
+
+// parseICal returns the dates of the events of a calendar in the iCalendar format (RFC 5545).
+// The dates of all-day events are the days from their start to their end, other events are on the date
+// of their start. Events recurring every year are supported, the other recurring events are only on their
+// first date.
+func parseICal(data []byte) (*holidays, error) {
+	h := &holidays{dates: map[string]bool{}}
+	var start, end string
+	var allDay, inEvent bool
+	var rrule map[string]string
+	for _, line := range unfoldICal(data) {
+		name, params, value := parseICalLine(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			inEvent, start, end, allDay, rrule = true, "", "", false, nil
+		case name == "END" && value == "VEVENT":
+			if err := h.addEvent(start, end, allDay, rrule); err != nil {
+				return nil, err
+			}
+			inEvent = false
+		case !inEvent:
+		case name == "DTSTART":
+			start = value
+			allDay = params["VALUE"] == "DATE" || len(value) == len(icalDateFormat)
+		case name == "DTEND":
+			end = value
+		case name == "RRULE":
+			rrule = map[string]string{}
+			for _, part := range strings.Split(value, ";") {
+				k, v, _ := strings.Cut(part, "=")
+				rrule[strings.ToUpper(k)] = v
+			}
+		}
+	}
+	if inEvent {
+		return nil, fmt.Errorf("invalid calendar, VEVENT is not ended")
+	}
+	return h, nil
+}
+
+func (h *holidays) addEvent(start, end string, allDay bool, rrule map[string]string) error {
+	if len(start) < len(icalDateFormat) {
+		return fmt.Errorf("invalid calendar, invalid DTSTART %q", start)
+	}
+	startDate, err := time.Parse(icalDateFormat, start[:len(icalDateFormat)])
+	if err != nil {
+		return fmt.Errorf("invalid calendar, invalid DTSTART %q, %w", start, err)
+	}
+	if rrule["FREQ"] == "YEARLY" && (rrule["INTERVAL"] == "" || rrule["INTERVAL"] == "1") {
+		y := yearlyDate{month: startDate.Month(), day: startDate.Day(), from: startDate.Year()}
+		if until := rrule["UNTIL"]; len(until) >= len(icalDateFormat) {
+			untilDate, err := time.Parse(icalDateFormat, until[:len(icalDateFormat)])
+			if err != nil {
+				return fmt.Errorf("invalid calendar, invalid UNTIL %q, %w", until, err)
+			}
+			y.until = untilDate.Year()
+		} else if count := rrule["COUNT"]; count != "" {
+			n, err := strconv.Atoi(count)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid calendar, invalid COUNT %q", count)
+			}
+			y.until = y.from + n - 1
+		}
+		h.yearly = append(h.yearly, y)
+		return nil
+	}
+	h.dates[startDate.Format(time.DateOnly)] = true
+	if allDay && len(end) >= len(icalDateFormat) {
+		endDate, err := time.Parse(icalDateFormat, end[:len(icalDateFormat)])
+		if err != nil {
+			return fmt.Errorf("invalid calendar, invalid DTEND %q, %w", end, err)
+		}
+		// the end of an all-day event is exclusive.
+		for d := startDate.AddDate(0, 0, 1); d.Before(endDate); d = d.AddDate(0, 0, 1) {
+			h.dates[d.Format(time.DateOnly)] = true
+		}
+	}
+	return nil
+}
+
+// unfoldICal returns the content lines, joining the lines folded over multiple lines
+func unfoldICal(data []byte) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxHolidayCalendarSize)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// parseICalLine returns the upper case name, the parameters and the value of a content line
+func parseICalLine(line string) (string, map[string]string, string) {
+	nameAndParams, value, _ := strings.Cut(line, ":")
+	parts := strings.Split(nameAndParams, ";")
+	params := map[string]string{}
+	for _, p := range parts[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		params[strings.ToUpper(k)] = strings.ToUpper(v)
+	}
+	return strings.ToUpper(parts[0]), params, strings.TrimSpace(value)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calendar
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	cronlib "github.com/robfig/cron/v3"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+// maxSkippedTimes is the maximum number of consecutive skipped times of a schedule, after which
+// the schedule is considered to have no next time.
+const maxSkippedTimes = 1000
+
+// planner computes the times of the events from the schedules, skipping the excluded dates.
+type planner struct {
+	schedules        []cronlib.Schedule
+	at               []time.Time
+	exclusionDates   []time.Time
+	businessDaysOnly bool
+	holidays         *holidays
+	jitter           time.Duration
+}
+
+// newPlanner returns the planner of a calendar event source, without the holidays
+func newPlanner(cal *v1alpha1.CalendarEventSource) (*planner, error) {
+	p := &planner{businessDaysOnly: cal.BusinessDaysOnly}
+	if cal.Schedule != "" || cal.Interval != "" {
+		schedule, err := resolveSchedule(cal)
+		if err != nil {
+			return nil, err
+		}
+		p.schedules = append(p.schedules, schedule)
+	}
+	for _, s := range cal.Schedules {
+		schedule, err := parseCron(s)
+		if err != nil {
+			return nil, err
+		}
+		p.schedules = append(p.schedules, schedule)
+	}
+	for _, s := range cal.At {
+		at, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the time %s from calendar event. Cause: %w", s, err)
+		}
+		p.at = append(p.at, at)
+	}
+	slices.SortFunc(p.at, time.Time.Compare)
+	exclusionDates, err := sharedutil.ParseExclusionDates(cal.ExclusionDates)
+	if err != nil {
+		return nil, err
+	}
+	p.exclusionDates = exclusionDates
+	if cal.Jitter != "" {
+		if p.jitter, err = time.ParseDuration(cal.Jitter); err != nil {
+			return nil, fmt.Errorf("failed to parse jitter %s from calendar event. Cause: %w", cal.Jitter, err)
+		}
+	}
+	return p, nil
+}
+
+// next returns the next time after last, in the location of last, or a zero time if there is none.
+// The one-shot times are never skipped.
+func (p *planner) next(last time.Time) time.Time {
+	var result time.Time
+	for _, schedule := range p.schedules {
+		t := schedule.Next(last)
+		for i := 0; !t.IsZero() && p.skipped(t); i++ {
+			if i == maxSkippedTimes {
+				t = time.Time{}
+				break
+			}
+			// the next time of the schedule on another day.
+			t = schedule.Next(time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location()).Add(-time.Nanosecond))
+		}
+		if !t.IsZero() && (result.IsZero() || t.Before(result)) {
+			result = t
+		}
+	}
+	for _, at := range p.at {
+		if at.After(last) {
+			if result.IsZero() || at.Before(result) {
+				result = at.In(last.Location())
+			}
+			break
+		}
+	}
+	return result
+}
+
+// nextTimes returns up to n next times after last
+func (p *planner) nextTimes(last time.Time, n int) []time.Time {
+	var result []time.Time
+	for t := p.next(last); !t.IsZero() && len(result) < n; t = p.next(t) {
+		result = append(result, t)
+	}
+	return result
+}
+
+// skipped checks if the date of t is an exclusion date, a holiday, or a weekend day for business days
+func (p *planner) skipped(t time.Time) bool {
+	for _, exDate := range p.exclusionDates {
+		if exDate.Year() == t.Year() && exDate.Month() == t.Month() && exDate.Day() == t.Day() {
+			return true
+		}
+	}
+	if p.businessDaysOnly && (t.Weekday() == time.Saturday || t.Weekday() == time.Sunday) {
+		return true
+	}
+	return p.holidays.contains(t)
+}
+
+// randomJitter returns a random delay up to the jitter
+func (p *planner) randomJitter() time.Duration {
+	if p.jitter <= 0 {
+		return 0
+	}
+	return rand.N(p.jitter)
+}
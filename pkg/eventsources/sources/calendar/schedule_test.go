@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/client/clientset/versioned/fake"
+)
+
+const holidayCalendar = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example//Holidays//EN
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20260101
+DTEND;VALUE=DATE:20260102
+SUMMARY:New Year's Day
+RRULE:FREQ=YEARLY
+END:VEVENT
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20260406
+DTEND;VALUE=DATE:20260408
+SUMMARY:Easter Monday and the day
+ after
+END:VEVENT
+BEGIN:VEVENT
+DTSTART:20261224T120000Z
+SUMMARY:Christmas Eve
+END:VEVENT
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20240501
+RRULE:FREQ=YEARLY;COUNT=2
+SUMMARY:Labour Day
+END:VEVENT
+END:VCALENDAR
+`
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestParseICal(t *testing.T) {
+	h, err := parseICal([]byte(holidayCalendar))
+	require.NoError(t, err)
+	assert.True(t, h.contains(date("2026-01-01T10:00:00Z")))
+	assert.True(t, h.contains(date("2030-01-01T10:00:00Z")))
+	assert.False(t, h.contains(date("2025-01-01T10:00:00Z")))
+	assert.True(t, h.contains(date("2026-04-06T10:00:00Z")))
+	assert.True(t, h.contains(date("2026-04-07T10:00:00Z")))
+	assert.False(t, h.contains(date("2026-04-08T10:00:00Z")))
+	assert.True(t, h.contains(date("2026-12-24T10:00:00Z")))
+	assert.True(t, h.contains(date("2025-05-01T10:00:00Z")))
+	assert.False(t, h.contains(date("2026-05-01T10:00:00Z")))
+
+	_, err = parseICal([]byte("BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:2026\nEND:VEVENT\n"))
+	assert.Error(t, err)
+	_, err = parseICal([]byte("BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20260101\n"))
+	assert.Error(t, err)
+}
+
+func TestPlanner(t *testing.T) {
+	t.Run("business days and holidays", func(t *testing.T) {
+		p, err := newPlanner(&v1alpha1.CalendarEventSource{
+			Schedule:         "0 9 * * *",
+			BusinessDaysOnly: true,
+			ExclusionDates:   []string{"EXDATE:20260102T090000Z"},
+		})
+		require.NoError(t, err)
+		p.holidays, err = parseICal([]byte(holidayCalendar))
+		require.NoError(t, err)
+		// 2026-01-01 is a holiday, 2026-01-02 is excluded, 2026-01-03 and 2026-01-04 are a weekend.
+		assert.Equal(t, date("2026-01-05T09:00:00Z"), p.next(date("2025-12-31T10:00:00Z")))
+		assert.Equal(t, []time.Time{
+			date("2026-01-05T09:00:00Z"),
+			date("2026-01-06T09:00:00Z"),
+			date("2026-01-07T09:00:00Z"),
+		}, p.nextTimes(date("2025-12-31T10:00:00Z"), 3))
+	})
+
+	t.Run("multiple schedules and one-shot times", func(t *testing.T) {
+		p, err := newPlanner(&v1alpha1.CalendarEventSource{
+			Schedule:  "0 9 * * *",
+			Schedules: []string{"30 17 * * *"},
+			At:        []string{"2026-06-01T12:00:00Z", "2026-05-31T23:00:00Z"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{
+			date("2026-05-31T17:30:00Z"),
+			date("2026-05-31T23:00:00Z"),
+			date("2026-06-01T09:00:00Z"),
+			date("2026-06-01T12:00:00Z"),
+			date("2026-06-01T17:30:00Z"),
+		}, p.nextTimes(date("2026-05-31T10:00:00Z"), 5))
+	})
+
+	t.Run("one-shot times only", func(t *testing.T) {
+		p, err := newPlanner(&v1alpha1.CalendarEventSource{At: []string{"2026-06-01T12:00:00Z"}})
+		require.NoError(t, err)
+		assert.Equal(t, date("2026-06-01T12:00:00Z"), p.next(date("2026-05-31T10:00:00Z")))
+		assert.True(t, p.next(date("2026-06-01T12:00:00Z")).IsZero())
+	})
+
+	t.Run("all the times skipped", func(t *testing.T) {
+		p, err := newPlanner(&v1alpha1.CalendarEventSource{Schedule: "0 9 * * 6", BusinessDaysOnly: true})
+		require.NoError(t, err)
+		assert.True(t, p.next(date("2026-05-31T10:00:00Z")).IsZero())
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		p, err := newPlanner(&v1alpha1.CalendarEventSource{Interval: "1m", Jitter: "10s"})
+		require.NoError(t, err)
+		for range 100 {
+			jitter := p.randomJitter()
+			assert.GreaterOrEqual(t, jitter, time.Duration(0))
+			assert.Less(t, jitter, 10*time.Second)
+		}
+	})
+}
+
+func TestPublishNextTimes(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "calendar", Namespace: "argo-events"},
+	})
+	el := &EventListener{
+		EventSourceName: "calendar",
+		EventName:       "example",
+		Namespace:       "argo-events",
+		log:             zaptest.NewLogger(t).Sugar(),
+		statusClient:    client,
+	}
+	times := []time.Time{date("2026-06-01T09:00:00Z"), date("2026-06-02T09:00:00Z")}
+	el.publishNextTimes(context.Background(), times)
+
+	es, err := client.ArgoprojV1alpha1().EventSources("argo-events").Get(context.Background(), "calendar", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, es.Status.Calendars["example"].NextFireTimes, 2)
+	assert.True(t, es.Status.Calendars["example"].NextFireTimes[0].Time.Equal(times[0]))
+	assert.Equal(t, times, el.publishedTimes)
+}
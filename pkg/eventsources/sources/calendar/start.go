@@ -20,21 +20,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
 	cronlib "github.com/robfig/cron/v3"
 	"go.uber.org/zap"
-	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsclient "github.com/argoproj/argo-events/pkg/client/clientset/versioned"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
 	"github.com/argoproj/argo-events/pkg/eventsources/events"
 	"github.com/argoproj/argo-events/pkg/eventsources/persist"
 	metrics "github.com/argoproj/argo-events/pkg/metrics"
 	"github.com/argoproj/argo-events/pkg/shared/logging"
-	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
 )
 
 // EventListener implements Eventing for calendar based events
@@ -47,6 +45,10 @@ type EventListener struct {
 
 	log              *zap.SugaredLogger
 	eventPersistence persist.EventPersist
+	// statusClient updates the EventSource status, nil if it can not be set up
+	statusClient eventsclient.Interface
+	// publishedTimes are the next times published in the EventSource status
+	publishedTimes []time.Time
 }
 
 // GetEventSourceName returns name of event source
@@ -65,26 +67,13 @@ func (el *EventListener) GetEventSourceType() v1alpha1.EventSourceType {
 }
 
 // initializePersistence initialize the persistence object.
-// This func can move to eventing.go once we start supporting persistence for all sources.
 func (el *EventListener) initializePersistence(ctx context.Context, persistence *v1alpha1.EventPersistence) error {
 	el.log.Info("Initializing Persistence")
-	if persistence.ConfigMap != nil {
-		kubeConfig, _ := os.LookupEnv(v1alpha1.EnvVarKubeConfig)
-
-		restConfig, err := sharedutil.GetClientConfig(kubeConfig)
-		if err != nil {
-			return fmt.Errorf("failed to get a K8s rest config for the event source %s, %w", el.GetEventName(), err)
-		}
-		kubeClientset, err := kubernetes.NewForConfig(restConfig)
-		if err != nil {
-			return fmt.Errorf("failed to set up a K8s client for the event source %s, %w", el.GetEventName(), err)
-		}
-
-		el.eventPersistence, err = persist.NewConfigMapPersist(ctx, kubeClientset, persistence.ConfigMap, el.Namespace)
-		if err != nil {
-			return err
-		}
+	eventPersistence, err := persist.NewEventPersist(ctx, persistence, el.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to initialize the persistence for the event source %s, %w", el.GetEventName(), err)
 	}
+	el.eventPersistence = eventPersistence
 	return nil
 }
 
@@ -139,16 +128,48 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	el.log.Info("started processing the calendar event source...")
 
 	calendarEventSource := &el.CalendarEventSource
-	el.log.Info("resolving calendar schedule...")
-	schedule, err := resolveSchedule(calendarEventSource)
+	el.log.Info("resolving calendar schedules...")
+	planner, err := newPlanner(calendarEventSource)
 	if err != nil {
 		return err
 	}
 
-	el.log.Info("parsing exclusion dates if any...")
-	exDates, err := sharedutil.ParseExclusionDates(calendarEventSource.ExclusionDates)
-	if err != nil {
-		return err
+	var holidaysRefreshInterval time.Duration
+	var holidaysLoadedAt time.Time
+	if calendarEventSource.Holidays != nil {
+		holidaysRefreshInterval = defaultHolidaysRefreshInterval
+		if calendarEventSource.Holidays.RefreshInterval != "" {
+			if holidaysRefreshInterval, err = time.ParseDuration(calendarEventSource.Holidays.RefreshInterval); err != nil {
+				return fmt.Errorf("failed to parse the holidays refresh interval, %w", err)
+			}
+		}
+		el.log.Info("loading the holiday calendar...")
+		if planner.holidays, err = loadHolidays(ctx, calendarEventSource.Holidays); err != nil {
+			return err
+		}
+		holidaysLoadedAt = time.Now()
+	}
+	// refreshHolidays reloads the holiday calendar, the holidays loaded previously are kept until the next
+	// refresh if it fails.
+	refreshHolidays := func() {
+		if calendarEventSource.Holidays == nil || time.Since(holidaysLoadedAt) < holidaysRefreshInterval {
+			return
+		}
+		el.log.Info("reloading the holiday calendar...")
+		holidaysLoadedAt = time.Now()
+		h, err := loadHolidays(ctx, calendarEventSource.Holidays)
+		if err != nil {
+			el.log.Errorw("failed to reload the holiday calendar", zap.Error(err))
+			return
+		}
+		planner.holidays = h
+	}
+	// holidaysRefresh is the channel of the next refresh of the holiday calendar, nil if there is none.
+	holidaysRefresh := func() <-chan time.Time {
+		if calendarEventSource.Holidays == nil {
+			return nil
+		}
+		return time.After(time.Until(holidaysLoadedAt.Add(holidaysRefreshInterval)))
 	}
 
 	el.eventPersistence = &persist.NullPersistence{}
@@ -160,20 +181,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		el.log.Info("Persistence not enabled")
 	}
 
-	var next Next
-	next = func(last time.Time) time.Time {
-		nextT := schedule.Next(last)
-		nextYear := nextT.Year()
-		nextMonth := nextT.Month()
-		nextDay := nextT.Day()
-		for _, exDate := range exDates {
-			// if exDate == nextEvent, then we need to skip this and get the next
-			if exDate.Year() == nextYear && exDate.Month() == nextMonth && exDate.Day() == nextDay {
-				return next(nextT)
-			}
-		}
-		return nextT
-	}
+	el.initializeStatus()
 
 	lastT, err := el.getExecutionTime()
 	if err != nil {
@@ -222,7 +230,15 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 	el.log.Infow("Calendar event start time:", zap.Any("Time", lastT.Format(time.RFC822)))
 	for {
-		t := next(lastT)
+		refreshHolidays()
+		t := planner.next(lastT)
+		if t.IsZero() {
+			el.log.Info("no more calendar events are scheduled")
+			el.publishNextTimes(ctx, nil)
+			<-ctx.Done()
+			el.log.Info("exiting calendar event listener...")
+			return nil
+		}
 
 		// Catchup scenario
 		// Trigger the event immediately if the current schedule time is earlier then
@@ -243,8 +259,10 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			continue
 		}
 
-		timer := time.After(time.Until(t))
-		el.log.Infow("expected next calendar event", zap.Any(logging.LabelTime, t.UTC().String()))
+		el.publishNextTimes(ctx, planner.nextTimes(lastT, nextTimesCount))
+		jitter := planner.randomJitter()
+		timer := time.After(time.Until(t) + jitter)
+		el.log.Infow("expected next calendar event", zap.Any(logging.LabelTime, t.UTC().String()), zap.Duration("jitter", jitter))
 		select {
 		case tx := <-timer:
 			if err = sendEventFunc(tx); err != nil {
@@ -255,10 +273,14 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 					continue
 				}
 			}
-			lastT = tx
+			// the next time is computed from the planned time, not from the time delayed by the jitter.
+			lastT = t
 			if location != nil {
 				lastT = lastT.In(location)
 			}
+		case <-holidaysRefresh():
+			// the next time is computed again with the reloaded holidays.
+			continue
 		case <-ctx.Done():
 			el.log.Info("exiting calendar event listener...")
 			return nil
@@ -266,19 +288,10 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	}
 }
 
-// Next is a function to compute the next event time from a given time
-type Next func(time.Time) time.Time
-
 // resolveSchedule parses the schedule and returns a valid cron schedule
 func resolveSchedule(cal *v1alpha1.CalendarEventSource) (cronlib.Schedule, error) {
 	if cal.Schedule != "" {
-		// standard cron expression
-		specParser := cronlib.NewParser(cronlib.Minute | cronlib.Hour | cronlib.Dom | cronlib.Month | cronlib.Dow)
-		schedule, err := specParser.Parse(cal.Schedule)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse schedule %s from calendar event. Cause: %w", cal.Schedule, err)
-		}
-		return schedule, nil
+		return parseCron(cal.Schedule)
 	}
 	if cal.Interval != "" {
 		intervalDuration, err := time.ParseDuration(cal.Interval)
@@ -290,3 +303,13 @@ func resolveSchedule(cal *v1alpha1.CalendarEventSource) (cronlib.Schedule, error
 	}
 	return nil, fmt.Errorf("calendar event must contain either a schedule or interval")
 }
+
+// parseCron parses a standard cron expression
+func parseCron(s string) (cronlib.Schedule, error) {
+	specParser := cronlib.NewParser(cronlib.Minute | cronlib.Hour | cronlib.Dom | cronlib.Month | cronlib.Dow)
+	schedule, err := specParser.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schedule %s from calendar event. Cause: %w", s, err)
+	}
+	return schedule, nil
+}
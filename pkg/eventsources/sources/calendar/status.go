@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calendar
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsclient "github.com/argoproj/argo-events/pkg/client/clientset/versioned"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+// nextTimesCount is the number of the next times published in the EventSource status
+const nextTimesCount = 5
+
+// initializeStatus sets up the client updating the EventSource status
+func (el *EventListener) initializeStatus() {
+	kubeConfig, _ := os.LookupEnv(v1alpha1.EnvVarKubeConfig)
+	restConfig, err := sharedutil.GetClientConfig(kubeConfig)
+	if err != nil {
+		el.log.Warnw("failed to get a K8s rest config, the next times won't be published in the status", zap.Error(err))
+		return
+	}
+	client, err := eventsclient.NewForConfig(restConfig)
+	if err != nil {
+		el.log.Warnw("failed to set up a K8s client, the next times won't be published in the status", zap.Error(err))
+		return
+	}
+	el.statusClient = client
+}
+
+// publishNextTimes patches the status of the EventSource with the next times if they changed. It is
+// best effort, the service account of the event source may not be allowed to patch the status.
+func (el *EventListener) publishNextTimes(ctx context.Context, times []time.Time) {
+	if el.statusClient == nil || (el.publishedTimes != nil && slices.EqualFunc(times, el.publishedTimes, time.Time.Equal)) {
+		return
+	}
+	nextFireTimes := make([]metav1.Time, 0, len(times))
+	for _, t := range times {
+		nextFireTimes = append(nextFireTimes, metav1.NewTime(t))
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"calendars": map[string]v1alpha1.CalendarStatus{
+				el.EventName: {NextFireTimes: nextFireTimes},
+			},
+		},
+	})
+	if err != nil {
+		el.log.Errorw("failed to marshal the status patch", zap.Error(err))
+		return
+	}
+	_, err = el.statusClient.ArgoprojV1alpha1().EventSources(el.Namespace).Patch(ctx, el.EventSourceName, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	if err != nil {
+		el.log.Warnw("failed to publish the next times in the EventSource status", zap.Error(err))
+		return
+	}
+	el.publishedTimes = append([]time.Time{}, times...)
+}
@@ -19,6 +19,7 @@ package calendar
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 )
@@ -32,11 +33,30 @@ func validate(calendarEventSource *v1alpha1.CalendarEventSource) error {
 	if calendarEventSource == nil {
 		return v1alpha1.ErrNilEventSource
 	}
-	if calendarEventSource.Schedule == "" && calendarEventSource.Interval == "" {
-		return fmt.Errorf("must have either schedule or interval")
+	if calendarEventSource.Schedule == "" && calendarEventSource.Interval == "" && len(calendarEventSource.Schedules) == 0 && len(calendarEventSource.At) == 0 {
+		return fmt.Errorf("must have either schedule, interval, schedules or at")
 	}
-	if _, err := resolveSchedule(calendarEventSource); err != nil {
+	if _, err := newPlanner(calendarEventSource); err != nil {
 		return err
 	}
+	if calendarEventSource.Jitter != "" {
+		if jitter, _ := time.ParseDuration(calendarEventSource.Jitter); jitter < 0 {
+			return fmt.Errorf("jitter can't be negative")
+		}
+	}
+	if holidays := calendarEventSource.Holidays; holidays != nil {
+		if (holidays.URL == "") == (holidays.ConfigMap == nil) {
+			return fmt.Errorf("either url or configMap must be specified for holidays")
+		}
+		if holidays.RefreshInterval != "" {
+			interval, err := time.ParseDuration(holidays.RefreshInterval)
+			if err != nil {
+				return fmt.Errorf("failed to parse the holidays refresh interval, %w", err)
+			}
+			if interval <= 0 {
+				return fmt.Errorf("holidays refresh interval must be positive")
+			}
+		}
+	}
 	return nil
 }
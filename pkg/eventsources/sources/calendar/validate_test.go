@@ -37,7 +37,7 @@ func TestValidateEventSource(t *testing.T) {
 
 	err := listener.ValidateEventSource(context.Background())
 	assert.Error(t, err)
-	assert.Equal(t, "must have either schedule or interval", err.Error())
+	assert.Equal(t, "must have either schedule, interval, schedules or at", err.Error())
 
 	content, err := os.ReadFile(fmt.Sprintf("%s/%s", sources.EventSourceDir, "calendar.yaml"))
 	assert.Nil(t, err)
@@ -55,3 +55,14 @@ func TestValidateEventSource(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, validate(&v1alpha1.CalendarEventSource{At: []string{"2026-06-01T12:00:00Z"}}))
+	assert.Error(t, validate(&v1alpha1.CalendarEventSource{At: []string{"2026-06-01"}}))
+	assert.NoError(t, validate(&v1alpha1.CalendarEventSource{Schedules: []string{"0 9 * * *"}, Jitter: "30s"}))
+	assert.Error(t, validate(&v1alpha1.CalendarEventSource{Schedules: []string{"0 9 * *"}}))
+	assert.Error(t, validate(&v1alpha1.CalendarEventSource{Interval: "1m", Jitter: "-1s"}))
+	assert.Error(t, validate(&v1alpha1.CalendarEventSource{Interval: "1m", Holidays: &v1alpha1.HolidayCalendar{}}))
+	assert.NoError(t, validate(&v1alpha1.CalendarEventSource{Interval: "1m", Holidays: &v1alpha1.HolidayCalendar{URL: "https://example.com/holidays.ics"}}))
+	assert.Error(t, validate(&v1alpha1.CalendarEventSource{Interval: "1m", Holidays: &v1alpha1.HolidayCalendar{URL: "https://example.com/holidays.ics", RefreshInterval: "0s"}}))
+}
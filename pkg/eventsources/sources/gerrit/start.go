@@ -102,7 +102,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 		return
 	}
 
-	webhook.DispatchEvent(route, eventBody, logger, writer)
+	webhook.DispatchEvent(request.Context(), route, eventBody, logger, writer)
 }
 
 // PostActivate performs operations once the route is activated and ready to consume requests
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// hooksPageSize is the number of hooks listed per page
+const hooksPageSize = 50
+
+// giteaClient is a client of the hooks API of Gitea and Forgejo.
+// Refer https://docs.gitea.com/api/.
+type giteaClient struct {
+	client  *http.Client
+	baseURL *url.URL
+	token   string
+}
+
+// giteaHook is a repository or organization hook
+type giteaHook struct {
+	ID     int64             `json:"id,omitempty"`
+	Type   string            `json:"type,omitempty"`
+	Config map[string]string `json:"config"`
+	Events []string          `json:"events"`
+	Active bool              `json:"active"`
+}
+
+func newGiteaClient(baseURL, token string, insecure bool) (*giteaClient, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse gitea base url %s, %w", baseURL, err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402
+	}
+	return &giteaClient{
+		client:  &http.Client{Transport: transport, Timeout: 30 * time.Second},
+		baseURL: u.JoinPath("api", "v1"),
+		token:   token,
+	}, nil
+}
+
+// repoHooksPath returns the path of the hooks of a repository
+func repoHooksPath(owner, name string) string {
+	return fmt.Sprintf("repos/%s/%s/hooks", url.PathEscape(owner), url.PathEscape(name))
+}
+
+// orgHooksPath returns the path of the hooks of an organization
+func orgHooksPath(org string) string {
+	return fmt.Sprintf("orgs/%s/hooks", url.PathEscape(org))
+}
+
+func (c *giteaClient) do(ctx context.Context, method string, u *url.URL, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "token "+c.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode > 299 {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		return fmt.Errorf("calling %s %s failed: status %d: response body: %s", method, u.Path, res.StatusCode, resBody)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(result)
+}
+
+// listHooks lists all the hooks of a repository or an organization
+func (c *giteaClient) listHooks(ctx context.Context, hooksPath string) ([]giteaHook, error) {
+	var result []giteaHook
+	for page := 1; ; page++ {
+		u := c.baseURL.JoinPath(hooksPath)
+		query := u.Query()
+		query.Set("page", strconv.Itoa(page))
+		query.Set("limit", strconv.Itoa(hooksPageSize))
+		u.RawQuery = query.Encode()
+		var hooks []giteaHook
+		if err := c.do(ctx, http.MethodGet, u, nil, &hooks); err != nil {
+			return nil, err
+		}
+		result = append(result, hooks...)
+		if len(hooks) < hooksPageSize {
+			return result, nil
+		}
+	}
+}
+
+// createHook creates a hook for a repository or an organization
+func (c *giteaClient) createHook(ctx context.Context, hooksPath string, hook *giteaHook) (*giteaHook, error) {
+	result := &giteaHook{}
+	if err := c.do(ctx, http.MethodPost, c.baseURL.JoinPath(hooksPath), hook, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// deleteHook deletes a hook of a repository or an organization
+func (c *giteaClient) deleteHook(ctx context.Context, hooksPath string, id int64) error {
+	return c.do(ctx, http.MethodDelete, c.baseURL.JoinPath(hooksPath, strconv.FormatInt(id, 10)), nil, nil)
+}
+
+// getHook returns the hook with the url, or nil if not found.
+func getHook(hooks []giteaHook, url string) *giteaHook {
+	for i := range hooks {
+		if hooks[i].Config["url"] == url {
+			return &hooks[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+// Signature headers, in order of preference. Forgejo sends both the Forgejo and the Gitea headers.
+var signatureHeaders = []string{
+	"X-Forgejo-Signature",
+	"X-Gitea-Signature",
+	"X-Gogs-Signature",
+}
+
+// controller controls the webhook operations
+var (
+	controller = webhook.NewController()
+)
+
+// set up the activation and inactivation channels to control the state of routes.
+func init() {
+	go webhook.ProcessRouteStatus(controller)
+}
+
+// Implement Router
+// 1. GetRoute
+// 2. HandleRoute
+// 3. PostActivate
+// 4. PostDeactivate
+
+// GetRoute returns the route
+func (router *Router) GetRoute() *webhook.Route {
+	return router.route
+}
+
+// HandleRoute handles incoming requests on the route
+func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Request) {
+	route := router.GetRoute()
+
+	logger := route.Logger.With(
+		logging.LabelEndpoint, route.Context.Endpoint,
+		logging.LabelPort, route.Context.Port,
+		logging.LabelHTTPMethod, route.Context.Method,
+	)
+
+	logger.Info("received a request, processing it...")
+
+	if !route.Active {
+		logger.Info("endpoint is not active, won't process the request")
+		sharedutil.SendErrorResponse(writer, "inactive endpoint")
+		return
+	}
+
+	defer func(start time.Time) {
+		route.Metrics.EventProcessingDuration(route.EventSourceName, route.EventName, float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	request.Body = http.MaxBytesReader(writer, request.Body, route.Context.GetMaxPayloadSize())
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		logger.Errorw("failed to parse request body", zap.Error(err))
+		sharedutil.SendErrorResponse(writer, err.Error())
+		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+		return
+	}
+
+	if router.hookSecret != "" {
+		if err := validateSignature(request.Header, body, router.hookSecret); err != nil {
+			logger.Errorw("request is not valid event notification, discarding it", zap.Error(err))
+			sharedutil.SendErrorResponse(writer, err.Error())
+			return
+		}
+	}
+
+	event := &events.GiteaEventData{
+		Headers:  request.Header,
+		Body:     (*json.RawMessage)(&body),
+		Metadata: router.giteaEventSource.Metadata,
+	}
+
+	eventBody, err := json.Marshal(event)
+	if err != nil {
+		logger.Info("failed to marshal event")
+		sharedutil.SendErrorResponse(writer, "invalid event")
+		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+		return
+	}
+
+	webhook.DispatchEvent(request.Context(), route, eventBody, logger, writer)
+}
+
+// PostActivate performs operations once the route is activated and ready to consume requests
+func (router *Router) PostActivate() error {
+	return nil
+}
+
+// PostInactivate performs operations after the route is inactivated
+func (router *Router) PostInactivate() error {
+	giteaEventSource := router.giteaEventSource
+	if !giteaEventSource.NeedToCreateHooks() || !giteaEventSource.DeleteHookOnFinish {
+		return nil
+	}
+
+	logger := router.route.Logger
+	logger.Info("deleting Gitea hooks...")
+
+	router.hookIDsLock.Lock()
+	defer router.hookIDsLock.Unlock()
+	for _, hooksPath := range hooksPaths(giteaEventSource) {
+		id, ok := router.hookIDs[hooksPath]
+		if !ok {
+			return fmt.Errorf("can not find hook ID for %s", hooksPath)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := router.giteaClient.deleteHook(ctx, hooksPath, id)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to delete hook for %s. err: %w", hooksPath, err)
+		}
+		logger.Infof("Gitea hook deleted for %s", hooksPath)
+	}
+	return nil
+}
+
+// StartListening starts an event source
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	logger := logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	logger.Info("started processing the Gitea event source...")
+
+	defer sources.Recover(el.GetEventName())
+
+	giteaEventSource := &el.GiteaEventSource
+
+	route := webhook.NewRoute(giteaEventSource.Webhook, logger, el.GetEventSourceName(), el.GetEventName(), el.Metrics)
+	router := &Router{
+		route:            route,
+		giteaEventSource: giteaEventSource,
+		hookIDs:          make(map[string]int64),
+	}
+
+	if giteaEventSource.WebhookSecret != nil {
+		logger.Info("retrieving the webhook secret...")
+		secret, err := sharedutil.GetSecretFromVolume(giteaEventSource.WebhookSecret)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve webhook secret. err: %w", err)
+		}
+		router.hookSecret = secret
+	}
+
+	if giteaEventSource.NeedToCreateHooks() {
+		logger.Info("retrieving the access token credentials...")
+		accessToken, err := sharedutil.GetSecretFromVolume(giteaEventSource.AccessToken)
+		if err != nil {
+			return fmt.Errorf("failed to get gitea credentials. err: %w", err)
+		}
+
+		logger.Info("setting up the client to connect to Gitea...")
+		router.giteaClient, err = newGiteaClient(giteaEventSource.GiteaBaseURL, accessToken, giteaEventSource.Insecure)
+		if err != nil {
+			return fmt.Errorf("failed to initialize client, %w", err)
+		}
+
+		formattedURL := sharedutil.FormattedURL(giteaEventSource.Webhook.URL, giteaEventSource.Webhook.Endpoint)
+		hook := &giteaHook{
+			Type: "gitea",
+			Config: map[string]string{
+				"url":          formattedURL,
+				"content_type": "json",
+			},
+			Events: giteaEventSource.Events,
+			Active: true,
+		}
+		if router.hookSecret != "" {
+			hook.Config["secret"] = router.hookSecret
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		f := func() {
+			for _, hooksPath := range hooksPaths(giteaEventSource) {
+				hooks, err := router.giteaClient.listHooks(ctx, hooksPath)
+				if err != nil {
+					logger.Errorf("failed to list existing webhooks of %s. err: %+v", hooksPath, err)
+					continue
+				}
+				h := getHook(hooks, formattedURL)
+				if h == nil {
+					logger.Infof("hook not found for %s, creating ...", hooksPath)
+					if h, err = router.giteaClient.createHook(ctx, hooksPath, hook); err != nil {
+						logger.Errorf("failed to create gitea webhook for %s. err: %+v", hooksPath, err)
+						continue
+					}
+					time.Sleep(500 * time.Millisecond)
+				}
+				router.hookIDsLock.Lock()
+				router.hookIDs[hooksPath] = h.ID
+				router.hookIDsLock.Unlock()
+			}
+		}
+
+		// Mitigate race conditions - it might create multiple hooks with same config when replicas > 1
+		randomNum, _ := rand.Int(rand.Reader, big.NewInt(int64(2000)))
+		time.Sleep(time.Duration(randomNum.Int64()) * time.Millisecond)
+		f()
+
+		go func() {
+			// Another kind of race conditions might happen when pods do rolling upgrade - new pod starts
+			// and old pod terminates, if DeleteHookOnFinish is true, the hook will be deleted from gitea.
+			// This is a workaround to mitigate the race conditions.
+			logger.Info("starting gitea hooks manager daemon")
+			ticker := time.NewTicker(60 * time.Second)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					logger.Info("exiting gitea hooks manager daemon")
+					return
+				case <-ticker.C:
+					f()
+				}
+			}
+		}()
+	} else {
+		logger.Info("no need to create webhooks")
+	}
+
+	return webhook.ManageRoute(ctx, router, controller, dispatch)
+}
+
+// hooksPaths returns the paths of the hooks of the organizations and the repositories
+func hooksPaths(giteaEventSource *v1alpha1.GiteaEventSource) []string {
+	var result []string
+	for _, org := range giteaEventSource.Organizations {
+		result = append(result, orgHooksPath(org))
+	}
+	for _, r := range giteaEventSource.Repositories {
+		for _, name := range r.Names {
+			result = append(result, repoHooksPath(r.Owner, name))
+		}
+	}
+	return result
+}
+
+// validateSignature checks the HMAC-SHA256 signature of the body, in hex, sent by Gitea or Forgejo.
+// X-Hub-Signature-256 is also accepted, with the sha256= prefix.
+func validateSignature(header http.Header, body []byte, secret string) error {
+	var signature string
+	for _, h := range signatureHeaders {
+		if signature = header.Get(h); signature != "" {
+			break
+		}
+	}
+	if signature == "" {
+		signature = strings.TrimPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+	}
+	if signature == "" {
+		return fmt.Errorf("missing signature header")
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	expectedMAC := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(strings.ToLower(signature)), []byte(expectedMAC)) {
+		return fmt.Errorf("hmac verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+func sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidateSignature(t *testing.T) {
+	body := []byte(`{"ref":"refs/heads/main"}`)
+	signature := sign(body, "secret")
+
+	for _, h := range []string{"X-Gitea-Signature", "X-Forgejo-Signature", "X-Gogs-Signature"} {
+		header := http.Header{}
+		header.Set(h, signature)
+		assert.NoError(t, validateSignature(header, body, "secret"), h)
+	}
+
+	header := http.Header{}
+	header.Set("X-Hub-Signature-256", "sha256="+signature)
+	assert.NoError(t, validateSignature(header, body, "secret"))
+
+	header = http.Header{}
+	header.Set("X-Gitea-Signature", signature)
+	assert.Error(t, validateSignature(header, body, "another-secret"))
+	assert.Error(t, validateSignature(header, []byte(`{}`), "secret"))
+	assert.Error(t, validateSignature(http.Header{}, body, "secret"))
+}
+
+func TestGiteaClient(t *testing.T) {
+	hooks := map[string][]giteaHook{
+		"/api/v1/repos/argoproj/argo-events/hooks": {
+			{ID: 1, Config: map[string]string{"url": "https://example.com/other"}},
+		},
+	}
+	var created giteaHook
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token abc", r.Header.Get("Authorization"))
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("page") != "1" {
+				_ = json.NewEncoder(w).Encode([]giteaHook{})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(hooks[r.URL.Path])
+		case http.MethodPost:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			created.ID = 2
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(created)
+		case http.MethodDelete:
+			deleted = r.URL.Path
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, err := newGiteaClient(server.URL+"/", "abc", false)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	list, err := client.listHooks(ctx, repoHooksPath("argoproj", "argo-events"))
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Nil(t, getHook(list, "https://example.com/push"))
+	assert.Equal(t, int64(1), getHook(list, "https://example.com/other").ID)
+
+	_, err = client.listHooks(ctx, orgHooksPath("argoproj"))
+	require.NoError(t, err)
+
+	h, err := client.createHook(ctx, orgHooksPath("argoproj"), &giteaHook{
+		Type:   "gitea",
+		Config: map[string]string{"url": "https://example.com/push", "content_type": "json"},
+		Events: []string{"push"},
+		Active: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), h.ID)
+	assert.Equal(t, "https://example.com/push", created.Config["url"])
+	assert.Equal(t, []string{"push"}, created.Events)
+
+	require.NoError(t, client.deleteHook(ctx, repoHooksPath("argoproj", "argo-events"), 2))
+	assert.Equal(t, "/api/v1/repos/argoproj/argo-events/hooks/2", deleted)
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	_, err = client.listHooks(ctx, orgHooksPath("argoproj"))
+	assert.Error(t, err)
+}
+
+func TestHooksPaths(t *testing.T) {
+	assert.Equal(t, []string{"orgs/argoproj/hooks", "repos/argoproj/argo-events/hooks", "repos/argoproj/argo-workflows/hooks"}, hooksPaths(&v1alpha1.GiteaEventSource{
+		Organizations: []string{"argoproj"},
+		Repositories: []v1alpha1.OwnedRepositories{
+			{Owner: "argoproj", Names: []string{"argo-events", "argo-workflows"}},
+		},
+	}))
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"sync"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+	metrics "github.com/argoproj/argo-events/pkg/metrics"
+)
+
+// EventListener implements ConfigExecutor
+type EventListener struct {
+	EventSourceName  string
+	EventName        string
+	GiteaEventSource v1alpha1.GiteaEventSource
+	Metrics          *metrics.Metrics
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() v1alpha1.EventSourceType {
+	return v1alpha1.GiteaEvent
+}
+
+// Router contains the configuration information for a route
+type Router struct {
+	// route contains information about a API endpoint
+	route *webhook.Route
+	// giteaClient is the client to manage the hooks
+	giteaClient *giteaClient
+	// giteaEventSource is the event source that contains configuration necessary to consume events from Gitea
+	giteaEventSource *v1alpha1.GiteaEventSource
+	// hookSecret is the secret the deliveries are signed with
+	hookSecret string
+	// hookIDsLock protects hookIDs, updated by the hooks manager daemon
+	hookIDsLock sync.Mutex
+	// hooks path -> hook ID
+	hookIDs map[string]int64
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+)
+
+// ValidateEventSource validates gitea event source
+func (listener *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&listener.GiteaEventSource)
+}
+
+func validate(eventSource *v1alpha1.GiteaEventSource) error {
+	if eventSource == nil {
+		return v1alpha1.ErrNilEventSource
+	}
+	if eventSource.AccessToken != nil {
+		if eventSource.GiteaBaseURL == "" {
+			return fmt.Errorf("gitea base url can't be empty")
+		}
+		if len(eventSource.Events) == 0 {
+			return fmt.Errorf("events can't be empty")
+		}
+		if len(eventSource.Repositories) == 0 && len(eventSource.Organizations) == 0 {
+			return fmt.Errorf("either repositories or organizations is required")
+		}
+	}
+	for _, r := range eventSource.Repositories {
+		if r.Owner == "" {
+			return fmt.Errorf("repository owner can't be empty")
+		}
+		if len(r.Names) == 0 {
+			return fmt.Errorf("repository names of owner %s can't be empty", r.Owner)
+		}
+	}
+	for _, org := range eventSource.Organizations {
+		if org == "" {
+			return fmt.Errorf("organization can't be empty")
+		}
+	}
+	return webhook.ValidateWebhookContext(eventSource.Webhook)
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	ghinstallation "github.com/bradleyfalzon/ghinstallation/v2"
+	gh "github.com/google/go-github/v50/github"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/eventsources/persist"
+)
+
+const (
+	defaultBackfillInterval = 5 * time.Minute
+	defaultBackfillMaxAge   = 24 * time.Hour
+	// backfillInitialDelay is the delay before the first check of the deliveries, to let the route be activated
+	backfillInitialDelay = 10 * time.Second
+	// deliveriesPageSize is the number of deliveries listed per page
+	deliveriesPageSize = 100
+	// appHookKey is the key of the webhook of the GitHub App in the backfill state
+	appHookKey = "app"
+)
+
+// backfillState is the state persisted between the checks of the deliveries
+type backfillState struct {
+	// LastDeliveryIDs holds the ID of the last delivery seen by hook
+	LastDeliveryIDs map[string]int64 `json:"lastDeliveryIDs,omitempty"`
+}
+
+// hookDeliveries lists and redelivers the deliveries of a hook
+type hookDeliveries struct {
+	// key identifies the hook in the backfill state
+	key       string
+	list      func(ctx context.Context, opts *gh.ListCursorOptions) ([]*gh.HookDelivery, *gh.Response, error)
+	redeliver func(ctx context.Context, deliveryID int64) error
+}
+
+// backfiller redelivers the failed deliveries of the hooks
+type backfiller struct {
+	router         *Router
+	logger         *zap.SugaredLogger
+	interval       time.Duration
+	maxAge         time.Duration
+	persistence    persist.EventPersist
+	persistenceKey string
+	state          *backfillState
+	// appClient is the client authenticated as the GitHub App, to get the deliveries of the webhook of the app
+	appClient *gh.Client
+}
+
+func (el *EventListener) newBackfiller(ctx context.Context, router *Router, logger *zap.SugaredLogger) (*backfiller, error) {
+	backfill := el.GithubEventSource.Backfill
+	b := &backfiller{
+		router:         router,
+		logger:         logger.With("backfill", true),
+		interval:       defaultBackfillInterval,
+		maxAge:         defaultBackfillMaxAge,
+		persistenceKey: fmt.Sprintf("%s.%s", el.EventSourceName, el.EventName),
+	}
+	var err error
+	if backfill.Interval != "" {
+		if b.interval, err = time.ParseDuration(backfill.Interval); err != nil {
+			return nil, fmt.Errorf("failed to parse the backfill interval, %w", err)
+		}
+	}
+	if backfill.MaxAge != "" {
+		if b.maxAge, err = time.ParseDuration(backfill.MaxAge); err != nil {
+			return nil, fmt.Errorf("failed to parse the backfill max age, %w", err)
+		}
+	}
+	if b.persistence, err = persist.NewEventPersist(ctx, backfill.Persistence, el.Namespace); err != nil {
+		return nil, fmt.Errorf("failed to initialize the persistence, %w", err)
+	}
+	if b.state, err = b.loadState(); err != nil {
+		return nil, err
+	}
+	if backfill.AppHook {
+		if b.appClient, err = router.newAppClient(); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// newAppClient returns a client authenticated as the GitHub App, instead of an installation of the app
+func (router *Router) newAppClient() (*gh.Client, error) {
+	appCreds := router.githubEventSource.GithubApp
+	privateKey, err := router.getCredentials(appCreds.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve github app credentials, %w", err)
+	}
+	transport, err := ghinstallation.NewAppsTransport(http.DefaultTransport, appCreds.AppID, []byte(privateKey.secret))
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up the github app transport, %w", err)
+	}
+	client := gh.NewClient(&http.Client{Transport: transport})
+	baseURL, err := parseUrlWithSlash(&router.githubEventSource.GithubBaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse github base url. err: %v", err)
+	}
+	if baseURL != nil {
+		client.BaseURL = baseURL
+	}
+	return client, nil
+}
+
+// run checks the deliveries periodically
+func (b *backfiller) run(ctx context.Context) {
+	b.logger.Info("starting github deliveries backfill")
+	timer := time.NewTimer(backfillInitialDelay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			b.logger.Info("exiting github deliveries backfill")
+			return
+		case <-timer.C:
+			b.backfill(ctx)
+			timer.Reset(b.interval)
+		}
+	}
+}
+
+// backfill redelivers the failed deliveries of all the hooks, and persists the last delivery seen of each hook
+func (b *backfiller) backfill(ctx context.Context) {
+	changed := false
+	for _, h := range b.hooks() {
+		lastID := b.state.LastDeliveryIDs[h.key]
+		newLastID, err := b.backfillHook(ctx, h, lastID, time.Now())
+		if err != nil {
+			b.logger.Errorw("failed to backfill the deliveries of the hook", zap.String("hook", h.key), zap.Error(err))
+			continue
+		}
+		if newLastID != lastID {
+			b.state.LastDeliveryIDs[h.key] = newLastID
+			changed = true
+		}
+	}
+	if changed {
+		if err := b.saveState(); err != nil {
+			b.logger.Errorw("failed to persist the last deliveries seen", zap.Error(err))
+		}
+	}
+}
+
+// backfillHook redelivers the deliveries of the hook after the last delivery seen, and not older than
+// the max age, which failed and were not redelivered successfully. It returns the ID of the last delivery
+// seen, which is unchanged if a redelivery failed, so they are retried.
+func (b *backfiller) backfillHook(ctx context.Context, h hookDeliveries, lastID int64, now time.Time) (int64, error) {
+	since := now.Add(-b.maxAge)
+	// the deliveries are listed from the most recent one.
+	var deliveries []*gh.HookDelivery
+	opts := &gh.ListCursorOptions{PerPage: deliveriesPageSize}
+list:
+	for {
+		page, resp, err := h.list(ctx, opts)
+		if err != nil {
+			return lastID, fmt.Errorf("failed to list the deliveries, %w", err)
+		}
+		for _, d := range page {
+			if d.GetID() <= lastID || d.GetDeliveredAt().Before(since) {
+				break list
+			}
+			deliveries = append(deliveries, d)
+		}
+		if resp == nil || resp.Cursor == "" || len(page) == 0 {
+			break
+		}
+		opts.Cursor = resp.Cursor
+	}
+	if len(deliveries) == 0 {
+		return lastID, nil
+	}
+
+	succeeded := map[string]bool{}
+	for _, d := range deliveries {
+		if d.GetStatusCode() >= 200 && d.GetStatusCode() < 300 {
+			succeeded[d.GetGUID()] = true
+		}
+	}
+	for i := len(deliveries) - 1; i >= 0; i-- {
+		d := deliveries[i]
+		if d.GetRedelivery() || succeeded[d.GetGUID()] {
+			continue
+		}
+		if err := h.redeliver(ctx, d.GetID()); err != nil {
+			return lastID, fmt.Errorf("failed to redeliver the delivery %d, %w", d.GetID(), err)
+		}
+		succeeded[d.GetGUID()] = true
+		b.logger.Infow("redelivered a failed delivery", zap.String("hook", h.key), zap.Int64("deliveryID", d.GetID()),
+			zap.String("guid", d.GetGUID()), zap.String("event", d.GetEvent()), zap.Int("statusCode", d.GetStatusCode()))
+	}
+	return deliveries[0].GetID(), nil
+}
+
+// hooks returns the deliveries of the webhook of the GitHub App, or of the hooks of the organizations and the repositories
+func (b *backfiller) hooks() []hookDeliveries {
+	if b.appClient != nil {
+		apps := b.appClient.Apps
+		return []hookDeliveries{{
+			key:  appHookKey,
+			list: apps.ListHookDeliveries,
+			redeliver: func(ctx context.Context, deliveryID int64) error {
+				_, _, err := apps.RedeliverHookDelivery(ctx, deliveryID)
+				return err
+			},
+		}}
+	}
+
+	router := b.router
+	router.hookIDsLock.Lock()
+	defer router.hookIDsLock.Unlock()
+	var result []hookDeliveries
+	for org, id := range router.orgHookIDs {
+		result = append(result, hookDeliveries{
+			key: "orgs/" + org,
+			list: func(ctx context.Context, opts *gh.ListCursorOptions) ([]*gh.HookDelivery, *gh.Response, error) {
+				return router.githubClient.Organizations.ListHookDeliveries(ctx, org, id, opts)
+			},
+			redeliver: func(ctx context.Context, deliveryID int64) error {
+				_, _, err := router.githubClient.Organizations.RedeliverHookDelivery(ctx, org, id, deliveryID)
+				return err
+			},
+		})
+	}
+	for r, id := range router.repoHookIDs {
+		owner, name, _ := strings.Cut(r, ",")
+		result = append(result, hookDeliveries{
+			key: "repos/" + owner + "/" + name,
+			list: func(ctx context.Context, opts *gh.ListCursorOptions) ([]*gh.HookDelivery, *gh.Response, error) {
+				return router.githubClient.Repositories.ListHookDeliveries(ctx, owner, name, id, opts)
+			},
+			redeliver: func(ctx context.Context, deliveryID int64) error {
+				_, _, err := router.githubClient.Repositories.RedeliverHookDelivery(ctx, owner, name, id, deliveryID)
+				return err
+			},
+		})
+	}
+	return result
+}
+
+func (b *backfiller) loadState() (*backfillState, error) {
+	state := &backfillState{LastDeliveryIDs: map[string]int64{}}
+	if !b.persistence.IsEnabled() {
+		return state, nil
+	}
+	event, err := b.persistence.Get(b.persistenceKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the persisted state, %w", err)
+	}
+	if event == nil || event.EventPayload == "" {
+		return state, nil
+	}
+	if err := json.Unmarshal([]byte(event.EventPayload), state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the persisted state, %w", err)
+	}
+	if state.LastDeliveryIDs == nil {
+		state.LastDeliveryIDs = map[string]int64{}
+	}
+	return state, nil
+}
+
+func (b *backfiller) saveState() error {
+	if !b.persistence.IsEnabled() {
+		return nil
+	}
+	payload, err := json.Marshal(b.state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the state, %w", err)
+	}
+	if err := b.persistence.Save(&persist.Event{EventKey: b.persistenceKey, EventPayload: string(payload)}); err != nil {
+		return fmt.Errorf("failed to persist the state, %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v50/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/argoproj/argo-events/pkg/eventsources/persist"
+)
+
+func TestBackfillHook(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	delivery := func(id int64, guid string, statusCode int, redelivery bool, hoursAgo int) *gh.HookDelivery {
+		return &gh.HookDelivery{
+			ID:          gh.Int64(id),
+			GUID:        gh.String(guid),
+			StatusCode:  gh.Int(statusCode),
+			Redelivery:  gh.Bool(redelivery),
+			DeliveredAt: &gh.Timestamp{Time: now.Add(-time.Duration(hoursAgo) * time.Hour)},
+		}
+	}
+	// most recent first, over two pages.
+	pages := [][]*gh.HookDelivery{
+		{
+			delivery(9, "g7", 200, true, 1),
+			delivery(8, "g8", 502, false, 1),
+			delivery(7, "g7", 502, false, 2),
+		},
+		{
+			delivery(6, "g6", 200, false, 3),
+			delivery(5, "g5", 0, false, 4),
+			delivery(4, "g4", 502, false, 30),
+		},
+	}
+	var redelivered []int64
+	h := hookDeliveries{
+		key: "repos/argoproj/argo-events",
+		list: func(ctx context.Context, opts *gh.ListCursorOptions) ([]*gh.HookDelivery, *gh.Response, error) {
+			if opts.Cursor == "" {
+				return pages[0], &gh.Response{Cursor: "next"}, nil
+			}
+			return pages[1], &gh.Response{}, nil
+		},
+		redeliver: func(ctx context.Context, deliveryID int64) error {
+			redelivered = append(redelivered, deliveryID)
+			return nil
+		},
+	}
+	b := &backfiller{logger: zaptest.NewLogger(t).Sugar(), maxAge: 24 * time.Hour}
+
+	// the failed deliveries within the max age are redelivered, from the oldest one, except the ones
+	// redelivered successfully.
+	lastID, err := b.backfillHook(context.Background(), h, 0, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), lastID)
+	assert.Equal(t, []int64{5, 8}, redelivered)
+
+	// the deliveries before the last delivery seen are not redelivered.
+	redelivered = nil
+	lastID, err = b.backfillHook(context.Background(), h, 8, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), lastID)
+	assert.Empty(t, redelivered)
+
+	// the last delivery seen is unchanged when a redelivery fails.
+	h.redeliver = func(ctx context.Context, deliveryID int64) error { return fmt.Errorf("rate limited") }
+	lastID, err = b.backfillHook(context.Background(), h, 6, now)
+	assert.Error(t, err)
+	assert.Equal(t, int64(6), lastID)
+}
+
+func TestBackfillState(t *testing.T) {
+	persistence := &fakePersist{events: map[string]*persist.Event{}}
+	b := &backfiller{persistence: persistence, persistenceKey: "github.example"}
+	state, err := b.loadState()
+	require.NoError(t, err)
+	assert.Empty(t, state.LastDeliveryIDs)
+
+	b.state = state
+	b.state.LastDeliveryIDs["repos/argoproj/argo-events"] = 9
+	require.NoError(t, b.saveState())
+	assert.JSONEq(t, `{"lastDeliveryIDs":{"repos/argoproj/argo-events":9}}`, persistence.events["github.example"].EventPayload)
+
+	state, err = b.loadState()
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), state.LastDeliveryIDs["repos/argoproj/argo-events"])
+}
+
+type fakePersist struct {
+	events map[string]*persist.Event
+}
+
+func (f *fakePersist) Save(event *persist.Event) error {
+	f.events[event.EventKey] = event
+	return nil
+}
+
+func (f *fakePersist) Get(key string) (*persist.Event, error) {
+	return f.events[key], nil
+}
+
+func (f *fakePersist) IsEnabled() bool {
+	return true
+}
@@ -32,3 +32,47 @@ func getHook(hooks []*gh.Hook, url string, event []string) *gh.Hook {
 
 	return nil
 }
+
+// findHook returns the hook with the id, or the hook with the url, preferably with the same events,
+// or nil if not found.
+func findHook(hooks []*gh.Hook, id int64, url string, events []string) *gh.Hook {
+	if id != 0 {
+		for _, hook := range hooks {
+			if hook.GetID() == id {
+				return hook
+			}
+		}
+	}
+	if hook := getHook(hooks, url, events); hook != nil {
+		return hook
+	}
+	for _, hook := range hooks {
+		if hook.Config["url"] == url {
+			return hook
+		}
+	}
+	return nil
+}
+
+// hookNeedsUpdate returns true if the url, the events, the status, the content type,
+// the TLS verification or the presence of the secret of the hook differ from the desired hook.
+func hookNeedsUpdate(hook, desired *gh.Hook) bool {
+	if hook.Config["url"] != desired.Config["url"] {
+		return true
+	}
+	if !sharedutil.ElementsMatch(hook.Events, desired.Events) &&
+		!(sharedutil.SliceContains(hook.Events, "*") && sharedutil.SliceContains(desired.Events, "*")) {
+		return true
+	}
+	if hook.GetActive() != desired.GetActive() {
+		return true
+	}
+	for _, k := range []string{"content_type", "insecure_ssl"} {
+		if v, ok := desired.Config[k]; ok && hook.Config[k] != v {
+			return true
+		}
+	}
+	_, hasSecret := hook.Config["secret"]
+	_, wantSecret := desired.Config["secret"]
+	return hasSecret != wantSecret
+}
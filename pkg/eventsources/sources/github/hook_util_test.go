@@ -51,3 +51,63 @@ func TestGetHook(t *testing.T) {
 	assert.Equal(t, hooks[1], getHook(hooks, "https://example.com/", []string{"*"}))
 	assert.Nil(t, getHook(hooks, "https://example.com/", []string{"does_not_exist"}))
 }
+
+func TestFindHook(t *testing.T) {
+	hooks := []*gh.Hook{
+		{
+			ID:     gh.Int64(1),
+			Config: map[string]interface{}{"url": "https://example.com/"},
+			Events: []string{"pull_request"},
+		},
+		{
+			ID:     gh.Int64(2),
+			Config: map[string]interface{}{"url": "https://example.com/"},
+			Events: []string{"push"},
+		},
+		{
+			ID:     gh.Int64(3),
+			Config: map[string]interface{}{"url": "https://changed.example.com/"},
+			Events: []string{"push"},
+		},
+	}
+
+	assert.Equal(t, hooks[2], findHook(hooks, 3, "https://example.com/", []string{"push"}))
+	assert.Equal(t, hooks[1], findHook(hooks, 4, "https://example.com/", []string{"push"}))
+	assert.Equal(t, hooks[0], findHook(hooks, 0, "https://example.com/", []string{"issues"}))
+	assert.Nil(t, findHook(hooks, 0, "https://other.example.com/", []string{"push"}))
+}
+
+func TestHookNeedsUpdate(t *testing.T) {
+	desired := &gh.Hook{
+		Active: gh.Bool(true),
+		Events: []string{"push", "pull_request"},
+		Config: map[string]interface{}{
+			"url":          "https://example.com/",
+			"content_type": "json",
+			"insecure_ssl": "0",
+			"secret":       "secret",
+		},
+	}
+	hook := func(modify func(*gh.Hook)) *gh.Hook {
+		h := &gh.Hook{
+			Active: gh.Bool(true),
+			Events: []string{"pull_request", "push"},
+			Config: map[string]interface{}{
+				"url":          "https://example.com/",
+				"content_type": "json",
+				"insecure_ssl": "0",
+				"secret":       "********",
+			},
+		}
+		modify(h)
+		return h
+	}
+
+	assert.False(t, hookNeedsUpdate(hook(func(h *gh.Hook) {}), desired))
+	assert.True(t, hookNeedsUpdate(hook(func(h *gh.Hook) { h.Config["url"] = "https://changed.example.com/" }), desired))
+	assert.True(t, hookNeedsUpdate(hook(func(h *gh.Hook) { h.Events = []string{"push"} }), desired))
+	assert.True(t, hookNeedsUpdate(hook(func(h *gh.Hook) { h.Active = gh.Bool(false) }), desired))
+	assert.True(t, hookNeedsUpdate(hook(func(h *gh.Hook) { h.Config["content_type"] = "form" }), desired))
+	assert.True(t, hookNeedsUpdate(hook(func(h *gh.Hook) { h.Config["insecure_ssl"] = "1" }), desired))
+	assert.True(t, hookNeedsUpdate(hook(func(h *gh.Hook) { delete(h.Config, "secret") }), desired))
+}
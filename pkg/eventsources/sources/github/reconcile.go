@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gh "github.com/google/go-github/v50/github"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultHookReconcileInterval and defaultHookReconcileCount are the interval and the number of the
+	// reconciliations of the hooks after the event source starts, if no interval is specified.
+	defaultHookReconcileInterval = 60 * time.Second
+	defaultHookReconcileCount    = 10
+	// hooksPageSize is the number of hooks listed per page
+	hooksPageSize = 100
+)
+
+// runHooksReconciler reconciles the hooks periodically.
+// Another kind of race conditions might happen when pods do rolling upgrade - new pod starts
+// and old pod terminates, if DeleteHookOnFinish is true, the hook will be deleted from github.
+// The reconciliation also mitigates the race conditions.
+func (router *Router) runHooksReconciler(ctx context.Context, logger *zap.SugaredLogger, hook *gh.Hook, url string) {
+	interval, count := defaultHookReconcileInterval, defaultHookReconcileCount
+	if router.githubEventSource.HookReconcileInterval != "" {
+		// the interval is validated.
+		interval, _ = time.ParseDuration(router.githubEventSource.HookReconcileInterval)
+		count = 0
+	}
+	logger.Info("starting github hooks manager daemon")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for i := 0; count == 0 || i < count; i++ {
+		select {
+		case <-ctx.Done():
+			logger.Info("exiting github hooks manager daemon")
+			return
+		case <-ticker.C:
+			router.reconcileHooks(ctx, logger, hook, url, false)
+		}
+	}
+	logger.Info("exiting github hooks manager daemon")
+}
+
+// reconcileHooks creates the hooks of the organizations and the repositories which do not exist,
+// and fixes the misconfigured ones. With updateSecret, the existing hooks are updated to set the secret.
+func (router *Router) reconcileHooks(ctx context.Context, logger *zap.SugaredLogger, hook *gh.Hook, url string, updateSecret bool) {
+	githubEventSource := router.githubEventSource
+	for _, org := range githubEventSource.Organizations {
+		hooks, _, err := router.githubClient.Organizations.ListHooks(ctx, org, &gh.ListOptions{PerPage: hooksPageSize})
+		if err != nil {
+			logger.Errorf("failed to list existing webhooks of organization %s. err: %+v", org, err)
+			continue
+		}
+		router.hookIDsLock.Lock()
+		id := router.orgHookIDs[org]
+		router.hookIDsLock.Unlock()
+		id, err = reconcileHook(logger, "organization "+org, hooks, id, hook, url, updateSecret,
+			func(h *gh.Hook) (*gh.Hook, error) {
+				h, _, err := router.githubClient.Organizations.CreateHook(ctx, org, h)
+				return h, err
+			},
+			func(id int64, h *gh.Hook) error {
+				_, _, err := router.githubClient.Organizations.EditHook(ctx, org, id, h)
+				return err
+			})
+		if err != nil {
+			logger.Errorw("failed to reconcile the github webhook", zap.Error(err))
+		}
+		if id != 0 {
+			router.hookIDsLock.Lock()
+			router.orgHookIDs[org] = id
+			router.hookIDsLock.Unlock()
+		}
+	}
+
+	for _, r := range githubEventSource.GetOwnedRepositories() {
+		for _, name := range r.Names {
+			hooks, _, err := router.githubClient.Repositories.ListHooks(ctx, r.Owner, name, &gh.ListOptions{PerPage: hooksPageSize})
+			if err != nil {
+				logger.Errorf("failed to list existing webhooks of %s/%s. err: %+v", r.Owner, name, err)
+				continue
+			}
+			key := r.Owner + "," + name
+			router.hookIDsLock.Lock()
+			id := router.repoHookIDs[key]
+			router.hookIDsLock.Unlock()
+			id, err = reconcileHook(logger, r.Owner+"/"+name, hooks, id, hook, url, updateSecret,
+				func(h *gh.Hook) (*gh.Hook, error) {
+					h, _, err := router.githubClient.Repositories.CreateHook(ctx, r.Owner, name, h)
+					return h, err
+				},
+				func(id int64, h *gh.Hook) error {
+					_, _, err := router.githubClient.Repositories.EditHook(ctx, r.Owner, name, id, h)
+					return err
+				})
+			if err != nil {
+				logger.Errorw("failed to reconcile the github webhook", zap.Error(err))
+			}
+			if id != 0 {
+				router.hookIDsLock.Lock()
+				router.repoHookIDs[key] = id
+				router.hookIDsLock.Unlock()
+			}
+		}
+	}
+}
+
+// reconcileHook creates the hook if it is not in the hooks, or updates it if it is misconfigured,
+// and returns its ID, or 0 if it could not be created.
+func reconcileHook(logger *zap.SugaredLogger, name string, hooks []*gh.Hook, id int64, desired *gh.Hook, url string, updateSecret bool,
+	create func(*gh.Hook) (*gh.Hook, error), edit func(int64, *gh.Hook) error) (int64, error) {
+	h := findHook(hooks, id, url, desired.Events)
+	if h == nil {
+		logger.Infof("hook not found for %s, creating ...", name)
+		created, err := create(desired)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create github webhook for %s. err: %w", name, err)
+		}
+		time.Sleep(500 * time.Millisecond)
+		return created.GetID(), nil
+	}
+	_, hasSecret := desired.Config["secret"]
+	if hookNeedsUpdate(h, desired) || (updateSecret && hasSecret) {
+		logger.Infof("updating the hook %d of %s ...", h.GetID(), name)
+		if err := edit(h.GetID(), desired); err != nil {
+			return h.GetID(), fmt.Errorf("failed to update github webhook %d for %s. err: %w", h.GetID(), name, err)
+		}
+	}
+	return h.GetID(), nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	gh "github.com/google/go-github/v50/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestReconcileHook(t *testing.T) {
+	logger := zaptest.NewLogger(t).Sugar()
+	desired := &gh.Hook{
+		Active: gh.Bool(true),
+		Events: []string{"push"},
+		Config: map[string]interface{}{"url": "https://example.com/", "secret": "secret"},
+	}
+	var created, edited []int64
+	create := func(h *gh.Hook) (*gh.Hook, error) {
+		created = append(created, 10)
+		return &gh.Hook{ID: gh.Int64(10)}, nil
+	}
+	edit := func(id int64, h *gh.Hook) error {
+		edited = append(edited, id)
+		return nil
+	}
+	upToDate := &gh.Hook{
+		ID:     gh.Int64(1),
+		Active: gh.Bool(true),
+		Events: []string{"push"},
+		Config: map[string]interface{}{"url": "https://example.com/", "secret": "********"},
+	}
+	misconfigured := &gh.Hook{
+		ID:     gh.Int64(2),
+		Active: gh.Bool(true),
+		Events: []string{"push"},
+		Config: map[string]interface{}{"url": "https://changed.example.com/"},
+	}
+
+	// the deleted hook is recreated.
+	id, err := reconcileHook(logger, "argoproj/argo-events", nil, 1, desired, "https://example.com/", false, create, edit)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), id)
+	assert.Equal(t, []int64{10}, created)
+
+	// the hook up to date is not updated, unless the secret is updated.
+	id, err = reconcileHook(logger, "argoproj/argo-events", []*gh.Hook{upToDate}, 1, desired, "https://example.com/", false, create, edit)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+	assert.Empty(t, edited)
+	_, err = reconcileHook(logger, "argoproj/argo-events", []*gh.Hook{upToDate}, 1, desired, "https://example.com/", true, create, edit)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, edited)
+
+	// the hook whose url was changed is fixed.
+	id, err = reconcileHook(logger, "argoproj/argo-events", []*gh.Hook{misconfigured}, 2, desired, "https://example.com/", false, create, edit)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), id)
+	assert.Equal(t, []int64{1, 2}, edited)
+
+	_, err = reconcileHook(logger, "argoproj/argo-events", nil, 0, desired, "https://example.com/", false,
+		func(h *gh.Hook) (*gh.Hook, error) { return nil, fmt.Errorf("forbidden") }, edit)
+	assert.Error(t, err)
+}
@@ -163,7 +163,14 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 		return
 	}
 
-	webhook.DispatchEvent(route, eventBody, logger, writer)
+	var opts []eventsourcecommon.Option
+	if router.githubEventSource.Backfill != nil {
+		// the redeliveries have the same delivery ID, which makes them deduplicated by the event bus.
+		if deliveryID := request.Header.Get(githubDeliveryHeader); deliveryID != "" {
+			opts = append(opts, eventsourcecommon.WithID(deliveryID))
+		}
+	}
+	webhook.DispatchEvents(request.Context(), route, []*webhook.Dispatch{{Data: eventBody, Options: opts}}, logger, writer)
 }
 
 // PostActivate performs operations once the route is activated and ready to consume requests
@@ -179,6 +186,9 @@ func (router *Router) PostInactivate() error {
 		logger := router.route.Logger
 		logger.Info("deleting GitHub org hooks...")
 
+		router.hookIDsLock.Lock()
+		defer router.hookIDsLock.Unlock()
+
 		for _, org := range githubEventSource.Organizations {
 			id, ok := router.orgHookIDs[org]
 			if !ok {
@@ -233,6 +243,9 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		router.hookSecret = webhookSecretCreds.secret
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	if githubEventSource.NeedToCreateHooks() {
 		// create webhooks
 
@@ -242,7 +255,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		// 3. Configure the hook with url, content type, ssl etc.
 		// 4. Set up a GitHub client
 		// 5. Set the base and upload url for the client
-		// 6. Create the hook if one doesn't exist already. If exists already, then use that one.
+		// 6. Create the hook if one doesn't exist already. If exists already, then fix it if it is misconfigured.
 
 		baseURL, err := parseUrlWithSlash(&githubEventSource.GithubBaseURL)
 		if err != nil {
@@ -268,7 +281,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		logger.Info("configuring GitHub hook...")
 		formattedURL := sharedutil.FormattedURL(githubEventSource.Webhook.URL, githubEventSource.Webhook.Endpoint)
 		hookConfig := map[string]interface{}{
-			"url": &formattedURL,
+			"url": formattedURL,
 		}
 		if githubEventSource.ContentType != "" {
 			hookConfig["content_type"] = githubEventSource.ContentType
@@ -301,77 +314,26 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			Config: hookConfig,
 		}
 
-		ctx, cancel := context.WithCancel(ctx)
-		defer cancel()
-
-		f := func() {
-			for _, org := range githubEventSource.Organizations {
-				hooks, _, err := router.githubClient.Organizations.ListHooks(ctx, org, nil)
-				if err != nil {
-					logger.Errorf("failed to list existing webhooks of organization %s. err: %+v", org, err)
-					continue
-				}
-				h := getHook(hooks, formattedURL, githubEventSource.Events)
-				if h != nil {
-					router.orgHookIDs[org] = *h.ID
-					continue
-				}
-				logger.Infof("hook not found for organization %s, creating ...", org)
-				h, _, err = router.githubClient.Organizations.CreateHook(ctx, org, hook)
-				if err != nil {
-					logger.Errorf("failed to create github webhook for organization %s. err: %+v", org, err)
-					continue
-				}
-				router.orgHookIDs[org] = *h.ID
-				time.Sleep(500 * time.Millisecond)
-			}
-
-			for _, r := range githubEventSource.GetOwnedRepositories() {
-				for _, name := range r.Names {
-					hooks, _, err := router.githubClient.Repositories.ListHooks(ctx, r.Owner, name, nil)
-					if err != nil {
-						logger.Errorf("failed to list existing webhooks of %s/%s. err: %+v", r.Owner, name, err)
-						continue
-					}
-					h := getHook(hooks, formattedURL, githubEventSource.Events)
-					if h != nil {
-						router.repoHookIDs[r.Owner+","+name] = *h.ID
-						continue
-					}
-					logger.Infof("hook not found for %s/%s, creating ...", r.Owner, name)
-					h, _, err = router.githubClient.Repositories.CreateHook(ctx, r.Owner, name, hook)
-					if err != nil {
-						logger.Errorf("failed to create github webhook for %s/%s. err: %+v", r.Owner, name, err)
-						continue
-					}
-					router.repoHookIDs[r.Owner+","+name] = *h.ID
-					time.Sleep(500 * time.Millisecond)
-				}
-			}
-		}
-
 		// Github can not handle race conditions well - it might create multiple hooks with same config
 		// when replicas > 1
 		// Randomly sleep some time to mitigate the issue.
 		randomNum, _ := rand.Int(rand.Reader, big.NewInt(int64(2000)))
 		time.Sleep(time.Duration(randomNum.Int64()) * time.Millisecond)
-		f()
-
-		go func() {
-			// Another kind of race conditions might happen when pods do rolling upgrade - new pod starts
-			// and old pod terminates, if DeleteHookOnFinish is true, the hook will be deleted from github.
-			// This is a workaround to mitigate the race conditions.
-			logger.Info("starting github hooks manager daemon")
-			for i := 0; i < 10; i++ {
-				time.Sleep(60 * time.Second)
-				f()
-			}
-			logger.Info("exiting github hooks manager daemon")
-		}()
+		router.reconcileHooks(ctx, logger, hook, formattedURL, true)
+
+		go router.runHooksReconciler(ctx, logger, hook, formattedURL)
 	} else {
 		logger.Info("no need to create webhooks")
 	}
 
+	if githubEventSource.Backfill != nil {
+		b, err := el.newBackfiller(ctx, router, logger)
+		if err != nil {
+			return fmt.Errorf("failed to set up the backfill of the deliveries, %w", err)
+		}
+		go b.run(ctx)
+	}
+
 	return webhook.ManageRoute(ctx, router, controller, dispatch)
 }
 
@@ -17,6 +17,7 @@ package github
 
 import (
 	"net/http"
+	"sync"
 
 	"github.com/google/go-github/v50/github"
 
@@ -30,6 +31,7 @@ type EventListener struct {
 	EventSourceName   string
 	EventName         string
 	GithubEventSource v1alpha1.GithubEventSource
+	Namespace         string
 	Metrics           *metrics.Metrics
 }
 
@@ -56,6 +58,8 @@ type Router struct {
 	githubEventSource *v1alpha1.GithubEventSource
 	// githubClient is the client to connect to GitHub
 	githubClient *github.Client
+	// hookIDsLock protects repoHookIDs and orgHookIDs, updated by the hooks reconciler
+	hookIDsLock sync.Mutex
 	// (owner + "," + repo name) -> hook ID
 	repoHookIDs map[string]int64
 	// org name -> hook ID
@@ -16,6 +16,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
@@ -56,5 +57,44 @@ func validate(githubEventSource *v1alpha1.GithubEventSource) error {
 			return fmt.Errorf("githubUploadURL is required when githubBaseURL is set")
 		}
 	}
+	if githubEventSource.HookReconcileInterval != "" {
+		interval, err := time.ParseDuration(githubEventSource.HookReconcileInterval)
+		if err != nil {
+			return fmt.Errorf("failed to parse hookReconcileInterval, %w", err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("hookReconcileInterval must be positive")
+		}
+	}
+	if err := validateBackfill(githubEventSource); err != nil {
+		return err
+	}
 	return webhook.ValidateWebhookContext(githubEventSource.Webhook)
 }
+
+func validateBackfill(githubEventSource *v1alpha1.GithubEventSource) error {
+	backfill := githubEventSource.Backfill
+	if backfill == nil {
+		return nil
+	}
+	if backfill.AppHook {
+		if !githubEventSource.HasGithubAppCreds() {
+			return fmt.Errorf("backfill of the app hook requires githubApp")
+		}
+	} else if !githubEventSource.NeedToCreateHooks() {
+		return fmt.Errorf("backfill requires the hooks to be created by the event source")
+	}
+	for name, value := range map[string]string{"interval": backfill.Interval, "maxAge": backfill.MaxAge} {
+		if value == "" {
+			continue
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("failed to parse backfill %s, %w", name, err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("backfill %s must be positive", name)
+		}
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/argoproj/argo-events/pkg/eventsources/sources"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestValidateEventSource(t *testing.T) {
@@ -51,3 +52,38 @@ func TestValidateEventSource(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestValidateHooksReconciliationAndBackfill(t *testing.T) {
+	eventSource := func(modify func(*v1alpha1.GithubEventSource)) *v1alpha1.GithubEventSource {
+		es := &v1alpha1.GithubEventSource{
+			Repositories: []v1alpha1.OwnedRepositories{{Owner: "argoproj", Names: []string{"argo-events"}}},
+			Events:       []string{"push"},
+			APIToken:     &corev1.SecretKeySelector{Key: "token"},
+			Webhook:      &v1alpha1.WebhookContext{Endpoint: "/push", Port: "12000", Method: "POST", URL: "https://example.com"},
+		}
+		modify(es)
+		return es
+	}
+
+	assert.NoError(t, validate(eventSource(func(es *v1alpha1.GithubEventSource) {
+		es.HookReconcileInterval = "5m"
+		es.Backfill = &v1alpha1.GithubDeliveryBackfill{Interval: "10m", MaxAge: "48h"}
+	})))
+	assert.Error(t, validate(eventSource(func(es *v1alpha1.GithubEventSource) { es.HookReconcileInterval = "5" })))
+	assert.Error(t, validate(eventSource(func(es *v1alpha1.GithubEventSource) { es.HookReconcileInterval = "-5m" })))
+	assert.Error(t, validate(eventSource(func(es *v1alpha1.GithubEventSource) {
+		es.Backfill = &v1alpha1.GithubDeliveryBackfill{MaxAge: "1d"}
+	})))
+	assert.Error(t, validate(eventSource(func(es *v1alpha1.GithubEventSource) {
+		es.APIToken = nil
+		es.Backfill = &v1alpha1.GithubDeliveryBackfill{}
+	})))
+	assert.Error(t, validate(eventSource(func(es *v1alpha1.GithubEventSource) {
+		es.Backfill = &v1alpha1.GithubDeliveryBackfill{AppHook: true}
+	})))
+	assert.NoError(t, validate(eventSource(func(es *v1alpha1.GithubEventSource) {
+		es.APIToken = nil
+		es.GithubApp = &v1alpha1.GithubAppCreds{PrivateKey: &corev1.SecretKeySelector{Key: "key"}, AppID: 1, InstallationID: 2}
+		es.Backfill = &v1alpha1.GithubDeliveryBackfill{AppHook: true}
+	})))
+}
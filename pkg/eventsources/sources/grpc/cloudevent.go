@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+)
+
+// specVersion is the only CloudEvents spec version accepted.
+const specVersion = "1.0"
+
+// cloudEvent is an io.cloudevents.v1.CloudEvent with its attributes and data converted to JSON values.
+type cloudEvent struct {
+	ID          string
+	Source      string
+	SpecVersion string
+	Type        string
+	Attributes  map[string]interface{}
+	// Data is the JSON representation of the data, nil if the event has no data.
+	Data *json.RawMessage
+}
+
+// newCloudEvent converts a CloudEvent received by the service.
+func newCloudEvent(pb *CloudEvent) (*cloudEvent, error) {
+	ce := &cloudEvent{
+		ID:          pb.GetId(),
+		Source:      pb.GetSource(),
+		SpecVersion: pb.GetSpecVersion(),
+		Type:        pb.GetType(),
+	}
+	for key, v := range pb.GetAttributes() {
+		if key == "" {
+			return nil, fmt.Errorf("invalid attribute, empty attribute name")
+		}
+		value, err := attributeValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid attribute %q, %w", key, err)
+		}
+		if ce.Attributes == nil {
+			ce.Attributes = map[string]interface{}{}
+		}
+		ce.Attributes[key] = value
+	}
+	contentType, _ := ce.Attributes["datacontenttype"].(string)
+	var err error
+	switch data := pb.GetData().(type) {
+	case *CloudEvent_BinaryData:
+		ce.Data, err = dataJSON(contentType, data.BinaryData, false)
+	case *CloudEvent_TextData:
+		ce.Data, err = dataJSON(contentType, []byte(data.TextData), true)
+	case *CloudEvent_ProtoData:
+		// the message type is not known, the data is kept in the JSON encoding of an unresolved google.protobuf.Any.
+		ce.Data, err = marshalRaw(map[string]string{
+			"@type": data.ProtoData.GetTypeUrl(),
+			"value": base64.StdEncoding.EncodeToString(data.ProtoData.GetValue()),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ce, nil
+}
+
+// attributeValue returns the value of an attribute, the timestamps are formatted in RFC 3339.
+func attributeValue(v *CloudEvent_CloudEventAttributeValue) (interface{}, error) {
+	switch attr := v.GetAttr().(type) {
+	case *CloudEvent_CloudEventAttributeValue_CeBoolean:
+		return attr.CeBoolean, nil
+	case *CloudEvent_CloudEventAttributeValue_CeInteger:
+		return attr.CeInteger, nil
+	case *CloudEvent_CloudEventAttributeValue_CeString:
+		return attr.CeString, nil
+	case *CloudEvent_CloudEventAttributeValue_CeBytes:
+		return attr.CeBytes, nil
+	case *CloudEvent_CloudEventAttributeValue_CeUri:
+		return attr.CeUri, nil
+	case *CloudEvent_CloudEventAttributeValue_CeUriRef:
+		return attr.CeUriRef, nil
+	case *CloudEvent_CloudEventAttributeValue_CeTimestamp:
+		if err := attr.CeTimestamp.CheckValid(); err != nil {
+			return nil, err
+		}
+		return attr.CeTimestamp.AsTime().UTC().Format(time.RFC3339Nano), nil
+	}
+	return nil, fmt.Errorf("no value")
+}
+
+// unmarshalCloudEvent decodes a CloudEvent in the protobuf binary encoding.
+func unmarshalCloudEvent(b []byte) (*cloudEvent, error) {
+	pb := &CloudEvent{}
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return nil, err
+	}
+	return newCloudEvent(pb)
+}
+
+// unmarshalCloudEventJSON decodes a CloudEvent in the protobuf JSON encoding. The proto data is kept
+// as it is received, its message type is not known to decode the google.protobuf.Any.
+func unmarshalCloudEventJSON(b []byte) (*cloudEvent, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	var protoData json.RawMessage
+	for _, name := range []string{"protoData", "proto_data"} {
+		if v, ok := fields[name]; ok {
+			protoData = v
+			delete(fields, name)
+		}
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	pb := &CloudEvent{}
+	if err := protojson.Unmarshal(b, pb); err != nil {
+		return nil, err
+	}
+	ce, err := newCloudEvent(pb)
+	if err != nil {
+		return nil, err
+	}
+	if protoData != nil && pb.GetData() == nil {
+		ce.Data = &protoData
+	}
+	return ce, nil
+}
+
+// dataJSON returns the JSON representation of the data. JSON data is kept as is, otherwise the
+// data is encoded as a string if it is text, or a base64 string.
+func dataJSON(contentType string, data []byte, text bool) (*json.RawMessage, error) {
+	if isJSON(contentType) && json.Valid(data) {
+		raw := json.RawMessage(data)
+		return &raw, nil
+	}
+	if text {
+		return marshalRaw(string(data))
+	}
+	return marshalRaw(data)
+}
+
+func marshalRaw(v interface{}) (*json.RawMessage, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	raw := json.RawMessage(b)
+	return &raw, nil
+}
+
+// isJSON returns true if the content type is JSON.
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || mediaType == "text/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// validate checks the required attributes of the event.
+func (ce *cloudEvent) validate() error {
+	switch {
+	case ce.ID == "":
+		return fmt.Errorf("id is required")
+	case ce.Source == "":
+		return fmt.Errorf("source is required")
+	case ce.Type == "":
+		return fmt.Errorf("type is required")
+	case ce.SpecVersion != specVersion:
+		return fmt.Errorf("spec version %q is not supported, only %q is", ce.SpecVersion, specVersion)
+	}
+	return nil
+}
+
+// eventData converts the event to the event data dispatched to the EventBus.
+func (ce *cloudEvent) eventData(metadata map[string]string) *events.GRPCEventData {
+	return &events.GRPCEventData{
+		ID:          ce.ID,
+		Source:      ce.Source,
+		SpecVersion: ce.SpecVersion,
+		Type:        ce.Type,
+		Attributes:  ce.Attributes,
+		Body:        ce.Data,
+		Metadata:    metadata,
+	}
+}
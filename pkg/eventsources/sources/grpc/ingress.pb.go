@@ -0,0 +1,132 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.27.2
+// source: ingress.proto
+
+package grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PublishResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of the published events.
+	Accepted      int64 `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishResponse) Reset() {
+	*x = PublishResponse{}
+	mi := &file_ingress_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishResponse) ProtoMessage() {}
+
+func (x *PublishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ingress_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishResponse.ProtoReflect.Descriptor instead.
+func (*PublishResponse) Descriptor() ([]byte, []int) {
+	return file_ingress_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PublishResponse) GetAccepted() int64 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+var File_ingress_proto protoreflect.FileDescriptor
+
+const file_ingress_proto_rawDesc = "" +
+	"\n" +
+	"\ringress.proto\x12\x17argoproj.events.grpc.v1\x1a\x11cloudevents.proto\"-\n" +
+	"\x0fPublishResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x03R\baccepted2\xc3\x01\n" +
+	"\x11CloudEventService\x12R\n" +
+	"\aPublish\x12\x1d.io.cloudevents.v1.CloudEvent\x1a(.argoproj.events.grpc.v1.PublishResponse\x12Z\n" +
+	"\rPublishStream\x12\x1d.io.cloudevents.v1.CloudEvent\x1a(.argoproj.events.grpc.v1.PublishResponse(\x01B?Z=github.com/argoproj/argo-events/pkg/eventsources/sources/grpcb\x06proto3"
+
+var (
+	file_ingress_proto_rawDescOnce sync.Once
+	file_ingress_proto_rawDescData []byte
+)
+
+func file_ingress_proto_rawDescGZIP() []byte {
+	file_ingress_proto_rawDescOnce.Do(func() {
+		file_ingress_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ingress_proto_rawDesc), len(file_ingress_proto_rawDesc)))
+	})
+	return file_ingress_proto_rawDescData
+}
+
+var file_ingress_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ingress_proto_goTypes = []any{
+	(*PublishResponse)(nil), // 0: argoproj.events.grpc.v1.PublishResponse
+	(*CloudEvent)(nil),      // 1: io.cloudevents.v1.CloudEvent
+}
+var file_ingress_proto_depIdxs = []int32{
+	1, // 0: argoproj.events.grpc.v1.CloudEventService.Publish:input_type -> io.cloudevents.v1.CloudEvent
+	1, // 1: argoproj.events.grpc.v1.CloudEventService.PublishStream:input_type -> io.cloudevents.v1.CloudEvent
+	0, // 2: argoproj.events.grpc.v1.CloudEventService.Publish:output_type -> argoproj.events.grpc.v1.PublishResponse
+	0, // 3: argoproj.events.grpc.v1.CloudEventService.PublishStream:output_type -> argoproj.events.grpc.v1.PublishResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ingress_proto_init() }
+func file_ingress_proto_init() {
+	if File_ingress_proto != nil {
+		return
+	}
+	file_cloudevents_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ingress_proto_rawDesc), len(file_ingress_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ingress_proto_goTypes,
+		DependencyIndexes: file_ingress_proto_depIdxs,
+		MessageInfos:      file_ingress_proto_msgTypes,
+	}.Build()
+	File_ingress_proto = out.File
+	file_ingress_proto_goTypes = nil
+	file_ingress_proto_depIdxs = nil
+}
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.2
+// source: ingress.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CloudEventService_Publish_FullMethodName       = "/argoproj.events.grpc.v1.CloudEventService/Publish"
+	CloudEventService_PublishStream_FullMethodName = "/argoproj.events.grpc.v1.CloudEventService/PublishStream"
+)
+
+// CloudEventServiceClient is the client API for CloudEventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CloudEventService is served by the gRPC event source. Publish is also served with the
+// Connect protocol, in both the binary (application/proto) and JSON (application/json) encodings.
+type CloudEventServiceClient interface {
+	// Publish publishes an event, it returns once the event is published to the EventBus.
+	Publish(ctx context.Context, in *CloudEvent, opts ...grpc.CallOption) (*PublishResponse, error)
+	// PublishStream publishes a stream of events, each event is published to the EventBus
+	// before the next one is received.
+	PublishStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CloudEvent, PublishResponse], error)
+}
+
+type cloudEventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCloudEventServiceClient(cc grpc.ClientConnInterface) CloudEventServiceClient {
+	return &cloudEventServiceClient{cc}
+}
+
+func (c *cloudEventServiceClient) Publish(ctx context.Context, in *CloudEvent, opts ...grpc.CallOption) (*PublishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishResponse)
+	err := c.cc.Invoke(ctx, CloudEventService_Publish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudEventServiceClient) PublishStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CloudEvent, PublishResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CloudEventService_ServiceDesc.Streams[0], CloudEventService_PublishStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CloudEvent, PublishResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CloudEventService_PublishStreamClient = grpc.ClientStreamingClient[CloudEvent, PublishResponse]
+
+// CloudEventServiceServer is the server API for CloudEventService service.
+// All implementations must embed UnimplementedCloudEventServiceServer
+// for forward compatibility.
+//
+// CloudEventService is served by the gRPC event source. Publish is also served with the
+// Connect protocol, in both the binary (application/proto) and JSON (application/json) encodings.
+type CloudEventServiceServer interface {
+	// Publish publishes an event, it returns once the event is published to the EventBus.
+	Publish(context.Context, *CloudEvent) (*PublishResponse, error)
+	// PublishStream publishes a stream of events, each event is published to the EventBus
+	// before the next one is received.
+	PublishStream(grpc.ClientStreamingServer[CloudEvent, PublishResponse]) error
+	mustEmbedUnimplementedCloudEventServiceServer()
+}
+
+// UnimplementedCloudEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCloudEventServiceServer struct{}
+
+func (UnimplementedCloudEventServiceServer) Publish(context.Context, *CloudEvent) (*PublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedCloudEventServiceServer) PublishStream(grpc.ClientStreamingServer[CloudEvent, PublishResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method PublishStream not implemented")
+}
+func (UnimplementedCloudEventServiceServer) mustEmbedUnimplementedCloudEventServiceServer() {}
+func (UnimplementedCloudEventServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeCloudEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CloudEventServiceServer will
+// result in compilation errors.
+type UnsafeCloudEventServiceServer interface {
+	mustEmbedUnimplementedCloudEventServiceServer()
+}
+
+func RegisterCloudEventServiceServer(s grpc.ServiceRegistrar, srv CloudEventServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCloudEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CloudEventService_ServiceDesc, srv)
+}
+
+func _CloudEventService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloudEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudEventServiceServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudEventService_Publish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudEventServiceServer).Publish(ctx, req.(*CloudEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudEventService_PublishStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CloudEventServiceServer).PublishStream(&grpc.GenericServerStream[CloudEvent, PublishResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CloudEventService_PublishStreamServer = grpc.ClientStreamingServer[CloudEvent, PublishResponse]
+
+// CloudEventService_ServiceDesc is the grpc.ServiceDesc for CloudEventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CloudEventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "argoproj.events.grpc.v1.CloudEventService",
+	HandlerType: (*CloudEventServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Publish",
+			Handler:    _CloudEventService_Publish_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PublishStream",
+			Handler:       _CloudEventService_PublishStream_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "ingress.proto",
+}
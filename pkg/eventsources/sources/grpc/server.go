@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// publisher publishes the events received by the server.
+type publisher interface {
+	// publish returns a gRPC status error if the event is not published.
+	publish(ce *cloudEvent) error
+	// authorize returns a gRPC status error if the authorization header is not valid.
+	authorize(authorization string) error
+}
+
+// cloudEventService serves the CloudEventService of ingress.proto.
+type cloudEventService struct {
+	UnimplementedCloudEventServiceServer
+	publisher publisher
+}
+
+func authorizeIncoming(ctx context.Context, p publisher) error {
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+	return p.authorize(authorization)
+}
+
+func (s *cloudEventService) Publish(ctx context.Context, in *CloudEvent) (*PublishResponse, error) {
+	if err := authorizeIncoming(ctx, s.publisher); err != nil {
+		return nil, err
+	}
+	ce, err := newCloudEvent(in)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode the event, %v", err)
+	}
+	if err := s.publisher.publish(ce); err != nil {
+		return nil, err
+	}
+	return &PublishResponse{Accepted: 1}, nil
+}
+
+func (s *cloudEventService) PublishStream(stream grpclib.ClientStreamingServer[CloudEvent, PublishResponse]) error {
+	if err := authorizeIncoming(stream.Context(), s.publisher); err != nil {
+		return err
+	}
+	var accepted int64
+	for {
+		in, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return stream.SendAndClose(&PublishResponse{Accepted: accepted})
+			}
+			return err
+		}
+		ce, err := newCloudEvent(in)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to decode the event after %d accepted events, %v", accepted, err)
+		}
+		if err := s.publisher.publish(ce); err != nil {
+			st := status.Convert(err)
+			return status.Errorf(st.Code(), "%s, %d events were accepted", st.Message(), accepted)
+		}
+		accepted++
+	}
+}
+
+// connectHandler serves the Publish method with the Connect protocol.
+type connectHandler struct {
+	publisher      publisher
+	maxMessageSize int64
+}
+
+func (h *connectHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != CloudEventService_Publish_FullMethodName {
+		writeConnectError(w, status.Errorf(codes.Unimplemented, "%s is not implemented", r.URL.Path))
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if contentType != "application/proto" && contentType != "application/json" {
+		w.Header().Set("Accept-Post", "application/proto, application/json")
+		http.Error(w, "unsupported content type", http.StatusUnsupportedMediaType)
+		return
+	}
+	if err := h.publisher.authorize(r.Header.Get("Authorization")); err != nil {
+		writeConnectError(w, err)
+		return
+	}
+	body := http.MaxBytesReader(w, r.Body, h.maxMessageSize)
+	var reader io.Reader = body
+	switch r.Header.Get("Content-Encoding") {
+	case "", "identity":
+	case "gzip":
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			writeConnectError(w, status.Errorf(codes.InvalidArgument, "invalid gzip body, %v", err))
+			return
+		}
+		defer gz.Close()
+		reader = io.LimitReader(gz, h.maxMessageSize+1)
+	default:
+		writeConnectError(w, status.Errorf(codes.Unimplemented, "content encoding %q is not supported", r.Header.Get("Content-Encoding")))
+		return
+	}
+	b, err := io.ReadAll(reader)
+	if err == nil && int64(len(b)) > h.maxMessageSize {
+		err = &http.MaxBytesError{Limit: h.maxMessageSize}
+	}
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeConnectError(w, status.Errorf(codes.ResourceExhausted, "message is larger than %d bytes", h.maxMessageSize))
+			return
+		}
+		writeConnectError(w, status.Errorf(codes.InvalidArgument, "failed to read the request, %v", err))
+		return
+	}
+	var ce *cloudEvent
+	if contentType == "application/json" {
+		ce, err = unmarshalCloudEventJSON(b)
+	} else {
+		ce, err = unmarshalCloudEvent(b)
+	}
+	if err != nil {
+		writeConnectError(w, status.Errorf(codes.InvalidArgument, "failed to decode the event, %v", err))
+		return
+	}
+	if err := h.publisher.publish(ce); err != nil {
+		writeConnectError(w, err)
+		return
+	}
+	resp := &PublishResponse{Accepted: 1}
+	if contentType == "application/json" {
+		b, err = protojson.Marshal(resp)
+	} else {
+		b, err = proto.Marshal(resp)
+	}
+	if err != nil {
+		writeConnectError(w, status.Errorf(codes.Internal, "failed to encode the response, %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write(b)
+}
+
+// connectCodes maps the gRPC codes to the Connect error codes and HTTP status codes.
+var connectCodes = map[codes.Code]struct {
+	name       string
+	httpStatus int
+}{
+	codes.InvalidArgument:   {"invalid_argument", http.StatusBadRequest},
+	codes.Unauthenticated:   {"unauthenticated", http.StatusUnauthorized},
+	codes.ResourceExhausted: {"resource_exhausted", http.StatusTooManyRequests},
+	codes.Unimplemented:     {"unimplemented", http.StatusNotImplemented},
+	codes.Unavailable:       {"unavailable", http.StatusServiceUnavailable},
+	codes.Internal:          {"internal", http.StatusInternalServerError},
+}
+
+func writeConnectError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	c, ok := connectCodes[st.Code()]
+	if !ok {
+		c = connectCodes[codes.Internal]
+	}
+	b, _ := json.Marshal(map[string]string{"code": c.name, "message": st.Message()})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(c.httpStatus)
+	_, _ = w.Write(b)
+}
+
+// newHandler returns the handler serving gRPC over HTTP/2, the Connect protocol and the health check.
+func newHandler(p publisher, maxMessageSize int64) http.Handler {
+	grpcServer := grpclib.NewServer(grpclib.MaxRecvMsgSize(int(maxMessageSize)))
+	RegisterCloudEventServiceServer(grpcServer, &cloudEventService{publisher: p})
+	connect := &connectHandler{publisher: p, maxMessageSize: maxMessageSize}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc"):
+			grpcServer.ServeHTTP(w, r)
+		case r.URL.Path == "/health" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte("OK"))
+		default:
+			connect.ServeHTTP(w, r)
+		}
+	})
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/metrics"
+)
+
+// newTestCloudEvent returns a CloudEvent with string attributes and text data.
+func newTestCloudEvent(id string, attributes map[string]string, text string) *CloudEvent {
+	pb := &CloudEvent{Id: id, Source: "/orders", SpecVersion: "1.0", Type: "order.created"}
+	for k, v := range attributes {
+		if pb.Attributes == nil {
+			pb.Attributes = map[string]*CloudEvent_CloudEventAttributeValue{}
+		}
+		pb.Attributes[k] = &CloudEvent_CloudEventAttributeValue{Attr: &CloudEvent_CloudEventAttributeValue_CeString{CeString: v}}
+	}
+	if text != "" {
+		pb.Data = &CloudEvent_TextData{TextData: text}
+	}
+	return pb
+}
+
+// encodeCloudEvent encodes a CloudEvent with string attributes and text data.
+func encodeCloudEvent(t *testing.T, id string, attributes map[string]string, text string) []byte {
+	t.Helper()
+	b, err := proto.Marshal(newTestCloudEvent(id, attributes, text))
+	require.NoError(t, err)
+	return b
+}
+
+func TestUnmarshalCloudEvent(t *testing.T) {
+	t.Run("json text data", func(t *testing.T) {
+		ce, err := unmarshalCloudEvent(encodeCloudEvent(t, "1", map[string]string{"datacontenttype": "application/json"}, `{"amount":10}`))
+		require.NoError(t, err)
+		assert.NoError(t, ce.validate())
+		assert.Equal(t, "1", ce.ID)
+		assert.Equal(t, "/orders", ce.Source)
+		assert.Equal(t, "order.created", ce.Type)
+		assert.Equal(t, "application/json", ce.Attributes["datacontenttype"])
+		assert.JSONEq(t, `{"amount":10}`, string(*ce.Data))
+	})
+
+	t.Run("text data", func(t *testing.T) {
+		ce, err := unmarshalCloudEvent(encodeCloudEvent(t, "1", nil, `hello`))
+		require.NoError(t, err)
+		assert.Equal(t, `"hello"`, string(*ce.Data))
+	})
+
+	t.Run("typed attributes and binary data", func(t *testing.T) {
+		pb := newTestCloudEvent("1", nil, "")
+		pb.Attributes = map[string]*CloudEvent_CloudEventAttributeValue{
+			"retry": {Attr: &CloudEvent_CloudEventAttributeValue_CeBoolean{CeBoolean: true}},
+			"time":  {Attr: &CloudEvent_CloudEventAttributeValue_CeTimestamp{CeTimestamp: timestamppb.New(time.Unix(1700000000, 0))}},
+		}
+		pb.Data = &CloudEvent_BinaryData{BinaryData: []byte{0xff, 0x00}}
+		ce, err := newCloudEvent(pb)
+		require.NoError(t, err)
+		assert.Equal(t, true, ce.Attributes["retry"])
+		assert.Equal(t, "2023-11-14T22:13:20Z", ce.Attributes["time"])
+		assert.Equal(t, `"/wA="`, string(*ce.Data))
+	})
+
+	t.Run("proto data", func(t *testing.T) {
+		pb := newTestCloudEvent("1", nil, "")
+		pb.Data = &CloudEvent_ProtoData{ProtoData: &anypb.Any{TypeUrl: "type.googleapis.com/shop.Order", Value: []byte{0x08, 0x01}}}
+		ce, err := newCloudEvent(pb)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"@type":"type.googleapis.com/shop.Order","value":"CAE="}`, string(*ce.Data))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := unmarshalCloudEvent([]byte{0x0a, 0x05, 'a'})
+		assert.Error(t, err)
+		ce, err := newCloudEvent(&CloudEvent{Id: "1"})
+		require.NoError(t, err)
+		assert.Error(t, ce.validate())
+	})
+}
+
+func TestUnmarshalCloudEventJSON(t *testing.T) {
+	ce, err := unmarshalCloudEventJSON([]byte(`{"id":"1","source":"/orders","specVersion":"1.0","type":"order.created",
+		"attributes":{"datacontenttype":{"ceString":"application/json"},"attempt":{"ceInteger":2}},"textData":"{\"amount\":10}"}`))
+	require.NoError(t, err)
+	assert.NoError(t, ce.validate())
+	assert.Equal(t, int32(2), ce.Attributes["attempt"])
+	assert.JSONEq(t, `{"amount":10}`, string(*ce.Data))
+
+	_, err = unmarshalCloudEventJSON([]byte(`{"id":"1","attributes":{"a":{}}}`))
+	assert.Error(t, err)
+}
+
+func newTestServer(t *testing.T, token string) (*httptest.Server, *eventsourcecommon.FakeDispatcher[events.GRPCEventData]) {
+	t.Helper()
+	f := &eventsourcecommon.FakeDispatcher[events.GRPCEventData]{}
+	el := &EventListener{EventSourceName: "es", EventName: "example", Metrics: metrics.NewMetrics("ns")}
+	el.GRPCEventSource.Metadata = map[string]string{"team": "payments"}
+	p := &eventPublisher{el: el, token: token, dispatch: f.Dispatch, log: zaptest.NewLogger(t).Sugar()}
+	ts := httptest.NewUnstartedServer(newHandler(p, 1024))
+	ts.Config.Protocols = new(http.Protocols)
+	ts.Config.Protocols.SetHTTP1(true)
+	ts.Config.Protocols.SetUnencryptedHTTP2(true)
+	ts.Start()
+	t.Cleanup(ts.Close)
+	return ts, f
+}
+
+func TestGRPC(t *testing.T) {
+	ts, f := newTestServer(t, "secret")
+	conn, err := grpclib.NewClient(strings.TrimPrefix(ts.URL, "http://"),
+		grpclib.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer secret")
+	client := NewCloudEventServiceClient(conn)
+	publish := func(ctx context.Context, pb *CloudEvent) error {
+		_, err := client.Publish(ctx, pb)
+		return err
+	}
+
+	require.NoError(t, publish(ctx, newTestCloudEvent("1", map[string]string{"datacontenttype": "application/json"}, `{"amount":10}`)))
+	require.Len(t, f.Events, 1)
+	assert.Equal(t, "1", f.Events[0].ID)
+	assert.Equal(t, "1", f.IDs[0])
+	assert.Equal(t, "payments", f.Events[0].Metadata["team"])
+	assert.JSONEq(t, `{"amount":10}`, string(*f.Events[0].Body))
+
+	err = publish(context.Background(), newTestCloudEvent("2", nil, ""))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	err = publish(ctx, &CloudEvent{Id: "3"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = publish(ctx, newTestCloudEvent("4", nil, strings.Repeat("a", 2048)))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	f.Err = eventbuscommon.NewEventBusError(fmt.Errorf("eventbus is down"))
+	err = publish(ctx, newTestCloudEvent("5", nil, ""))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	f.Err = nil
+
+	stream, err := client.PublishStream(ctx)
+	require.NoError(t, err)
+	for _, id := range []string{"6", "7", "8"} {
+		require.NoError(t, stream.Send(newTestCloudEvent(id, nil, "hello")))
+	}
+	resp, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.GetAccepted())
+	assert.Len(t, f.Events, 4)
+}
+
+func TestConnect(t *testing.T) {
+	ts, f := newTestServer(t, "secret")
+	url := ts.URL + CloudEventService_Publish_FullMethodName
+	post := func(contentType, token string, body []byte) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		return resp
+	}
+
+	resp := post("application/json", "secret", []byte(`{"id":"1","source":"/orders","specVersion":"1.0","type":"order.created"}`))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	assert.JSONEq(t, `{"accepted":"1"}`, string(body))
+
+	resp = post("application/proto", "secret", encodeCloudEvent(t, "2", nil, "hello"))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, f.Events, 2)
+	assert.Equal(t, `"hello"`, string(*f.Events[1].Body))
+
+	resp = post("application/json", "wrong", []byte(`{}`))
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	body, _ = io.ReadAll(resp.Body)
+	assert.JSONEq(t, `{"code":"unauthenticated","message":"invalid auth token"}`, string(body))
+
+	resp = post("application/json", "secret", []byte(`{"id":"3"}`))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	f.Err = eventbuscommon.NewEventBusError(fmt.Errorf("eventbus is down"))
+	resp = post("application/json", "secret", []byte(`{"id":"4","source":"/orders","specVersion":"1.0","type":"order.created"}`))
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	resp = post("text/plain", "secret", []byte(`hello`))
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+
+	health, err := http.Get(ts.URL + "/health")
+	require.NoError(t, err)
+	defer health.Body.Close()
+	assert.Equal(t, http.StatusOK, health.StatusCode)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+	metrics "github.com/argoproj/argo-events/pkg/metrics"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	defaultMaxMessageSize int64 = 4 * 1024 * 1024
+	shutdownTimeout             = 10 * time.Second
+)
+
+// EventListener implements Eventing for the gRPC event source
+type EventListener struct {
+	EventSourceName string
+	EventName       string
+	GRPCEventSource v1alpha1.GRPCEventSource
+	Metrics         *metrics.Metrics
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() v1alpha1.EventSourceType {
+	return v1alpha1.GRPCEvent
+}
+
+// StartListening starts the server receiving the pushed events
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	log := logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	defer sources.Recover(el.GetEventName())
+
+	log.Info("started processing the grpc event source...")
+	grpcEventSource := &el.GRPCEventSource
+
+	var token string
+	if grpcEventSource.AuthSecret != nil {
+		var err error
+		if token, err = sharedutil.GetSecretFromVolume(grpcEventSource.AuthSecret); err != nil {
+			return fmt.Errorf("failed to get the auth token, %w", err)
+		}
+	}
+
+	maxMessageSize := defaultMaxMessageSize
+	if grpcEventSource.MaxMessageSize != nil {
+		maxMessageSize = *grpcEventSource.MaxMessageSize
+	}
+
+	p := &eventPublisher{el: el, token: token, dispatch: dispatch, log: log}
+	server := &http.Server{
+		Addr:              ":" + grpcEventSource.Port,
+		Handler:           newHandler(p, maxMessageSize),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	if grpcEventSource.ServerCertSecret != nil && grpcEventSource.ServerKeySecret != nil {
+		certPath, err := sharedutil.GetSecretVolumePath(grpcEventSource.ServerCertSecret)
+		if err != nil {
+			return fmt.Errorf("failed to get the server cert path, %w", err)
+		}
+		keyPath, err := sharedutil.GetSecretVolumePath(grpcEventSource.ServerKeySecret)
+		if err != nil {
+			return fmt.Errorf("failed to get the server key path, %w", err)
+		}
+		go func() {
+			log.Infow("starting the grpc server with TLS...", zap.String("port", grpcEventSource.Port))
+			errCh <- server.ListenAndServeTLS(certPath, keyPath)
+		}()
+	} else {
+		// gRPC clients use HTTP/2 without TLS with prior knowledge.
+		server.Protocols = new(http.Protocols)
+		server.Protocols.SetHTTP1(true)
+		server.Protocols.SetUnencryptedHTTP2(true)
+		go func() {
+			log.Infow("starting the grpc server...", zap.String("port", grpcEventSource.Port))
+			errCh <- server.ListenAndServe()
+		}()
+	}
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("failed to run the grpc server for event source %s, %w", el.GetEventName(), err)
+	case <-ctx.Done():
+		log.Info("event source is stopped, shutting down the grpc server...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Errorw("failed to shut down the grpc server gracefully", zap.Error(err))
+			_ = server.Close()
+		}
+		return nil
+	}
+}
+
+// eventPublisher dispatches the events received by the server.
+type eventPublisher struct {
+	el       *EventListener
+	token    string
+	dispatch func([]byte, ...eventsourcecommon.Option) error
+	log      *zap.SugaredLogger
+	// mu serializes the dispatching like the webhook event sources, the requests are served concurrently.
+	mu sync.Mutex
+}
+
+func (p *eventPublisher) authorize(authorization string) error {
+	if p.token == "" {
+		return nil
+	}
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(p.token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid auth token")
+	}
+	return nil
+}
+
+// publish returns once the event is published to the EventBus, the error code is Unavailable if
+// the EventBus failed so the client can retry.
+func (p *eventPublisher) publish(ce *cloudEvent) error {
+	el := p.el
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	if err := ce.validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid event, %v", err)
+	}
+	eventBytes, err := json.Marshal(ce.eventData(el.GRPCEventSource.Metadata))
+	if err != nil {
+		el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+		return status.Errorf(codes.Internal, "failed to marshal the event data, %v", err)
+	}
+	p.log.Debugw("dispatching the event...", zap.String("id", ce.ID), zap.String("source", ce.Source), zap.String("type", ce.Type))
+	p.mu.Lock()
+	err = p.dispatch(eventBytes, eventsourcecommon.WithID(ce.ID))
+	p.mu.Unlock()
+	if err != nil {
+		p.log.Errorw("failed to dispatch the event", zap.String("id", ce.ID), zap.Error(err))
+		el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+		if eventbuscommon.IsEventBusError(err) {
+			return status.Errorf(codes.Unavailable, "failed to publish the event, %v", err)
+		}
+		return status.Errorf(codes.Internal, "failed to dispatch the event, %v", err)
+	}
+	return nil
+}
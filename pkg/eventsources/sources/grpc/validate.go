@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+// ValidateEventSource validates the grpc event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.GRPCEventSource)
+}
+
+func validate(eventSource *v1alpha1.GRPCEventSource) error {
+	if eventSource == nil {
+		return v1alpha1.ErrNilEventSource
+	}
+	if port, err := strconv.Atoi(eventSource.Port); err != nil || port <= 0 || port > 65535 {
+		return fmt.Errorf("invalid port %q", eventSource.Port)
+	}
+	if (eventSource.ServerCertSecret == nil) != (eventSource.ServerKeySecret == nil) {
+		return fmt.Errorf("serverCertSecret and serverKeySecret must be specified together")
+	}
+	if eventSource.MaxMessageSize != nil && *eventSource.MaxMessageSize <= 0 {
+		return fmt.Errorf("maxMessageSize must be positive")
+	}
+	return nil
+}
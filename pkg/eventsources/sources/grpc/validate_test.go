@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+)
+
+func TestValidateEventSource(t *testing.T) {
+	listener := &EventListener{}
+
+	err := listener.ValidateEventSource(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, `invalid port ""`, err.Error())
+
+	content, err := os.ReadFile(fmt.Sprintf("%s/%s", sources.EventSourceDir, "grpc.yaml"))
+	assert.Nil(t, err)
+
+	var eventSource *v1alpha1.EventSource
+	err = yaml.Unmarshal(content, &eventSource)
+	assert.Nil(t, err)
+	assert.NotNil(t, eventSource.Spec.GRPC)
+
+	for name, value := range eventSource.Spec.GRPC {
+		fmt.Println(name)
+		l := &EventListener{
+			GRPCEventSource: value,
+		}
+		err := l.ValidateEventSource(context.Background())
+		assert.NoError(t, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, validate(&v1alpha1.GRPCEventSource{Port: "12000"}))
+	assert.Error(t, validate(&v1alpha1.GRPCEventSource{Port: "70000"}))
+
+	secret := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s"}, Key: "k"}
+	assert.Error(t, validate(&v1alpha1.GRPCEventSource{Port: "12000", ServerCertSecret: secret}))
+	assert.NoError(t, validate(&v1alpha1.GRPCEventSource{Port: "12000", ServerCertSecret: secret, ServerKeySecret: secret}))
+
+	size := int64(0)
+	assert.Error(t, validate(&v1alpha1.GRPCEventSource{Port: "12000", MaxMessageSize: &size}))
+}
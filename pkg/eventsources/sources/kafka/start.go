@@ -19,11 +19,9 @@ package kafka
 import (
 	"context"
 	"crypto/tls"
-	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"strconv"
 	"strings"
 	"sync"
@@ -31,12 +29,12 @@ import (
 
 	"github.com/IBM/sarama"
 	saslsigner "github.com/aws/aws-msk-iam-sasl-signer-go/signer"
-	"github.com/riferrei/srclient"
 	"go.uber.org/zap"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/decoder"
 	"github.com/argoproj/argo-events/pkg/eventsources/events"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources"
 	metrics "github.com/argoproj/argo-events/pkg/metrics"
@@ -50,7 +48,12 @@ type EventListener struct {
 	EventName        string
 	KafkaEventSource v1alpha1.KafkaEventSource
 	Metrics          *metrics.Metrics
-	SchemaRegistry   *srclient.SchemaRegistryClient
+
+	// payloadDecoder decodes the message values into JSON, if any
+	payloadDecoder decoder.Decoder
+	// resumeOffset is the offset of the message failed to be published to the eventbus,
+	// the partition consumer resumes from it when it restarts.
+	resumeOffset *int64
 }
 
 // GetEventSourceName returns name of event source
@@ -77,27 +80,6 @@ func verifyPartitionAvailable(part int32, partitions []int32) bool {
 	return false
 }
 
-func (el *EventListener) createSchemaRegistryClient() (*srclient.SchemaRegistryClient, error) {
-	sr := el.KafkaEventSource.SchemaRegistry
-	if sr == nil {
-		return nil, fmt.Errorf("schema registry configuration is not provided")
-	}
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		},
-		Timeout: 5 * time.Second,
-	}
-	schemaRegistryClient := srclient.NewSchemaRegistryClient(sr.URL, srclient.WithClient(httpClient), srclient.WithSemaphoreWeight(16))
-
-	if sr.Auth.Username != nil && sr.Auth.Password != nil {
-		user, _ := sharedutil.GetSecretFromVolume(sr.Auth.Username)
-		password, _ := sharedutil.GetSecretFromVolume(sr.Auth.Password)
-		schemaRegistryClient.SetCredentials(user, password)
-	}
-	return schemaRegistryClient, nil
-}
-
 // StartListening starts listening events
 func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
 	log := logging.FromContext(ctx).
@@ -107,14 +89,21 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	log.Info("start kafka event source...")
 	kafkaEventSource := &el.KafkaEventSource
 
-	// Initialize SchemaRegistryClient
-	if kafkaEventSource.SchemaRegistry != nil {
+	switch {
+	case kafkaEventSource.Decoder != nil:
+		payloadDecoder, err := decoder.New(kafkaEventSource.Decoder)
+		if err != nil {
+			return fmt.Errorf("failed to create the decoder for event source %s, %w", el.GetEventName(), err)
+		}
+		el.payloadDecoder = payloadDecoder
+	case kafkaEventSource.SchemaRegistry != nil && kafkaEventSource.JSONBody:
+		// the values are in the Confluent wire format.
 		log.Info("connecting to schema registry at url ", kafkaEventSource.SchemaRegistry.URL)
-		schemaRegistry, err := el.createSchemaRegistryClient()
+		payloadDecoder, err := decoder.New(&v1alpha1.PayloadDecoder{Confluent: kafkaEventSource.SchemaRegistry})
 		if err != nil {
 			return fmt.Errorf("failed to create schema registry client for event source %s, %w", el.GetEventName(), err)
 		}
-		el.SchemaRegistry = schemaRegistry
+		el.payloadDecoder = payloadDecoder
 	}
 
 	if kafkaEventSource.ConsumerGroup == nil {
@@ -150,7 +139,7 @@ func (el *EventListener) consumerGroupConsumer(ctx context.Context, log *zap.Sug
 		eventSourceName:  el.EventSourceName,
 		eventName:        el.EventName,
 		metrics:          el.Metrics,
-		schemaRegistry:   el.SchemaRegistry,
+		payloadDecoder:   el.payloadDecoder,
 	}
 
 	urls := strings.Split(kafkaEventSource.URL, ",")
@@ -242,7 +231,12 @@ func (el *EventListener) partitionConsumer(ctx context.Context, log *zap.Sugared
 	}
 
 	log.Info("getting partition consumer...")
-	partitionConsumer, err := consumer.ConsumePartition(kafkaEventSource.Topic, partition, sarama.OffsetNewest)
+	offset := sarama.OffsetNewest
+	if el.resumeOffset != nil {
+		offset = *el.resumeOffset
+		log.Infof("resuming from offset %d", offset)
+	}
+	partitionConsumer, err := consumer.ConsumePartition(kafkaEventSource.Topic, partition, offset)
 	if err != nil {
 		return fmt.Errorf("failed to create consumer partition for event source %s, %w", el.GetEventName(), err)
 	}
@@ -269,19 +263,11 @@ func (el *EventListener) partitionConsumer(ctx context.Context, log *zap.Sugared
 
 		eventData.Headers = headers
 
-		if kafkaEventSource.JSONBody {
-			if el.SchemaRegistry != nil {
-				value, err := toJson(el.SchemaRegistry, msg)
-				if err != nil {
-					return fmt.Errorf("failed to retrieve json value using the schema registry, %w", err)
-				}
-				eventData.Body = (*json.RawMessage)(&value)
-			} else {
-				eventData.Body = (*json.RawMessage)(&msg.Value)
-			}
-		} else {
-			eventData.Body = msg.Value
+		body, err := messageBody(el.payloadDecoder, kafkaEventSource.JSONBody, msg.Value)
+		if err != nil {
+			return err
 		}
+		eventData.Body = body
 		eventBody, err := json.Marshal(eventData)
 		if err != nil {
 			return fmt.Errorf("failed to marshal the event data, rejecting the event, %w", err)
@@ -302,7 +288,15 @@ func (el *EventListener) partitionConsumer(ctx context.Context, log *zap.Sugared
 			if err := processOne(msg); err != nil {
 				log.Errorw("failed to process a Kafka message", zap.Error(err))
 				el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+				if eventbuscommon.IsEventBusError(err) {
+					// restart from the message failed to be published
+					el.resumeOffset = &msg.Offset
+					_ = partitionConsumer.Close()
+					return err
+				}
+				continue
 			}
+			el.resumeOffset = nil
 		case err := <-partitionConsumer.Errors():
 			return fmt.Errorf("failed to consume messages for event source %s, %w", el.GetEventName(), err)
 
@@ -397,7 +391,7 @@ type Consumer struct {
 	eventSourceName  string
 	eventName        string
 	metrics          *metrics.Metrics
-	schemaRegistry   *srclient.SchemaRegistryClient
+	payloadDecoder   decoder.Decoder
 }
 
 // Setup is run at the beginning of a new session, before ConsumeClaim
@@ -464,19 +458,11 @@ func (consumer *Consumer) processOne(session sarama.ConsumerGroupSession, messag
 
 	eventData.Headers = headers
 
-	if consumer.kafkaEventSource.JSONBody {
-		if consumer.schemaRegistry != nil {
-			value, err := toJson(consumer.schemaRegistry, message)
-			if err != nil {
-				return fmt.Errorf("failed to retrieve json value using the schema registry, %w", err)
-			}
-			eventData.Body = (*json.RawMessage)(&value)
-		} else {
-			eventData.Body = (*json.RawMessage)(&message.Value)
-		}
-	} else {
-		eventData.Body = message.Value
+	body, err := messageBody(consumer.payloadDecoder, consumer.kafkaEventSource.JSONBody, message.Value)
+	if err != nil {
+		return err
 	}
+	eventData.Body = body
 	eventBody, err := json.Marshal(eventData)
 	if err != nil {
 		return fmt.Errorf("failed to marshal the event data, rejecting the event, %w", err)
@@ -491,18 +477,19 @@ func (consumer *Consumer) processOne(session sarama.ConsumerGroupSession, messag
 	return nil
 }
 
-func toJson(schemaRegistry *srclient.SchemaRegistryClient, message *sarama.ConsumerMessage) ([]byte, error) {
-	schemaID := binary.BigEndian.Uint32(message.Value[1:5])
-	schema, err := schemaRegistry.GetSchema(int(schemaID))
-	if err != nil {
-		return nil, fmt.Errorf("failed getting schema with id '%d', %w", schemaID, err)
+// messageBody returns the body of the event of a message value, decoded into JSON by the decoder, if any
+func messageBody(payloadDecoder decoder.Decoder, jsonBody bool, value []byte) (interface{}, error) {
+	if payloadDecoder != nil {
+		decoded, err := payloadDecoder.Decode(value, decoder.Metadata{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the message value, %w", err)
+		}
+		return (*json.RawMessage)(&decoded), nil
 	}
-	payload, _, err := schema.Codec().NativeFromBinary(message.Value[5:])
-	if err != nil {
-		return nil, fmt.Errorf("failed to get native from binary, %w", err)
+	if jsonBody {
+		return (*json.RawMessage)(&value), nil
 	}
-	value, err := schema.Codec().TextualFromNative(nil, payload)
-	return value, err
+	return value, nil
 }
 
 // Function can be passed as Option to generate unique id for kafka event
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/decoder"
 )
 
 // ValidateEventSource validates the gateway event source
@@ -41,6 +42,17 @@ func validate(eventSource *v1alpha1.KafkaEventSource) error {
 	if eventSource.Partition == "" && eventSource.ConsumerGroup == nil {
 		return fmt.Errorf("consumerGroup or partition must be specified")
 	}
+	if eventSource.Decoder != nil {
+		if eventSource.SchemaRegistry != nil {
+			return fmt.Errorf("decoder and schemaRegistry can't be specified together")
+		}
+		if eventSource.Decoder.Pulsar != nil {
+			return fmt.Errorf("the pulsar decoder is only supported by the pulsar event source")
+		}
+		if err := decoder.Validate(eventSource.Decoder); err != nil {
+			return err
+		}
+	}
 	if eventSource.TLS != nil {
 		return v1alpha1.ValidateTLSConfig(eventSource.TLS)
 	}
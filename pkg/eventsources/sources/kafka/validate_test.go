@@ -89,3 +89,28 @@ func TestValidateEventSource_AWSMSKIAMAuth(t *testing.T) {
 		assert.EqualError(t, err, "awsMskIamAuth.region must be specified")
 	})
 }
+
+func TestValidateEventSource_Decoder(t *testing.T) {
+	eventSource := v1alpha1.KafkaEventSource{
+		URL:       "kafka.argo-events:9092",
+		Topic:     "orders",
+		Partition: "0",
+		Decoder: &v1alpha1.PayloadDecoder{
+			Confluent: &v1alpha1.SchemaRegistryConfig{URL: "http://schema-registry:8081"},
+		},
+	}
+	l := &EventListener{KafkaEventSource: eventSource}
+	assert.NoError(t, l.ValidateEventSource(context.Background()))
+
+	l.KafkaEventSource.SchemaRegistry = &v1alpha1.SchemaRegistryConfig{URL: "http://schema-registry:8081"}
+	err := l.ValidateEventSource(context.Background())
+	assert.EqualError(t, err, "decoder and schemaRegistry can't be specified together")
+
+	l.KafkaEventSource = eventSource
+	l.KafkaEventSource.Decoder = &v1alpha1.PayloadDecoder{Pulsar: &v1alpha1.PulsarSchemaRegistry{AdminURL: "http://pulsar:8080"}}
+	err = l.ValidateEventSource(context.Background())
+	assert.EqualError(t, err, "the pulsar decoder is only supported by the pulsar event source")
+
+	l.KafkaEventSource.Decoder = &v1alpha1.PayloadDecoder{Confluent: &v1alpha1.SchemaRegistryConfig{}}
+	assert.Error(t, l.ValidateEventSource(context.Background()))
+}
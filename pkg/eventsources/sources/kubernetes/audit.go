@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+// defaultAuditStage is the stage of the audit events dispatched if no stage is specified
+const defaultAuditStage = "ResponseComplete"
+
+// controller controls the webhook operations
+var (
+	controller = webhook.NewController()
+)
+
+// set up the activation and inactivation channels to control the state of routes.
+func init() {
+	go webhook.ProcessRouteStatus(controller)
+}
+
+// auditEventList is the audit.k8s.io/v1 EventList sent by the audit webhook backend
+type auditEventList struct {
+	Kind  string            `json:"kind"`
+	Items []json.RawMessage `json:"items"`
+}
+
+// auditEvent holds the fields of an audit.k8s.io/v1 Event the filters are applied on
+type auditEvent struct {
+	AuditID string `json:"auditID"`
+	Stage   string `json:"stage"`
+	Verb    string `json:"verb"`
+	User    struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	ObjectRef *struct {
+		Resource  string `json:"resource"`
+		Namespace string `json:"namespace"`
+		APIGroup  string `json:"apiGroup"`
+	} `json:"objectRef"`
+}
+
+// GetRoute returns the route
+func (rc *Router) GetRoute() *webhook.Route {
+	return rc.route
+}
+
+// HandleRoute handles the batches of audit events sent by the API server
+func (rc *Router) HandleRoute(writer http.ResponseWriter, request *http.Request) {
+	route := rc.route
+
+	logger := route.Logger.With(
+		logging.LabelEndpoint, route.Context.Endpoint,
+		logging.LabelPort, route.Context.Port,
+		logging.LabelHTTPMethod, route.Context.Method,
+	)
+
+	logger.Info("request a received, processing it...")
+
+	if !route.Active {
+		logger.Warn("endpoint is not active, won't process it")
+		sharedutil.SendErrorResponse(writer, "endpoint is inactive")
+		return
+	}
+
+	defer func(start time.Time) {
+		route.Metrics.EventProcessingDuration(route.EventSourceName, route.EventName, float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	request.Body = http.MaxBytesReader(writer, request.Body, route.Context.GetMaxPayloadSize())
+	payload, err := io.ReadAll(request.Body)
+	if err != nil {
+		logger.Errorw("error reading request body", zap.Error(err))
+		writer.WriteHeader(http.StatusServiceUnavailable)
+		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+		return
+	}
+
+	dispatches, err := rc.auditDispatches(payload)
+	if err != nil {
+		logger.Errorw("failed to parse the audit events", zap.Error(err))
+		sharedutil.SendErrorResponse(writer, "invalid audit events")
+		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+		return
+	}
+	logger.Debugw("dispatching the audit events...", zap.Int("count", len(dispatches)))
+	webhook.DispatchEvents(request.Context(), route, dispatches, logger, writer)
+}
+
+// auditDispatches returns the events to dispatch for the audit events of a batch passing the filters
+func (rc *Router) auditDispatches(payload []byte) ([]*webhook.Dispatch, error) {
+	var list auditEventList
+	if err := json.Unmarshal(payload, &list); err != nil {
+		return nil, err
+	}
+	if list.Kind != "EventList" {
+		return nil, fmt.Errorf("unexpected kind %q, expected EventList", list.Kind)
+	}
+	var dispatches []*webhook.Dispatch
+	for _, item := range list.Items {
+		var e auditEvent
+		if err := json.Unmarshal(item, &e); err != nil {
+			return nil, err
+		}
+		if !filterAuditEvent(&e, rc.audit) {
+			continue
+		}
+		data, err := json.Marshal(&events.KubernetesEventData{
+			Type:     eventDataTypeAudit,
+			Audit:    &item,
+			Metadata: rc.metadata,
+		})
+		if err != nil {
+			return nil, err
+		}
+		d := &webhook.Dispatch{Data: data}
+		if e.AuditID != "" {
+			// the same audit event is sent for each of its stages.
+			d.Options = []eventsourcecommon.Option{eventsourcecommon.WithID(e.AuditID + "-" + e.Stage)}
+		}
+		dispatches = append(dispatches, d)
+	}
+	return dispatches, nil
+}
+
+// filterAuditEvent checks if an audit event passes the filters
+func filterAuditEvent(e *auditEvent, audit *v1alpha1.KubernetesAuditWebhook) bool {
+	stages := audit.Stages
+	if len(stages) == 0 {
+		stages = []string{defaultAuditStage}
+	}
+	if !slices.Contains(stages, e.Stage) {
+		return false
+	}
+	if len(audit.Verbs) > 0 && !slices.Contains(audit.Verbs, e.Verb) {
+		return false
+	}
+	if len(audit.Users) > 0 && !slices.Contains(audit.Users, e.User.Username) {
+		return false
+	}
+	if len(audit.Resources) > 0 {
+		if e.ObjectRef == nil {
+			return false
+		}
+		resource := e.ObjectRef.Resource
+		if e.ObjectRef.APIGroup != "" {
+			resource = e.ObjectRef.APIGroup + "/" + resource
+		}
+		if !slices.Contains(audit.Resources, resource) {
+			return false
+		}
+	}
+	if len(audit.Namespaces) > 0 && (e.ObjectRef == nil || !slices.Contains(audit.Namespaces, e.ObjectRef.Namespace)) {
+		return false
+	}
+	return true
+}
+
+// PostActivate performs operations once the route is activated and ready to consume requests
+func (rc *Router) PostActivate() error {
+	return nil
+}
+
+// PostInactivate performs operations after the route is inactivated
+func (rc *Router) PostInactivate() error {
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"testing"
+
+	cloudevent "github.com/cloudevents/sdk-go/v2/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+)
+
+const testAuditEventList = `{
+  "kind": "EventList",
+  "apiVersion": "audit.k8s.io/v1",
+  "items": [
+    {"auditID": "a1", "stage": "ResponseComplete", "verb": "delete", "user": {"username": "alice"},
+     "objectRef": {"resource": "secrets", "namespace": "payments", "name": "db", "apiVersion": "v1"}},
+    {"auditID": "a2", "stage": "ResponseComplete", "verb": "get", "user": {"username": "alice"},
+     "objectRef": {"resource": "secrets", "namespace": "payments", "name": "db", "apiVersion": "v1"}},
+    {"auditID": "a3", "stage": "RequestReceived", "verb": "delete", "user": {"username": "alice"},
+     "objectRef": {"resource": "secrets", "namespace": "payments", "name": "db", "apiVersion": "v1"}},
+    {"auditID": "a4", "stage": "ResponseComplete", "verb": "create", "user": {"username": "bob"},
+     "objectRef": {"resource": "deployments", "namespace": "orders", "name": "api", "apiGroup": "apps", "apiVersion": "v1"}},
+    {"auditID": "a5", "stage": "ResponseComplete", "verb": "create", "user": {"username": "bob"},
+     "requestURI": "/version"}
+  ]
+}`
+
+func TestAuditDispatches(t *testing.T) {
+	ids := func(audit *v1alpha1.KubernetesAuditWebhook) []string {
+		rc := &Router{audit: audit, metadata: map[string]string{"team": "platform"}}
+		dispatches, err := rc.auditDispatches([]byte(testAuditEventList))
+		require.NoError(t, err)
+		var result []string
+		for _, d := range dispatches {
+			var data events.KubernetesEventData
+			require.NoError(t, json.Unmarshal(d.Data, &data))
+			assert.Equal(t, eventDataTypeAudit, data.Type)
+			assert.Equal(t, "platform", data.Metadata["team"])
+			e := cloudevent.New()
+			for _, opt := range d.Options {
+				require.NoError(t, opt(&e))
+			}
+			result = append(result, e.ID())
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"a1-ResponseComplete", "a2-ResponseComplete", "a4-ResponseComplete", "a5-ResponseComplete"}, ids(&v1alpha1.KubernetesAuditWebhook{}))
+	assert.Equal(t, []string{"a1-ResponseComplete", "a3-RequestReceived"}, ids(&v1alpha1.KubernetesAuditWebhook{
+		Verbs:  []string{"delete"},
+		Stages: []string{"RequestReceived", "ResponseComplete"},
+	}))
+	assert.Equal(t, []string{"a1-ResponseComplete", "a2-ResponseComplete", "a4-ResponseComplete"}, ids(&v1alpha1.KubernetesAuditWebhook{
+		Resources: []string{"secrets", "apps/deployments"},
+	}))
+	assert.Equal(t, []string{"a4-ResponseComplete"}, ids(&v1alpha1.KubernetesAuditWebhook{
+		Users:      []string{"bob"},
+		Namespaces: []string{"orders"},
+	}))
+
+	rc := &Router{audit: &v1alpha1.KubernetesAuditWebhook{}}
+	_, err := rc.auditDispatches([]byte(`{"kind":"Event"}`))
+	assert.Error(t, err)
+	_, err = rc.auditDispatches([]byte(`not json`))
+	assert.Error(t, err)
+}
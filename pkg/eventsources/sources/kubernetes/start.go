@@ -0,0 +1,398 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources/resource"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	eventDataTypeEvent = "event"
+	eventDataTypeAudit = "audit"
+)
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() v1alpha1.EventSourceType {
+	return v1alpha1.KubernetesEvent
+}
+
+// StartListening watches the K8s Events and receives the audit events
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	log := logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	defer sources.Recover(el.GetEventName())
+
+	log.Info("started processing the kubernetes event source...")
+	kubernetesEventSource := &el.KubernetesEventSource
+
+	// the K8s Events and the audit events are dispatched from different goroutines.
+	var mu sync.Mutex
+	syncDispatch := func(data []byte, opts ...eventsourcecommon.Option) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return dispatch(data, opts...)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errCh := make(chan error, 2)
+	listeners := 0
+	if kubernetesEventSource.Audit != nil {
+		listeners++
+		go func() {
+			route := webhook.NewRoute(kubernetesEventSource.Audit.Webhook, log, el.GetEventSourceName(), el.GetEventName(), el.Metrics)
+			errCh <- webhook.ManageRoute(ctx, &Router{
+				route:    route,
+				audit:    kubernetesEventSource.Audit,
+				metadata: kubernetesEventSource.Metadata,
+			}, controller, syncDispatch)
+		}()
+	}
+	if kubernetesEventSource.Events != nil {
+		listeners++
+		go func() {
+			errCh <- el.watchEvents(ctx, syncDispatch, log)
+		}()
+	}
+
+	var result error
+	for range listeners {
+		if err := <-errCh; err != nil && result == nil {
+			result = err
+			cancel()
+		}
+	}
+	return result
+}
+
+// watchEvents watches the K8s Events until the context is done
+func (el *EventListener) watchEvents(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) error {
+	eventsWatch := el.KubernetesEventSource.Events
+
+	log.Info("setting up a K8s client")
+	kubeConfig, _ := os.LookupEnv(v1alpha1.EnvVarKubeConfig)
+	restConfig, err := sharedutil.GetClientConfig(kubeConfig)
+	if err != nil {
+		return fmt.Errorf("failed to get a K8s rest config for the event source %s, %w", el.GetEventName(), err)
+	}
+	client, err := clientset.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to set up a K8s client for the event source %s, %w", el.GetEventName(), err)
+	}
+
+	w, err := newEventWatcher(eventsWatch, time.Now())
+	if err != nil {
+		return err
+	}
+	if eventsWatch.IncludeInvolvedObject || w.labels != nil {
+		dynamicClient, err := dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return fmt.Errorf("failed to set up a dynamic K8s client for the event source %s, %w", el.GetEventName(), err)
+		}
+		w.getObject = involvedObjectGetter(client.Discovery(), dynamicClient)
+	}
+
+	// eventCh receives the old and the new K8s Event of an update, or the new one of a creation.
+	eventCh := make(chan [2]*corev1.Event)
+	send := func(e [2]*corev1.Event) {
+		select {
+		case eventCh <- e:
+		case <-ctx.Done():
+		}
+	}
+	handlerFuncs := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if e, ok := obj.(*corev1.Event); ok {
+				send([2]*corev1.Event{nil, e})
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldEvent, ok1 := oldObj.(*corev1.Event)
+			newEvent, ok2 := newObj.(*corev1.Event)
+			if ok1 && ok2 {
+				send([2]*corev1.Event{oldEvent, newEvent})
+			}
+		},
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(client, 0, informers.WithNamespace(eventsWatch.Namespace))
+	if _, err := factory.Core().V1().Events().Informer().AddEventHandler(handlerFuncs); err != nil {
+		return fmt.Errorf("failed to add event handler for the K8s Events, %w", err)
+	}
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	log.Infow("watching the K8s Events...", zap.String("namespace", eventsWatch.Namespace))
+	factory.Start(doneCh)
+
+	for {
+		select {
+		case e := <-eventCh:
+			if err := el.processEvent(ctx, w, e[0], e[1], dispatch, log); err != nil {
+				log.Errorw("failed to process a K8s Event", zap.Error(err))
+				el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+			}
+		case <-ctx.Done():
+			log.Info("event source is stopped")
+			return nil
+		}
+	}
+}
+
+// processEvent dispatches a K8s Event if it is created, or updated with a different reason, type
+// or message, and it passes the filters
+func (el *EventListener) processEvent(ctx context.Context, w *eventWatcher, oldEvent, newEvent *corev1.Event, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) error {
+	if !w.accept(oldEvent, newEvent, time.Now()) {
+		return nil
+	}
+
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	var involvedObject *json.RawMessage
+	if w.getObject != nil {
+		obj, err := w.getObject(ctx, &newEvent.InvolvedObject)
+		if err != nil {
+			if w.labels != nil {
+				return fmt.Errorf("failed to get the involved object %s %s/%s, %w", newEvent.InvolvedObject.Kind, newEvent.InvolvedObject.Namespace, newEvent.InvolvedObject.Name, err)
+			}
+			log.Warnw("failed to get the involved object", zap.String("kind", newEvent.InvolvedObject.Kind),
+				zap.String("namespace", newEvent.InvolvedObject.Namespace), zap.String("name", newEvent.InvolvedObject.Name), zap.Error(err))
+		} else {
+			if w.labels != nil && !w.labels.Matches(labels.Set(obj.GetLabels())) {
+				log.Debugw("the labels of the involved object do not match", zap.String("name", newEvent.InvolvedObject.Name))
+				return nil
+			}
+			if involvedObject, err = objectMetadata(obj); err != nil {
+				return err
+			}
+		}
+	}
+
+	eventBody, err := eventData(newEvent, involvedObject, el.KubernetesEventSource.Metadata)
+	if err != nil {
+		return err
+	}
+	if err := dispatch(eventBody); err != nil {
+		return fmt.Errorf("failed to dispatch a K8s Event, %w", err)
+	}
+	return nil
+}
+
+// eventWatcher filters and deduplicates the K8s Events
+type eventWatcher struct {
+	watch     *v1alpha1.KubernetesEventsWatch
+	names     []*regexp.Regexp
+	labels    labels.Selector
+	window    time.Duration
+	startTime time.Time
+	// dispatched holds the time the K8s Events were dispatched at by deduplication key, within the window
+	dispatched map[string]time.Time
+	// getObject gets the involved object, nil if it is not needed
+	getObject func(ctx context.Context, ref *corev1.ObjectReference) (*unstructured.Unstructured, error)
+}
+
+func newEventWatcher(eventsWatch *v1alpha1.KubernetesEventsWatch, startTime time.Time) (*eventWatcher, error) {
+	w := &eventWatcher{watch: eventsWatch, startTime: startTime, dispatched: map[string]time.Time{}}
+	if filter := eventsWatch.InvolvedObject; filter != nil {
+		for _, name := range filter.Names {
+			exp, err := regexp.Compile(name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile the involved object name %q, %w", name, err)
+			}
+			w.names = append(w.names, exp)
+		}
+		if len(filter.Labels) > 0 {
+			sel, err := resource.LabelSelector(filter.Labels)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create the involved object label selector, %w", err)
+			}
+			w.labels = sel
+		}
+	}
+	if eventsWatch.DeduplicationWindow != "" {
+		window, err := time.ParseDuration(eventsWatch.DeduplicationWindow)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the deduplication window, %w", err)
+		}
+		w.window = window
+	}
+	return w, nil
+}
+
+// accept checks if a K8s Event needs to be dispatched. The K8s Events listed when the watch starts
+// and the updates only bumping the count are skipped, and the K8s Events dispatched within the
+// deduplication window are skipped.
+func (w *eventWatcher) accept(oldEvent, newEvent *corev1.Event, now time.Time) bool {
+	if oldEvent == nil {
+		if lastTime(newEvent).Before(w.startTime) {
+			return false
+		}
+	} else if oldEvent.Reason == newEvent.Reason && oldEvent.Type == newEvent.Type && oldEvent.Message == newEvent.Message {
+		return false
+	}
+	if !w.matches(newEvent) {
+		return false
+	}
+	if w.window > 0 {
+		for key, t := range w.dispatched {
+			if now.Sub(t) >= w.window {
+				delete(w.dispatched, key)
+			}
+		}
+		key := deduplicationKey(newEvent)
+		if _, ok := w.dispatched[key]; ok {
+			return false
+		}
+		w.dispatched[key] = now
+	}
+	return true
+}
+
+// matches checks if a K8s Event passes the reason, type, kind and name filters
+func (w *eventWatcher) matches(e *corev1.Event) bool {
+	if len(w.watch.Reasons) > 0 && !slices.Contains(w.watch.Reasons, e.Reason) {
+		return false
+	}
+	if len(w.watch.Types) > 0 && !slices.Contains(w.watch.Types, e.Type) {
+		return false
+	}
+	if filter := w.watch.InvolvedObject; filter != nil && len(filter.Kinds) > 0 && !slices.Contains(filter.Kinds, e.InvolvedObject.Kind) {
+		return false
+	}
+	if len(w.names) > 0 && !slices.ContainsFunc(w.names, func(exp *regexp.Regexp) bool {
+		return exp.MatchString(e.InvolvedObject.Name)
+	}) {
+		return false
+	}
+	return true
+}
+
+// deduplicationKey identifies the K8s Events about the same occurrence
+func deduplicationKey(e *corev1.Event) string {
+	ref := e.InvolvedObject
+	return fmt.Sprintf("%s/%s/%s/%s\n%s\n%s\n%s", ref.Kind, ref.Namespace, ref.Name, ref.UID, e.Reason, e.Type, e.Message)
+}
+
+// lastTime returns the last time a K8s Event was observed
+func lastTime(e *corev1.Event) time.Time {
+	t := e.CreationTimestamp.Time
+	for _, other := range []time.Time{e.LastTimestamp.Time, e.EventTime.Time} {
+		if other.After(t) {
+			t = other
+		}
+	}
+	if e.Series != nil && e.Series.LastObservedTime.After(t) {
+		t = e.Series.LastObservedTime.Time
+	}
+	return t
+}
+
+// involvedObjectGetter returns a function getting the involved objects with the dynamic client,
+// the resources of the kinds are discovered when they are first needed.
+func involvedObjectGetter(discoveryClient discovery.DiscoveryInterface, client dynamic.Interface) func(context.Context, *corev1.ObjectReference) (*unstructured.Unstructured, error) {
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	return func(ctx context.Context, ref *corev1.ObjectReference) (*unstructured.Unstructured, error) {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		mapping, err := mapper.RESTMapping(gv.WithKind(ref.Kind).GroupKind(), gv.Version)
+		if err != nil {
+			mapper.Reset()
+			return nil, err
+		}
+		return client.Resource(mapping.Resource).Namespace(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	}
+}
+
+// objectMetadata returns the metadata of an object without the managed fields
+func objectMetadata(obj *unstructured.Unstructured) (*json.RawMessage, error) {
+	obj = obj.DeepCopy()
+	obj.SetManagedFields(nil)
+	metadata, err := json.Marshal(map[string]interface{}{
+		"apiVersion": obj.GetAPIVersion(),
+		"kind":       obj.GetKind(),
+		"metadata":   obj.Object["metadata"],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the involved object metadata, %w", err)
+	}
+	return (*json.RawMessage)(&metadata), nil
+}
+
+// eventData returns the payload of a K8s Event
+func eventData(e *corev1.Event, involvedObject *json.RawMessage, metadata map[string]string) ([]byte, error) {
+	e = e.DeepCopy()
+	e.APIVersion, e.Kind = "v1", "Event"
+	e.ManagedFields = nil
+	body, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the K8s Event, %w", err)
+	}
+	data, err := json.Marshal(&events.KubernetesEventData{
+		Type:           eventDataTypeEvent,
+		Event:          (*json.RawMessage)(&body),
+		InvolvedObject: involvedObject,
+		Metadata:       metadata,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the event data, %w", err)
+	}
+	return data, nil
+}
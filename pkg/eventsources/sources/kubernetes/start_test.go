@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/metrics"
+)
+
+func newEvent(name, reason, message string, count int32, last time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argo-events", CreationTimestamp: metav1.NewTime(last)},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: "v1", Kind: "Pod", Namespace: "argo-events", Name: "payments-1", UID: "uid-1",
+		},
+		Reason:        reason,
+		Message:       message,
+		Type:          corev1.EventTypeWarning,
+		Count:         count,
+		LastTimestamp: metav1.NewTime(last),
+	}
+}
+
+func TestAccept(t *testing.T) {
+	start := time.Now()
+
+	t.Run("skips the events before the start and the count bumps", func(t *testing.T) {
+		w, err := newEventWatcher(&v1alpha1.KubernetesEventsWatch{}, start)
+		require.NoError(t, err)
+		assert.False(t, w.accept(nil, newEvent("e1", "BackOff", "restarting", 1, start.Add(-time.Minute)), start))
+
+		created := newEvent("e1", "BackOff", "restarting", 1, start.Add(time.Second))
+		assert.True(t, w.accept(nil, created, start))
+		bumped := newEvent("e1", "BackOff", "restarting", 2, start.Add(time.Minute))
+		assert.False(t, w.accept(created, bumped, start))
+		changed := newEvent("e1", "BackOff", "restarting again", 3, start.Add(time.Minute))
+		assert.True(t, w.accept(bumped, changed, start))
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		w, err := newEventWatcher(&v1alpha1.KubernetesEventsWatch{
+			Reasons: []string{"BackOff"},
+			Types:   []string{corev1.EventTypeWarning},
+			InvolvedObject: &v1alpha1.InvolvedObjectFilter{
+				Kinds: []string{"Pod"},
+				Names: []string{"^payments-"},
+			},
+		}, start)
+		require.NoError(t, err)
+		e := newEvent("e1", "BackOff", "restarting", 1, start.Add(time.Second))
+		assert.True(t, w.accept(nil, e, start))
+
+		e = newEvent("e2", "Pulled", "pulled", 1, start.Add(time.Second))
+		assert.False(t, w.accept(nil, e, start))
+
+		e = newEvent("e3", "BackOff", "restarting", 1, start.Add(time.Second))
+		e.Type = corev1.EventTypeNormal
+		assert.False(t, w.accept(nil, e, start))
+
+		e = newEvent("e4", "BackOff", "restarting", 1, start.Add(time.Second))
+		e.InvolvedObject.Kind = "Deployment"
+		assert.False(t, w.accept(nil, e, start))
+
+		e = newEvent("e5", "BackOff", "restarting", 1, start.Add(time.Second))
+		e.InvolvedObject.Name = "orders-1"
+		assert.False(t, w.accept(nil, e, start))
+	})
+
+	t.Run("deduplication window", func(t *testing.T) {
+		w, err := newEventWatcher(&v1alpha1.KubernetesEventsWatch{DeduplicationWindow: "10m"}, start)
+		require.NoError(t, err)
+		assert.True(t, w.accept(nil, newEvent("e1", "BackOff", "restarting", 1, start.Add(time.Second)), start))
+		// the same occurrence reported by a new K8s Event
+		assert.False(t, w.accept(nil, newEvent("e2", "BackOff", "restarting", 1, start.Add(time.Minute)), start.Add(time.Minute)))
+		assert.True(t, w.accept(nil, newEvent("e3", "BackOff", "other", 1, start.Add(time.Minute)), start.Add(time.Minute)))
+		assert.True(t, w.accept(nil, newEvent("e4", "BackOff", "restarting", 1, start.Add(11*time.Minute)), start.Add(11*time.Minute)))
+		assert.Len(t, w.dispatched, 1)
+	})
+}
+
+func TestProcessEvent(t *testing.T) {
+	start := time.Now()
+	el := &EventListener{EventSourceName: "es", EventName: "example", Metrics: metrics.NewMetrics("ns")}
+	el.KubernetesEventSource.Metadata = map[string]string{"team": "payments"}
+	log := zaptest.NewLogger(t).Sugar()
+
+	var dispatched []events.KubernetesEventData
+	dispatch := func(data []byte, _ ...eventsourcecommon.Option) error {
+		var d events.KubernetesEventData
+		if err := json.Unmarshal(data, &d); err != nil {
+			return err
+		}
+		dispatched = append(dispatched, d)
+		return nil
+	}
+
+	w, err := newEventWatcher(&v1alpha1.KubernetesEventsWatch{
+		InvolvedObject: &v1alpha1.InvolvedObjectFilter{
+			Labels: []v1alpha1.Selector{{Key: "app", Operation: "==", Value: "payments"}},
+		},
+	}, start)
+	require.NoError(t, err)
+	objects := map[string]*unstructured.Unstructured{
+		"payments-1": {Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"name":          "payments-1",
+				"labels":        map[string]interface{}{"app": "payments"},
+				"managedFields": []interface{}{map[string]interface{}{"manager": "kubelet"}},
+			},
+		}},
+		"orders-1": {Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"name":   "orders-1",
+				"labels": map[string]interface{}{"app": "orders"},
+			},
+		}},
+	}
+	w.getObject = func(_ context.Context, ref *corev1.ObjectReference) (*unstructured.Unstructured, error) {
+		if obj, ok := objects[ref.Name]; ok {
+			return obj, nil
+		}
+		return nil, fmt.Errorf("not found")
+	}
+
+	require.NoError(t, el.processEvent(context.Background(), w, nil, newEvent("e1", "BackOff", "restarting", 1, start.Add(time.Second)), dispatch, log))
+	require.Len(t, dispatched, 1)
+	assert.Equal(t, eventDataTypeEvent, dispatched[0].Type)
+	assert.Equal(t, "payments", dispatched[0].Metadata["team"])
+	var e corev1.Event
+	require.NoError(t, json.Unmarshal(*dispatched[0].Event, &e))
+	assert.Equal(t, "Event", e.Kind)
+	assert.Equal(t, "BackOff", e.Reason)
+	assert.JSONEq(t, `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"payments-1","labels":{"app":"payments"}}}`, string(*dispatched[0].InvolvedObject))
+
+	e2 := newEvent("e2", "BackOff", "restarting", 1, start.Add(time.Second))
+	e2.InvolvedObject.Name = "orders-1"
+	require.NoError(t, el.processEvent(context.Background(), w, nil, e2, dispatch, log))
+	assert.Len(t, dispatched, 1)
+
+	e3 := newEvent("e3", "BackOff", "restarting", 1, start.Add(time.Second))
+	e3.InvolvedObject.Name = "deleted-1"
+	assert.Error(t, el.processEvent(context.Background(), w, nil, e3, dispatch, log))
+	assert.Len(t, dispatched, 1)
+}
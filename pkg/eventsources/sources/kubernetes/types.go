@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+	metrics "github.com/argoproj/argo-events/pkg/metrics"
+)
+
+// EventListener implements Eventing for the Kubernetes event source
+type EventListener struct {
+	EventSourceName       string
+	EventName             string
+	KubernetesEventSource v1alpha1.KubernetesEventSource
+	Metrics               *metrics.Metrics
+}
+
+// Router contains the information about the audit webhook endpoint
+type Router struct {
+	// route holds information to process an incoming request
+	route *webhook.Route
+	// audit is the configuration of the audit webhook
+	audit *v1alpha1.KubernetesAuditWebhook
+	// metadata holds the user defined metadata passed along the event payload
+	metadata map[string]string
+}
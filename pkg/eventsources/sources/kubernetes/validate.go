@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+)
+
+// ValidateEventSource validates the kubernetes event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.KubernetesEventSource)
+}
+
+func validate(eventSource *v1alpha1.KubernetesEventSource) error {
+	if eventSource == nil {
+		return v1alpha1.ErrNilEventSource
+	}
+	if eventSource.Events == nil && eventSource.Audit == nil {
+		return fmt.Errorf("either events or audit must be specified")
+	}
+	if eventSource.Events != nil {
+		if err := validateEventsWatch(eventSource.Events); err != nil {
+			return err
+		}
+	}
+	if eventSource.Audit != nil {
+		if err := validateAuditWebhook(eventSource.Audit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateEventsWatch(eventsWatch *v1alpha1.KubernetesEventsWatch) error {
+	for _, t := range eventsWatch.Types {
+		if t != corev1.EventTypeNormal && t != corev1.EventTypeWarning {
+			return fmt.Errorf("invalid event type %q, must be either %s or %s", t, corev1.EventTypeNormal, corev1.EventTypeWarning)
+		}
+	}
+	if eventsWatch.DeduplicationWindow != "" {
+		window, err := time.ParseDuration(eventsWatch.DeduplicationWindow)
+		if err != nil {
+			return fmt.Errorf("failed to parse the deduplication window, %w", err)
+		}
+		if window <= 0 {
+			return fmt.Errorf("deduplication window must be positive")
+		}
+	}
+	if filter := eventsWatch.InvolvedObject; filter != nil {
+		for _, sel := range filter.Labels {
+			if sel.Key == "" {
+				return fmt.Errorf("key can't be empty for selector")
+			}
+		}
+	}
+	// compiles the names and the label selector.
+	_, err := newEventWatcher(eventsWatch, time.Now())
+	return err
+}
+
+func validateAuditWebhook(audit *v1alpha1.KubernetesAuditWebhook) error {
+	if audit.Webhook == nil {
+		return fmt.Errorf("webhook must be specified for audit")
+	}
+	if err := webhook.ValidateWebhookContext(audit.Webhook); err != nil {
+		return err
+	}
+	for _, stage := range audit.Stages {
+		switch stage {
+		case "RequestReceived", "ResponseStarted", "ResponseComplete", "Panic":
+		default:
+			return fmt.Errorf("invalid audit stage %q, must be one of RequestReceived, ResponseStarted, ResponseComplete and Panic", stage)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+)
+
+func TestValidateEventSource(t *testing.T) {
+	listener := &EventListener{}
+
+	err := listener.ValidateEventSource(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, "either events or audit must be specified", err.Error())
+
+	content, err := os.ReadFile(fmt.Sprintf("%s/%s", sources.EventSourceDir, "kubernetes.yaml"))
+	assert.Nil(t, err)
+
+	var eventSource *v1alpha1.EventSource
+	err = yaml.Unmarshal(content, &eventSource)
+	assert.Nil(t, err)
+	assert.NotNil(t, eventSource.Spec.Kubernetes)
+
+	for name, value := range eventSource.Spec.Kubernetes {
+		fmt.Println(name)
+		l := &EventListener{
+			KubernetesEventSource: value,
+		}
+		err := l.ValidateEventSource(context.Background())
+		assert.NoError(t, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	events := func(w v1alpha1.KubernetesEventsWatch) *v1alpha1.KubernetesEventSource {
+		return &v1alpha1.KubernetesEventSource{Events: &w}
+	}
+	assert.NoError(t, validate(events(v1alpha1.KubernetesEventsWatch{})))
+	assert.Error(t, validate(events(v1alpha1.KubernetesEventsWatch{Types: []string{"Error"}})))
+	assert.Error(t, validate(events(v1alpha1.KubernetesEventsWatch{DeduplicationWindow: "10"})))
+	assert.Error(t, validate(events(v1alpha1.KubernetesEventsWatch{DeduplicationWindow: "-1m"})))
+	assert.Error(t, validate(events(v1alpha1.KubernetesEventsWatch{
+		InvolvedObject: &v1alpha1.InvolvedObjectFilter{Names: []string{"("}},
+	})))
+	assert.Error(t, validate(events(v1alpha1.KubernetesEventsWatch{
+		InvolvedObject: &v1alpha1.InvolvedObjectFilter{Labels: []v1alpha1.Selector{{Value: "payments"}}},
+	})))
+	assert.Error(t, validate(events(v1alpha1.KubernetesEventsWatch{
+		InvolvedObject: &v1alpha1.InvolvedObjectFilter{Labels: []v1alpha1.Selector{{Key: "app", Operation: "~", Value: "payments"}}},
+	})))
+
+	webhookContext := &v1alpha1.WebhookContext{Endpoint: "/audit", Port: "12000", Method: "POST"}
+	assert.Error(t, validate(&v1alpha1.KubernetesEventSource{Audit: &v1alpha1.KubernetesAuditWebhook{}}))
+	assert.NoError(t, validate(&v1alpha1.KubernetesEventSource{Audit: &v1alpha1.KubernetesAuditWebhook{Webhook: webhookContext}}))
+	assert.Error(t, validate(&v1alpha1.KubernetesEventSource{Audit: &v1alpha1.KubernetesAuditWebhook{
+		Webhook: webhookContext,
+		Stages:  []string{"Complete"},
+	}}))
+}
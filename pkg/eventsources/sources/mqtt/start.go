@@ -26,6 +26,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
 	"github.com/argoproj/argo-events/pkg/eventsources/events"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources"
@@ -70,40 +71,14 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		log.Info("assuming all events have a json body...")
 	}
 
-	log.Info("setting up the message handler...")
-	handler := func(c mqttlib.Client, msg mqttlib.Message) {
-		defer func(start time.Time) {
-			el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
-		}(time.Now())
-
-		eventData := &events.MQTTEventData{
-			Topic:     msg.Topic(),
-			MessageID: int(msg.MessageID()),
-			Body:      msg.Payload(),
-			Metadata:  mqttEventSource.Metadata,
-		}
-		if mqttEventSource.JSONBody {
-			body := msg.Payload()
-			eventData.Body = (*json.RawMessage)(&body)
-		} else {
-			eventData.Body = msg.Payload()
-		}
-
-		eventBody, err := json.Marshal(eventData)
-		if err != nil {
-			log.Errorw("failed to marshal the event data, rejecting the event...", zap.Error(err))
-			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
-			return
-		}
-		log.Info("dispatching event on the data channel...")
-		if err = dispatch(eventBody); err != nil {
-			log.Errorw("failed to dispatch MQTT event...", zap.Error(err))
-			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
-		}
-	}
+	subs := subscriptions(mqttEventSource)
+	persistentSession := mqttEventSource.CleanSession != nil && !*mqttEventSource.CleanSession
 
 	log.Info("setting up the mqtt broker client...")
-	opts := mqttlib.NewClientOptions().AddBroker(mqttEventSource.URL).SetClientID(mqttEventSource.ClientID)
+	opts := mqttlib.NewClientOptions().AddBroker(mqttEventSource.URL).SetClientID(mqttEventSource.ClientID).SetAutoAckDisabled(true)
+	if mqttEventSource.CleanSession != nil {
+		opts.SetCleanSession(*mqttEventSource.CleanSession)
+	}
 	if mqttEventSource.TLS != nil {
 		tlsConfig, err := sharedutil.GetTLSConfig(mqttEventSource.TLS)
 		if err != nil {
@@ -125,31 +100,107 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		opts.SetPassword(password)
 	}
 
-	var client mqttlib.Client
+	filters := make(map[string]byte, len(subs))
+	topics := make([]string, 0, len(subs))
+	for _, sub := range subs {
+		filters[sub.filter] = sub.qos
+		topics = append(topics, sub.filter)
+	}
 
-	log.Info("connecting to mqtt broker...")
-	if err := sharedutil.DoWithRetry(mqttEventSource.ConnectionBackoff, func() error {
-		client = mqttlib.NewClient(opts)
-		if token := client.Connect(); token.Wait() && token.Error() != nil {
-			return token.Error()
+	for {
+		var client mqttlib.Client
+		log.Info("connecting to mqtt broker...")
+		if err := sharedutil.DoWithRetry(mqttEventSource.ConnectionBackoff, func() error {
+			client = mqttlib.NewClient(opts)
+			if token := client.Connect(); token.Wait() && token.Error() != nil {
+				return token.Error()
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to connect to the mqtt broker for event source %s, %w", el.GetEventName(), err)
+		}
+
+		// a message failing to be published is redelivered by reconnecting with the persistent session
+		redeliverCh := make(chan error, 1)
+		handler := func(c mqttlib.Client, msg mqttlib.Message) {
+			err := el.handleOne(msg, subs, dispatch, log)
+			if err != nil {
+				log.Errorw("failed to process a MQTT message", zap.Error(err))
+				el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+				if persistentSession && msg.Qos() > 0 && eventbuscommon.IsEventBusError(err) {
+					select {
+					case redeliverCh <- err:
+					default:
+					}
+					return
+				}
+			}
+			msg.Ack()
+		}
+
+		log.Infow("subscribing to the topics...", "topics", topics)
+		if token := client.SubscribeMultiple(filters, handler); token.Wait() && token.Error() != nil {
+			client.Disconnect(0)
+			log.Errorw("failed to subscribe to the topics, reconnecting in 5 seconds...", "topics", topics, zap.Error(token.Error()))
+		} else {
+			select {
+			case <-ctx.Done():
+				log.Info("event source is stopped, unsubscribing the client...")
+				if !persistentSession {
+					token := client.Unsubscribe(topics...)
+					if token.Wait() && token.Error() != nil {
+						log.Errorw("failed to unsubscribe client", zap.Error(token.Error()))
+					}
+				}
+				client.Disconnect(250)
+				return nil
+			case err := <-redeliverCh:
+				// disconnecting without acknowledging the message, it is redelivered after reconnecting
+				client.Disconnect(0)
+				log.Errorw("failed to publish a MQTT message to the eventbus, reconnecting in 5 seconds for redelivery...", zap.Error(err))
+			}
 		}
-		return nil
-	}); err != nil {
-		return fmt.Errorf("failed to connect to the mqtt broker for event source %s, %w", el.GetEventName(), err)
-	}
 
-	log.Info("subscribing to the topic...")
-	if token := client.Subscribe(mqttEventSource.Topic, 0, handler); token.Wait() && token.Error() != nil {
-		return fmt.Errorf("failed to subscribe to the topic %s for event source %s, %w", mqttEventSource.Topic, el.GetEventName(), token.Error())
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(5 * time.Second):
+		}
 	}
+}
 
-	<-ctx.Done()
-	log.Info("event source is stopped, unsubscribing the client...")
+func (el *EventListener) handleOne(msg mqttlib.Message, subs []subscription, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) error {
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
 
-	token := client.Unsubscribe(mqttEventSource.Topic)
-	if token.Error() != nil {
-		log.Errorw("failed to unsubscribe client", zap.Error(token.Error()))
+	mqttEventSource := &el.MQTTEventSource
+	eventData := &events.MQTTEventData{
+		Topic:     msg.Topic(),
+		MessageID: int(msg.MessageID()),
+		QoS:       int(msg.Qos()),
+		Retained:  msg.Retained(),
+		Duplicate: msg.Duplicate(),
+		Metadata:  mqttEventSource.Metadata,
+	}
+	if sub := matchSubscription(subs, msg.Topic()); sub != nil {
+		eventData.Subscription = sub.topicFilter
+		eventData.SharedGroup = sub.group
+	}
+	if mqttEventSource.JSONBody {
+		body := msg.Payload()
+		eventData.Body = (*json.RawMessage)(&body)
+	} else {
+		eventData.Body = msg.Payload()
 	}
 
+	eventBody, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the event data, rejecting the event, %w", err)
+	}
+	log.Info("dispatching event on the data channel...")
+	if err = dispatch(eventBody); err != nil {
+		return fmt.Errorf("failed to dispatch MQTT event, %w", err)
+	}
 	return nil
 }
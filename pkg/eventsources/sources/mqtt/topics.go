@@ -0,0 +1,104 @@
+/*
+Copyright 2018 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"strings"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+const sharedSubscriptionPrefix = "$share/"
+
+// subscription is a topic filter subscribed to
+type subscription struct {
+	// filter is the topic filter sent to the broker, including the shared subscription prefix
+	filter string
+	// topicFilter is the topic filter without the shared subscription prefix
+	topicFilter string
+	group       string
+	qos         byte
+}
+
+// subscriptions returns the topic filters to subscribe to, in the order of the specification.
+func subscriptions(eventSource *v1alpha1.MQTTEventSource) []subscription {
+	var topics []v1alpha1.MQTTTopic
+	if eventSource.Topic != "" {
+		topics = append(topics, v1alpha1.MQTTTopic{Topic: eventSource.Topic})
+	}
+	topics = append(topics, eventSource.Topics...)
+
+	result := make([]subscription, 0, len(topics))
+	for _, t := range topics {
+		filter := t.Topic
+		if eventSource.SharedGroup != "" {
+			filter = sharedSubscriptionPrefix + eventSource.SharedGroup + "/" + t.Topic
+		}
+		group, topicFilter := splitSharedSubscription(filter)
+		result = append(result, subscription{
+			filter:      filter,
+			topicFilter: topicFilter,
+			group:       group,
+			qos:         byte(t.QoS),
+		})
+	}
+	return result
+}
+
+// splitSharedSubscription splits a "$share/{group}/{topic}" filter into the group and the topic filter.
+func splitSharedSubscription(filter string) (string, string) {
+	if !strings.HasPrefix(filter, sharedSubscriptionPrefix) {
+		return "", filter
+	}
+	group, topicFilter, found := strings.Cut(strings.TrimPrefix(filter, sharedSubscriptionPrefix), "/")
+	if !found {
+		return "", filter
+	}
+	return group, topicFilter
+}
+
+// matchSubscription returns the first subscription whose topic filter matches the topic.
+func matchSubscription(subs []subscription, topic string) *subscription {
+	for i := range subs {
+		if matchTopic(subs[i].topicFilter, topic) {
+			return &subs[i]
+		}
+	}
+	return nil
+}
+
+// matchTopic returns true if the topic matches the topic filter, following the wildcard rules of MQTT.
+func matchTopic(filter, topic string) bool {
+	filterLevels := strings.Split(filter, "/")
+	topicLevels := strings.Split(topic, "/")
+	// topics beginning with $ are not matched by a wildcard at the first level
+	if strings.HasPrefix(topic, "$") && len(filterLevels) > 0 && (filterLevels[0] == "+" || filterLevels[0] == "#") {
+		return false
+	}
+	for i, level := range filterLevels {
+		if level == "#" {
+			return true
+		}
+		if i >= len(topicLevels) {
+			return false
+		}
+		if level != "+" && level != topicLevels[i] {
+			return false
+		}
+	}
+	return len(filterLevels) == len(topicLevels)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2018 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+func TestSubscriptions(t *testing.T) {
+	t.Run("topic and topics", func(t *testing.T) {
+		subs := subscriptions(&v1alpha1.MQTTEventSource{
+			Topic: "foo",
+			Topics: []v1alpha1.MQTTTopic{
+				{Topic: "bar/+", QoS: 1},
+				{Topic: "$share/g/baz/#", QoS: 2},
+			},
+		})
+		require.Len(t, subs, 3)
+		assert.Equal(t, subscription{filter: "foo", topicFilter: "foo"}, subs[0])
+		assert.Equal(t, subscription{filter: "bar/+", topicFilter: "bar/+", qos: 1}, subs[1])
+		assert.Equal(t, subscription{filter: "$share/g/baz/#", topicFilter: "baz/#", group: "g", qos: 2}, subs[2])
+	})
+
+	t.Run("shared group", func(t *testing.T) {
+		subs := subscriptions(&v1alpha1.MQTTEventSource{
+			SharedGroup: "g",
+			Topics:      []v1alpha1.MQTTTopic{{Topic: "bar/+", QoS: 1}},
+		})
+		require.Len(t, subs, 1)
+		assert.Equal(t, subscription{filter: "$share/g/bar/+", topicFilter: "bar/+", group: "g", qos: 1}, subs[0])
+	})
+}
+
+func TestMatchTopic(t *testing.T) {
+	tests := []struct {
+		filter string
+		topic  string
+		match  bool
+	}{
+		{"a/b", "a/b", true},
+		{"a/b", "a/c", false},
+		{"a/+", "a/b", true},
+		{"a/+", "a/b/c", false},
+		{"a/+/c", "a/b/c", true},
+		{"a/#", "a", true},
+		{"a/#", "a/b/c", true},
+		{"#", "a/b", true},
+		{"#", "$SYS/a", false},
+		{"+/a", "$SYS/a", false},
+		{"$SYS/#", "$SYS/a", true},
+		{"a/b", "a/b/c", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, matchTopic(tt.filter, tt.topic), "filter %s, topic %s", tt.filter, tt.topic)
+	}
+
+	subs := subscriptions(&v1alpha1.MQTTEventSource{
+		Topics: []v1alpha1.MQTTTopic{{Topic: "a/+"}, {Topic: "$share/g/b/#"}},
+	})
+	sub := matchSubscription(subs, "b/c/d")
+	require.NotNil(t, sub)
+	assert.Equal(t, "b/#", sub.topicFilter)
+	assert.Equal(t, "g", sub.group)
+	assert.Nil(t, matchSubscription(subs, "c"))
+}
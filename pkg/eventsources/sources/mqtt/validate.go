@@ -19,6 +19,7 @@ package mqtt
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 )
@@ -35,14 +36,38 @@ func validate(eventSource *v1alpha1.MQTTEventSource) error {
 	if eventSource.URL == "" {
 		return fmt.Errorf("url must be specified")
 	}
-	if eventSource.Topic == "" {
-		return fmt.Errorf("topic must be specified")
+	if eventSource.Topic == "" && len(eventSource.Topics) == 0 {
+		return fmt.Errorf("topic or topics must be specified")
 	}
 	if eventSource.ClientID == "" {
 		return fmt.Errorf("client id must be specified")
 	}
+	if strings.ContainsAny(eventSource.SharedGroup, "/+#") {
+		return fmt.Errorf("shared group %q must not contain '/', '+' or '#'", eventSource.SharedGroup)
+	}
+	seen := make(map[string]bool)
+	for _, t := range eventSource.Topics {
+		if t.Topic == "" {
+			return fmt.Errorf("topic in topics must be specified")
+		}
+		if t.QoS < 0 || t.QoS > 2 {
+			return fmt.Errorf("qos of topic %s must be one of 0, 1 or 2", t.Topic)
+		}
+		if eventSource.SharedGroup != "" && strings.HasPrefix(t.Topic, sharedSubscriptionPrefix) {
+			return fmt.Errorf("topic %s must not be a shared subscription if shared group is specified", t.Topic)
+		}
+		if seen[t.Topic] || t.Topic == eventSource.Topic {
+			return fmt.Errorf("topic %s is specified more than once", t.Topic)
+		}
+		seen[t.Topic] = true
+	}
+	if eventSource.SharedGroup != "" && strings.HasPrefix(eventSource.Topic, sharedSubscriptionPrefix) {
+		return fmt.Errorf("topic %s must not be a shared subscription if shared group is specified", eventSource.Topic)
+	}
 	if eventSource.TLS != nil {
-		return v1alpha1.ValidateTLSConfig(eventSource.TLS)
+		if err := v1alpha1.ValidateTLSConfig(eventSource.TLS); err != nil {
+			return err
+		}
 	}
 	if eventSource.Auth != nil {
 		return v1alpha1.ValidateBasicAuth(eventSource.Auth)
@@ -52,3 +52,53 @@ func TestValidateEventSource(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestValidateTopics(t *testing.T) {
+	newSource := func() *v1alpha1.MQTTEventSource {
+		return &v1alpha1.MQTTEventSource{
+			URL:      "tcp://localhost:1883",
+			ClientID: "client",
+			Topics: []v1alpha1.MQTTTopic{
+				{Topic: "a/+", QoS: 1},
+				{Topic: "b/#", QoS: 2},
+			},
+		}
+	}
+
+	t.Run("topics", func(t *testing.T) {
+		assert.NoError(t, validate(newSource()))
+	})
+
+	t.Run("no topic", func(t *testing.T) {
+		es := newSource()
+		es.Topics = nil
+		err := validate(es)
+		assert.Error(t, err)
+		assert.Equal(t, "topic or topics must be specified", err.Error())
+	})
+
+	t.Run("invalid qos", func(t *testing.T) {
+		es := newSource()
+		es.Topics[0].QoS = 3
+		assert.Error(t, validate(es))
+	})
+
+	t.Run("duplicate topic", func(t *testing.T) {
+		es := newSource()
+		es.Topic = "a/+"
+		err := validate(es)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "more than once")
+	})
+
+	t.Run("shared group", func(t *testing.T) {
+		es := newSource()
+		es.SharedGroup = "g"
+		assert.NoError(t, validate(es))
+		es.SharedGroup = "g/1"
+		assert.Error(t, validate(es))
+		es.SharedGroup = "g"
+		es.Topics[0].Topic = "$share/other/a"
+		assert.Error(t, validate(es))
+	})
+}
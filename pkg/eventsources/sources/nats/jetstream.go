@@ -0,0 +1,213 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	natslib "github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+)
+
+const (
+	defaultFetchBatchSize = 10
+	fetchMaxWait          = 5 * time.Second
+)
+
+// consumeJetStream consumes the messages with a durable JetStream consumer, the messages are acknowledged
+// after the events are published to the eventbus, and negatively acknowledged for redelivery if it fails.
+func (el *EventListener) consumeJetStream(ctx context.Context, conn *natslib.Conn, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) error {
+	natsEventSource := &el.NATSEventSource
+	jsConfig := natsEventSource.JetStream
+
+	js, err := conn.JetStream()
+	if err != nil {
+		return fmt.Errorf("failed to get the JetStream context for event source %s, %w", el.GetEventName(), err)
+	}
+	cfg, err := consumerConfig(el.GetEventSourceName(), el.GetEventName(), natsEventSource)
+	if err != nil {
+		return err
+	}
+	if err := ensureConsumer(js, jsConfig.Stream, cfg); err != nil {
+		return fmt.Errorf("failed to create or update the consumer %s of stream %s for event source %s, %w", cfg.Durable, jsConfig.Stream, el.GetEventName(), err)
+	}
+	log = log.With("stream", jsConfig.Stream, "consumer", cfg.Durable)
+
+	handle := func(msg *natslib.Msg) {
+		err := el.handleOne(msg, dispatch, log)
+		if err != nil {
+			log.Errorw("failed to process a JetStream message", zap.Error(err))
+			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+		}
+		if err := acknowledge(msg, err); err != nil {
+			log.Errorw("failed to acknowledge a JetStream message", zap.Error(err))
+		}
+	}
+
+	// The subject of binding to a consumer must match its filter subject, if there is only one
+	subject := cfg.FilterSubject
+	if jsConfig.Mode == v1alpha1.NATSJetStreamConsumerPush {
+		var sub *natslib.Subscription
+		if cfg.DeliverGroup != "" {
+			sub, err = js.QueueSubscribe(subject, cfg.DeliverGroup, handle, natslib.Bind(jsConfig.Stream, cfg.Durable), natslib.ManualAck())
+		} else {
+			sub, err = js.Subscribe(subject, handle, natslib.Bind(jsConfig.Stream, cfg.Durable), natslib.ManualAck())
+		}
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to the consumer %s for event source %s, %w", cfg.Durable, el.GetEventName(), err)
+		}
+		defer func() {
+			_ = sub.Unsubscribe()
+		}()
+		log.Info("consuming messages with the push consumer")
+		<-ctx.Done()
+		log.Info("event source is stopped")
+		return nil
+	}
+
+	sub, err := js.PullSubscribe(subject, cfg.Durable, natslib.Bind(jsConfig.Stream, cfg.Durable))
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to the consumer %s for event source %s, %w", cfg.Durable, el.GetEventName(), err)
+	}
+	defer func() {
+		_ = sub.Unsubscribe()
+	}()
+	batchSize := defaultFetchBatchSize
+	if jsConfig.FetchBatchSize > 0 {
+		batchSize = int(jsConfig.FetchBatchSize)
+	}
+	log.Info("consuming messages with the pull consumer")
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("event source is stopped")
+			return nil
+		default:
+		}
+		msgs, err := sub.Fetch(batchSize, natslib.MaxWait(fetchMaxWait))
+		if err != nil {
+			if errors.Is(err, natslib.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+				continue
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to fetch messages from the consumer %s for event source %s, %w", cfg.Durable, el.GetEventName(), err)
+		}
+		for _, msg := range msgs {
+			handle(msg)
+		}
+	}
+}
+
+// acknowledge acknowledges the message if the event is published, negatively acknowledges it for redelivery
+// if it fails to be published to the eventbus, otherwise terminates it as it can never be processed.
+func acknowledge(msg *natslib.Msg, dispatchErr error) error {
+	switch {
+	case dispatchErr == nil:
+		return msg.Ack()
+	case eventbuscommon.IsEventBusError(dispatchErr):
+		return msg.Nak()
+	default:
+		return msg.Term()
+	}
+}
+
+// consumerConfig returns the configuration of the durable consumer.
+func consumerConfig(eventSourceName, eventName string, src *v1alpha1.NATSEventsSource) (*natslib.ConsumerConfig, error) {
+	jsConfig := src.JetStream
+	cfg := &natslib.ConsumerConfig{
+		Durable:       jsConfig.Consumer,
+		AckPolicy:     natslib.AckExplicitPolicy,
+		MaxAckPending: int(jsConfig.MaxAckPending),
+	}
+	if cfg.Durable == "" {
+		cfg.Durable = fmt.Sprintf("%s-%s", eventSourceName, eventName)
+	}
+	switch jsConfig.DeliverPolicy {
+	case "", v1alpha1.NATSJetStreamDeliverAll:
+		cfg.DeliverPolicy = natslib.DeliverAllPolicy
+	case v1alpha1.NATSJetStreamDeliverNew:
+		cfg.DeliverPolicy = natslib.DeliverNewPolicy
+	case v1alpha1.NATSJetStreamDeliverByStartTime:
+		t, err := time.Parse(time.RFC3339, jsConfig.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start time %q, %w", jsConfig.StartTime, err)
+		}
+		cfg.DeliverPolicy = natslib.DeliverByStartTimePolicy
+		cfg.OptStartTime = &t
+	case v1alpha1.NATSJetStreamDeliverByStartSequence:
+		cfg.DeliverPolicy = natslib.DeliverByStartSequencePolicy
+		cfg.OptStartSeq = jsConfig.StartSequence
+	default:
+		return nil, fmt.Errorf("unsupported deliver policy %q", jsConfig.DeliverPolicy)
+	}
+	if jsConfig.AckWait != nil {
+		d, err := time.ParseDuration(*jsConfig.AckWait)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ack wait %q, %w", *jsConfig.AckWait, err)
+		}
+		cfg.AckWait = d
+	}
+	subjects := jsConfig.FilterSubjects
+	if len(subjects) == 0 && src.Subject != "" {
+		subjects = []string{src.Subject}
+	}
+	if len(subjects) == 1 {
+		cfg.FilterSubject = subjects[0]
+	} else {
+		cfg.FilterSubjects = subjects
+	}
+	if jsConfig.Mode == v1alpha1.NATSJetStreamConsumerPush {
+		cfg.DeliverSubject = natslib.NewInbox()
+		if src.Queue != nil {
+			cfg.DeliverGroup = *src.Queue
+		}
+	}
+	return cfg, nil
+}
+
+// ensureConsumer creates the consumer if it does not exist, otherwise updates the settings which can be changed.
+func ensureConsumer(js natslib.JetStreamManager, stream string, cfg *natslib.ConsumerConfig) error {
+	info, err := js.ConsumerInfo(stream, cfg.Durable)
+	if err != nil {
+		if !errors.Is(err, natslib.ErrConsumerNotFound) {
+			return err
+		}
+		_, err = js.AddConsumer(stream, cfg)
+		return err
+	}
+	if (cfg.DeliverSubject == "") != (info.Config.DeliverSubject == "") {
+		return fmt.Errorf("the existing consumer is a %s consumer", consumerMode(&info.Config))
+	}
+	updated := mergeConsumerConfig(info.Config, *cfg)
+	if _, err = js.UpdateConsumer(stream, &updated); err != nil {
+		return err
+	}
+	*cfg = updated
+	return nil
+}
+
+// mergeConsumerConfig applies the settings which can be updated on an existing consumer,
+// the deliver policy and deliver subject are kept as they are.
+func mergeConsumerConfig(existing, desired natslib.ConsumerConfig) natslib.ConsumerConfig {
+	result := existing
+	result.AckWait = desired.AckWait
+	result.MaxAckPending = desired.MaxAckPending
+	result.FilterSubject = desired.FilterSubject
+	result.FilterSubjects = desired.FilterSubjects
+	result.DeliverGroup = desired.DeliverGroup
+	return result
+}
+
+func consumerMode(cfg *natslib.ConsumerConfig) v1alpha1.NATSJetStreamConsumerMode {
+	if cfg.DeliverSubject != "" {
+		return v1alpha1.NATSJetStreamConsumerPush
+	}
+	return v1alpha1.NATSJetStreamConsumerPull
+}
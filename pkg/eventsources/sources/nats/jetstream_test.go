@@ -0,0 +1,214 @@
+package nats
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	natslib "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/metrics"
+)
+
+func TestConsumerConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		cfg, err := consumerConfig("es", "ev", &v1alpha1.NATSEventsSource{
+			Subject:   "foo",
+			JetStream: &v1alpha1.NATSJetStreamConsumer{Stream: "s"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "es-ev", cfg.Durable)
+		assert.Equal(t, natslib.AckExplicitPolicy, cfg.AckPolicy)
+		assert.Equal(t, natslib.DeliverAllPolicy, cfg.DeliverPolicy)
+		assert.Equal(t, "foo", cfg.FilterSubject)
+		assert.Empty(t, cfg.FilterSubjects)
+		assert.Empty(t, cfg.DeliverSubject)
+	})
+
+	t.Run("filter subjects", func(t *testing.T) {
+		cfg, err := consumerConfig("es", "ev", &v1alpha1.NATSEventsSource{
+			Subject:   "foo",
+			JetStream: &v1alpha1.NATSJetStreamConsumer{Stream: "s", FilterSubjects: []string{"a", "b"}},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, cfg.FilterSubject)
+		assert.Equal(t, []string{"a", "b"}, cfg.FilterSubjects)
+	})
+
+	t.Run("start time", func(t *testing.T) {
+		ackWait := "1m"
+		cfg, err := consumerConfig("es", "ev", &v1alpha1.NATSEventsSource{
+			JetStream: &v1alpha1.NATSJetStreamConsumer{
+				Stream:        "s",
+				Consumer:      "c",
+				DeliverPolicy: v1alpha1.NATSJetStreamDeliverByStartTime,
+				StartTime:     "2024-01-01T00:00:00Z",
+				AckWait:       &ackWait,
+				MaxAckPending: 5,
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "c", cfg.Durable)
+		assert.Equal(t, natslib.DeliverByStartTimePolicy, cfg.DeliverPolicy)
+		require.NotNil(t, cfg.OptStartTime)
+		assert.True(t, cfg.OptStartTime.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, time.Minute, cfg.AckWait)
+		assert.Equal(t, 5, cfg.MaxAckPending)
+		assert.Empty(t, cfg.FilterSubject)
+	})
+
+	t.Run("start sequence", func(t *testing.T) {
+		cfg, err := consumerConfig("es", "ev", &v1alpha1.NATSEventsSource{
+			JetStream: &v1alpha1.NATSJetStreamConsumer{Stream: "s", DeliverPolicy: v1alpha1.NATSJetStreamDeliverByStartSequence, StartSequence: 42},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, natslib.DeliverByStartSequencePolicy, cfg.DeliverPolicy)
+		assert.Equal(t, uint64(42), cfg.OptStartSeq)
+	})
+
+	t.Run("push", func(t *testing.T) {
+		queue := "q"
+		cfg, err := consumerConfig("es", "ev", &v1alpha1.NATSEventsSource{
+			Queue:     &queue,
+			JetStream: &v1alpha1.NATSJetStreamConsumer{Stream: "s", Mode: v1alpha1.NATSJetStreamConsumerPush},
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, cfg.DeliverSubject)
+		assert.Equal(t, "q", cfg.DeliverGroup)
+		assert.Equal(t, v1alpha1.NATSJetStreamConsumerPush, consumerMode(cfg))
+	})
+}
+
+func TestMergeConsumerConfig(t *testing.T) {
+	existing := natslib.ConsumerConfig{
+		Durable:        "c",
+		DeliverPolicy:  natslib.DeliverNewPolicy,
+		DeliverSubject: "inbox",
+		AckWait:        time.Second,
+		FilterSubject:  "foo",
+	}
+	desired := natslib.ConsumerConfig{
+		Durable:        "c",
+		DeliverPolicy:  natslib.DeliverAllPolicy,
+		DeliverSubject: "other",
+		AckWait:        time.Minute,
+		MaxAckPending:  10,
+		FilterSubjects: []string{"a", "b"},
+	}
+	result := mergeConsumerConfig(existing, desired)
+	assert.Equal(t, natslib.DeliverNewPolicy, result.DeliverPolicy)
+	assert.Equal(t, "inbox", result.DeliverSubject)
+	assert.Equal(t, time.Minute, result.AckWait)
+	assert.Equal(t, 10, result.MaxAckPending)
+	assert.Empty(t, result.FilterSubject)
+	assert.Equal(t, []string{"a", "b"}, result.FilterSubjects)
+}
+
+// runJetStreamServer starts an embedded NATS server with JetStream enabled and a stream of the "events.>" subjects.
+func runJetStreamServer(t *testing.T) *natslib.Conn {
+	t.Helper()
+	s, err := natsserver.NewServer(&natsserver.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	require.NoError(t, err)
+	go s.Start()
+	t.Cleanup(s.Shutdown)
+	require.True(t, s.ReadyForConnections(10*time.Second))
+
+	conn, err := natslib.Connect(s.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(conn.Close)
+	js, err := conn.JetStream()
+	require.NoError(t, err)
+	_, err = js.AddStream(&natslib.StreamConfig{Name: "events", Subjects: []string{"events.>"}})
+	require.NoError(t, err)
+	return conn
+}
+
+func TestConsumeJetStream(t *testing.T) {
+	for _, mode := range []v1alpha1.NATSJetStreamConsumerMode{v1alpha1.NATSJetStreamConsumerPull, v1alpha1.NATSJetStreamConsumerPush} {
+		t.Run(string(mode), func(t *testing.T) {
+			conn := runJetStreamServer(t)
+			js, err := conn.JetStream()
+			require.NoError(t, err)
+
+			el := &EventListener{
+				EventSourceName: "es",
+				EventName:       "ev",
+				NATSEventSource: v1alpha1.NATSEventsSource{
+					Subject:   "events.>",
+					JSONBody:  true,
+					JetStream: &v1alpha1.NATSJetStreamConsumer{Stream: "events", Mode: mode},
+				},
+				Metrics: metrics.NewMetrics("ns"),
+			}
+			// the first attempt to publish the "retry" event to the eventbus fails
+			attempts := map[string]int{}
+			fake := &eventsourcecommon.FakeDispatcher[events.NATSEventData]{
+				Fail: func(e events.NATSEventData) bool {
+					attempts[e.Subject]++
+					return e.Subject == "events.retry" && attempts[e.Subject] == 1
+				},
+			}
+			var mu sync.Mutex
+			dispatch := func(data []byte, opts ...eventsourcecommon.Option) error {
+				mu.Lock()
+				defer mu.Unlock()
+				return fake.Dispatch(data, opts...)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error)
+			go func() {
+				done <- el.consumeJetStream(ctx, conn, dispatch, zaptest.NewLogger(t).Sugar())
+			}()
+
+			_, err = js.Publish("events.ok", []byte(`{"a":1}`))
+			require.NoError(t, err)
+			// an invalid JSON body can never be processed
+			_, err = js.Publish("events.invalid", []byte(`not-json`))
+			require.NoError(t, err)
+			_, err = js.Publish("events.retry", []byte(`{"b":2}`))
+			require.NoError(t, err)
+
+			assert.Eventually(t, func() bool {
+				info, err := js.ConsumerInfo("events", "es-ev")
+				if err != nil || info.NumPending > 0 || info.NumAckPending > 0 {
+					return false
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				return len(fake.Events) == 2
+			}, 20*time.Second, 100*time.Millisecond)
+
+			cancel()
+			require.NoError(t, <-done)
+
+			mu.Lock()
+			defer mu.Unlock()
+			// acknowledged once published, the message failing to be published is negatively acknowledged
+			// and redelivered, the invalid one is terminated
+			assert.Equal(t, "events.ok", fake.Events[0].Subject)
+			assert.Equal(t, "events.retry", fake.Events[1].Subject)
+			assert.Equal(t, "es:ev:events:3", fake.IDs[1])
+			assert.Equal(t, 2, attempts["events.retry"])
+			assert.Equal(t, 0, attempts["events.invalid"])
+			info, err := js.ConsumerInfo("events", "es-ev")
+			require.NoError(t, err)
+			assert.Equal(t, uint64(3), info.AckFloor.Stream)
+			// the three messages and the redelivery
+			assert.Equal(t, uint64(4), info.Delivered.Consumer)
+		})
+	}
+}
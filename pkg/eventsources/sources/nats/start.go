@@ -27,6 +27,7 @@ import (
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/decoder"
 	"github.com/argoproj/argo-events/pkg/eventsources/events"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources"
 	metrics "github.com/argoproj/argo-events/pkg/metrics"
@@ -40,6 +41,9 @@ type EventListener struct {
 	EventName       string
 	NATSEventSource v1alpha1.NATSEventsSource
 	Metrics         *metrics.Metrics
+
+	// payloadDecoder decodes the message data into JSON, if any
+	payloadDecoder decoder.Decoder
 }
 
 // GetEventSourceName returns name of event source
@@ -65,6 +69,14 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 	natsEventSource := &el.NATSEventSource
 
+	if natsEventSource.Decoder != nil {
+		payloadDecoder, err := decoder.New(natsEventSource.Decoder)
+		if err != nil {
+			return fmt.Errorf("failed to create the decoder for event source %s, %w", el.GetEventName(), err)
+		}
+		el.payloadDecoder = payloadDecoder
+	}
+
 	var opt []natslib.Option
 	if natsEventSource.TLS != nil {
 		tlsConfig, err := sharedutil.GetTLSConfig(natsEventSource.TLS)
@@ -128,31 +140,13 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		log.Info("assuming all events have a json body...")
 	}
 
-	handler := func(msg *natslib.Msg) {
-		defer func(start time.Time) {
-			el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
-		}(time.Now())
-
-		eventData := &events.NATSEventData{
-			Subject:  msg.Subject,
-			Header:   msg.Header,
-			Metadata: natsEventSource.Metadata,
-		}
-		if natsEventSource.JSONBody {
-			eventData.Body = (*json.RawMessage)(&msg.Data)
-		} else {
-			eventData.Body = msg.Data
-		}
+	if natsEventSource.JetStream != nil {
+		return el.consumeJetStream(ctx, conn, dispatch, log)
+	}
 
-		eventBody, err := json.Marshal(eventData)
-		if err != nil {
-			log.Errorw("failed to marshal the event data, rejecting the event...", zap.Error(err))
-			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
-			return
-		}
-		log.Info("dispatching the event on data channel...")
-		if err = dispatch(eventBody); err != nil {
-			log.Errorw("failed to dispatch a NATS event", zap.Error(err))
+	handler := func(msg *natslib.Msg) {
+		if err := el.handleOne(msg, dispatch, log); err != nil {
+			log.Errorw("failed to process a NATS message", zap.Error(err))
 			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
 		}
 	}
@@ -179,3 +173,49 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	log.Info("event source is stopped")
 	return nil
 }
+
+func (el *EventListener) handleOne(msg *natslib.Msg, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) error {
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	natsEventSource := &el.NATSEventSource
+	eventData := &events.NATSEventData{
+		Subject:  msg.Subject,
+		Header:   msg.Header,
+		Metadata: natsEventSource.Metadata,
+	}
+	switch {
+	case el.payloadDecoder != nil:
+		decoded, err := el.payloadDecoder.Decode(msg.Data, decoder.Metadata{})
+		if err != nil {
+			return fmt.Errorf("failed to decode the message data, %w", err)
+		}
+		eventData.Body = (*json.RawMessage)(&decoded)
+	case natsEventSource.JSONBody:
+		eventData.Body = (*json.RawMessage)(&msg.Data)
+	default:
+		eventData.Body = msg.Data
+	}
+	var opts []eventsourcecommon.Option
+	if natsEventSource.JetStream != nil {
+		meta, err := msg.Metadata()
+		if err != nil {
+			return fmt.Errorf("failed to get the metadata of the JetStream message, %w", err)
+		}
+		eventData.Stream = meta.Stream
+		eventData.Sequence = meta.Sequence.Stream
+		// the redelivered messages have the same event ID
+		opts = append(opts, eventsourcecommon.WithID(fmt.Sprintf("%s:%s:%s:%d", el.GetEventSourceName(), el.GetEventName(), meta.Stream, meta.Sequence.Stream)))
+	}
+
+	eventBody, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the event data, rejecting the event, %w", err)
+	}
+	log.Info("dispatching the event on data channel...")
+	if err = dispatch(eventBody, opts...); err != nil {
+		return fmt.Errorf("failed to dispatch a NATS event, %w", err)
+	}
+	return nil
+}
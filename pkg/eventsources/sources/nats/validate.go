@@ -19,8 +19,10 @@ package nats
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/decoder"
 )
 
 // ValidateEventSource validates nats event source
@@ -35,14 +37,63 @@ func validate(eventSource *v1alpha1.NATSEventsSource) error {
 	if eventSource.URL == "" {
 		return fmt.Errorf("url must be specified")
 	}
-	if eventSource.Subject == "" {
+	if eventSource.Subject == "" && eventSource.JetStream == nil {
 		return fmt.Errorf("subject must be specified")
 	}
 	if eventSource.TLS != nil {
-		return v1alpha1.ValidateTLSConfig(eventSource.TLS)
+		if err := v1alpha1.ValidateTLSConfig(eventSource.TLS); err != nil {
+			return err
+		}
 	}
 	if eventSource.Queue != nil && *eventSource.Queue == "" {
 		return fmt.Errorf("queue group cannot be empty if specified")
 	}
+	if eventSource.Decoder != nil {
+		if eventSource.Decoder.Pulsar != nil {
+			return fmt.Errorf("the pulsar decoder is only supported by the pulsar event source")
+		}
+		if err := decoder.Validate(eventSource.Decoder); err != nil {
+			return err
+		}
+	}
+	if eventSource.JetStream != nil {
+		return validateJetStream(eventSource.JetStream)
+	}
+	return nil
+}
+
+func validateJetStream(js *v1alpha1.NATSJetStreamConsumer) error {
+	if js.Stream == "" {
+		return fmt.Errorf("jetStream stream must be specified")
+	}
+	switch js.Mode {
+	case "", v1alpha1.NATSJetStreamConsumerPull, v1alpha1.NATSJetStreamConsumerPush:
+	default:
+		return fmt.Errorf("invalid jetStream mode %q, must be one of Pull, Push", js.Mode)
+	}
+	switch js.DeliverPolicy {
+	case "", v1alpha1.NATSJetStreamDeliverAll, v1alpha1.NATSJetStreamDeliverNew:
+	case v1alpha1.NATSJetStreamDeliverByStartTime:
+		if _, err := time.Parse(time.RFC3339, js.StartTime); err != nil {
+			return fmt.Errorf("jetStream startTime must be a RFC3339 time for deliver policy %s, %w", js.DeliverPolicy, err)
+		}
+	case v1alpha1.NATSJetStreamDeliverByStartSequence:
+		if js.StartSequence == 0 {
+			return fmt.Errorf("jetStream startSequence must be specified for deliver policy %s", js.DeliverPolicy)
+		}
+	default:
+		return fmt.Errorf("invalid jetStream deliverPolicy %q, must be one of All, New, ByStartTime, ByStartSequence", js.DeliverPolicy)
+	}
+	if js.AckWait != nil {
+		if _, err := time.ParseDuration(*js.AckWait); err != nil {
+			return fmt.Errorf("invalid jetStream ackWait %q, %w", *js.AckWait, err)
+		}
+	}
+	if js.MaxAckPending < 0 {
+		return fmt.Errorf("jetStream maxAckPending must not be negative")
+	}
+	if js.FetchBatchSize < 0 {
+		return fmt.Errorf("jetStream fetchBatchSize must not be negative")
+	}
 	return nil
 }
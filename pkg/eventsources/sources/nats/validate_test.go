@@ -52,3 +52,55 @@ func TestValidateEventSource(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestValidateJetStream(t *testing.T) {
+	newSource := func(js *v1alpha1.NATSJetStreamConsumer) *v1alpha1.NATSEventsSource {
+		return &v1alpha1.NATSEventsSource{
+			URL:       "nats://localhost:4222",
+			JetStream: js,
+		}
+	}
+
+	t.Run("no subject", func(t *testing.T) {
+		assert.NoError(t, validate(newSource(&v1alpha1.NATSJetStreamConsumer{Stream: "s"})))
+	})
+
+	t.Run("no stream", func(t *testing.T) {
+		err := validate(newSource(&v1alpha1.NATSJetStreamConsumer{}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "stream must be specified")
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		err := validate(newSource(&v1alpha1.NATSJetStreamConsumer{Stream: "s", Mode: "abc"}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid jetStream mode")
+	})
+
+	t.Run("deliver policy", func(t *testing.T) {
+		err := validate(newSource(&v1alpha1.NATSJetStreamConsumer{Stream: "s", DeliverPolicy: "abc"}))
+		assert.Error(t, err)
+		err = validate(newSource(&v1alpha1.NATSJetStreamConsumer{Stream: "s", DeliverPolicy: v1alpha1.NATSJetStreamDeliverByStartTime}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "startTime")
+		err = validate(newSource(&v1alpha1.NATSJetStreamConsumer{Stream: "s", DeliverPolicy: v1alpha1.NATSJetStreamDeliverByStartTime, StartTime: "2024-01-01T00:00:00Z"}))
+		assert.NoError(t, err)
+		err = validate(newSource(&v1alpha1.NATSJetStreamConsumer{Stream: "s", DeliverPolicy: v1alpha1.NATSJetStreamDeliverByStartSequence}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "startSequence")
+		err = validate(newSource(&v1alpha1.NATSJetStreamConsumer{Stream: "s", DeliverPolicy: v1alpha1.NATSJetStreamDeliverByStartSequence, StartSequence: 10}))
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid ack wait", func(t *testing.T) {
+		ackWait := "abc"
+		err := validate(newSource(&v1alpha1.NATSJetStreamConsumer{Stream: "s", AckWait: &ackWait}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "ackWait")
+	})
+
+	t.Run("negative values", func(t *testing.T) {
+		assert.Error(t, validate(newSource(&v1alpha1.NATSJetStreamConsumer{Stream: "s", MaxAckPending: -1})))
+		assert.Error(t, validate(newSource(&v1alpha1.NATSJetStreamConsumer{Stream: "s", FetchBatchSize: -1})))
+	})
+}
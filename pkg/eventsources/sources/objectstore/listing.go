@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	minio "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/eventsources/persist"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	// defaultPollInterval is the interval between two listings if it is not specified
+	defaultPollInterval = time.Minute
+	// maxStateObjectsSize is the maximum size of the objects tracked to detect the removals, it keeps the
+	// persisted state well under the 1 MiB size limit of a ConfigMap.
+	maxStateObjectsSize = 512 * 1024
+)
+
+// object is a listed object
+type object struct {
+	Key          string
+	Size         int64
+	ETag         string
+	LastModified time.Time
+}
+
+// lister lists the objects of the bucket
+type lister interface {
+	list(ctx context.Context) ([]object, error)
+}
+
+// minioLister lists the objects with ListObjectsV2
+type minioLister struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func newMinioLister(src *v1alpha1.ObjectStoreEventSource) (*minioLister, error) {
+	opts := &minio.Options{
+		Secure: !src.Insecure,
+		Region: src.Region,
+	}
+	if src.AccessKey != nil {
+		accessKey, err := sharedutil.GetSecretFromVolume(src.AccessKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the access key, %w", err)
+		}
+		secretKey, err := sharedutil.GetSecretFromVolume(src.SecretKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the secret key, %w", err)
+		}
+		opts.Creds = credentials.NewStaticV4(accessKey, secretKey, "")
+	} else {
+		opts.Creds = credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvAWS{},
+			&credentials.IAM{Client: &http.Client{Transport: http.DefaultTransport}},
+		})
+	}
+	if src.CACertificate != nil {
+		caCertificate, err := sharedutil.GetSecretFromVolume(src.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the CA certificate, %w", err)
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM([]byte(caCertificate))
+		opts.Transport = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: caCertPool}}
+	}
+	client, err := minio.New(src.Endpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &minioLister{client: client, bucket: src.Bucket, prefix: src.Prefix}, nil
+}
+
+func (l *minioLister) list(ctx context.Context) ([]object, error) {
+	var objects []object
+	for info := range l.client.ListObjects(ctx, l.bucket, minio.ListObjectsOptions{Prefix: l.prefix, Recursive: true}) {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+		objects = append(objects, object{Key: info.Key, Size: info.Size, ETag: info.ETag, LastModified: info.LastModified})
+	}
+	return objects, nil
+}
+
+// listingState is the state persisted between the listings
+type listingState struct {
+	// Listed is true once the bucket was listed
+	Listed bool `json:"listed,omitempty"`
+	// Watermark is the last modification time of the newest object dispatched
+	Watermark time.Time `json:"watermark,omitempty"`
+	// AtWatermark holds the ETag of the objects modified at the watermark by key, so they are not dispatched again
+	AtWatermark map[string]string `json:"atWatermark,omitempty"`
+	// Objects holds the ETag of all the objects by key, to detect the removals. It is nil if the objects are not tracked.
+	Objects map[string]string `json:"objects"`
+}
+
+func (el *EventListener) getPersistenceKey() string {
+	return fmt.Sprintf("%s.%s", el.EventSourceName, el.EventName)
+}
+
+func (el *EventListener) loadState() (*listingState, error) {
+	state := &listingState{}
+	if !el.eventPersistence.IsEnabled() {
+		return state, nil
+	}
+	event, err := el.eventPersistence.Get(el.getPersistenceKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the persisted state, %w", err)
+	}
+	if event == nil || event.EventPayload == "" {
+		return state, nil
+	}
+	if err := json.Unmarshal([]byte(event.EventPayload), state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the persisted state, %w", err)
+	}
+	return state, nil
+}
+
+func (el *EventListener) saveState(state *listingState) error {
+	if !el.eventPersistence.IsEnabled() {
+		return nil
+	}
+	payload, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the state, %w", err)
+	}
+	if err := el.eventPersistence.Save(&persist.Event{EventKey: el.getPersistenceKey(), EventPayload: string(payload)}); err != nil {
+		return fmt.Errorf("failed to persist the state, %w", err)
+	}
+	return nil
+}
+
+// listPeriodically lists the bucket at the interval until the context is done
+func (el *EventListener) listPeriodically(ctx context.Context, l lister, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	interval := defaultPollInterval
+	if el.ObjectStoreEventSource.Poll.Interval != "" {
+		// the interval is validated with the event source.
+		interval, _ = time.ParseDuration(el.ObjectStoreEventSource.Poll.Interval)
+	}
+	state, err := el.loadState()
+	if err != nil {
+		return err
+	}
+	el.log.Infow("listing the bucket periodically...", zap.Duration("interval", interval))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := el.listOnce(ctx, l, state, dispatch); err != nil {
+			el.log.Errorw("failed to list the bucket", zap.Error(err))
+			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+		}
+		select {
+		case <-ctx.Done():
+			el.log.Info("exiting object store event listener...")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// listOnce lists the bucket, dispatches the objects created and removed since the previous listing, and saves the state
+func (el *EventListener) listOnce(ctx context.Context, l lister, state *listingState, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	objects, err := l.list(ctx)
+	if err != nil {
+		return err
+	}
+	*state = *el.processListing(objects, state, time.Now().UTC(), dispatch)
+	return el.saveState(state)
+}
+
+// processListing dispatches the objects created and removed since the previous listing, and returns the new state.
+// The objects are dispatched from the oldest, and the watermark does not advance after an object fails to be dispatched,
+// so it is dispatched again with the next listing.
+func (el *EventListener) processListing(objects []object, state *listingState, now time.Time, dispatch func([]byte, ...eventsourcecommon.Option) error) *listingState {
+	poll := el.ObjectStoreEventSource.Poll
+	first := !state.Listed
+	// the removals are detected since the objects are tracked
+	tracked := poll.DetectRemovals && state.Objects != nil
+	next := &listingState{
+		Listed:      true,
+		Watermark:   state.Watermark,
+		AtWatermark: maps.Clone(state.AtWatermark),
+	}
+	if next.AtWatermark == nil {
+		next.AtWatermark = map[string]string{}
+	}
+	if poll.DetectRemovals {
+		next.Objects = map[string]string{}
+	}
+
+	objects = slices.DeleteFunc(objects, func(o object) bool { return !strings.HasSuffix(o.Key, el.ObjectStoreEventSource.Suffix) })
+	slices.SortFunc(objects, func(a, b object) int {
+		if c := a.LastModified.Compare(b.LastModified); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	failed := false
+	for _, o := range objects {
+		created := o.LastModified.After(state.Watermark) ||
+			(o.LastModified.Equal(state.Watermark) && state.AtWatermark[o.Key] != o.ETag)
+		if tracked {
+			// the objects appearing with an older modification time, e.g. after a long multipart upload, are created too.
+			previous, seen := state.Objects[o.Key]
+			created = created || !seen || previous != o.ETag
+		}
+		if first && !poll.EmitExisting {
+			created = false
+		}
+		if created {
+			if failed {
+				el.keepPrevious(next, state, o.Key)
+				continue
+			}
+			err := el.dispatchObject(&events.ObjectStoreEventData{
+				Type:   typeCreated,
+				Bucket: el.ObjectStoreEventSource.Bucket,
+				Key:    o.Key,
+				Size:   o.Size,
+				ETag:   o.ETag,
+				Time:   o.LastModified,
+			}, o.ETag+"@"+o.LastModified.Format(time.RFC3339Nano), dispatch)
+			if err != nil {
+				el.log.Errorw("failed to dispatch the object", zap.String("key", o.Key), zap.Error(err))
+				el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+				failed = true
+				el.keepPrevious(next, state, o.Key)
+				continue
+			}
+		}
+		if next.Objects != nil {
+			next.Objects[o.Key] = o.ETag
+		}
+		if failed {
+			continue
+		}
+		if o.LastModified.After(next.Watermark) {
+			next.Watermark = o.LastModified
+			next.AtWatermark = map[string]string{}
+		}
+		if o.LastModified.Equal(next.Watermark) {
+			next.AtWatermark[o.Key] = o.ETag
+		}
+	}
+
+	if next.Objects != nil && stateObjectsSize(next.Objects) > maxStateObjectsSize {
+		el.log.Warnw("the objects exceed the size limit of the state, the removals are not detected until they fit in it",
+			zap.Int("objects", len(next.Objects)), zap.Int("limit", maxStateObjectsSize))
+		next.Objects = nil
+		tracked = false
+	}
+	if tracked {
+		for _, key := range slices.Sorted(maps.Keys(state.Objects)) {
+			if _, ok := next.Objects[key]; ok || !el.matches(el.ObjectStoreEventSource.Bucket, key) {
+				continue
+			}
+			err := el.dispatchObject(&events.ObjectStoreEventData{
+				Type:   typeRemoved,
+				Bucket: el.ObjectStoreEventSource.Bucket,
+				Key:    key,
+				ETag:   state.Objects[key],
+				Time:   now,
+			}, state.Objects[key], dispatch)
+			if err != nil {
+				el.log.Errorw("failed to dispatch the removed object", zap.String("key", key), zap.Error(err))
+				el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+				// the object is removed again with the next listing.
+				next.Objects[key] = state.Objects[key]
+			}
+		}
+	}
+	return next
+}
+
+// stateObjectsSize returns the size of the tracked objects in the persisted state
+func stateObjectsSize(objects map[string]string) int {
+	size := 0
+	for key, etag := range objects {
+		// the JSON quotes and separators are included
+		size += len(key) + len(etag) + 6
+	}
+	return size
+}
+
+// keepPrevious keeps the previous ETag of an object which is not dispatched, so it is dispatched with the next listing
+func (el *EventListener) keepPrevious(next, state *listingState, key string) {
+	if next.Objects == nil {
+		return
+	}
+	if previous, seen := state.Objects[key]; seen {
+		next.Objects[key] = previous
+	}
+}
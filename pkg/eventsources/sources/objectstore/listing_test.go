@@ -0,0 +1,279 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/eventsources/persist"
+	"github.com/argoproj/argo-events/pkg/metrics"
+)
+
+type fakePersist struct {
+	events map[string]*persist.Event
+}
+
+func (f *fakePersist) Save(event *persist.Event) error {
+	f.events[event.EventKey] = event
+	return nil
+}
+
+func (f *fakePersist) Get(key string) (*persist.Event, error) {
+	return f.events[key], nil
+}
+
+func (f *fakePersist) IsEnabled() bool {
+	return true
+}
+
+type fakeLister struct {
+	objects []object
+}
+
+func (l *fakeLister) list(ctx context.Context) ([]object, error) {
+	return l.objects, nil
+}
+
+var t0 = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func keys(data []events.ObjectStoreEventData) []string {
+	result := []string{}
+	for _, d := range data {
+		result = append(result, d.Type+" "+d.Key)
+	}
+	return result
+}
+
+func TestListOnce(t *testing.T) {
+	el := &EventListener{
+		EventSourceName:        "es",
+		EventName:              "objects",
+		ObjectStoreEventSource: v1alpha1.ObjectStoreEventSource{Bucket: "my-bucket", Suffix: ".csv", Poll: &v1alpha1.ObjectStorePoll{}},
+		Metrics:                metrics.NewMetrics("ns"),
+		log:                    zaptest.NewLogger(t).Sugar(),
+		eventPersistence:       &fakePersist{events: map[string]*persist.Event{}},
+	}
+	l := &fakeLister{objects: []object{
+		{Key: "a.csv", ETag: "1", LastModified: t0},
+		{Key: "b.txt", ETag: "1", LastModified: t0},
+	}}
+	state := &listingState{}
+	r := &eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{}
+
+	// the objects already in the bucket are not emitted with the first listing.
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Empty(t, r.Events)
+
+	l.objects = append(l.objects,
+		object{Key: "c.csv", ETag: "1", LastModified: t0},
+		object{Key: "d.csv", ETag: "1", LastModified: t0.Add(time.Second)},
+	)
+	l.objects[0].ETag = "2"
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	// an object modified within the same second as the watermark is emitted once.
+	assert.Equal(t, []string{"created a.csv", "created c.csv", "created d.csv"}, keys(r.Events))
+	assert.Equal(t, "my-bucket", r.Events[0].Bucket)
+	assert.Equal(t, t0.Add(time.Second), state.Watermark)
+
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Len(t, r.Events, 3)
+
+	t.Run("state is persisted", func(t *testing.T) {
+		loaded, err := el.loadState()
+		require.NoError(t, err)
+		assert.Equal(t, state.Watermark, loaded.Watermark)
+		assert.Equal(t, map[string]string{"d.csv": "1"}, loaded.AtWatermark)
+	})
+}
+
+func TestListOnceEmitExisting(t *testing.T) {
+	el := &EventListener{
+		EventSourceName:        "es",
+		EventName:              "objects",
+		ObjectStoreEventSource: v1alpha1.ObjectStoreEventSource{Bucket: "my-bucket", Suffix: ".csv", Poll: &v1alpha1.ObjectStorePoll{EmitExisting: true}},
+		Metrics:                metrics.NewMetrics("ns"),
+		log:                    zaptest.NewLogger(t).Sugar(),
+		eventPersistence:       &fakePersist{events: map[string]*persist.Event{}},
+	}
+	l := &fakeLister{objects: []object{
+		{Key: "b.csv", ETag: "1", LastModified: t0.Add(time.Second)},
+		{Key: "a.csv", ETag: "1", LastModified: t0},
+	}}
+	r := &eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{}
+	require.NoError(t, el.listOnce(context.Background(), l, &listingState{}, r.Dispatch))
+	assert.Equal(t, []string{"created a.csv", "created b.csv"}, keys(r.Events))
+}
+
+func TestListOnceDispatchFailure(t *testing.T) {
+	el := &EventListener{
+		EventSourceName:        "es",
+		EventName:              "objects",
+		ObjectStoreEventSource: v1alpha1.ObjectStoreEventSource{Bucket: "my-bucket", Suffix: ".csv", Poll: &v1alpha1.ObjectStorePoll{}},
+		Metrics:                metrics.NewMetrics("ns"),
+		log:                    zaptest.NewLogger(t).Sugar(),
+		eventPersistence:       &fakePersist{events: map[string]*persist.Event{}},
+	}
+	l := &fakeLister{}
+	state := &listingState{}
+	require.NoError(t, el.listOnce(context.Background(), l, state, (&eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{}).Dispatch))
+
+	l.objects = []object{
+		{Key: "a.csv", ETag: "1", LastModified: t0},
+		{Key: "b.csv", ETag: "1", LastModified: t0.Add(time.Second)},
+		{Key: "c.csv", ETag: "1", LastModified: t0.Add(2 * time.Second)},
+	}
+	r := &eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{Fail: func(event events.ObjectStoreEventData) bool {
+		return event.Key == "b.csv"
+	}}
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Equal(t, []string{"created a.csv"}, keys(r.Events))
+	assert.Equal(t, t0, state.Watermark)
+
+	r.Fail = nil
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Equal(t, []string{"created a.csv", "created b.csv", "created c.csv"}, keys(r.Events))
+}
+
+func TestListOnceDetectRemovals(t *testing.T) {
+	el := &EventListener{
+		EventSourceName:        "es",
+		EventName:              "objects",
+		ObjectStoreEventSource: v1alpha1.ObjectStoreEventSource{Bucket: "my-bucket", Suffix: ".csv", Poll: &v1alpha1.ObjectStorePoll{DetectRemovals: true}},
+		Metrics:                metrics.NewMetrics("ns"),
+		log:                    zaptest.NewLogger(t).Sugar(),
+		eventPersistence:       &fakePersist{events: map[string]*persist.Event{}},
+	}
+	l := &fakeLister{objects: []object{
+		{Key: "a.csv", ETag: "1", LastModified: t0},
+		{Key: "b.csv", ETag: "1", LastModified: t0},
+	}}
+	state := &listingState{}
+	r := &eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{}
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Empty(t, r.Events)
+
+	// an object appearing with an older modification time is created too.
+	l.objects = []object{
+		{Key: "b.csv", ETag: "1", LastModified: t0},
+		{Key: "c.csv", ETag: "1", LastModified: t0.Add(-time.Hour)},
+	}
+	r.Fail = func(event events.ObjectStoreEventData) bool {
+		return event.Key == "a.csv"
+	}
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Equal(t, []string{"created c.csv"}, keys(r.Events))
+
+	// the removal failed to be dispatched is dispatched with the next listing.
+	r.Fail = nil
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Equal(t, []string{"created c.csv", "removed a.csv"}, keys(r.Events))
+	assert.Equal(t, map[string]string{"b.csv": "1", "c.csv": "1"}, state.Objects)
+}
+
+func TestListOnceDetectRemovalsStateLimit(t *testing.T) {
+	el := &EventListener{
+		EventSourceName:        "es",
+		EventName:              "objects",
+		ObjectStoreEventSource: v1alpha1.ObjectStoreEventSource{Bucket: "my-bucket", Poll: &v1alpha1.ObjectStorePoll{DetectRemovals: true}},
+		Metrics:                metrics.NewMetrics("ns"),
+		log:                    zaptest.NewLogger(t).Sugar(),
+		eventPersistence:       &fakePersist{events: map[string]*persist.Event{}},
+	}
+	prefix := strings.Repeat("k", 1000)
+	l := &fakeLister{}
+	for i := range maxStateObjectsSize / 1000 {
+		l.objects = append(l.objects, object{Key: fmt.Sprintf("%s%d", prefix, i), ETag: "1", LastModified: t0})
+	}
+	state := &listingState{}
+	r := &eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{}
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Nil(t, state.Objects)
+
+	// the objects are created by the watermark, but the removals are not detected.
+	l.objects = append(l.objects[1:], object{Key: "new", ETag: "1", LastModified: t0.Add(time.Second)})
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Equal(t, []string{"created new"}, keys(r.Events))
+	assert.Nil(t, state.Objects)
+
+	// the objects are tracked again once they fit in the state.
+	l.objects = []object{{Key: "a", ETag: "1", LastModified: t0}, l.objects[len(l.objects)-1]}
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Equal(t, []string{"created new"}, keys(r.Events))
+	assert.Equal(t, map[string]string{"a": "1", "new": "1"}, state.Objects)
+
+	l.objects = l.objects[1:]
+	state, err := el.loadState()
+	require.NoError(t, err)
+	require.NoError(t, el.listOnce(context.Background(), l, state, r.Dispatch))
+	assert.Equal(t, []string{"created new", "removed a"}, keys(r.Events))
+}
+
+func TestMinioLister(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("list-type") != "2" || r.URL.Query().Get("prefix") != "reports/" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		if r.URL.Query().Get("continuation-token") == "" {
+			fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>my-bucket</Name><Prefix>reports/</Prefix><KeyCount>1</KeyCount><MaxKeys>1000</MaxKeys>
+  <IsTruncated>true</IsTruncated><NextContinuationToken>next</NextContinuationToken>
+  <Contents><Key>reports/a.csv</Key><LastModified>2026-01-02T03:04:05.000Z</LastModified><ETag>"1"</ETag><Size>10</Size></Contents>
+</ListBucketResult>`)
+			return
+		}
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>my-bucket</Name><Prefix>reports/</Prefix><KeyCount>1</KeyCount><MaxKeys>1000</MaxKeys>
+  <IsTruncated>false</IsTruncated>
+  <Contents><Key>reports/b.csv</Key><LastModified>2026-01-02T03:04:06.000Z</LastModified><ETag>"2"</ETag><Size>20</Size></Contents>
+</ListBucketResult>`)
+	}))
+	defer server.Close()
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "access")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	l, err := newMinioLister(&v1alpha1.ObjectStoreEventSource{
+		Endpoint: strings.TrimPrefix(server.URL, "http://"),
+		Insecure: true,
+		Region:   "us-east-1",
+		Bucket:   "my-bucket",
+		Prefix:   "reports/",
+	})
+	require.NoError(t, err)
+	objects, err := l.list(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []object{
+		{Key: "reports/a.csv", ETag: "1", Size: 10, LastModified: t0},
+		{Key: "reports/b.csv", ETag: "2", Size: 20, LastModified: t0.Add(time.Second)},
+	}, objects)
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sqslib "github.com/aws/aws-sdk-go/service/sqs"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	awscommon "github.com/argoproj/argo-events/pkg/eventsources/common/aws"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+)
+
+// defaultWaitTimeSeconds is the duration of the long polling of the queue if it is not specified
+const defaultWaitTimeSeconds = 20
+
+// message is a message of the queue
+type message struct {
+	id            string
+	body          string
+	receiptHandle string
+}
+
+// queue is the queue the notifications are delivered to
+type queue interface {
+	// receive waits for messages
+	receive(ctx context.Context) ([]message, error)
+	// delete deletes a processed message
+	delete(ctx context.Context, m message) error
+	// release makes a message visible again for redelivery
+	release(ctx context.Context, m message) error
+}
+
+// sqsQueue is an SQS queue
+type sqsQueue struct {
+	client      *sqslib.SQS
+	url         string
+	waitSeconds int64
+}
+
+func newSQSQueue(src *v1alpha1.ObjectStoreEventSource) (*sqsQueue, error) {
+	awsSession, err := awscommon.CreateAWSSessionWithCredsInVolume(src.Region, src.SQS.RoleARN, src.AccessKey, src.SecretKey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the aws session, %w", err)
+	}
+	var client *sqslib.SQS
+	if src.SQS.Endpoint == "" {
+		client = sqslib.New(awsSession)
+	} else {
+		client = sqslib.New(awsSession, &aws.Config{Endpoint: &src.SQS.Endpoint, Region: &src.Region})
+	}
+	input := &sqslib.GetQueueUrlInput{QueueName: &src.SQS.Queue}
+	if src.SQS.QueueAccountID != "" {
+		input.QueueOwnerAWSAccountId = &src.SQS.QueueAccountID
+	}
+	output, err := client.GetQueueUrl(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the url of the queue %s, %w", src.SQS.Queue, err)
+	}
+	waitSeconds := src.SQS.WaitTimeSeconds
+	if waitSeconds == 0 {
+		waitSeconds = defaultWaitTimeSeconds
+	}
+	return &sqsQueue{client: client, url: *output.QueueUrl, waitSeconds: waitSeconds}, nil
+}
+
+func (q *sqsQueue) receive(ctx context.Context) ([]message, error) {
+	output, err := q.client.ReceiveMessageWithContext(ctx, &sqslib.ReceiveMessageInput{
+		QueueUrl:            &q.url,
+		MaxNumberOfMessages: aws.Int64(10),
+		VisibilityTimeout:   aws.Int64(120),
+		WaitTimeSeconds:     aws.Int64(q.waitSeconds),
+	})
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]message, 0, len(output.Messages))
+	for _, m := range output.Messages {
+		messages = append(messages, message{id: aws.StringValue(m.MessageId), body: aws.StringValue(m.Body), receiptHandle: aws.StringValue(m.ReceiptHandle)})
+	}
+	return messages, nil
+}
+
+func (q *sqsQueue) delete(ctx context.Context, m message) error {
+	_, err := q.client.DeleteMessageWithContext(ctx, &sqslib.DeleteMessageInput{QueueUrl: &q.url, ReceiptHandle: &m.receiptHandle})
+	return err
+}
+
+func (q *sqsQueue) release(ctx context.Context, m message) error {
+	_, err := q.client.ChangeMessageVisibilityWithContext(ctx, &sqslib.ChangeMessageVisibilityInput{
+		QueueUrl:          &q.url,
+		ReceiptHandle:     &m.receiptHandle,
+		VisibilityTimeout: aws.Int64(0),
+	})
+	return err
+}
+
+// receiveNotifications dispatches the S3 event notifications received from the queue until the context is done
+func (el *EventListener) receiveNotifications(ctx context.Context, q queue, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	el.log.Info("listening for notifications on the queue...")
+	for {
+		messages, err := q.receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				el.log.Info("exiting object store event listener...")
+				return nil
+			}
+			el.log.Errorw("failed to receive messages from the queue", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(2 * time.Second):
+			}
+			continue
+		}
+		for _, m := range messages {
+			el.processMessage(ctx, q, m, dispatch)
+		}
+	}
+}
+
+// processMessage dispatches the records of a message, and deletes it once they are dispatched. The message is
+// released for redelivery if the EventBus fails, the records already dispatched are then deduplicated by the EventBus.
+func (el *EventListener) processMessage(ctx context.Context, q queue, m message, dispatch func([]byte, ...eventsourcecommon.Option) error) {
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	records, err := parseNotification(m.body)
+	if err != nil {
+		el.log.Errorw("invalid notification, deleting it", zap.String("messageId", m.id), zap.Error(err))
+		el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+	}
+	for _, r := range records {
+		if !el.matches(r.data.Bucket, r.data.Key) {
+			continue
+		}
+		if err := el.dispatchObject(r.data, r.change, dispatch); err != nil {
+			el.log.Errorw("failed to dispatch the notification", zap.String("key", r.data.Key), zap.Error(err))
+			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+			if eventbuscommon.IsEventBusError(err) {
+				if err := q.release(ctx, m); err != nil {
+					el.log.Errorw("failed to release the message", zap.String("messageId", m.id), zap.Error(err))
+				}
+				return
+			}
+		}
+	}
+	if err := q.delete(ctx, m); err != nil {
+		el.log.Errorw("failed to delete the message", zap.String("messageId", m.id), zap.Error(err))
+	}
+}
+
+// s3Notification is an S3 event notification, https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+type s3Notification struct {
+	Records []s3Record `json:"Records"`
+	// Event is s3:TestEvent for the test notification sent when the notifications are configured
+	Event string `json:"Event"`
+	// Type and Message are the ones of an SNS envelope
+	Type    string `json:"Type"`
+	Message string `json:"Message"`
+}
+
+type s3Record struct {
+	EventName string    `json:"eventName"`
+	EventTime time.Time `json:"eventTime"`
+	S3        struct {
+		Bucket struct {
+			Name string `json:"name"`
+		} `json:"bucket"`
+		Object struct {
+			Key       string `json:"key"`
+			Size      int64  `json:"size"`
+			ETag      string `json:"eTag"`
+			VersionID string `json:"versionId"`
+			Sequencer string `json:"sequencer"`
+		} `json:"object"`
+	} `json:"s3"`
+}
+
+// record is the event of a record, and the change of the object identifying the event
+type record struct {
+	data   *events.ObjectStoreEventData
+	change string
+}
+
+// parseNotification returns the created and removed objects of an S3 event notification, unwrapping it from its SNS
+// envelope, if any
+func parseNotification(body string) ([]record, error) {
+	var n s3Notification
+	if err := json.Unmarshal([]byte(body), &n); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the notification, %w", err)
+	}
+	if n.Type == "Notification" && n.Message != "" {
+		return parseNotification(n.Message)
+	}
+	if n.Event == "s3:TestEvent" {
+		return nil, nil
+	}
+	if n.Records == nil {
+		return nil, fmt.Errorf("no records in the notification")
+	}
+	var records []record
+	for _, r := range n.Records {
+		// MinIO prefixes the event names with "s3:".
+		eventName := strings.TrimPrefix(r.EventName, "s3:")
+		var eventType string
+		switch {
+		case strings.HasPrefix(eventName, "ObjectCreated:"):
+			eventType = typeCreated
+		case strings.HasPrefix(eventName, "ObjectRemoved:"):
+			eventType = typeRemoved
+		default:
+			continue
+		}
+		key, err := url.QueryUnescape(r.S3.Object.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s, %w", r.S3.Object.Key, err)
+		}
+		change := r.S3.Object.Sequencer
+		if change == "" {
+			change = r.S3.Object.VersionID + "@" + r.EventTime.Format(time.RFC3339Nano)
+		}
+		records = append(records, record{
+			data: &events.ObjectStoreEventData{
+				Type:      eventType,
+				EventName: eventName,
+				Bucket:    r.S3.Bucket.Name,
+				Key:       key,
+				Size:      r.S3.Object.Size,
+				ETag:      r.S3.Object.ETag,
+				VersionID: r.S3.Object.VersionID,
+				Time:      r.EventTime,
+			},
+			change: change,
+		})
+	}
+	return records, nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/metrics"
+)
+
+const notification = `{
+  "Records": [
+    {
+      "eventName": "ObjectCreated:Put",
+      "eventTime": "2026-01-02T03:04:05.000Z",
+      "s3": {
+        "bucket": {"name": "my-bucket"},
+        "object": {"key": "uploads/2026+report%281%29.csv", "size": 1024, "eTag": "abc", "sequencer": "0055AED6DCD90281E5"}
+      }
+    },
+    {
+      "eventName": "ObjectRemoved:Delete",
+      "eventTime": "2026-01-02T03:04:06.000Z",
+      "s3": {
+        "bucket": {"name": "my-bucket"},
+        "object": {"key": "uploads/old.csv", "sequencer": "0055AED6DCD90281E6"}
+      }
+    },
+    {
+      "eventName": "ObjectRestore:Post",
+      "eventTime": "2026-01-02T03:04:07.000Z",
+      "s3": {
+        "bucket": {"name": "my-bucket"},
+        "object": {"key": "uploads/archived.csv"}
+      }
+    }
+  ]
+}`
+
+type fakeQueue struct {
+	deleted  []string
+	released []string
+}
+
+func (q *fakeQueue) receive(ctx context.Context) ([]message, error) {
+	return nil, nil
+}
+
+func (q *fakeQueue) delete(ctx context.Context, m message) error {
+	q.deleted = append(q.deleted, m.id)
+	return nil
+}
+
+func (q *fakeQueue) release(ctx context.Context, m message) error {
+	q.released = append(q.released, m.id)
+	return nil
+}
+
+func TestParseNotification(t *testing.T) {
+	records, err := parseNotification(notification)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, typeCreated, records[0].data.Type)
+	assert.Equal(t, "ObjectCreated:Put", records[0].data.EventName)
+	assert.Equal(t, "my-bucket", records[0].data.Bucket)
+	assert.Equal(t, "uploads/2026 report(1).csv", records[0].data.Key)
+	assert.Equal(t, int64(1024), records[0].data.Size)
+	assert.Equal(t, "abc", records[0].data.ETag)
+	assert.Equal(t, "0055AED6DCD90281E5", records[0].change)
+	assert.Equal(t, typeRemoved, records[1].data.Type)
+	assert.Equal(t, "uploads/old.csv", records[1].data.Key)
+
+	t.Run("sns envelope", func(t *testing.T) {
+		body, err := json.Marshal(map[string]string{"Type": "Notification", "Message": notification})
+		require.NoError(t, err)
+		wrapped, err := parseNotification(string(body))
+		require.NoError(t, err)
+		assert.Equal(t, records, wrapped)
+	})
+
+	t.Run("test event", func(t *testing.T) {
+		records, err := parseNotification(`{"Service": "Amazon S3", "Event": "s3:TestEvent", "Bucket": "my-bucket"}`)
+		assert.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("minio", func(t *testing.T) {
+		records, err := parseNotification(`{"Records": [{"eventName": "s3:ObjectCreated:Put", "eventTime": "2026-01-02T03:04:05.000Z", "s3": {"bucket": {"name": "b"}, "object": {"key": "a.txt", "versionId": "v1"}}}]}`)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, "ObjectCreated:Put", records[0].data.EventName)
+		assert.Equal(t, "v1@2026-01-02T03:04:05Z", records[0].change)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parseNotification(`{"Message": "hello"}`)
+		assert.Error(t, err)
+		_, err = parseNotification(`not json`)
+		assert.Error(t, err)
+	})
+}
+
+func TestProcessMessage(t *testing.T) {
+	el := &EventListener{
+		EventSourceName: "es",
+		EventName:       "objects",
+		ObjectStoreEventSource: v1alpha1.ObjectStoreEventSource{
+			Prefix:   "uploads/",
+			Suffix:   ".csv",
+			Metadata: map[string]string{"env": "test"},
+			SQS:      &v1alpha1.ObjectStoreSQS{Queue: "q"},
+		},
+		Metrics: metrics.NewMetrics("ns"),
+		log:     zaptest.NewLogger(t).Sugar(),
+	}
+	q := &fakeQueue{}
+	r := &eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{}
+	el.processMessage(context.Background(), q, message{id: "1", body: notification}, r.Dispatch)
+	require.Len(t, r.Events, 2)
+	assert.Equal(t, "uploads/2026 report(1).csv", r.Events[0].Key)
+	assert.Equal(t, map[string]string{"env": "test"}, r.Events[0].Metadata)
+	assert.Equal(t, "uploads/old.csv", r.Events[1].Key)
+	assert.Equal(t, []string{"1"}, q.deleted)
+
+	t.Run("same change has the same id", func(t *testing.T) {
+		again := &eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{}
+		el.processMessage(context.Background(), q, message{id: "2", body: notification}, again.Dispatch)
+		assert.Equal(t, r.IDs, again.IDs)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		el := &EventListener{
+			EventSourceName:        "es",
+			EventName:              "objects",
+			ObjectStoreEventSource: v1alpha1.ObjectStoreEventSource{Bucket: "other-bucket", SQS: &v1alpha1.ObjectStoreSQS{Queue: "q"}},
+			Metrics:                metrics.NewMetrics("ns"),
+			log:                    zaptest.NewLogger(t).Sugar(),
+		}
+		q := &fakeQueue{}
+		r := &eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{}
+		el.processMessage(context.Background(), q, message{id: "1", body: notification}, r.Dispatch)
+		assert.Empty(t, r.Events)
+		assert.Equal(t, []string{"1"}, q.deleted)
+	})
+
+	t.Run("eventbus failure", func(t *testing.T) {
+		q := &fakeQueue{}
+		r := &eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{Fail: func(event events.ObjectStoreEventData) bool {
+			return event.Key == "uploads/old.csv"
+		}}
+		el.processMessage(context.Background(), q, message{id: "1", body: notification}, r.Dispatch)
+		assert.Len(t, r.Events, 1)
+		assert.Empty(t, q.deleted)
+		assert.Equal(t, []string{"1"}, q.released)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		q := &fakeQueue{}
+		r := &eventsourcecommon.FakeDispatcher[events.ObjectStoreEventData]{}
+		el.processMessage(context.Background(), q, message{id: "1", body: "not json"}, r.Dispatch)
+		assert.Empty(t, r.Events)
+		assert.Equal(t, []string{"1"}, q.deleted)
+	})
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/eventsources/persist"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+	metrics "github.com/argoproj/argo-events/pkg/metrics"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+)
+
+const (
+	typeCreated = "created"
+	typeRemoved = "removed"
+)
+
+// EventListener implements Eventing for the object store event source
+type EventListener struct {
+	EventSourceName        string
+	EventName              string
+	Namespace              string
+	ObjectStoreEventSource v1alpha1.ObjectStoreEventSource
+	Metrics                *metrics.Metrics
+
+	log              *zap.SugaredLogger
+	eventPersistence persist.EventPersist
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() v1alpha1.EventSourceType {
+	return v1alpha1.ObjectStoreEvent
+}
+
+// StartListening starts receiving the notifications, or listing the bucket
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	el.log = logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	defer sources.Recover(el.GetEventName())
+	el.log.Info("started processing the object store event source...")
+
+	if el.ObjectStoreEventSource.SQS != nil {
+		q, err := newSQSQueue(&el.ObjectStoreEventSource)
+		if err != nil {
+			return fmt.Errorf("failed to set up the queue for event source %s, %w", el.GetEventName(), err)
+		}
+		return el.receiveNotifications(ctx, q, dispatch)
+	}
+
+	l, err := newMinioLister(&el.ObjectStoreEventSource)
+	if err != nil {
+		return fmt.Errorf("failed to set up the client for event source %s, %w", el.GetEventName(), err)
+	}
+	eventPersistence, err := persist.NewEventPersist(ctx, el.ObjectStoreEventSource.Poll.Persistence, el.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to initialize the persistence for event source %s, %w", el.GetEventName(), err)
+	}
+	el.eventPersistence = eventPersistence
+	return el.listPeriodically(ctx, l, dispatch)
+}
+
+// matches checks if the object is in the bucket, and its key has the prefix and the suffix
+func (el *EventListener) matches(bucket, key string) bool {
+	src := &el.ObjectStoreEventSource
+	return (src.Bucket == "" || src.Bucket == bucket) && strings.HasPrefix(key, src.Prefix) && strings.HasSuffix(key, src.Suffix)
+}
+
+// dispatchObject dispatches the event of an object, with an id identifying the change of the object,
+// so the same change received again is deduplicated by the EventBus.
+func (el *EventListener) dispatchObject(data *events.ObjectStoreEventData, change string, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	data.Metadata = el.ObjectStoreEventSource.Metadata
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the event data, %w", err)
+	}
+	id := fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join([]string{data.Type, data.Bucket, data.Key, change}, "\n"))))
+	el.log.Infow("dispatching object store event...", zap.String("type", data.Type), zap.String("key", data.Key))
+	return dispatch(payload, eventsourcecommon.WithID(id))
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+// ValidateEventSource validates the object store event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.ObjectStoreEventSource)
+}
+
+func validate(eventSource *v1alpha1.ObjectStoreEventSource) error {
+	if eventSource == nil {
+		return v1alpha1.ErrNilEventSource
+	}
+	if (eventSource.SQS == nil) == (eventSource.Poll == nil) {
+		return fmt.Errorf("exactly one of sqs and poll is required")
+	}
+	if (eventSource.AccessKey == nil) != (eventSource.SecretKey == nil) {
+		return fmt.Errorf("accessKey and secretKey must be specified together")
+	}
+	if eventSource.SQS != nil {
+		if eventSource.SQS.Queue == "" {
+			return fmt.Errorf("sqs queue can't be empty")
+		}
+		if eventSource.SQS.WaitTimeSeconds < 0 || eventSource.SQS.WaitTimeSeconds > 20 {
+			return fmt.Errorf("sqs waitTimeSeconds must be between 0 and 20")
+		}
+		return nil
+	}
+	if eventSource.Endpoint == "" {
+		return fmt.Errorf("endpoint can't be empty")
+	}
+	if eventSource.Bucket == "" {
+		return fmt.Errorf("bucket can't be empty")
+	}
+	if eventSource.Poll.Interval != "" {
+		interval, err := time.ParseDuration(eventSource.Poll.Interval)
+		if err != nil {
+			return fmt.Errorf("failed to parse poll interval %q, %w", eventSource.Poll.Interval, err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("poll interval must be positive")
+		}
+	}
+	return nil
+}
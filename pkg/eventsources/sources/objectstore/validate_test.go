@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+)
+
+func TestValidateEventSource(t *testing.T) {
+	listener := &EventListener{}
+
+	err := listener.ValidateEventSource(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, "exactly one of sqs and poll is required", err.Error())
+
+	content, err := os.ReadFile(fmt.Sprintf("%s/%s", sources.EventSourceDir, "object-store.yaml"))
+	assert.Nil(t, err)
+
+	var eventSource *v1alpha1.EventSource
+	err = yaml.Unmarshal(content, &eventSource)
+	assert.Nil(t, err)
+	assert.NotNil(t, eventSource.Spec.ObjectStore)
+
+	for name, value := range eventSource.Spec.ObjectStore {
+		fmt.Println(name)
+		l := &EventListener{
+			ObjectStoreEventSource: value,
+		}
+		err := l.ValidateEventSource(context.Background())
+		assert.NoError(t, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "s"}, Key: "k"}
+	tests := []struct {
+		name   string
+		source v1alpha1.ObjectStoreEventSource
+		err    string
+	}{
+		{
+			name:   "both sqs and poll",
+			source: v1alpha1.ObjectStoreEventSource{SQS: &v1alpha1.ObjectStoreSQS{Queue: "q"}, Poll: &v1alpha1.ObjectStorePoll{}},
+			err:    "exactly one of sqs and poll is required",
+		},
+		{
+			name:   "access key without secret key",
+			source: v1alpha1.ObjectStoreEventSource{SQS: &v1alpha1.ObjectStoreSQS{Queue: "q"}, AccessKey: secret},
+			err:    "accessKey and secretKey must be specified together",
+		},
+		{
+			name:   "no queue",
+			source: v1alpha1.ObjectStoreEventSource{SQS: &v1alpha1.ObjectStoreSQS{}},
+			err:    "sqs queue can't be empty",
+		},
+		{
+			name:   "wait time too long",
+			source: v1alpha1.ObjectStoreEventSource{SQS: &v1alpha1.ObjectStoreSQS{Queue: "q", WaitTimeSeconds: 30}},
+			err:    "sqs waitTimeSeconds must be between 0 and 20",
+		},
+		{
+			name:   "sqs",
+			source: v1alpha1.ObjectStoreEventSource{SQS: &v1alpha1.ObjectStoreSQS{Queue: "q"}, AccessKey: secret, SecretKey: secret},
+		},
+		{
+			name:   "poll without endpoint",
+			source: v1alpha1.ObjectStoreEventSource{Bucket: "b", Poll: &v1alpha1.ObjectStorePoll{}},
+			err:    "endpoint can't be empty",
+		},
+		{
+			name:   "poll without bucket",
+			source: v1alpha1.ObjectStoreEventSource{Endpoint: "e", Poll: &v1alpha1.ObjectStorePoll{}},
+			err:    "bucket can't be empty",
+		},
+		{
+			name:   "invalid interval",
+			source: v1alpha1.ObjectStoreEventSource{Endpoint: "e", Bucket: "b", Poll: &v1alpha1.ObjectStorePoll{Interval: "often"}},
+			err:    `failed to parse poll interval "often", time: invalid duration "often"`,
+		},
+		{
+			name:   "negative interval",
+			source: v1alpha1.ObjectStoreEventSource{Endpoint: "e", Bucket: "b", Poll: &v1alpha1.ObjectStorePoll{Interval: "-1m"}},
+			err:    "poll interval must be positive",
+		},
+		{
+			name:   "poll",
+			source: v1alpha1.ObjectStoreEventSource{Endpoint: "e", Bucket: "b", Poll: &v1alpha1.ObjectStorePoll{Interval: "30s"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate(&tt.source)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poll
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/evilmonkeyinc/jsonpath"
+	jsonpatherrors "github.com/evilmonkeyinc/jsonpath/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	defaultTimeout  = 30 * time.Second
+	defaultMaxPages = 10
+)
+
+// client gets the items from the endpoint
+type client struct {
+	httpClient *http.Client
+	src        *v1alpha1.PollEventSource
+	headers    http.Header
+	username   string
+	password   string
+}
+
+// fetchResult is the result of a poll
+type fetchResult struct {
+	// notModified is true if the first page is not modified since the ETag
+	notModified bool
+	etag        string
+	cursor      string
+	items       []interface{}
+}
+
+func newClient(ctx context.Context, src *v1alpha1.PollEventSource) (*client, error) {
+	timeout := defaultTimeout
+	if src.Timeout != "" {
+		d, err := time.ParseDuration(src.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse timeout %s, %w", src.Timeout, err)
+		}
+		timeout = d
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if src.TLS != nil {
+		tlsConfig, err := sharedutil.GetTLSConfig(src.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	httpClient := &http.Client{Transport: transport, Timeout: timeout}
+
+	if src.OAuth2 != nil {
+		clientID, err := sharedutil.GetSecretFromVolume(src.OAuth2.ClientID)
+		if err != nil {
+			return nil, fmt.Errorf("oauth2 client id not found, %w", err)
+		}
+		clientSecret, err := sharedutil.GetSecretFromVolume(src.OAuth2.ClientSecret)
+		if err != nil {
+			return nil, fmt.Errorf("oauth2 client secret not found, %w", err)
+		}
+		config := &clientcredentials.Config{
+			ClientID:       clientID,
+			ClientSecret:   clientSecret,
+			TokenURL:       src.OAuth2.TokenURL,
+			Scopes:         src.OAuth2.Scopes,
+			EndpointParams: url.Values{},
+		}
+		for k, v := range src.OAuth2.EndpointParams {
+			config.EndpointParams.Set(k, v)
+		}
+		// the token source keeps the context to refresh the token
+		tokenCtx := context.WithValue(context.WithoutCancel(ctx), oauth2.HTTPClient, httpClient)
+		httpClient = &http.Client{
+			Transport: &oauth2.Transport{Source: config.TokenSource(tokenCtx), Base: transport},
+			Timeout:   timeout,
+		}
+	}
+
+	c := &client{
+		httpClient: httpClient,
+		src:        src,
+		headers:    http.Header{},
+	}
+	for k, v := range src.Headers {
+		c.headers.Set(k, v)
+	}
+	for _, secure := range src.SecureHeaders {
+		if secure == nil || secure.ValueFrom == nil {
+			continue
+		}
+		var value string
+		var err error
+		switch {
+		case secure.ValueFrom.SecretKeyRef != nil:
+			value, err = sharedutil.GetSecretFromVolume(secure.ValueFrom.SecretKeyRef)
+		case secure.ValueFrom.ConfigMapKeyRef != nil:
+			value, err = sharedutil.GetConfigMapFromVolume(secure.ValueFrom.ConfigMapKeyRef)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the value of header %s, %w", secure.Name, err)
+		}
+		c.headers.Set(secure.Name, value)
+	}
+	if src.BasicAuth != nil {
+		username, err := sharedutil.GetSecretFromVolume(src.BasicAuth.Username)
+		if err != nil {
+			return nil, fmt.Errorf("username not found, %w", err)
+		}
+		password, err := sharedutil.GetSecretFromVolume(src.BasicAuth.Password)
+		if err != nil {
+			return nil, fmt.Errorf("password not found, %w", err)
+		}
+		c.username, c.password = username, password
+	}
+	return c, nil
+}
+
+// fetch gets all the pages of a poll, the first page is requested with the ETag and the cursor of the previous poll.
+func (c *client) fetch(ctx context.Context, etag, cursor string) (*fetchResult, error) {
+	firstURL, err := url.Parse(c.src.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse url %s, %w", c.src.URL, err)
+	}
+	if c.src.Cursor != nil && cursor != "" {
+		query := firstURL.Query()
+		query.Set(c.src.Cursor.Param, cursor)
+		firstURL.RawQuery = query.Encode()
+	}
+
+	maxPages := defaultMaxPages
+	if c.src.Pagination != nil && c.src.Pagination.MaxPages > 0 {
+		maxPages = int(c.src.Pagination.MaxPages)
+	}
+
+	result := &fetchResult{}
+	pageURL := firstURL
+	for page := 0; page < maxPages && pageURL != nil; page++ {
+		pageETag := ""
+		if page == 0 {
+			pageETag = etag
+		}
+		resp, err := c.do(ctx, pageURL, pageETag)
+		if err != nil {
+			return nil, err
+		}
+		if page == 0 {
+			if resp.notModified {
+				result.notModified = true
+				return result, nil
+			}
+			result.etag = resp.etag
+		}
+
+		items, err := extractItems(resp.body, c.src.ItemsPath)
+		if err != nil {
+			return nil, err
+		}
+		result.items = append(result.items, items...)
+		if c.src.Cursor != nil {
+			value, err := queryString(resp.body, c.src.Cursor.Path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the cursor, %w", err)
+			}
+			if value != "" {
+				result.cursor = value
+			}
+		}
+		pageURL, err = c.nextPage(pageURL, resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// response is a page of a poll
+type response struct {
+	notModified bool
+	etag        string
+	link        string
+	body        interface{}
+}
+
+func (c *client) do(ctx context.Context, u *url.URL, etag string) (*response, error) {
+	method := c.src.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	var body io.Reader
+	if c.src.Body != "" {
+		body = strings.NewReader(c.src.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the request, %w", err)
+	}
+	req.Header = c.headers.Clone()
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json")
+	}
+	if c.username != "" || c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request %s, %w", u.Redacted(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return &response{notModified: true}, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("request to %s failed with status %s", u.Redacted(), resp.Status)
+	}
+	result := &response{
+		etag: resp.Header.Get("ETag"),
+		link: resp.Header.Get("Link"),
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result.body); err != nil {
+		return nil, fmt.Errorf("failed to decode the response of %s, %w", u.Redacted(), err)
+	}
+	return result, nil
+}
+
+// nextPage returns the URL of the next page, or nil if there is no next page.
+func (c *client) nextPage(current *url.URL, resp *response) (*url.URL, error) {
+	pagination := c.src.Pagination
+	if pagination == nil {
+		return nil, nil
+	}
+	switch {
+	case pagination.NextURLPath != "":
+		next, err := queryString(resp.body, pagination.NextURLPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the url of the next page, %w", err)
+		}
+		if next == "" {
+			return nil, nil
+		}
+		return current.Parse(next)
+	case pagination.CursorPath != "":
+		cursor, err := queryString(resp.body, pagination.CursorPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the cursor of the next page, %w", err)
+		}
+		if cursor == "" {
+			return nil, nil
+		}
+		next := *current
+		query := next.Query()
+		query.Set(pagination.CursorParam, cursor)
+		next.RawQuery = query.Encode()
+		return &next, nil
+	case pagination.LinkHeader:
+		next := nextLink(resp.link)
+		if next == "" {
+			return nil, nil
+		}
+		return current.Parse(next)
+	}
+	return nil, nil
+}
+
+// nextLink returns the target of the "next" relation of a Link header, e.g. `<https://api/items?page=2>; rel="next"`
+func nextLink(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		target := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		for _, param := range parts[1:] {
+			key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || !strings.EqualFold(key, "rel") {
+				continue
+			}
+			for _, rel := range strings.Fields(strings.Trim(value, `"`)) {
+				if strings.EqualFold(rel, "next") {
+					return strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// extractItems returns the items in the response body
+func extractItems(body interface{}, itemsPath string) ([]interface{}, error) {
+	if itemsPath == "" {
+		return []interface{}{body}, nil
+	}
+	value, err := jsonpath.Query(itemsPath, body)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get the items with %s, %w", itemsPath, err)
+	}
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("the result of %s is not an array", itemsPath)
+	}
+}
+
+// queryString returns the string value of the JSONPath in the body, or an empty string if it is not found.
+func queryString(body interface{}, path string) (string, error) {
+	value, err := jsonpath.Query(path, body)
+	if err != nil {
+		if isNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return stringValue(value)
+}
+
+// isNotFound returns true if the JSONPath does not exist in the document
+func isNotFound(err error) bool {
+	return errors.Is(err, jsonpatherrors.ErrInvalidToken)
+}
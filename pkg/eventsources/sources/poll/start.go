@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poll
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	cronlib "github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/eventsources/persist"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+	metrics "github.com/argoproj/argo-events/pkg/metrics"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	changeNew     = "new"
+	changeChanged = "changed"
+)
+
+var cronParser = cronlib.NewParser(cronlib.Minute | cronlib.Hour | cronlib.Dom | cronlib.Month | cronlib.Dow)
+
+// EventListener implements Eventing for the HTTP polling event source
+type EventListener struct {
+	EventSourceName string
+	EventName       string
+	Namespace       string
+	PollEventSource v1alpha1.PollEventSource
+	Metrics         *metrics.Metrics
+
+	log              *zap.SugaredLogger
+	eventPersistence persist.EventPersist
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() v1alpha1.EventSourceType {
+	return v1alpha1.PollEvent
+}
+
+// StartListening starts polling the endpoint
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	el.log = logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	defer sources.Recover(el.GetEventName())
+	el.log.Info("started processing the poll event source...")
+
+	pollEventSource := &el.PollEventSource
+	schedule, err := resolveSchedule(pollEventSource)
+	if err != nil {
+		return err
+	}
+	client, err := newClient(ctx, pollEventSource)
+	if err != nil {
+		return fmt.Errorf("failed to create the http client for event source %s, %w", el.GetEventName(), err)
+	}
+
+	eventPersistence, err := persist.NewEventPersist(ctx, pollEventSource.Persistence, el.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to initialize the persistence for event source %s, %w", el.GetEventName(), err)
+	}
+	el.eventPersistence = eventPersistence
+	state, err := el.loadState()
+	if err != nil {
+		return err
+	}
+
+	nextT := firstPollTime(pollEventSource, schedule, state.LastPoll, time.Now())
+	for {
+		el.log.Infow("expected next poll", zap.Any(logging.LabelTime, nextT.UTC().String()))
+		timer := time.NewTimer(time.Until(nextT))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			el.log.Info("exiting poll event listener...")
+			return nil
+		case <-timer.C:
+		}
+		if err := el.poll(ctx, client, state, dispatch); err != nil {
+			el.log.Errorw("failed to poll", zap.Error(err))
+			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+		}
+		nextT = schedule.Next(time.Now())
+	}
+}
+
+// poll gets the items from the endpoint and dispatches an event for each new or changed item.
+// The items failing to be dispatched are left out of the state, so they are dispatched again in the next poll.
+func (el *EventListener) poll(ctx context.Context, client *client, state *pollState, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	pollEventSource := &el.PollEventSource
+	pollTime := time.Now()
+	result, err := client.fetch(ctx, state.ETag, state.Cursor)
+	if err != nil {
+		return err
+	}
+	if result.notModified {
+		el.log.Debug("the response is not modified")
+		state.LastPoll = pollTime
+		return el.saveState(state)
+	}
+
+	items := make(map[string]string, min(len(result.items), maxStateItems))
+	remember := func(key, sum string) {
+		if len(items) < maxStateItems {
+			items[key] = sum
+		}
+	}
+	failed := false
+	for _, item := range result.items {
+		id, hash, err := identify(item, pollEventSource.IDPath)
+		if err != nil {
+			el.log.Errorw("failed to identify the item, skipping it", zap.Error(err))
+			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+			continue
+		}
+		key, sum := fingerprint(id), hash[:fingerprintLength]
+		if _, ok := items[key]; ok {
+			continue
+		}
+		previous, seen := state.Items[key]
+		if seen && previous == sum {
+			remember(key, sum)
+			continue
+		}
+		change := changeNew
+		if seen {
+			change = changeChanged
+		}
+		if err := el.dispatchItem(item, id, hash, change, dispatch); err != nil {
+			el.log.Errorw("failed to dispatch the item", zap.String("id", id), zap.Error(err))
+			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+			failed = true
+			if seen {
+				remember(key, previous)
+			}
+			continue
+		}
+		remember(key, sum)
+	}
+	if len(result.items) > maxStateItems {
+		el.log.Warnw("the number of the items exceeds the limit of the state, the items over the limit are emitted again in the next polls",
+			zap.Int("items", len(result.items)), zap.Int("limit", maxStateItems))
+	}
+
+	state.Items = items
+	state.LastPoll = pollTime
+	// keep the previous ETag and cursor for the failed items to be received again
+	if !failed {
+		state.ETag = result.etag
+		if result.cursor != "" {
+			state.Cursor = result.cursor
+		}
+	}
+	return el.saveState(state)
+}
+
+func (el *EventListener) dispatchItem(item interface{}, id, hash, change string, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	eventData := &events.PollEventData{
+		URL:      el.PollEventSource.URL,
+		ID:       id,
+		Change:   change,
+		Body:     item,
+		Metadata: el.PollEventSource.Metadata,
+	}
+	payload, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the event data, %w", err)
+	}
+	el.log.Infow("dispatching poll event...", zap.String("id", id), zap.String("change", change))
+	return dispatch(payload, eventsourcecommon.WithID(fmt.Sprintf("%s:%s", id, hash)))
+}
+
+// resolveSchedule returns the schedule of the polls
+func resolveSchedule(src *v1alpha1.PollEventSource) (cronlib.Schedule, error) {
+	if src.Schedule != "" {
+		schedule, err := cronParser.Parse(src.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse schedule %s, %w", src.Schedule, err)
+		}
+		return schedule, nil
+	}
+	if src.Interval != "" {
+		interval, err := time.ParseDuration(src.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse interval %s, %w", src.Interval, err)
+		}
+		return cronlib.ConstantDelaySchedule{Delay: interval}, nil
+	}
+	return nil, fmt.Errorf("either schedule or interval must be specified")
+}
+
+// firstPollTime returns the time of the first poll, the endpoint is polled immediately
+// if it was never polled, or a poll was missed while the event source was not running.
+func firstPollTime(src *v1alpha1.PollEventSource, schedule cronlib.Schedule, lastPoll, now time.Time) time.Time {
+	if lastPoll.IsZero() {
+		return now
+	}
+	if src.Schedule != "" {
+		prev, err := sharedutil.PrevCronTime(src.Schedule, cronParser, now)
+		if err == nil && prev.After(lastPoll) {
+			return now
+		}
+		return schedule.Next(now)
+	}
+	nextT := schedule.Next(lastPoll)
+	if nextT.Before(now) {
+		return now
+	}
+	return nextT
+}
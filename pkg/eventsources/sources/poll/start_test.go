@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poll
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	"github.com/argoproj/argo-events/pkg/eventsources/persist"
+	"github.com/argoproj/argo-events/pkg/metrics"
+)
+
+type fakePersist struct {
+	events map[string]*persist.Event
+}
+
+func (f *fakePersist) Save(event *persist.Event) error {
+	f.events[event.EventKey] = event
+	return nil
+}
+
+func (f *fakePersist) Get(key string) (*persist.Event, error) {
+	return f.events[key], nil
+}
+
+func (f *fakePersist) IsEnabled() bool {
+	return true
+}
+
+func TestPollChanges(t *testing.T) {
+	items := []map[string]interface{}{{"id": "a", "v": 1}, {"id": "b", "v": 1}}
+	etag := `"1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+	}))
+	defer server.Close()
+
+	el := &EventListener{
+		EventSourceName:  "es",
+		EventName:        "poll",
+		PollEventSource:  v1alpha1.PollEventSource{URL: server.URL, Interval: "1m", ItemsPath: "$.items", IDPath: "$.id"},
+		Metrics:          metrics.NewMetrics("ns"),
+		log:              zaptest.NewLogger(t).Sugar(),
+		eventPersistence: &fakePersist{events: map[string]*persist.Event{}},
+	}
+	c, err := newClient(context.Background(), &el.PollEventSource)
+	require.NoError(t, err)
+	state, err := el.loadState()
+	require.NoError(t, err)
+	r := &eventsourcecommon.FakeDispatcher[events.PollEventData]{}
+
+	require.NoError(t, el.poll(context.Background(), c, state, r.Dispatch))
+	require.Len(t, r.Events, 2)
+	assert.Equal(t, "a", r.Events[0].ID)
+	assert.Equal(t, changeNew, r.Events[0].Change)
+
+	// not modified
+	require.NoError(t, el.poll(context.Background(), c, state, r.Dispatch))
+	assert.Len(t, r.Events, 2)
+
+	// one item changed, one added, restarting from the persisted state
+	items = []map[string]interface{}{{"id": "a", "v": 2}, {"id": "b", "v": 1}, {"id": "c", "v": 1}}
+	etag = `"2"`
+	state, err = el.loadState()
+	require.NoError(t, err)
+	assert.Equal(t, `"1"`, state.ETag)
+	require.NoError(t, el.poll(context.Background(), c, state, r.Dispatch))
+	require.Len(t, r.Events, 4)
+	assert.Equal(t, "a", r.Events[2].ID)
+	assert.Equal(t, changeChanged, r.Events[2].Change)
+	assert.Equal(t, "c", r.Events[3].ID)
+	assert.Equal(t, changeNew, r.Events[3].Change)
+}
+
+func TestPollDispatchFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"1"`)
+		_, _ = w.Write([]byte(`[{"id": 1}, {"id": 2}]`))
+	}))
+	defer server.Close()
+
+	el := &EventListener{
+		EventSourceName:  "es",
+		EventName:        "poll",
+		PollEventSource:  v1alpha1.PollEventSource{URL: server.URL, Interval: "1m", ItemsPath: "$", IDPath: "$.id"},
+		Metrics:          metrics.NewMetrics("ns"),
+		log:              zaptest.NewLogger(t).Sugar(),
+		eventPersistence: &fakePersist{events: map[string]*persist.Event{}},
+	}
+	c, err := newClient(context.Background(), &el.PollEventSource)
+	require.NoError(t, err)
+	state, err := el.loadState()
+	require.NoError(t, err)
+	r := &eventsourcecommon.FakeDispatcher[events.PollEventData]{Fail: func(event events.PollEventData) bool {
+		return event.ID == "2"
+	}}
+
+	require.NoError(t, el.poll(context.Background(), c, state, r.Dispatch))
+	require.Len(t, r.Events, 1)
+	assert.Equal(t, "", state.ETag)
+	assert.Contains(t, state.Items, fingerprint("1"))
+	assert.NotContains(t, state.Items, fingerprint("2"))
+
+	r.Fail = nil
+	require.NoError(t, el.poll(context.Background(), c, state, r.Dispatch))
+	require.Len(t, r.Events, 2)
+	assert.Equal(t, "2", r.Events[1].ID)
+	assert.Equal(t, `"1"`, state.ETag)
+}
+
+func TestPollStateLimit(t *testing.T) {
+	items := make([]map[string]interface{}, maxStateItems+1)
+	for i := range items {
+		items[i] = map[string]interface{}{"id": i}
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(items)
+	}))
+	defer server.Close()
+
+	el := &EventListener{
+		EventSourceName:  "es",
+		EventName:        "poll",
+		PollEventSource:  v1alpha1.PollEventSource{URL: server.URL, Interval: "1m", ItemsPath: "$", IDPath: "$.id"},
+		Metrics:          metrics.NewMetrics("ns"),
+		log:              zaptest.NewLogger(t).Sugar(),
+		eventPersistence: &fakePersist{events: map[string]*persist.Event{}},
+	}
+	c, err := newClient(context.Background(), &el.PollEventSource)
+	require.NoError(t, err)
+	state, err := el.loadState()
+	require.NoError(t, err)
+	r := &eventsourcecommon.FakeDispatcher[events.PollEventData]{}
+
+	require.NoError(t, el.poll(context.Background(), c, state, r.Dispatch))
+	assert.Len(t, r.Events, maxStateItems+1)
+	assert.Len(t, state.Items, maxStateItems)
+	for k, v := range state.Items {
+		assert.Len(t, k, fingerprintLength)
+		assert.Len(t, v, fingerprintLength)
+		break
+	}
+	persisted := el.eventPersistence.(*fakePersist).events[el.getPersistenceKey()]
+	assert.Less(t, len(persisted.EventPayload), 1<<20)
+
+	// only the item over the limit is emitted again
+	require.NoError(t, el.poll(context.Background(), c, state, r.Dispatch))
+	assert.Len(t, r.Events, maxStateItems+2)
+}
+
+func TestPollPagination(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/items?page=2>; rel="next"`, server.URL))
+			_, _ = w.Write([]byte(`{"items": [{"id": 1}], "next": "/items?page=2", "cursor": "2"}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"items": [{"id": 2}], "next": null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := map[string]*v1alpha1.PollPagination{
+		"next url":    {NextURLPath: "$.next"},
+		"cursor":      {CursorPath: "$.cursor", CursorParam: "page"},
+		"link header": {LinkHeader: true},
+	}
+	for name, pagination := range tests {
+		t.Run(name, func(t *testing.T) {
+			src := v1alpha1.PollEventSource{URL: server.URL + "/items", Interval: "1m", ItemsPath: "$.items", Pagination: pagination}
+			c, err := newClient(context.Background(), &src)
+			require.NoError(t, err)
+			result, err := c.fetch(context.Background(), "", "")
+			require.NoError(t, err)
+			assert.Len(t, result.items, 2)
+		})
+	}
+
+	t.Run("max pages", func(t *testing.T) {
+		src := v1alpha1.PollEventSource{URL: server.URL + "/items", Interval: "1m", ItemsPath: "$.items", Pagination: &v1alpha1.PollPagination{LinkHeader: true, MaxPages: 1}}
+		c, err := newClient(context.Background(), &src)
+		require.NoError(t, err)
+		result, err := c.fetch(context.Background(), "", "")
+		require.NoError(t, err)
+		assert.Len(t, result.items, 1)
+	})
+}
+
+func TestPollCursor(t *testing.T) {
+	var since []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		since = append(since, r.URL.Query().Get("since"))
+		_, _ = w.Write([]byte(`{"items": [], "until": "100"}`))
+	}))
+	defer server.Close()
+
+	el := &EventListener{
+		EventSourceName:  "es",
+		EventName:        "poll",
+		PollEventSource:  v1alpha1.PollEventSource{URL: server.URL, Interval: "1m", ItemsPath: "$.items", Cursor: &v1alpha1.PollCursor{Path: "$.until", Param: "since"}},
+		Metrics:          metrics.NewMetrics("ns"),
+		log:              zaptest.NewLogger(t).Sugar(),
+		eventPersistence: &fakePersist{events: map[string]*persist.Event{}},
+	}
+	c, err := newClient(context.Background(), &el.PollEventSource)
+	require.NoError(t, err)
+	state, err := el.loadState()
+	require.NoError(t, err)
+	r := &eventsourcecommon.FakeDispatcher[events.PollEventData]{}
+	require.NoError(t, el.poll(context.Background(), c, state, r.Dispatch))
+	require.NoError(t, el.poll(context.Background(), c, state, r.Dispatch))
+	assert.Equal(t, []string{"", "100"}, since)
+}
+
+func TestIdentify(t *testing.T) {
+	id, hash, err := identify(map[string]interface{}{"id": 1.0, "a": "b"}, "$.id")
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+	assert.Len(t, hash, 64)
+
+	id, hash, err = identify(map[string]interface{}{"a": "b"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, hash, id)
+
+	_, _, err = identify(map[string]interface{}{"a": "b"}, "$.id")
+	assert.Error(t, err)
+}
+
+func TestNextLink(t *testing.T) {
+	assert.Equal(t, "https://api/items?page=3", nextLink(`<https://api/items?page=1>; rel="prev", <https://api/items?page=3>; rel="next"`))
+	assert.Equal(t, "", nextLink(`<https://api/items?page=1>; rel="prev"`))
+	assert.Equal(t, "", nextLink(""))
+}
+
+func TestFirstPollTime(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	src := &v1alpha1.PollEventSource{Interval: "10m"}
+	schedule, err := resolveSchedule(src)
+	require.NoError(t, err)
+	assert.Equal(t, now, firstPollTime(src, schedule, time.Time{}, now))
+	assert.Equal(t, now.Add(5*time.Minute), firstPollTime(src, schedule, now.Add(-5*time.Minute), now))
+	assert.Equal(t, now, firstPollTime(src, schedule, now.Add(-time.Hour), now))
+
+	src = &v1alpha1.PollEventSource{Schedule: "0 * * * *"}
+	schedule, err = resolveSchedule(src)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(30*time.Minute), firstPollTime(src, schedule, now.Add(-10*time.Minute), now))
+	// the poll at 10:00 was missed
+	assert.Equal(t, now, firstPollTime(src, schedule, now.Add(-time.Hour), now))
+}
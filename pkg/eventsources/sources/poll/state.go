@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poll
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/evilmonkeyinc/jsonpath"
+
+	"github.com/argoproj/argo-events/pkg/eventsources/persist"
+)
+
+const (
+	// maxStateItems is the maximum number of items tracked in the state, it keeps the persisted
+	// state well under the 1 MiB size limit of a ConfigMap.
+	maxStateItems = 20000
+	// fingerprintLength is the number of hex characters the IDs and the hashes of the items
+	// are shortened to in the state.
+	fingerprintLength = 16
+)
+
+// pollState is the state persisted between the polls
+type pollState struct {
+	// ETag of the first page of the last response
+	ETag string `json:"etag,omitempty"`
+	// Cursor to send with the next poll
+	Cursor string `json:"cursor,omitempty"`
+	// LastPoll is the time of the last poll
+	LastPoll time.Time `json:"lastPoll,omitempty"`
+	// Items holds the fingerprint of the content of the items seen in the last poll by the fingerprint of their IDs
+	Items map[string]string `json:"items,omitempty"`
+}
+
+func (el *EventListener) getPersistenceKey() string {
+	return fmt.Sprintf("%s.%s", el.EventSourceName, el.EventName)
+}
+
+func (el *EventListener) loadState() (*pollState, error) {
+	state := &pollState{Items: map[string]string{}}
+	if !el.eventPersistence.IsEnabled() {
+		return state, nil
+	}
+	event, err := el.eventPersistence.Get(el.getPersistenceKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the persisted state, %w", err)
+	}
+	if event == nil || event.EventPayload == "" {
+		return state, nil
+	}
+	if err := json.Unmarshal([]byte(event.EventPayload), state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the persisted state, %w", err)
+	}
+	if state.Items == nil {
+		state.Items = map[string]string{}
+	}
+	return state, nil
+}
+
+func (el *EventListener) saveState(state *pollState) error {
+	if !el.eventPersistence.IsEnabled() {
+		return nil
+	}
+	payload, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the state, %w", err)
+	}
+	if err := el.eventPersistence.Save(&persist.Event{EventKey: el.getPersistenceKey(), EventPayload: string(payload)}); err != nil {
+		return fmt.Errorf("failed to persist the state, %w", err)
+	}
+	return nil
+}
+
+// fingerprint returns the compact form of an item ID kept in the state.
+func fingerprint(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:])[:fingerprintLength]
+}
+
+// identify returns the ID and the hash of the content of an item, the hash is used as the ID if no ID path is specified.
+func identify(item interface{}, idPath string) (string, string, error) {
+	content, err := json.Marshal(item)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal the item, %w", err)
+	}
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:])
+	if idPath == "" {
+		return hash, hash, nil
+	}
+	value, err := jsonpath.Query(idPath, item)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get the id of the item with %s, %w", idPath, err)
+	}
+	id, err := stringValue(value)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get the id of the item with %s, %w", idPath, err)
+	}
+	if id == "" {
+		return "", "", fmt.Errorf("the id of the item with %s is empty", idPath)
+	}
+	return id, hash, nil
+}
+
+// stringValue returns the string of a value in a JSON document
+func stringValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
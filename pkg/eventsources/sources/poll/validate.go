@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poll
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/evilmonkeyinc/jsonpath"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+// ValidateEventSource validates the poll event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.PollEventSource)
+}
+
+func validate(eventSource *v1alpha1.PollEventSource) error {
+	if eventSource == nil {
+		return v1alpha1.ErrNilEventSource
+	}
+	if eventSource.URL == "" {
+		return fmt.Errorf("url must be specified")
+	}
+	if _, err := url.Parse(eventSource.URL); err != nil {
+		return fmt.Errorf("invalid url %s, %w", eventSource.URL, err)
+	}
+	if (eventSource.Interval == "") == (eventSource.Schedule == "") {
+		return fmt.Errorf("either interval or schedule must be specified")
+	}
+	if _, err := resolveSchedule(eventSource); err != nil {
+		return err
+	}
+	if eventSource.Timeout != "" {
+		if _, err := time.ParseDuration(eventSource.Timeout); err != nil {
+			return fmt.Errorf("failed to parse timeout %s, %w", eventSource.Timeout, err)
+		}
+	}
+	for _, header := range eventSource.SecureHeaders {
+		if header == nil || header.Name == "" {
+			return fmt.Errorf("name of secure header must be specified")
+		}
+		if header.ValueFrom == nil || (header.ValueFrom.SecretKeyRef == nil && header.ValueFrom.ConfigMapKeyRef == nil) {
+			return fmt.Errorf("value of secure header %s must be specified", header.Name)
+		}
+	}
+	if eventSource.BasicAuth != nil {
+		if err := v1alpha1.ValidateBasicAuth(eventSource.BasicAuth); err != nil {
+			return err
+		}
+	}
+	if eventSource.OAuth2 != nil {
+		if eventSource.OAuth2.TokenURL == "" {
+			return fmt.Errorf("oauth2 token url must be specified")
+		}
+		if eventSource.OAuth2.ClientID == nil || eventSource.OAuth2.ClientSecret == nil {
+			return fmt.Errorf("oauth2 client id and client secret must be specified")
+		}
+	}
+	if eventSource.TLS != nil {
+		if err := v1alpha1.ValidateTLSConfig(eventSource.TLS); err != nil {
+			return err
+		}
+	}
+	for _, path := range []string{eventSource.ItemsPath, eventSource.IDPath} {
+		if err := validateJSONPath(path); err != nil {
+			return err
+		}
+	}
+	if eventSource.Pagination != nil {
+		if err := validatePagination(eventSource.Pagination); err != nil {
+			return err
+		}
+	}
+	if eventSource.Cursor != nil {
+		if eventSource.Cursor.Path == "" || eventSource.Cursor.Param == "" {
+			return fmt.Errorf("cursor path and param must be specified")
+		}
+		if err := validateJSONPath(eventSource.Cursor.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePagination(pagination *v1alpha1.PollPagination) error {
+	count := 0
+	if pagination.NextURLPath != "" {
+		count++
+	}
+	if pagination.CursorPath != "" {
+		count++
+	}
+	if pagination.LinkHeader {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of nextURLPath, cursorPath and linkHeader must be specified for pagination")
+	}
+	if pagination.CursorPath != "" && pagination.CursorParam == "" {
+		return fmt.Errorf("cursorParam must be specified with cursorPath for pagination")
+	}
+	if pagination.MaxPages < 0 {
+		return fmt.Errorf("maxPages must not be negative")
+	}
+	for _, path := range []string{pagination.NextURLPath, pagination.CursorPath} {
+		if err := validateJSONPath(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateJSONPath(path string) error {
+	if path == "" {
+		return nil
+	}
+	if _, err := jsonpath.Compile(path); err != nil {
+		return fmt.Errorf("invalid JSONPath %s, %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poll
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+)
+
+func TestValidateEventSource(t *testing.T) {
+	listener := &EventListener{}
+
+	err := listener.ValidateEventSource(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, "url must be specified", err.Error())
+
+	content, err := os.ReadFile(fmt.Sprintf("%s/%s", sources.EventSourceDir, "poll.yaml"))
+	assert.Nil(t, err)
+
+	var eventSource *v1alpha1.EventSource
+	err = yaml.Unmarshal(content, &eventSource)
+	assert.Nil(t, err)
+	assert.NotNil(t, eventSource.Spec.Poll)
+
+	for name, value := range eventSource.Spec.Poll {
+		fmt.Println(name)
+		l := &EventListener{
+			PollEventSource: value,
+		}
+		err := l.ValidateEventSource(context.Background())
+		assert.NoError(t, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	newSource := func() *v1alpha1.PollEventSource {
+		return &v1alpha1.PollEventSource{
+			URL:      "http://localhost/items",
+			Interval: "1m",
+		}
+	}
+
+	t.Run("schedule", func(t *testing.T) {
+		es := newSource()
+		es.Interval = ""
+		assert.Error(t, validate(es))
+		es.Schedule = "*/5 * * * *"
+		assert.NoError(t, validate(es))
+		es.Interval = "1m"
+		assert.Error(t, validate(es))
+		es.Interval = ""
+		es.Schedule = "abc"
+		assert.Error(t, validate(es))
+	})
+
+	t.Run("json path", func(t *testing.T) {
+		es := newSource()
+		es.ItemsPath = "$.items["
+		assert.Error(t, validate(es))
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		es := newSource()
+		es.Pagination = &v1alpha1.PollPagination{}
+		assert.Error(t, validate(es))
+		es.Pagination = &v1alpha1.PollPagination{NextURLPath: "$.next", LinkHeader: true}
+		assert.Error(t, validate(es))
+		es.Pagination = &v1alpha1.PollPagination{CursorPath: "$.cursor"}
+		assert.Error(t, validate(es))
+		es.Pagination = &v1alpha1.PollPagination{CursorPath: "$.cursor", CursorParam: "cursor"}
+		assert.NoError(t, validate(es))
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		es := newSource()
+		es.Cursor = &v1alpha1.PollCursor{Path: "$.since"}
+		assert.Error(t, validate(es))
+		es.Cursor.Param = "since"
+		assert.NoError(t, validate(es))
+	})
+
+	t.Run("oauth2", func(t *testing.T) {
+		es := newSource()
+		es.OAuth2 = &v1alpha1.OAuth2ClientCredentials{}
+		assert.Error(t, validate(es))
+	})
+
+	t.Run("secure header", func(t *testing.T) {
+		es := newSource()
+		es.SecureHeaders = []*v1alpha1.SecureHeader{{Name: "Authorization"}}
+		assert.Error(t, validate(es))
+	})
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// The messages of the streaming replication protocol and the pgoutput plugin (protocol version 1),
+// see https://www.postgresql.org/docs/current/protocol-logicalrep-message-formats.html
+
+const (
+	xLogDataByteID                = 'w'
+	primaryKeepaliveMessageByteID = 'k'
+	standbyStatusUpdateByteID     = 'r'
+)
+
+// postgresEpoch is the epoch of the timestamps of the protocol
+var postgresEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// LSN is a position in the WAL
+type LSN uint64
+
+func (lsn LSN) String() string {
+	return fmt.Sprintf("%X/%X", uint32(lsn>>32), uint32(lsn))
+}
+
+func pgTime(micros int64) time.Time {
+	return postgresEpoch.Add(time.Duration(micros) * time.Microsecond)
+}
+
+// xLogData is a chunk of the WAL data
+type xLogData struct {
+	walStart     LSN
+	serverWALEnd LSN
+	data         []byte
+}
+
+// primaryKeepalive is sent by the server periodically
+type primaryKeepalive struct {
+	serverWALEnd   LSN
+	replyRequested bool
+}
+
+func parseXLogData(buf []byte) (*xLogData, error) {
+	if len(buf) < 24 {
+		return nil, fmt.Errorf("XLogData must be at least 24 bytes, got %d", len(buf))
+	}
+	return &xLogData{
+		walStart:     LSN(binary.BigEndian.Uint64(buf)),
+		serverWALEnd: LSN(binary.BigEndian.Uint64(buf[8:])),
+		data:         buf[24:],
+	}, nil
+}
+
+func parsePrimaryKeepalive(buf []byte) (*primaryKeepalive, error) {
+	if len(buf) != 17 {
+		return nil, fmt.Errorf("primary keepalive message must be 17 bytes, got %d", len(buf))
+	}
+	return &primaryKeepalive{
+		serverWALEnd:   LSN(binary.BigEndian.Uint64(buf)),
+		replyRequested: buf[16] != 0,
+	}, nil
+}
+
+// standbyStatusUpdate encodes the message reporting the position confirmed by the client
+func standbyStatusUpdate(lsn LSN, now time.Time) []byte {
+	buf := make([]byte, 34)
+	buf[0] = standbyStatusUpdateByteID
+	binary.BigEndian.PutUint64(buf[1:], uint64(lsn))
+	binary.BigEndian.PutUint64(buf[9:], uint64(lsn))
+	binary.BigEndian.PutUint64(buf[17:], uint64(lsn))
+	binary.BigEndian.PutUint64(buf[25:], uint64(now.Sub(postgresEpoch).Microseconds()))
+	return buf
+}
+
+// message is a decoded pgoutput message
+type message interface{}
+
+type beginMessage struct {
+	finalLSN   LSN
+	commitTime time.Time
+	xid        uint32
+}
+
+type commitMessage struct {
+	commitLSN         LSN
+	transactionEndLSN LSN
+	commitTime        time.Time
+}
+
+type relationColumn struct {
+	name     string
+	dataType uint32
+}
+
+type relationMessage struct {
+	relationID uint32
+	namespace  string
+	name       string
+	columns    []relationColumn
+}
+
+// tupleColumn is the value of a column, null and unchanged TOAST values have no data.
+type tupleColumn struct {
+	kind byte
+	data []byte
+}
+
+const (
+	tupleNull      = 'n'
+	tupleUnchanged = 'u'
+	tupleText      = 't'
+)
+
+type insertMessage struct {
+	relationID uint32
+	newTuple   []tupleColumn
+}
+
+type updateMessage struct {
+	relationID uint32
+	oldTuple   []tupleColumn
+	newTuple   []tupleColumn
+}
+
+type deleteMessage struct {
+	relationID uint32
+	oldTuple   []tupleColumn
+}
+
+// ignoredMessage is a message which is not needed to build the events, e.g. Origin, Type and Truncate.
+type ignoredMessage struct {
+	messageType byte
+}
+
+// decoder reads the fields of a message
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) fail(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (d *decoder) uint8() byte {
+	if d.err != nil || len(d.buf) < 1 {
+		d.fail("unexpected end of message")
+		return 0
+	}
+	v := d.buf[0]
+	d.buf = d.buf[1:]
+	return v
+}
+
+func (d *decoder) uint16() uint16 {
+	if d.err != nil || len(d.buf) < 2 {
+		d.fail("unexpected end of message")
+		return 0
+	}
+	v := binary.BigEndian.Uint16(d.buf)
+	d.buf = d.buf[2:]
+	return v
+}
+
+func (d *decoder) uint32() uint32 {
+	if d.err != nil || len(d.buf) < 4 {
+		d.fail("unexpected end of message")
+		return 0
+	}
+	v := binary.BigEndian.Uint32(d.buf)
+	d.buf = d.buf[4:]
+	return v
+}
+
+func (d *decoder) uint64() uint64 {
+	if d.err != nil || len(d.buf) < 8 {
+		d.fail("unexpected end of message")
+		return 0
+	}
+	v := binary.BigEndian.Uint64(d.buf)
+	d.buf = d.buf[8:]
+	return v
+}
+
+func (d *decoder) string() string {
+	if d.err != nil {
+		return ""
+	}
+	for i, b := range d.buf {
+		if b == 0 {
+			s := string(d.buf[:i])
+			d.buf = d.buf[i+1:]
+			return s
+		}
+	}
+	d.fail("unterminated string")
+	return ""
+}
+
+func (d *decoder) bytes(n int) []byte {
+	if d.err != nil || n < 0 || len(d.buf) < n {
+		d.fail("unexpected end of message")
+		return nil
+	}
+	v := d.buf[:n]
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) tuple() []tupleColumn {
+	n := int(d.uint16())
+	columns := make([]tupleColumn, 0, n)
+	for i := 0; i < n && d.err == nil; i++ {
+		kind := d.uint8()
+		switch kind {
+		case tupleNull, tupleUnchanged:
+			columns = append(columns, tupleColumn{kind: kind})
+		case tupleText:
+			length := int(d.uint32())
+			columns = append(columns, tupleColumn{kind: kind, data: d.bytes(length)})
+		default:
+			d.fail("unsupported tuple data kind %q", kind)
+		}
+	}
+	return columns
+}
+
+// parseMessage decodes a pgoutput message
+func parseMessage(buf []byte) (message, error) {
+	if len(buf) == 0 {
+		return nil, fmt.Errorf("empty message")
+	}
+	d := &decoder{buf: buf[1:]}
+	var msg message
+	switch buf[0] {
+	case 'B':
+		msg = &beginMessage{
+			finalLSN:   LSN(d.uint64()),
+			commitTime: pgTime(int64(d.uint64())),
+			xid:        d.uint32(),
+		}
+	case 'C':
+		d.uint8() // flags
+		msg = &commitMessage{
+			commitLSN:         LSN(d.uint64()),
+			transactionEndLSN: LSN(d.uint64()),
+			commitTime:        pgTime(int64(d.uint64())),
+		}
+	case 'R':
+		rel := &relationMessage{
+			relationID: d.uint32(),
+			namespace:  d.string(),
+			name:       d.string(),
+		}
+		d.uint8() // replica identity
+		n := int(d.uint16())
+		for i := 0; i < n && d.err == nil; i++ {
+			d.uint8() // flags
+			column := relationColumn{name: d.string(), dataType: d.uint32()}
+			d.uint32() // type modifier
+			rel.columns = append(rel.columns, column)
+		}
+		msg = rel
+	case 'I':
+		ins := &insertMessage{relationID: d.uint32()}
+		if kind := d.uint8(); kind != 'N' {
+			d.fail("unexpected tuple type %q in insert message", kind)
+		}
+		ins.newTuple = d.tuple()
+		msg = ins
+	case 'U':
+		upd := &updateMessage{relationID: d.uint32()}
+		kind := d.uint8()
+		if kind == 'K' || kind == 'O' {
+			upd.oldTuple = d.tuple()
+			kind = d.uint8()
+		}
+		if kind != 'N' {
+			d.fail("unexpected tuple type %q in update message", kind)
+		}
+		upd.newTuple = d.tuple()
+		msg = upd
+	case 'D':
+		del := &deleteMessage{relationID: d.uint32()}
+		if kind := d.uint8(); kind != 'K' && kind != 'O' {
+			d.fail("unexpected tuple type %q in delete message", kind)
+		}
+		del.oldTuple = d.tuple()
+		msg = del
+	case 'O', 'Y', 'T', 'M':
+		msg = &ignoredMessage{messageType: buf[0]}
+	default:
+		return nil, fmt.Errorf("unknown message type %q", buf[0])
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("failed to decode message %q, %w", buf[0], d.err)
+	}
+	return msg, nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// encoder builds the pgoutput messages of the tests
+type encoder struct {
+	buf []byte
+}
+
+func newEncoder(messageType byte) *encoder {
+	return &encoder{buf: []byte{messageType}}
+}
+
+func (e *encoder) uint8(v byte) *encoder {
+	e.buf = append(e.buf, v)
+	return e
+}
+
+func (e *encoder) uint16(v uint16) *encoder {
+	e.buf = binary.BigEndian.AppendUint16(e.buf, v)
+	return e
+}
+
+func (e *encoder) uint32(v uint32) *encoder {
+	e.buf = binary.BigEndian.AppendUint32(e.buf, v)
+	return e
+}
+
+func (e *encoder) uint64(v uint64) *encoder {
+	e.buf = binary.BigEndian.AppendUint64(e.buf, v)
+	return e
+}
+
+func (e *encoder) string(s string) *encoder {
+	e.buf = append(append(e.buf, s...), 0)
+	return e
+}
+
+// tuple encodes the values, nil is a null value
+func (e *encoder) tuple(values ...*string) *encoder {
+	e.uint16(uint16(len(values)))
+	for _, v := range values {
+		if v == nil {
+			e.uint8(tupleNull)
+			continue
+		}
+		e.uint8(tupleText).uint32(uint32(len(*v)))
+		e.buf = append(e.buf, *v...)
+	}
+	return e
+}
+
+func str(s string) *string {
+	return &s
+}
+
+func beginMsg(finalLSN LSN, xid uint32) []byte {
+	return newEncoder('B').uint64(uint64(finalLSN)).uint64(uint64(time.Hour / time.Microsecond)).uint32(xid).buf
+}
+
+func commitMsg(commitLSN, endLSN LSN) []byte {
+	return newEncoder('C').uint8(0).uint64(uint64(commitLSN)).uint64(uint64(endLSN)).uint64(0).buf
+}
+
+// relationMsg encodes a relation of the columns id int4, name text and data jsonb
+func relationMsg(id uint32, namespace, name string) []byte {
+	e := newEncoder('R').uint32(id).string(namespace).string(name).uint8('d').uint16(3)
+	e.uint8(1).string("id").uint32(23).uint32(0xffffffff)
+	e.uint8(0).string("name").uint32(25).uint32(0xffffffff)
+	e.uint8(0).string("data").uint32(3802).uint32(0xffffffff)
+	return e.buf
+}
+
+func TestParseMessage(t *testing.T) {
+	t.Run("begin", func(t *testing.T) {
+		msg, err := parseMessage(beginMsg(0x100, 7))
+		require.NoError(t, err)
+		begin := msg.(*beginMessage)
+		assert.Equal(t, LSN(0x100), begin.finalLSN)
+		assert.Equal(t, uint32(7), begin.xid)
+		assert.Equal(t, postgresEpoch.Add(time.Hour), begin.commitTime)
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		msg, err := parseMessage(commitMsg(0x100, 0x110))
+		require.NoError(t, err)
+		commit := msg.(*commitMessage)
+		assert.Equal(t, LSN(0x100), commit.commitLSN)
+		assert.Equal(t, LSN(0x110), commit.transactionEndLSN)
+	})
+
+	t.Run("relation", func(t *testing.T) {
+		msg, err := parseMessage(relationMsg(1, "public", "users"))
+		require.NoError(t, err)
+		rel := msg.(*relationMessage)
+		assert.Equal(t, uint32(1), rel.relationID)
+		assert.Equal(t, "public", rel.namespace)
+		assert.Equal(t, "users", rel.name)
+		assert.Equal(t, []relationColumn{{"id", 23}, {"name", 25}, {"data", 3802}}, rel.columns)
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		msg, err := parseMessage(newEncoder('I').uint32(1).uint8('N').tuple(str("1"), nil, str("{}")).buf)
+		require.NoError(t, err)
+		ins := msg.(*insertMessage)
+		assert.Equal(t, uint32(1), ins.relationID)
+		assert.Equal(t, []tupleColumn{{kind: tupleText, data: []byte("1")}, {kind: tupleNull}, {kind: tupleText, data: []byte("{}")}}, ins.newTuple)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		msg, err := parseMessage(newEncoder('U').uint32(1).uint8('O').tuple(str("1"), str("a"), nil).uint8('N').tuple(str("1"), str("b"), nil).buf)
+		require.NoError(t, err)
+		upd := msg.(*updateMessage)
+		assert.Len(t, upd.oldTuple, 3)
+		assert.Len(t, upd.newTuple, 3)
+
+		msg, err = parseMessage(newEncoder('U').uint32(1).uint8('N').tuple(str("1")).buf)
+		require.NoError(t, err)
+		assert.Nil(t, msg.(*updateMessage).oldTuple)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		msg, err := parseMessage(newEncoder('D').uint32(1).uint8('K').tuple(str("1"), nil, nil).buf)
+		require.NoError(t, err)
+		assert.Len(t, msg.(*deleteMessage).oldTuple, 3)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := parseMessage(newEncoder('I').uint32(1).uint8('N').uint16(1).uint8(tupleText).uint32(10).buf)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := parseMessage([]byte{'Z'})
+		assert.Error(t, err)
+	})
+}
+
+func TestLSN(t *testing.T) {
+	assert.Equal(t, "16/B374D848", LSN(0x16B374D848).String())
+}
+
+func TestStandbyStatusUpdate(t *testing.T) {
+	buf := standbyStatusUpdate(0x100, postgresEpoch.Add(time.Second))
+	require.Len(t, buf, 34)
+	assert.Equal(t, byte('r'), buf[0])
+	assert.Equal(t, uint64(0x100), binary.BigEndian.Uint64(buf[1:]))
+	assert.Equal(t, uint64(0x100), binary.BigEndian.Uint64(buf[9:]))
+	assert.Equal(t, uint64(0x100), binary.BigEndian.Uint64(buf[17:]))
+	assert.Equal(t, uint64(1000000), binary.BigEndian.Uint64(buf[25:]))
+}
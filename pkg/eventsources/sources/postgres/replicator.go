@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+)
+
+const (
+	operationInsert = "insert"
+	operationUpdate = "update"
+	operationDelete = "delete"
+)
+
+// replicator builds the events from the pgoutput messages, and keeps track of the confirmed position.
+// A position is confirmed once all the changes of the transactions before it are published to the eventbus.
+type replicator struct {
+	el         *EventListener
+	log        *zap.SugaredLogger
+	relations  map[uint32]*relationMessage
+	tables     map[string]bool
+	operations map[string]bool
+	// begin is the transaction in progress
+	begin     *beginMessage
+	confirmed LSN
+}
+
+func newReplicator(el *EventListener, log *zap.SugaredLogger) *replicator {
+	r := &replicator{
+		el:        el,
+		log:       log,
+		relations: map[uint32]*relationMessage{},
+	}
+	if len(el.PostgresEventSource.Tables) > 0 {
+		r.tables = map[string]bool{}
+		for _, table := range el.PostgresEventSource.Tables {
+			r.tables[qualifiedTableName(table)] = true
+		}
+	}
+	if len(el.PostgresEventSource.Operations) > 0 {
+		r.operations = map[string]bool{}
+		for _, op := range el.PostgresEventSource.Operations {
+			r.operations[strings.ToLower(op)] = true
+		}
+	}
+	return r
+}
+
+// qualifiedTableName returns the table name with the schema, "public" is the default schema.
+func qualifiedTableName(table string) string {
+	if strings.Contains(table, ".") {
+		return table
+	}
+	return "public." + table
+}
+
+// keepalive confirms the position of the server if no transaction is in progress.
+func (r *replicator) keepalive(k *primaryKeepalive) {
+	if r.begin == nil && k.serverWALEnd > r.confirmed {
+		r.confirmed = k.serverWALEnd
+	}
+}
+
+// handle processes a chunk of WAL data. An error is returned if a change fails to be published to the eventbus,
+// the changes are received again from the confirmed position after reconnecting.
+func (r *replicator) handle(x *xLogData, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	msg, err := parseMessage(x.data)
+	if err != nil {
+		return err
+	}
+	switch m := msg.(type) {
+	case *relationMessage:
+		r.relations[m.relationID] = m
+	case *beginMessage:
+		r.begin = m
+	case *commitMessage:
+		r.begin = nil
+		if m.transactionEndLSN > r.confirmed {
+			r.confirmed = m.transactionEndLSN
+		}
+	case *insertMessage:
+		return r.emit(operationInsert, m.relationID, nil, m.newTuple, x.walStart, dispatch)
+	case *updateMessage:
+		return r.emit(operationUpdate, m.relationID, m.oldTuple, m.newTuple, x.walStart, dispatch)
+	case *deleteMessage:
+		return r.emit(operationDelete, m.relationID, m.oldTuple, nil, x.walStart, dispatch)
+	}
+	return nil
+}
+
+func (r *replicator) emit(operation string, relationID uint32, before, after []tupleColumn, lsn LSN, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	rel, ok := r.relations[relationID]
+	if !ok {
+		return fmt.Errorf("unknown relation %d", relationID)
+	}
+	if r.tables != nil && !r.tables[rel.namespace+"."+rel.name] {
+		return nil
+	}
+	if r.operations != nil && !r.operations[operation] {
+		return nil
+	}
+
+	el := r.el
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	eventData := &events.PostgresEventData{
+		Operation: operation,
+		Schema:    rel.namespace,
+		Table:     rel.name,
+		Before:    decodeTuple(rel, before),
+		After:     decodeTuple(rel, after),
+		LSN:       lsn.String(),
+		Metadata:  el.PostgresEventSource.Metadata,
+	}
+	if r.begin != nil {
+		eventData.CommitLSN = r.begin.finalLSN.String()
+		eventData.CommitTime = r.begin.commitTime.UTC().Format(time.RFC3339Nano)
+		eventData.XID = r.begin.xid
+	}
+	payload, err := json.Marshal(eventData)
+	if err != nil {
+		r.log.Errorw("failed to marshal the event data, rejecting the change...", zap.Error(err))
+		el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+		return nil
+	}
+	r.log.Infow("dispatching the change...", zap.String("table", rel.namespace+"."+rel.name), zap.String("operation", operation), zap.String("lsn", eventData.LSN))
+	if err := dispatch(payload, eventsourcecommon.WithID(fmt.Sprintf("%s:%s", el.PostgresEventSource.Slot, eventData.LSN))); err != nil {
+		el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+		if eventbuscommon.IsEventBusError(err) {
+			return fmt.Errorf("failed to dispatch the change at %s, %w", eventData.LSN, err)
+		}
+		r.log.Errorw("failed to dispatch the change, rejecting it...", zap.Error(err))
+	}
+	return nil
+}
+
+// decodeTuple returns the values of a row by column name, unchanged TOAST values are left out.
+func decodeTuple(rel *relationMessage, tuple []tupleColumn) map[string]interface{} {
+	if tuple == nil {
+		return nil
+	}
+	row := make(map[string]interface{}, len(tuple))
+	for i, col := range tuple {
+		if i >= len(rel.columns) {
+			break
+		}
+		column := rel.columns[i]
+		switch col.kind {
+		case tupleNull:
+			row[column.name] = nil
+		case tupleText:
+			row[column.name] = decodeValue(column.dataType, string(col.data))
+		}
+	}
+	return row
+}
+
+// decodeValue converts the booleans, numbers and JSON values, the other values are kept in the text format of PostgreSQL.
+func decodeValue(oid uint32, text string) interface{} {
+	switch oid {
+	case pgtype.BoolOID:
+		return text == "t"
+	case pgtype.Int2OID, pgtype.Int4OID, pgtype.Int8OID, pgtype.OIDOID:
+		if v, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return v
+		}
+	case pgtype.Float4OID, pgtype.Float8OID, pgtype.NumericOID:
+		// NaN and Infinity are not valid JSON numbers
+		if json.Valid([]byte(text)) {
+			return json.Number(text)
+		}
+	case pgtype.JSONOID, pgtype.JSONBOID:
+		if json.Valid([]byte(text)) {
+			return json.RawMessage(text)
+		}
+	}
+	return text
+}
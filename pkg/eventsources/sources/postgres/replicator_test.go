@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/metrics"
+)
+
+func newTestReplicator(t *testing.T, src v1alpha1.PostgresEventSource) *replicator {
+	t.Helper()
+	src.Slot = "slot"
+	el := &EventListener{
+		EventSourceName:     "es",
+		EventName:           "pg",
+		PostgresEventSource: src,
+		Metrics:             metrics.NewMetrics("ns"),
+	}
+	return newReplicator(el, zaptest.NewLogger(t).Sugar())
+}
+
+func handleAll(t *testing.T, r *replicator, rec *eventsourcecommon.FakeDispatcher[map[string]interface{}], msgs ...[]byte) error {
+	t.Helper()
+	for i, msg := range msgs {
+		if err := r.handle(&xLogData{walStart: LSN(0x100 + i), data: msg}, rec.Dispatch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestReplicatorTransaction(t *testing.T) {
+	r := newTestReplicator(t, v1alpha1.PostgresEventSource{})
+	rec := &eventsourcecommon.FakeDispatcher[map[string]interface{}]{}
+
+	err := handleAll(t, r, rec,
+		relationMsg(1, "public", "users"),
+		beginMsg(0x200, 9),
+		newEncoder('I').uint32(1).uint8('N').tuple(str("1"), str("alice"), str(`{"a":1}`)).buf,
+		newEncoder('U').uint32(1).uint8('O').tuple(str("1"), str("alice"), nil).uint8('N').tuple(str("1"), str("bob"), nil).buf,
+	)
+	require.NoError(t, err)
+	// the transaction is not confirmed until the commit
+	assert.Equal(t, LSN(0), r.confirmed)
+	r.keepalive(&primaryKeepalive{serverWALEnd: 0x300})
+	assert.Equal(t, LSN(0), r.confirmed)
+
+	require.NoError(t, handleAll(t, r, rec,
+		newEncoder('D').uint32(1).uint8('K').tuple(str("1"), nil, nil).buf,
+		commitMsg(0x200, 0x210),
+	))
+	assert.Equal(t, LSN(0x210), r.confirmed)
+	r.keepalive(&primaryKeepalive{serverWALEnd: 0x300})
+	assert.Equal(t, LSN(0x300), r.confirmed)
+
+	require.Len(t, rec.Events, 3)
+	insert := rec.Events[0]
+	assert.Equal(t, "insert", insert["operation"])
+	assert.Equal(t, "public", insert["schema"])
+	assert.Equal(t, "users", insert["table"])
+	assert.Equal(t, map[string]interface{}{"id": 1.0, "name": "alice", "data": map[string]interface{}{"a": 1.0}}, insert["after"])
+	assert.Nil(t, insert["before"])
+	assert.Equal(t, "0/200", insert["commitLsn"])
+	assert.Equal(t, 9.0, insert["xid"])
+	assert.Equal(t, "2000-01-01T01:00:00Z", insert["commitTime"])
+
+	update := rec.Events[1]
+	assert.Equal(t, "update", update["operation"])
+	assert.Equal(t, map[string]interface{}{"id": 1.0, "name": "alice", "data": nil}, update["before"])
+	assert.Equal(t, map[string]interface{}{"id": 1.0, "name": "bob", "data": nil}, update["after"])
+
+	del := rec.Events[2]
+	assert.Equal(t, "delete", del["operation"])
+	assert.Equal(t, map[string]interface{}{"id": 1.0, "name": nil, "data": nil}, del["before"])
+	assert.Nil(t, del["after"])
+}
+
+func TestReplicatorFilters(t *testing.T) {
+	r := newTestReplicator(t, v1alpha1.PostgresEventSource{Tables: []string{"users"}, Operations: []string{"DELETE"}})
+	rec := &eventsourcecommon.FakeDispatcher[map[string]interface{}]{}
+	err := handleAll(t, r, rec,
+		relationMsg(1, "public", "users"),
+		relationMsg(2, "audit", "users"),
+		beginMsg(0x200, 9),
+		newEncoder('I').uint32(1).uint8('N').tuple(str("1"), nil, nil).buf,
+		newEncoder('D').uint32(2).uint8('K').tuple(str("1"), nil, nil).buf,
+		newEncoder('D').uint32(1).uint8('K').tuple(str("2"), nil, nil).buf,
+		commitMsg(0x200, 0x210),
+	)
+	require.NoError(t, err)
+	require.Len(t, rec.Events, 1)
+	assert.Equal(t, "delete", rec.Events[0]["operation"])
+	assert.Equal(t, "public", rec.Events[0]["schema"])
+	assert.Equal(t, LSN(0x210), r.confirmed)
+}
+
+func TestReplicatorDispatchFailure(t *testing.T) {
+	r := newTestReplicator(t, v1alpha1.PostgresEventSource{})
+	rec := &eventsourcecommon.FakeDispatcher[map[string]interface{}]{Err: eventbuscommon.NewEventBusError(fmt.Errorf("failed"))}
+	err := handleAll(t, r, rec,
+		relationMsg(1, "public", "users"),
+		beginMsg(0x200, 9),
+		newEncoder('I').uint32(1).uint8('N').tuple(str("1"), nil, nil).buf,
+	)
+	assert.Error(t, err)
+	assert.True(t, eventbuscommon.IsEventBusError(err))
+	assert.Equal(t, LSN(0), r.confirmed)
+
+	// the changes failing for other reasons are not received again
+	r = newTestReplicator(t, v1alpha1.PostgresEventSource{})
+	rec = &eventsourcecommon.FakeDispatcher[map[string]interface{}]{Err: fmt.Errorf("failed")}
+	err = handleAll(t, r, rec,
+		relationMsg(1, "public", "users"),
+		beginMsg(0x200, 9),
+		newEncoder('I').uint32(1).uint8('N').tuple(str("1"), nil, nil).buf,
+		commitMsg(0x200, 0x210),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, LSN(0x210), r.confirmed)
+}
+
+func TestReplicatorUnknownRelation(t *testing.T) {
+	r := newTestReplicator(t, v1alpha1.PostgresEventSource{})
+	err := handleAll(t, r, &eventsourcecommon.FakeDispatcher[map[string]interface{}]{}, newEncoder('I').uint32(1).uint8('N').tuple(str("1")).buf)
+	assert.Error(t, err)
+}
+
+func TestDecodeValue(t *testing.T) {
+	assert.Equal(t, true, decodeValue(16, "t"))
+	assert.Equal(t, int64(42), decodeValue(20, "42"))
+	assert.Equal(t, json.Number("1.5"), decodeValue(1700, "1.5"))
+	assert.Equal(t, "NaN", decodeValue(701, "NaN"))
+	assert.Equal(t, json.RawMessage(`{"a":1}`), decodeValue(3802, `{"a":1}`))
+	assert.Equal(t, "2024-01-01 00:00:00+00", decodeValue(1184, "2024-01-01 00:00:00+00"))
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+	metrics "github.com/argoproj/argo-events/pkg/metrics"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+const (
+	defaultPort           = 5432
+	defaultStatusInterval = 10 * time.Second
+	// duplicateObjectCode is the error code of creating a replication slot which exists
+	duplicateObjectCode = "42710"
+)
+
+// EventListener implements Eventing for the PostgreSQL logical replication event source
+type EventListener struct {
+	EventSourceName     string
+	EventName           string
+	PostgresEventSource v1alpha1.PostgresEventSource
+	Metrics             *metrics.Metrics
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() v1alpha1.EventSourceType {
+	return v1alpha1.PostgresEvent
+}
+
+// StartListening starts consuming the changes from the replication slot
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	log := logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	defer sources.Recover(el.GetEventName())
+
+	log.Info("started processing the postgres event source...")
+	pgEventSource := &el.PostgresEventSource
+
+	config, err := connConfig(pgEventSource)
+	if err != nil {
+		return err
+	}
+
+	statusInterval := defaultStatusInterval
+	if pgEventSource.StatusInterval != "" {
+		if statusInterval, err = time.ParseDuration(pgEventSource.StatusInterval); err != nil {
+			return fmt.Errorf("failed to parse status interval %s, %w", pgEventSource.StatusInterval, err)
+		}
+	}
+
+	for {
+		var conn *pgconn.PgConn
+		log.Info("connecting to the postgres server...")
+		if err := sharedutil.DoWithRetry(pgEventSource.ConnectionBackoff, func() error {
+			var err error
+			conn, err = pgconn.ConnectConfig(ctx, config)
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to connect to the postgres server for event source %s, %w", el.GetEventName(), err)
+		}
+
+		if pgEventSource.CreateSlot {
+			if err := createSlot(ctx, conn, pgEventSource.Slot); err != nil {
+				_ = conn.Close(context.Background())
+				return fmt.Errorf("failed to create the replication slot %s for event source %s, %w", pgEventSource.Slot, el.GetEventName(), err)
+			}
+		}
+
+		log.Infow("starting the replication...", zap.String("slot", pgEventSource.Slot), zap.Strings("publications", pgEventSource.Publications))
+		if err := startReplication(ctx, conn, pgEventSource.Slot, pgEventSource.Publications); err != nil {
+			log.Errorw("failed to start the replication, reconnecting in 5 seconds...", zap.String("slot", pgEventSource.Slot), zap.Error(err))
+		} else if err := el.consume(ctx, conn, newReplicator(el, log), statusInterval, dispatch, log); err != nil {
+			// the changes after the confirmed position are streamed again after reconnecting
+			log.Errorw("replication is interrupted, reconnecting in 5 seconds...", zap.Error(err))
+		}
+		_ = conn.Close(context.Background())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+// consume receives the WAL data, and reports the confirmed position periodically,
+// the server only discards the WAL data before the confirmed position.
+func (el *EventListener) consume(ctx context.Context, conn *pgconn.PgConn, r *replicator, statusInterval time.Duration, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) error {
+	var reported LSN
+	sendStatus := func() error {
+		conn.Frontend().Send(&pgproto3.CopyData{Data: standbyStatusUpdate(r.confirmed, time.Now())})
+		if err := conn.Frontend().Flush(); err != nil {
+			return fmt.Errorf("failed to send the standby status update, %w", err)
+		}
+		if r.confirmed != reported {
+			log.Debugw("confirmed the position", zap.String("lsn", r.confirmed.String()))
+			reported = r.confirmed
+		}
+		return nil
+	}
+
+	nextStatus := time.Now().Add(statusInterval)
+	for {
+		if time.Now().After(nextStatus) {
+			if err := sendStatus(); err != nil {
+				return err
+			}
+			nextStatus = time.Now().Add(statusInterval)
+		}
+
+		recvCtx, cancel := context.WithDeadline(ctx, nextStatus)
+		rawMsg, err := conn.ReceiveMessage(recvCtx)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				log.Info("event source is stopped, reporting the confirmed position...")
+				return sendStatus()
+			}
+			if pgconn.Timeout(err) {
+				continue
+			}
+			return fmt.Errorf("failed to receive the replication message, %w", err)
+		}
+
+		switch msg := rawMsg.(type) {
+		case *pgproto3.ErrorResponse:
+			return fmt.Errorf("replication failed, %w", pgconn.ErrorResponseToPgError(msg))
+		case *pgproto3.CopyData:
+			if len(msg.Data) == 0 {
+				continue
+			}
+			switch msg.Data[0] {
+			case primaryKeepaliveMessageByteID:
+				k, err := parsePrimaryKeepalive(msg.Data[1:])
+				if err != nil {
+					return err
+				}
+				r.keepalive(k)
+				if k.replyRequested {
+					nextStatus = time.Time{}
+				}
+			case xLogDataByteID:
+				x, err := parseXLogData(msg.Data[1:])
+				if err != nil {
+					return err
+				}
+				if err := r.handle(x, dispatch); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// connConfig returns the configuration of a replication connection
+func connConfig(src *v1alpha1.PostgresEventSource) (*pgconn.Config, error) {
+	port := defaultPort
+	if src.Port > 0 {
+		port = int(src.Port)
+	}
+	connURL := url.URL{
+		Scheme:   "postgres",
+		Host:     net.JoinHostPort(src.Host, strconv.Itoa(port)),
+		Path:     "/" + src.Database,
+		RawQuery: url.Values{"replication": []string{"database"}, "sslmode": []string{"disable"}}.Encode(),
+	}
+	config, err := pgconn.ParseConfig(connURL.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the connection configuration, %w", err)
+	}
+	if src.Username != nil {
+		if config.User, err = sharedutil.GetSecretFromVolume(src.Username); err != nil {
+			return nil, fmt.Errorf("username not found, %w", err)
+		}
+	}
+	if src.Password != nil {
+		if config.Password, err = sharedutil.GetSecretFromVolume(src.Password); err != nil {
+			return nil, fmt.Errorf("password not found, %w", err)
+		}
+	}
+	if src.TLS != nil {
+		tlsConfig, err := sharedutil.GetTLSConfig(src.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+		}
+		if tlsConfig.ServerName == "" && !tlsConfig.InsecureSkipVerify {
+			tlsConfig.ServerName = src.Host
+		}
+		config.TLSConfig = tlsConfig
+	}
+	return config, nil
+}
+
+func createSlot(ctx context.Context, conn *pgconn.PgConn, slot string) error {
+	sql := fmt.Sprintf("CREATE_REPLICATION_SLOT %s LOGICAL pgoutput NOEXPORT_SNAPSHOT", quoteIdentifier(slot))
+	_, err := conn.Exec(ctx, sql).ReadAll()
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == duplicateObjectCode {
+		return nil
+	}
+	return err
+}
+
+// startReplication starts streaming the changes from the confirmed position of the slot
+func startReplication(ctx context.Context, conn *pgconn.PgConn, slot string, publications []string) error {
+	names := make([]string, 0, len(publications))
+	for _, publication := range publications {
+		names = append(names, quoteIdentifier(publication))
+	}
+	sql := fmt.Sprintf("START_REPLICATION SLOT %s LOGICAL 0/0 (proto_version '1', publication_names %s)",
+		quoteIdentifier(slot), quoteLiteral(strings.Join(names, ",")))
+	conn.Frontend().Send(&pgproto3.Query{String: sql})
+	if err := conn.Frontend().Flush(); err != nil {
+		return err
+	}
+	for {
+		msg, err := conn.ReceiveMessage(ctx)
+		if err != nil {
+			return err
+		}
+		switch msg := msg.(type) {
+		case *pgproto3.CopyBothResponse:
+			return nil
+		case *pgproto3.ErrorResponse:
+			return pgconn.ErrorResponseToPgError(msg)
+		case *pgproto3.NoticeResponse, *pgproto3.ParameterStatus:
+		default:
+			return fmt.Errorf("unexpected response %T", msg)
+		}
+	}
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+func quoteLiteral(s string) string {
+	return `'` + strings.ReplaceAll(s, `'`, `''`) + `'`
+}
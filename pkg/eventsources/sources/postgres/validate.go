@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+// slotNameRegex is the pattern of the replication slot names accepted by PostgreSQL
+var slotNameRegex = regexp.MustCompile(`^[a-z0-9_]{1,63}$`)
+
+// ValidateEventSource validates the postgres event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.PostgresEventSource)
+}
+
+func validate(eventSource *v1alpha1.PostgresEventSource) error {
+	if eventSource == nil {
+		return v1alpha1.ErrNilEventSource
+	}
+	if eventSource.Host == "" {
+		return fmt.Errorf("host must be specified")
+	}
+	if eventSource.Port < 0 || eventSource.Port > 65535 {
+		return fmt.Errorf("invalid port %d", eventSource.Port)
+	}
+	if eventSource.Database == "" {
+		return fmt.Errorf("database must be specified")
+	}
+	if eventSource.Username == nil {
+		return fmt.Errorf("username must be specified")
+	}
+	if !slotNameRegex.MatchString(eventSource.Slot) {
+		return fmt.Errorf("slot must be specified with lower case letters, numbers and underscores, up to 63 characters")
+	}
+	if len(eventSource.Publications) == 0 {
+		return fmt.Errorf("publications must be specified")
+	}
+	for _, publication := range eventSource.Publications {
+		if publication == "" {
+			return fmt.Errorf("publication name must not be empty")
+		}
+	}
+	for _, table := range eventSource.Tables {
+		if table == "" || strings.Count(table, ".") > 1 {
+			return fmt.Errorf("invalid table %q, must be in the form of \"schema.table\" or \"table\"", table)
+		}
+	}
+	for _, op := range eventSource.Operations {
+		switch strings.ToLower(op) {
+		case operationInsert, operationUpdate, operationDelete:
+		default:
+			return fmt.Errorf("invalid operation %q, must be one of insert, update and delete", op)
+		}
+	}
+	if eventSource.StatusInterval != "" {
+		if d, err := time.ParseDuration(eventSource.StatusInterval); err != nil || d <= 0 {
+			return fmt.Errorf("invalid status interval %s", eventSource.StatusInterval)
+		}
+	}
+	if eventSource.TLS != nil {
+		return v1alpha1.ValidateTLSConfig(eventSource.TLS)
+	}
+	return nil
+}
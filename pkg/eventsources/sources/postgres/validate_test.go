@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/sources"
+)
+
+func TestValidateEventSource(t *testing.T) {
+	listener := &EventListener{}
+
+	err := listener.ValidateEventSource(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, "host must be specified", err.Error())
+
+	content, err := os.ReadFile(fmt.Sprintf("%s/%s", sources.EventSourceDir, "postgres.yaml"))
+	assert.Nil(t, err)
+
+	var eventSource *v1alpha1.EventSource
+	err = yaml.Unmarshal(content, &eventSource)
+	assert.Nil(t, err)
+	assert.NotNil(t, eventSource.Spec.Postgres)
+
+	for name, value := range eventSource.Spec.Postgres {
+		fmt.Println(name)
+		l := &EventListener{
+			PostgresEventSource: value,
+		}
+		err := l.ValidateEventSource(context.Background())
+		assert.NoError(t, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	newSource := func() *v1alpha1.PostgresEventSource {
+		return &v1alpha1.PostgresEventSource{
+			Host:         "localhost",
+			Database:     "db",
+			Username:     &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s"}, Key: "u"},
+			Slot:         "slot_1",
+			Publications: []string{"pub"},
+		}
+	}
+	assert.NoError(t, validate(newSource()))
+
+	es := newSource()
+	es.Slot = "Slot-1"
+	assert.Error(t, validate(es))
+
+	es = newSource()
+	es.Publications = nil
+	assert.Error(t, validate(es))
+
+	es = newSource()
+	es.Tables = []string{"a.b.c"}
+	assert.Error(t, validate(es))
+
+	es = newSource()
+	es.Operations = []string{"truncate"}
+	assert.Error(t, validate(es))
+
+	es = newSource()
+	es.StatusInterval = "0s"
+	assert.Error(t, validate(es))
+}
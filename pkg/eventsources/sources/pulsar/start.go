@@ -25,7 +25,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/decoder"
 	"github.com/argoproj/argo-events/pkg/eventsources/events"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources"
 	metrics "github.com/argoproj/argo-events/pkg/metrics"
@@ -39,6 +41,9 @@ type EventListener struct {
 	EventName         string
 	PulsarEventSource v1alpha1.PulsarEventSource
 	Metrics           *metrics.Metrics
+
+	// payloadDecoder decodes the message payloads into JSON, if any
+	payloadDecoder decoder.Decoder
 }
 
 // GetEventSourceName returns name of event source
@@ -67,6 +72,14 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 	pulsarEventSource := &el.PulsarEventSource
 
+	if pulsarEventSource.Decoder != nil {
+		payloadDecoder, err := decoder.New(pulsarEventSource.Decoder)
+		if err != nil {
+			return fmt.Errorf("failed to create the decoder for event source %s, %w", el.GetEventName(), err)
+		}
+		el.payloadDecoder = payloadDecoder
+	}
+
 	subscriptionType := pulsar.Exclusive
 	if pulsarEventSource.Type == "shared" {
 		subscriptionType = pulsar.Shared
@@ -170,6 +183,11 @@ consumeMessages:
 			if err := el.handleOne(msg, dispatch, log); err != nil {
 				log.Errorw("failed to process a Pulsar event", zap.Error(err))
 				el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+				if eventbuscommon.IsEventBusError(err) {
+					// redeliver the message later
+					consumer.Nack(msg.Message)
+					continue
+				}
 			}
 
 			if err := consumer.Ack(msg.Message); err != nil {
@@ -199,7 +217,14 @@ func (el *EventListener) handleOne(msg pulsar.Message, dispatch func([]byte, ...
 		Body:        payload,
 		Metadata:    el.PulsarEventSource.Metadata,
 	}
-	if el.PulsarEventSource.JSONBody {
+	switch {
+	case el.payloadDecoder != nil:
+		decoded, err := el.payloadDecoder.Decode(payload, decoder.Metadata{Topic: msg.Topic(), SchemaVersion: msg.SchemaVersion()})
+		if err != nil {
+			return fmt.Errorf("failed to decode the message payload, %w", err)
+		}
+		eventData.Body = (*json.RawMessage)(&decoded)
+	case el.PulsarEventSource.JSONBody:
 		eventData.Body = (*json.RawMessage)(&payload)
 	}
 
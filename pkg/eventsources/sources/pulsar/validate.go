@@ -18,6 +18,8 @@ package pulsar
 import (
 	"context"
 	"fmt"
+
+	"github.com/argoproj/argo-events/pkg/eventsources/common/decoder"
 )
 
 func (el *EventListener) ValidateEventSource(ctx context.Context) error {
@@ -31,5 +33,8 @@ func (el *EventListener) ValidateEventSource(ctx context.Context) error {
 	if es.Type != "exclusive" && es.Type != "shared" {
 		return fmt.Errorf("subscription type must be either exclusive or shared")
 	}
+	if es.Decoder != nil {
+		return decoder.Validate(es.Decoder)
+	}
 	return nil
 }
@@ -26,6 +26,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventbuscommon "github.com/argoproj/argo-events/pkg/eventbus/common"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
 	"github.com/argoproj/argo-events/pkg/eventsources/events"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources"
@@ -168,14 +169,23 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 			msgsToAcknowledge = msgsToAcknowledge[:0]
 
+			redeliver := false
 			for _, message := range entry.Messages {
 				if err := el.handleOne(entry.Stream, message, dispatch, log); err != nil {
 					log.With("stream", entry.Stream, "message_id", message.ID).Errorw("failed to process Redis stream message", zap.Error(err))
 					el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+					if eventbuscommon.IsEventBusError(err) {
+						// Leave the rest of the messages unacknowledged, and read them again from the pending ones.
+						redeliver = true
+						break
+					}
 					continue
 				}
 				msgsToAcknowledge = append(msgsToAcknowledge, message.ID)
 			}
+			if redeliver {
+				streamToLastEntryMapping[entry.Stream] = "0-0"
+			}
 
 			if len(msgsToAcknowledge) == 0 {
 				continue
@@ -185,7 +195,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			if err := client.XAck(ctx, entry.Stream, consumersGroup, msgsToAcknowledge...).Err(); err != nil {
 				log.With("stream", entry.Stream, "message_ids", msgsToAcknowledge).Errorw("failed to acknowledge messages from the Redis stream", zap.Error(err))
 			}
-			if streamToLastEntryMapping[entry.Stream] != ">" {
+			if !redeliver && streamToLastEntryMapping[entry.Stream] != ">" {
 				streamToLastEntryMapping[entry.Stream] = msgsToAcknowledge[len(msgsToAcknowledge)-1]
 			}
 		}
@@ -23,11 +23,13 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
+	"gomodules.xyz/jsonpatch/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -51,6 +53,7 @@ type InformerEvent struct {
 	Obj    interface{}
 	OldObj interface{}
 	Type   v1alpha1.ResourceEventType
+	GVR    schema.GroupVersionResource
 }
 
 // EventListener implements Eventing
@@ -95,14 +98,14 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 	resourceEventSource := &el.ResourceEventSource
 
-	gvr := schema.GroupVersionResource{
-		Group:    resourceEventSource.Group,
-		Version:  resourceEventSource.Version,
-		Resource: resourceEventSource.Resource,
+	for _, eventType := range resourceEventSource.EventTypes {
+		switch eventType {
+		case v1alpha1.ADD, v1alpha1.UPDATE, v1alpha1.DELETE:
+		default:
+			return fmt.Errorf("unknown event type: %s", string(eventType))
+		}
 	}
 
-	client.Resource(gvr)
-
 	options := &metav1.ListOptions{}
 
 	log.Info("configuring label selectors if filters are selected...")
@@ -118,11 +121,31 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		*op = *options
 	}
 
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+
+	// namespaces holds the namespaces selected by the namespace selector
+	var namespaces cache.Store
+	if len(resourceEventSource.NamespaceSelector) > 0 {
+		sel, err := LabelSelector(resourceEventSource.NamespaceSelector)
+		if err != nil {
+			return fmt.Errorf("failed to create the namespace selector for the event source %s, %w", el.GetEventName(), err)
+		}
+		log.Infow("watching the namespaces selected...", zap.String("namespaceSelector", sel.String()))
+		nsFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, 0, "", func(op *metav1.ListOptions) {
+			op.LabelSelector = sel.String()
+		})
+		nsInformer := nsFactory.ForResource(namespacesGVR).Informer()
+		namespaces = nsInformer.GetStore()
+		nsFactory.Start(doneCh)
+		if !cache.WaitForCacheSync(doneCh, nsInformer.HasSynced) {
+			return fmt.Errorf("failed to sync the namespaces for the event source %s", el.GetEventName())
+		}
+	}
+
 	log.Info("setting up informer factory...")
 	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, 0, resourceEventSource.Namespace, tweakListOptions)
 
-	informer := factory.ForResource(gvr)
-
 	informerEventCh := make(chan *InformerEvent)
 	stopCh := make(chan struct{})
 	startTime := time.Now()
@@ -131,32 +154,17 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		if !passFilters(event, resourceEventSource.Filter, startTime, log) {
 			return nil
 		}
+		if !inNamespaces(event, namespaces) {
+			return nil
+		}
 
 		defer func(start time.Time) {
 			el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
 		}(time.Now())
 
-		objBody, err := json.Marshal(event.Obj)
+		eventData, err := newEventData(event, resourceEventSource.Metadata)
 		if err != nil {
-			return fmt.Errorf("failed to marshal the resource, rejecting the event, %w", err)
-		}
-
-		var oldObjBody []byte
-		if event.OldObj != nil {
-			oldObjBody, err = json.Marshal(event.OldObj)
-			if err != nil {
-				return fmt.Errorf("failed to marshal the resource, rejecting the event, %w", err)
-			}
-		}
-
-		eventData := &events.ResourceEventData{
-			EventType: string(event.Type),
-			Body:      (*json.RawMessage)(&objBody),
-			OldBody:   (*json.RawMessage)(&oldObjBody),
-			Group:     resourceEventSource.Group,
-			Version:   resourceEventSource.Version,
-			Resource:  resourceEventSource.Resource,
-			Metadata:  resourceEventSource.Metadata,
+			return err
 		}
 
 		eventBody, err := json.Marshal(eventData)
@@ -185,67 +193,135 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		}
 	}()
 
-	handlerFuncs := cache.ResourceEventHandlerFuncs{}
-
-	for _, eventType := range resourceEventSource.EventTypes {
-		switch eventType {
-		case v1alpha1.ADD:
-			handlerFuncs.AddFunc = func(obj interface{}) {
-				log.Info("detected create event")
-				informerEventCh <- &InformerEvent{
-					Obj:  obj,
-					Type: v1alpha1.ADD,
-				}
-			}
-		case v1alpha1.UPDATE:
-			handlerFuncs.UpdateFunc = func(oldObj, newObj interface{}) {
-				log.Info("detected update event")
-				uNewObj := newObj.(*unstructured.Unstructured)
-				uOldObj := oldObj.(*unstructured.Unstructured)
-				if uNewObj.GetResourceVersion() == uOldObj.GetResourceVersion() {
-					log.Infof("rejecting update event with identical resource versions: %s", uNewObj.GetResourceVersion())
-					return
+	for _, gvr := range resourceGVRs(resourceEventSource) {
+		handlerFuncs := cache.ResourceEventHandlerFuncs{}
+		for _, eventType := range resourceEventSource.EventTypes {
+			switch eventType {
+			case v1alpha1.ADD:
+				handlerFuncs.AddFunc = func(obj interface{}) {
+					log.Info("detected create event")
+					informerEventCh <- &InformerEvent{
+						Obj:  obj,
+						Type: v1alpha1.ADD,
+						GVR:  gvr,
+					}
 				}
-				informerEventCh <- &InformerEvent{
-					Obj:    newObj,
-					OldObj: oldObj,
-					Type:   v1alpha1.UPDATE,
+			case v1alpha1.UPDATE:
+				handlerFuncs.UpdateFunc = func(oldObj, newObj interface{}) {
+					log.Info("detected update event")
+					uNewObj := newObj.(*unstructured.Unstructured)
+					uOldObj := oldObj.(*unstructured.Unstructured)
+					if uNewObj.GetResourceVersion() == uOldObj.GetResourceVersion() {
+						log.Infof("rejecting update event with identical resource versions: %s", uNewObj.GetResourceVersion())
+						return
+					}
+					informerEventCh <- &InformerEvent{
+						Obj:    newObj,
+						OldObj: oldObj,
+						Type:   v1alpha1.UPDATE,
+						GVR:    gvr,
+					}
 				}
-			}
-		case v1alpha1.DELETE:
-			handlerFuncs.DeleteFunc = func(obj interface{}) {
-				log.Info("detected delete event")
-				informerEventCh <- &InformerEvent{
-					Obj:  obj,
-					Type: v1alpha1.DELETE,
+			case v1alpha1.DELETE:
+				handlerFuncs.DeleteFunc = func(obj interface{}) {
+					log.Info("detected delete event")
+					informerEventCh <- &InformerEvent{
+						Obj:  obj,
+						Type: v1alpha1.DELETE,
+						GVR:  gvr,
+					}
 				}
 			}
-		default:
-			stopCh <- struct{}{}
-			return fmt.Errorf("unknown event type: %s", string(eventType))
 		}
-	}
 
-	sharedInformer := informer.Informer()
-	if _, err := sharedInformer.AddEventHandler(handlerFuncs); err != nil {
-		return fmt.Errorf("failed to add event handler, %w", err)
+		log.Infow("watching the resource...", zap.String("gvr", gvr.String()))
+		if _, err := factory.ForResource(gvr).Informer().AddEventHandler(handlerFuncs); err != nil {
+			stopCh <- struct{}{}
+			return fmt.Errorf("failed to add event handler for %s, %w", gvr.String(), err)
+		}
 	}
 
-	doneCh := make(chan struct{})
-
-	log.Info("running informer...")
-	sharedInformer.Run(doneCh)
+	log.Info("running informers...")
+	factory.Start(doneCh)
 
 	<-ctx.Done()
-	doneCh <- struct{}{}
 	stopCh <- struct{}{}
 
 	log.Info("event source is stopped")
-	close(informerEventCh)
-
 	return nil
 }
 
+// namespacesGVR is the resource of the namespaces
+var namespacesGVR = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+// resourceGVRs returns the resources to watch
+func resourceGVRs(eventSource *v1alpha1.ResourceEventSource) []schema.GroupVersionResource {
+	var gvrs []schema.GroupVersionResource
+	if eventSource.Resource != "" {
+		gvrs = append(gvrs, schema.GroupVersionResource{
+			Group:    eventSource.Group,
+			Version:  eventSource.Version,
+			Resource: eventSource.Resource,
+		})
+	}
+	for _, r := range eventSource.Resources {
+		gvrs = append(gvrs, schema.GroupVersionResource{Group: r.Group, Version: r.Version, Resource: r.Resource})
+	}
+	return gvrs
+}
+
+// inNamespaces checks if the object is in one of the namespaces selected, namespaces is nil if
+// no namespace selector is specified.
+func inNamespaces(event *InformerEvent, namespaces cache.Store) bool {
+	if namespaces == nil {
+		return true
+	}
+	obj, ok := event.Obj.(metav1.Object)
+	if !ok || obj.GetNamespace() == "" {
+		return true
+	}
+	_, exists, err := namespaces.GetByKey(obj.GetNamespace())
+	return err == nil && exists
+}
+
+// newEventData returns the event data of an informer event, with the JSON patch from the old
+// object for an UPDATE event.
+func newEventData(event *InformerEvent, metadata map[string]string) (*events.ResourceEventData, error) {
+	objBody, err := json.Marshal(event.Obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the resource, rejecting the event, %w", err)
+	}
+
+	eventData := &events.ResourceEventData{
+		EventType: string(event.Type),
+		Body:      (*json.RawMessage)(&objBody),
+		Group:     event.GVR.Group,
+		Version:   event.GVR.Version,
+		Resource:  event.GVR.Resource,
+		Metadata:  metadata,
+	}
+
+	var oldObjBody []byte
+	if event.OldObj != nil {
+		oldObjBody, err = json.Marshal(event.OldObj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal the resource, rejecting the event, %w", err)
+		}
+		ops, err := jsonpatch.CreatePatch(oldObjBody, objBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute the patch of the resource, rejecting the event, %w", err)
+		}
+		sort.Sort(jsonpatch.ByPath(ops))
+		patch, err := json.Marshal(ops)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal the patch of the resource, rejecting the event, %w", err)
+		}
+		eventData.Patch = (*json.RawMessage)(&patch)
+	}
+	eventData.OldBody = (*json.RawMessage)(&oldObjBody)
+	return eventData, nil
+}
+
 // LabelReq returns label requirements
 func LabelReq(sel v1alpha1.Selector) (*labels.Requirement, error) {
 	op := selection.Equals
@@ -317,11 +393,55 @@ func passFilters(event *InformerEvent, filter *v1alpha1.ResourceFilter, startTim
 			return false
 		}
 
-		return filterFields(jsData, filter.Fields, log)
+		if !filterFields(jsData, filter.Fields, log) {
+			return false
+		}
+	}
+	if event.Type == v1alpha1.UPDATE && (len(filter.ChangedFields) > 0 || len(filter.Conditions) > 0) {
+		return filterChanges(event, filter, log)
 	}
 	return true
 }
 
+// filterChanges checks if any of the fields changed, or any of the conditions transitioned in an update
+func filterChanges(event *InformerEvent, filter *v1alpha1.ResourceFilter, log *zap.SugaredLogger) bool {
+	newData, err := json.Marshal(event.Obj)
+	if err != nil {
+		log.Errorw("failed to marshal informer event", zap.Error(err))
+		return false
+	}
+	oldData, err := json.Marshal(event.OldObj)
+	if err != nil {
+		log.Errorw("failed to marshal informer event", zap.Error(err))
+		return false
+	}
+	for _, field := range filter.ChangedFields {
+		if gjson.GetBytes(oldData, field).Raw != gjson.GetBytes(newData, field).Raw {
+			return true
+		}
+	}
+	for _, condition := range filter.Conditions {
+		oldStatus := conditionStatus(oldData, condition.Type)
+		newStatus := conditionStatus(newData, condition.Type)
+		if oldStatus != newStatus && (condition.Status == "" || condition.Status == newStatus) {
+			return true
+		}
+	}
+	log.Debug("no watched field changed or condition transitioned")
+	return false
+}
+
+// conditionStatus returns the status of the condition of the type in "status.conditions", or an empty
+// string if there is no such condition
+func conditionStatus(data []byte, conditionType string) string {
+	for _, c := range gjson.GetBytes(data, "status.conditions").Array() {
+		if c.Get("type").String() == conditionType {
+			return c.Get("status").String()
+		}
+	}
+	return ""
+}
+
 func filterFields(jsonData []byte, selectors []v1alpha1.Selector, log *zap.SugaredLogger) bool {
 	for _, selector := range selectors {
 		res := gjson.GetBytes(jsonData, selector.Key)
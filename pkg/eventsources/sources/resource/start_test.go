@@ -24,11 +24,15 @@ import (
 	"time"
 
 	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/shared/logging"
@@ -277,3 +281,89 @@ func TestLabelSelector(t *testing.T) {
 		}
 	})
 }
+
+func newUnstructuredPod(phase string, conditions ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":            "fake",
+			"namespace":       "fake",
+			"resourceVersion": phase,
+		},
+		"status": map[string]interface{}{
+			"phase":      phase,
+			"conditions": conditions,
+		},
+	}}
+}
+
+func readyCondition(status string) interface{} {
+	return map[string]interface{}{"type": "Ready", "status": status}
+}
+
+func TestFilterChanges(t *testing.T) {
+	logger := logging.NewArgoEventsLogger()
+	update := func(oldObj, newObj *unstructured.Unstructured) *InformerEvent {
+		return &InformerEvent{Obj: newObj, OldObj: oldObj, Type: v1alpha1.UPDATE}
+	}
+
+	t.Run("changed fields", func(t *testing.T) {
+		filter := &v1alpha1.ResourceFilter{ChangedFields: []string{"status.phase"}}
+		assert.True(t, passFilters(update(newUnstructuredPod("Pending"), newUnstructuredPod("Running")), filter, time.Now(), logger))
+
+		oldObj, newObj := newUnstructuredPod("Running"), newUnstructuredPod("Running")
+		newObj.SetAnnotations(map[string]string{"a": "b"})
+		assert.False(t, passFilters(update(oldObj, newObj), filter, time.Now(), logger))
+
+		// the filter does not apply to the other events
+		assert.True(t, passFilters(&InformerEvent{Obj: newObj, Type: v1alpha1.ADD}, filter, time.Now(), logger))
+	})
+
+	t.Run("condition transitions", func(t *testing.T) {
+		filter := &v1alpha1.ResourceFilter{Conditions: []v1alpha1.ResourceConditionFilter{{Type: "Ready", Status: "False"}}}
+		assert.True(t, passFilters(update(newUnstructuredPod("Running", readyCondition("True")), newUnstructuredPod("Running", readyCondition("False"))), filter, time.Now(), logger))
+		assert.False(t, passFilters(update(newUnstructuredPod("Running", readyCondition("False")), newUnstructuredPod("Running", readyCondition("True"))), filter, time.Now(), logger))
+		assert.False(t, passFilters(update(newUnstructuredPod("Running", readyCondition("False")), newUnstructuredPod("Failed", readyCondition("False"))), filter, time.Now(), logger))
+
+		filter.Conditions[0].Status = ""
+		assert.True(t, passFilters(update(newUnstructuredPod("Running"), newUnstructuredPod("Running", readyCondition("True"))), filter, time.Now(), logger))
+	})
+}
+
+func TestNewEventData(t *testing.T) {
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	data, err := newEventData(&InformerEvent{
+		Obj:    newUnstructuredPod("Running"),
+		OldObj: newUnstructuredPod("Pending"),
+		Type:   v1alpha1.UPDATE,
+		GVR:    gvr,
+	}, map[string]string{"a": "b"})
+	require.NoError(t, err)
+	assert.Equal(t, "v1", data.Version)
+	assert.Equal(t, "pods", data.Resource)
+	assert.JSONEq(t, `[
+		{"op":"replace","path":"/metadata/resourceVersion","value":"Running"},
+		{"op":"replace","path":"/status/phase","value":"Running"}
+	]`, string(*data.Patch))
+
+	data, err = newEventData(&InformerEvent{Obj: newUnstructuredPod("Running"), Type: v1alpha1.ADD, GVR: gvr}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, data.Patch)
+}
+
+func TestInNamespaces(t *testing.T) {
+	assert.True(t, inNamespaces(&InformerEvent{Obj: newUnstructuredPod("Running")}, nil))
+
+	namespaces := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	assert.False(t, inNamespaces(&InformerEvent{Obj: newUnstructuredPod("Running")}, namespaces))
+
+	ns := &unstructured.Unstructured{}
+	ns.SetName("fake")
+	require.NoError(t, namespaces.Add(ns))
+	assert.True(t, inNamespaces(&InformerEvent{Obj: newUnstructuredPod("Running")}, namespaces))
+
+	node := &unstructured.Unstructured{}
+	node.SetName("node")
+	assert.True(t, inNamespaces(&InformerEvent{Obj: node}, namespaces))
+}
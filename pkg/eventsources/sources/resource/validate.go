@@ -34,11 +34,26 @@ func validate(eventSource *v1alpha1.ResourceEventSource) error {
 	if eventSource == nil {
 		return v1alpha1.ErrNilEventSource
 	}
-	if eventSource.Version == "" {
-		return fmt.Errorf("version must be specified")
+	if len(eventSource.Resources) == 0 || eventSource.Version != "" || eventSource.Resource != "" {
+		if eventSource.Version == "" {
+			return fmt.Errorf("version must be specified")
+		}
+		if eventSource.Resource == "" {
+			return fmt.Errorf("resource must be specified")
+		}
+	}
+	for _, r := range eventSource.Resources {
+		if r.Version == "" || r.Resource == "" {
+			return fmt.Errorf("version and resource must be specified for resources, %s", r.String())
+		}
 	}
-	if eventSource.Resource == "" {
-		return fmt.Errorf("resource must be specified")
+	if len(eventSource.NamespaceSelector) > 0 {
+		if eventSource.Namespace != "" {
+			return fmt.Errorf("namespace selector can only be specified when namespace is not specified")
+		}
+		if err := validateSelectors(eventSource.NamespaceSelector); err != nil {
+			return err
+		}
 	}
 	if eventSource.EventTypes == nil {
 		return fmt.Errorf("event types must be specified")
@@ -54,6 +69,21 @@ func validate(eventSource *v1alpha1.ResourceEventSource) error {
 				return err
 			}
 		}
+		for _, field := range eventSource.Filter.ChangedFields {
+			if field == "" {
+				return fmt.Errorf("changed field can't be empty")
+			}
+		}
+		for _, condition := range eventSource.Filter.Conditions {
+			if condition.Type == "" {
+				return fmt.Errorf("type must be specified for condition")
+			}
+			switch condition.Status {
+			case "", "True", "False", "Unknown":
+			default:
+				return fmt.Errorf("invalid condition status %q, must be one of True, False and Unknown", condition.Status)
+			}
+		}
 	}
 	return nil
 }
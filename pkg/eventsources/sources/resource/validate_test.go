@@ -24,6 +24,7 @@ import (
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/eventsources/sources"
@@ -53,3 +54,30 @@ func TestValidateEventSource(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestValidateResources(t *testing.T) {
+	es := &v1alpha1.ResourceEventSource{
+		Resources: []metav1.GroupVersionResource{
+			{Version: "v1", Resource: "pods"},
+			{Group: "apps", Version: "v1", Resource: "deployments"},
+		},
+		EventTypes:        []v1alpha1.ResourceEventType{v1alpha1.UPDATE},
+		NamespaceSelector: []v1alpha1.Selector{{Key: "team", Value: "payments"}},
+		Filter: &v1alpha1.ResourceFilter{
+			ChangedFields: []string{"status.phase"},
+			Conditions:    []v1alpha1.ResourceConditionFilter{{Type: "Ready", Status: "False"}},
+		},
+	}
+	assert.NoError(t, validate(es))
+
+	es.Resources = append(es.Resources, metav1.GroupVersionResource{Resource: "services"})
+	assert.Error(t, validate(es))
+	es.Resources = es.Resources[:2]
+
+	es.Namespace = "argo-events"
+	assert.Error(t, validate(es))
+	es.Namespace = ""
+
+	es.Filter.Conditions[0].Status = "false"
+	assert.Error(t, validate(es))
+}
@@ -186,7 +186,7 @@ func (rc *Router) HandleRoute(writer http.ResponseWriter, request *http.Request)
 	}
 
 	if data != nil {
-		webhook.DispatchEvent(route, data, logger, writer)
+		webhook.DispatchEvent(request.Context(), route, data, logger, writer)
 	} else {
 		logger.Debug("request successfully processed")
 		sharedutil.SendSuccessResponse(writer, "success")
@@ -199,7 +199,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
 			return
 		}
-		webhook.DispatchEvent(route, eventBody, logger, writer)
+		webhook.DispatchEvent(request.Context(), route, eventBody, logger, writer)
 		return
 	}
 
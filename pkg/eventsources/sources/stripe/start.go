@@ -132,7 +132,7 @@ func (rc *Router) HandleRoute(writer http.ResponseWriter, request *http.Request)
 		return
 	}
 
-	webhook.DispatchEvent(route, data, logger, writer)
+	webhook.DispatchEvent(request.Context(), route, data, logger, writer)
 }
 
 // PostActivate performs operations once the route is activated and ready to consume requests
@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/google/uuid"
+	minio "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
+)
+
+// objectStore stores the file parts of the multipart requests
+type objectStore interface {
+	// put stores the content under the key, and returns its size and its URL
+	put(ctx context.Context, key string, content io.Reader, contentType string) (int64, string, error)
+	// location returns the bucket and the full key of the object of the key
+	location(key string) (string, string)
+}
+
+// s3Store stores the objects in an S3 bucket, under the bucket key as prefix
+type s3Store struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+// newS3Store returns the store of the S3 artifact
+func newS3Store(s3 *v1alpha1.S3Artifact) (*s3Store, error) {
+	accessKey, err := sharedutil.GetSecretFromVolume(s3.AccessKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the access key, %w", err)
+	}
+	secretKey, err := sharedutil.GetSecretFromVolume(s3.SecretKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the secret key, %w", err)
+	}
+	opts := &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: !s3.Insecure,
+		Region: s3.Region,
+	}
+	if s3.CACertificate != nil {
+		caCertificate, err := sharedutil.GetSecretFromVolume(s3.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the CA certificate, %w", err)
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM([]byte(caCertificate))
+		opts.Transport = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: caCertPool}}
+	}
+	client, err := minio.New(s3.Endpoint, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the S3 client, %w", err)
+	}
+	return &s3Store{client: client, bucket: s3.Bucket.Name, prefix: s3.Bucket.Key}, nil
+}
+
+func (s *s3Store) location(key string) (string, string) {
+	return s.bucket, path.Join(s.prefix, key)
+}
+
+func (s *s3Store) put(ctx context.Context, key string, content io.Reader, contentType string) (int64, string, error) {
+	bucket, key := s.location(key)
+	info, err := s.client.PutObject(ctx, bucket, key, content, -1, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return 0, "", err
+	}
+	return info.Size, s.client.EndpointURL().JoinPath(bucket, key).String(), nil
+}
+
+// handleSplit handles a request whose body is split into the bodies of several events
+func (router *Router) handleSplit(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger) {
+	route := router.GetRoute()
+	maxSize := route.Context.GetMaxPayloadSize()
+
+	// the body is hashed as it is read, the events of a retried request have the same ids
+	hash := sha256.New()
+	request.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.TeeReader(request.Body, hash), request.Body}
+
+	var bodies []json.RawMessage
+	var err error
+	switch {
+	case router.split.Multipart != nil:
+		// the files streamed to the store are limited by the max file size instead
+		if router.store == nil {
+			request.Body = http.MaxBytesReader(writer, request.Body, maxSize)
+		}
+		bodies, err = splitMultipart(request.Context(), request, router.store, maxSize, router.split.Multipart.GetMaxFileSize())
+	case router.split.NDJSON:
+		request.Body = http.MaxBytesReader(writer, request.Body, maxSize)
+		bodies, err = splitNDJSON(request.Body, maxSize)
+	default:
+		var body *json.RawMessage
+		if body, err = GetBody(&writer, request, route, logger); err != nil {
+			break
+		}
+		bodies, err = splitJSON(*body, router.split.JSONPath)
+	}
+	if err != nil {
+		logger.Errorw("failed to split the request body", zap.Error(err))
+		sharedutil.SendErrorResponse(writer, err.Error())
+		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+		return
+	}
+
+	base := hex.EncodeToString(hash.Sum(nil))
+	dispatches := make([]*webhook.Dispatch, 0, len(bodies))
+	for i, body := range bodies {
+		data, err := json.Marshal(&events.WebhookEventData{
+			Header:   request.Header,
+			Body:     &body,
+			Metadata: route.Context.Metadata,
+		})
+		if err != nil {
+			logger.Errorw("failed to construct the event payload", zap.Error(err))
+			sharedutil.SendErrorResponse(writer, err.Error())
+			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+			return
+		}
+		dispatch := &webhook.Dispatch{Data: data}
+		if !router.split.BestEffort {
+			dispatch.Options = []eventsourcecommon.Option{eventsourcecommon.WithID(fmt.Sprintf("%s:%d", base, i))}
+		}
+		dispatches = append(dispatches, dispatch)
+	}
+	logger.Infow("split the request body", "events", len(dispatches))
+	if router.split.BestEffort {
+		webhook.DispatchEventsBestEffort(request.Context(), route, dispatches, logger, writer)
+		return
+	}
+	webhook.DispatchEvents(request.Context(), route, dispatches, logger, writer)
+}
+
+// splitJSON returns the elements of the array at the path of the JSON body
+func splitJSON(body []byte, jsonPath string) ([]json.RawMessage, error) {
+	if !gjson.ValidBytes(body) {
+		return nil, fmt.Errorf("the body is not valid JSON")
+	}
+	result := gjson.GetBytes(body, jsonPath)
+	if !result.IsArray() {
+		return nil, fmt.Errorf("no array at %s in the body", jsonPath)
+	}
+	elements := result.Array()
+	bodies := make([]json.RawMessage, 0, len(elements))
+	for _, e := range elements {
+		bodies = append(bodies, json.RawMessage(e.Raw))
+	}
+	return bodies, nil
+}
+
+// splitNDJSON returns the JSON lines of a newline delimited JSON body, skipping the empty lines
+func splitNDJSON(body io.Reader, maxSize int64) ([]json.RawMessage, error) {
+	var bodies []json.RawMessage
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), int(maxSize))
+	for line := 1; scanner.Scan(); line++ {
+		b := bytes.TrimSpace(scanner.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+		if !json.Valid(b) {
+			return nil, fmt.Errorf("line %d of the body is not valid JSON", line)
+		}
+		bodies = append(bodies, json.RawMessage(bytes.Clone(b)))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the request body, %w", err)
+	}
+	return bodies, nil
+}
+
+// splitMultipart returns the bodies of the parts of a multipart/form-data body, which are limited to
+// maxSize in total. The file parts are streamed to the store if there is one, each limited to maxFileSize.
+func splitMultipart(ctx context.Context, request *http.Request, store objectStore, maxSize, maxFileSize int64) ([]json.RawMessage, error) {
+	reader, err := request.MultipartReader()
+	if err != nil {
+		return nil, fmt.Errorf("invalid multipart request, %w", err)
+	}
+	var bodies []json.RawMessage
+	remaining := maxSize
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return bodies, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the part %d, %w", len(bodies), err)
+		}
+		data := &events.WebhookPartData{
+			Name:        part.FormName(),
+			FileName:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+		}
+		switch {
+		case data.FileName == "":
+			value, err := io.ReadAll(&limitedReader{r: part, n: remaining})
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the part %s, %w", data.Name, err)
+			}
+			data.Value = string(value)
+		case store == nil:
+			if data.Content, err = io.ReadAll(&limitedReader{r: part, n: remaining}); err != nil {
+				return nil, fmt.Errorf("failed to read the file %s, %w", data.FileName, err)
+			}
+			data.Size = int64(len(data.Content))
+		default:
+			uuidNew := uuid.New()
+			key := path.Join(fmt.Sprintf("%x", uuidNew[:]), path.Base(data.FileName))
+			data.Bucket, data.Key = store.location(key)
+			if data.Size, data.URL, err = store.put(ctx, key, &limitedReader{r: part, n: maxFileSize}, data.ContentType); err != nil {
+				return nil, fmt.Errorf("failed to store the file %s, %w", data.FileName, err)
+			}
+		}
+		body, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		if remaining -= int64(len(body)); remaining < 0 {
+			return nil, fmt.Errorf("the parts are larger than %d bytes", maxSize)
+		}
+		bodies = append(bodies, body)
+	}
+}
+
+// limitedReader reads from r, and fails once more than n bytes are read
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	if l.n -= int64(n); l.n < 0 {
+		return n, fmt.Errorf("larger than the limit")
+	}
+	return n, err
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"slices"
+	"strings"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/pkg/eventsources/events"
+)
+
+// dispatchID returns the id set by the options of the dispatch
+func dispatchID(d *webhook.Dispatch) string {
+	e := cloudevents.NewEvent()
+	for _, opt := range d.Options {
+		_ = opt(&e)
+	}
+	return e.ID()
+}
+
+type fakeStore struct {
+	objects map[string]string
+}
+
+func (s *fakeStore) location(key string) (string, string) {
+	return "uploads", path.Join("webhook", key)
+}
+
+func (s *fakeStore) put(ctx context.Context, key string, content io.Reader, contentType string) (int64, string, error) {
+	b, err := io.ReadAll(content)
+	if err != nil {
+		return 0, "", err
+	}
+	_, key = s.location(key)
+	s.objects[key] = string(b)
+	return int64(len(b)), "https://s3.example.com/uploads/" + key, nil
+}
+
+func TestSplitJSON(t *testing.T) {
+	bodies, err := splitJSON([]byte(`{"batch":[{"n":1},{"n":2}]}`), "batch")
+	require.NoError(t, err)
+	assert.Equal(t, []json.RawMessage{json.RawMessage(`{"n":1}`), json.RawMessage(`{"n":2}`)}, bodies)
+
+	bodies, err = splitJSON([]byte(`[1, "two"]`), "@this")
+	require.NoError(t, err)
+	assert.Equal(t, []json.RawMessage{json.RawMessage(`1`), json.RawMessage(`"two"`)}, bodies)
+
+	_, err = splitJSON([]byte(`{"batch":{}}`), "batch")
+	assert.ErrorContains(t, err, "no array at batch")
+	_, err = splitJSON([]byte(`{"batch":`), "batch")
+	assert.ErrorContains(t, err, "not valid JSON")
+}
+
+func TestSplitNDJSON(t *testing.T) {
+	bodies, err := splitNDJSON(strings.NewReader("{\"n\":1}\r\n\n  {\"n\":2}\n"), 1024)
+	require.NoError(t, err)
+	assert.Equal(t, []json.RawMessage{json.RawMessage(`{"n":1}`), json.RawMessage(`{"n":2}`)}, bodies)
+
+	_, err = splitNDJSON(strings.NewReader("{\"n\":1}\n{\"n\":\n"), 1024)
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func newMultipartRequest(t *testing.T) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	require.NoError(t, w.WriteField("subject", "hello"))
+	file, err := w.CreateFormFile("attachment", "../report.csv")
+	require.NoError(t, err)
+	_, err = file.Write([]byte("a,b\n1,2\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	request := httptest.NewRequest(http.MethodPost, "/fake", &body)
+	request.Header.Set("Content-Type", w.FormDataContentType())
+	return request
+}
+
+func TestSplitMultipart(t *testing.T) {
+	t.Run("file parts in the events", func(t *testing.T) {
+		bodies, err := splitMultipart(context.Background(), newMultipartRequest(t), nil, 1024, 1024)
+		require.NoError(t, err)
+		require.Len(t, bodies, 2)
+
+		var field, file events.WebhookPartData
+		require.NoError(t, json.Unmarshal(bodies[0], &field))
+		require.NoError(t, json.Unmarshal(bodies[1], &file))
+		assert.Equal(t, events.WebhookPartData{Name: "subject", Value: "hello"}, field)
+		assert.Equal(t, "attachment", file.Name)
+		assert.Equal(t, "a,b\n1,2\n", string(file.Content))
+		assert.Equal(t, int64(8), file.Size)
+	})
+
+	t.Run("file parts in the store", func(t *testing.T) {
+		store := &fakeStore{objects: map[string]string{}}
+		bodies, err := splitMultipart(context.Background(), newMultipartRequest(t), store, 1024, 1024)
+		require.NoError(t, err)
+		require.Len(t, bodies, 2)
+
+		var file events.WebhookPartData
+		require.NoError(t, json.Unmarshal(bodies[1], &file))
+		assert.Empty(t, file.Content)
+		assert.Equal(t, "uploads", file.Bucket)
+		assert.True(t, strings.HasPrefix(file.Key, "webhook/"))
+		assert.True(t, strings.HasSuffix(file.Key, "/report.csv"))
+		assert.Equal(t, "https://s3.example.com/uploads/"+file.Key, file.URL)
+		assert.Equal(t, "a,b\n1,2\n", store.objects[file.Key])
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		// the parts are 8 bytes long, their events are larger
+		_, err := splitMultipart(context.Background(), newMultipartRequest(t), nil, 64, 1024)
+		assert.ErrorContains(t, err, "larger than 64 bytes")
+		_, err = splitMultipart(context.Background(), newMultipartRequest(t), nil, 4, 1024)
+		assert.ErrorContains(t, err, "failed to read the part subject, larger than the limit")
+
+		// the files streamed to the store are only limited by the max file size
+		store := &fakeStore{objects: map[string]string{}}
+		_, err = splitMultipart(context.Background(), newMultipartRequest(t), store, 512, 8)
+		assert.NoError(t, err)
+		_, err = splitMultipart(context.Background(), newMultipartRequest(t), store, 512, 7)
+		assert.ErrorContains(t, err, "failed to store the file report.csv, larger than the limit")
+	})
+
+	t.Run("not a multipart request", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader("{}"))
+		request.Header.Set("Content-Type", "application/json")
+		_, err := splitMultipart(context.Background(), request, nil, 1024, 1024)
+		assert.ErrorContains(t, err, "invalid multipart request")
+	})
+}
+
+func TestHandleSplit(t *testing.T) {
+	newRouter := func(split *v1alpha1.WebhookSplit) *Router {
+		router := &Router{route: webhook.GetFakeRoute(), split: split}
+		router.route.Active = true
+		router.route.Context.Method = http.MethodPost
+		return router
+	}
+	// receive dispatches the events, failing the ones at the given indexes
+	receive := func(router *Router, n int, failed ...int) chan *webhook.Dispatch {
+		out := make(chan *webhook.Dispatch, n)
+		go func() {
+			for i := 0; i < n; i++ {
+				temp := <-router.route.DispatchChan
+				temp.SuccessChan <- !slices.Contains(failed, i)
+				out <- temp
+			}
+		}()
+		return out
+	}
+	request := func() *http.Request {
+		request := httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader(`{"events":[{"n":1},{"n":2},{"n":3}]}`))
+		request.Header.Set("Content-Type", "application/json")
+		return request
+	}
+
+	t.Run("all or nothing", func(t *testing.T) {
+		router := newRouter(&v1alpha1.WebhookSplit{JSONPath: "events"})
+		out := receive(router, 2, 1)
+		writer := &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, request())
+		assert.Equal(t, http.StatusInternalServerError, writer.HeaderStatus)
+		first, second := <-out, <-out
+		assert.Contains(t, string(first.Data), `"body":{"n":1}`)
+		assert.Contains(t, string(second.Data), `"body":{"n":2}`)
+
+		// the retried request has the same ids
+		out = receive(router, 3)
+		router.HandleRoute(writer, request())
+		assert.Equal(t, http.StatusOK, writer.HeaderStatus)
+		sum := sha256.Sum256([]byte(`{"events":[{"n":1},{"n":2},{"n":3}]}`))
+		for i, previous := range []*webhook.Dispatch{first, second, nil} {
+			d := <-out
+			assert.Equal(t, fmt.Sprintf("%s:%d", hex.EncodeToString(sum[:]), i), dispatchID(d))
+			if previous != nil {
+				assert.Equal(t, dispatchID(previous), dispatchID(d))
+			}
+		}
+	})
+
+	t.Run("best effort", func(t *testing.T) {
+		router := newRouter(&v1alpha1.WebhookSplit{JSONPath: "events", BestEffort: true})
+		out := receive(router, 3, 1)
+		writer := &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, request())
+		assert.Equal(t, http.StatusOK, writer.HeaderStatus)
+		<-out
+		<-out
+		assert.Contains(t, string((<-out).Data), `"body":{"n":3}`)
+	})
+
+	t.Run("best effort with all the events failed", func(t *testing.T) {
+		router := newRouter(&v1alpha1.WebhookSplit{JSONPath: "events", BestEffort: true})
+		receive(router, 3, 0, 1, 2)
+		writer := &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, request())
+		assert.Equal(t, http.StatusInternalServerError, writer.HeaderStatus)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		router := newRouter(&v1alpha1.WebhookSplit{NDJSON: true})
+		writer := &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader("{")))
+		assert.Equal(t, http.StatusBadRequest, writer.HeaderStatus)
+	})
+}
@@ -25,6 +25,9 @@ import (
 	"net/http"
 	"time"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
 	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
@@ -70,6 +73,12 @@ func (el *EventListener) GetEventSourceType() v1alpha1.EventSourceType {
 type Router struct {
 	// route contains information about a API endpoint
 	route *webhook.Route
+	// cloudEvents accepts CloudEvents and publishes them as they are
+	cloudEvents bool
+	// split splits the request bodies into the bodies of several events
+	split *v1alpha1.WebhookSplit
+	// store stores the file parts of the multipart requests
+	store objectStore
 }
 
 // Implement Router
@@ -111,6 +120,16 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 		route.Metrics.EventProcessingDuration(route.EventSourceName, route.EventName, float64(time.Since(start)/time.Millisecond))
 	}(time.Now())
 
+	if router.cloudEvents {
+		router.handleCloudEvents(writer, request, logger)
+		return
+	}
+
+	if router.split != nil {
+		router.handleSplit(writer, request, logger)
+		return
+	}
+
 	body, err := GetBody(&writer, request, route, logger)
 	if err != nil {
 		logger.Errorw("failed to get body", zap.Error(err))
@@ -133,7 +152,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 		return
 	}
 
-	webhook.DispatchEvent(route, data, logger, writer)
+	webhook.DispatchEvent(request.Context(), route, data, logger, writer)
 }
 
 // PostActivate performs operations once the route is activated and ready to consume requests
@@ -153,9 +172,61 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	log.Info("started processing the webhook event source...")
 
 	route := webhook.NewRoute(&el.Webhook.WebhookContext, log, el.GetEventSourceName(), el.GetEventName(), el.Metrics)
-	return webhook.ManageRoute(ctx, &Router{
-		route: route,
-	}, controller, dispatch)
+	router := &Router{
+		route:       route,
+		cloudEvents: el.Webhook.CloudEvents,
+		split:       el.Webhook.Split,
+	}
+	if split := el.Webhook.Split; split != nil && split.Multipart != nil && split.Multipart.S3 != nil {
+		store, err := newS3Store(split.Multipart.S3)
+		if err != nil {
+			return fmt.Errorf("failed to set up the store of the file parts for event source %s, %w", el.GetEventName(), err)
+		}
+		router.store = store
+	}
+	return webhook.ManageRoute(ctx, router, controller, dispatch)
+}
+
+// handleCloudEvents handles a request carrying CloudEvents in the binary, structured or batched
+// HTTP mode, the events are published as they are.
+func (router *Router) handleCloudEvents(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger) {
+	route := router.GetRoute()
+	request.Body = http.MaxBytesReader(writer, request.Body, route.Context.GetMaxPayloadSize())
+
+	var cloudEvents []cloudevents.Event
+	if cehttp.IsHTTPBatch(request.Header) {
+		var err error
+		if cloudEvents, err = cehttp.NewEventsFromHTTPRequest(request); err != nil {
+			logger.Errorw("failed to read the batch of cloudevents", zap.Error(err))
+			sharedutil.SendErrorResponse(writer, fmt.Sprintf("invalid cloudevents batch, %v", err))
+			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+			return
+		}
+	} else {
+		e, err := cehttp.NewEventFromHTTPRequest(request)
+		if err != nil {
+			logger.Errorw("failed to read the cloudevent", zap.Error(err))
+			sharedutil.SendErrorResponse(writer, fmt.Sprintf("invalid cloudevent, %v", err))
+			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+			return
+		}
+		cloudEvents = append(cloudEvents, *e)
+	}
+
+	dispatches := make([]*webhook.Dispatch, 0, len(cloudEvents))
+	for _, e := range cloudEvents {
+		if err := e.Validate(); err != nil {
+			logger.Errorw("invalid cloudevent", zap.String("id", e.ID()), zap.Error(err))
+			sharedutil.SendErrorResponse(writer, fmt.Sprintf("invalid cloudevent %q, %v", e.ID(), err))
+			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+			return
+		}
+		dispatches = append(dispatches, &webhook.Dispatch{
+			Data:    e.Data(),
+			Options: []eventsourcecommon.Option{eventsourcecommon.WithCloudEvent(e)},
+		})
+	}
+	webhook.DispatchEvents(request.Context(), route, dispatches, logger, writer)
 }
 
 func GetBody(writer *http.ResponseWriter, request *http.Request, route *webhook.Route, logger *zap.SugaredLogger) (*json.RawMessage, error) {
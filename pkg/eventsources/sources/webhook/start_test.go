@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2/event"
 	"github.com/smartystreets/goconvey/convey"
 
+	eventsourcecommon "github.com/argoproj/argo-events/pkg/eventsources/common"
 	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
 )
 
@@ -118,3 +121,95 @@ func TestHandleRoute(t *testing.T) {
 		})
 	})
 }
+
+func TestHandleCloudEvents(t *testing.T) {
+	convey.Convey("Given a route that accepts cloudevents", t, func() {
+		router := &Router{
+			route:       webhook.GetFakeRoute(),
+			cloudEvents: true,
+		}
+		router.route.Active = true
+		router.route.Context.Method = http.MethodPost
+
+		// receive dispatches the given number of events, and returns them with the options applied
+		receive := func(n int) chan cloudevents.Event {
+			out := make(chan cloudevents.Event, n)
+			go func() {
+				for i := 0; i < n; i++ {
+					temp := <-router.route.DispatchChan
+					e := cloudevents.New()
+					for _, opt := range temp.Options {
+						_ = opt(&e)
+					}
+					_ = e.SetData(e.DataContentType(), temp.Data)
+					temp.SuccessChan <- true
+					out <- e
+				}
+			}()
+			return out
+		}
+
+		convey.Convey("Test binary mode", func() {
+			writer := &webhook.FakeHttpWriter{}
+			out := receive(1)
+			request := httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader(`{"amount":10}`))
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("ce-specversion", "1.0")
+			request.Header.Set("ce-id", "1")
+			request.Header.Set("ce-source", "/orders")
+			request.Header.Set("ce-type", "order.created")
+			request.Header.Set("ce-subject", "order-1")
+			request.Header.Set("ce-tenant", "acme")
+
+			router.HandleRoute(writer, request)
+			e := <-out
+			convey.So(writer.HeaderStatus, convey.ShouldEqual, http.StatusOK)
+			convey.So(e.ID(), convey.ShouldEqual, "1")
+			convey.So(e.Type(), convey.ShouldEqual, "order.created")
+			convey.So(e.DataContentType(), convey.ShouldEqual, "application/json")
+			convey.So(string(e.Data()), convey.ShouldEqual, `{"amount":10}`)
+			convey.So(e.Extensions()["tenant"], convey.ShouldEqual, "acme")
+			convey.So(e.Extensions()[eventsourcecommon.OriginSourceExtension], convey.ShouldEqual, "/orders")
+			convey.So(e.Extensions()[eventsourcecommon.OriginSubjectExtension], convey.ShouldEqual, "order-1")
+		})
+
+		convey.Convey("Test structured mode", func() {
+			writer := &webhook.FakeHttpWriter{}
+			out := receive(1)
+			request := httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader(
+				`{"specversion":"1.0","id":"2","source":"/orders","type":"order.shipped","datacontenttype":"text/plain","data":"shipped"}`))
+			request.Header.Set("Content-Type", "application/cloudevents+json")
+
+			router.HandleRoute(writer, request)
+			e := <-out
+			convey.So(writer.HeaderStatus, convey.ShouldEqual, http.StatusOK)
+			convey.So(e.ID(), convey.ShouldEqual, "2")
+			convey.So(e.Type(), convey.ShouldEqual, "order.shipped")
+			convey.So(e.DataContentType(), convey.ShouldEqual, "text/plain")
+			convey.So(string(e.Data()), convey.ShouldEqual, "shipped")
+		})
+
+		convey.Convey("Test batched mode", func() {
+			writer := &webhook.FakeHttpWriter{}
+			out := receive(2)
+			request := httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader(
+				`[{"specversion":"1.0","id":"3","source":"/orders","type":"order.created","data":{"n":3}},
+				  {"specversion":"1.0","id":"4","source":"/orders","type":"order.created","data":{"n":4}}]`))
+			request.Header.Set("Content-Type", "application/cloudevents-batch+json")
+
+			router.HandleRoute(writer, request)
+			convey.So((<-out).ID(), convey.ShouldEqual, "3")
+			convey.So((<-out).ID(), convey.ShouldEqual, "4")
+			convey.So(writer.HeaderStatus, convey.ShouldEqual, http.StatusOK)
+		})
+
+		convey.Convey("Test a request which is not a cloudevent", func() {
+			writer := &webhook.FakeHttpWriter{}
+			request := httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader(`{"amount":10}`))
+			request.Header.Set("Content-Type", "application/json")
+
+			router.HandleRoute(writer, request)
+			convey.So(writer.HeaderStatus, convey.ShouldEqual, http.StatusBadRequest)
+		})
+	})
+}
@@ -18,6 +18,8 @@ package webhook
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/eventsources/common/webhook"
@@ -32,5 +34,57 @@ func validate(webhookEventSource *v1alpha1.WebhookEventSource) error {
 	if webhookEventSource == nil {
 		return v1alpha1.ErrNilEventSource
 	}
+	if webhookEventSource.CloudEvents && webhookEventSource.Method != http.MethodPost {
+		return fmt.Errorf("method must be POST to accept cloudevents")
+	}
+	if webhookEventSource.Split != nil {
+		if err := validateSplit(webhookEventSource); err != nil {
+			return err
+		}
+	}
 	return webhook.ValidateWebhookContext(&webhookEventSource.WebhookContext)
 }
+
+func validateSplit(webhookEventSource *v1alpha1.WebhookEventSource) error {
+	split := webhookEventSource.Split
+	if webhookEventSource.CloudEvents {
+		return fmt.Errorf("split can't be specified to accept cloudevents")
+	}
+	modes := 0
+	if split.JSONPath != "" {
+		modes++
+	}
+	if split.NDJSON {
+		modes++
+	}
+	if split.Multipart != nil {
+		modes++
+	}
+	if modes != 1 {
+		return fmt.Errorf("exactly one of jsonPath, ndjson and multipart must be specified to split the requests")
+	}
+	if (split.NDJSON || split.Multipart != nil) && webhookEventSource.Method != http.MethodPost {
+		return fmt.Errorf("method must be POST to split ndjson or multipart requests")
+	}
+	if split.Multipart != nil && split.Multipart.S3 != nil {
+		s3 := split.Multipart.S3
+		if s3.Endpoint == "" {
+			return fmt.Errorf("s3 endpoint must be specified")
+		}
+		if s3.Bucket == nil || s3.Bucket.Name == "" {
+			return fmt.Errorf("s3 bucket name must be specified")
+		}
+		if s3.AccessKey == nil || s3.SecretKey == nil {
+			return fmt.Errorf("s3 access key and secret key must be specified")
+		}
+	}
+	if split.Multipart != nil && split.Multipart.MaxFileSize != nil {
+		if split.Multipart.S3 == nil {
+			return fmt.Errorf("maxFileSize only applies to the files streamed to s3")
+		}
+		if *split.Multipart.MaxFileSize <= 0 {
+			return fmt.Errorf("maxFileSize must be positive")
+		}
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/argoproj/argo-events/pkg/eventsources/sources"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestValidateEventSource(t *testing.T) {
@@ -52,3 +53,42 @@ func TestValidateEventSource(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestValidateCloudEvents(t *testing.T) {
+	es := &v1alpha1.WebhookEventSource{
+		WebhookContext: v1alpha1.WebhookContext{Endpoint: "/events", Method: "POST", Port: "12000"},
+		CloudEvents:    true,
+	}
+	assert.NoError(t, validate(es))
+
+	es.Method = "GET"
+	assert.Error(t, validate(es))
+}
+
+func TestValidateSplit(t *testing.T) {
+	es := &v1alpha1.WebhookEventSource{
+		WebhookContext: v1alpha1.WebhookContext{Endpoint: "/events", Method: "POST", Port: "12000"},
+		Split:          &v1alpha1.WebhookSplit{JSONPath: "events"},
+	}
+	assert.NoError(t, validate(es))
+
+	es.Split.NDJSON = true
+	assert.ErrorContains(t, validate(es), "exactly one of")
+
+	es.Split = &v1alpha1.WebhookSplit{Multipart: &v1alpha1.WebhookMultipartSplit{S3: &v1alpha1.S3Artifact{Endpoint: "s3.amazonaws.com"}}}
+	assert.ErrorContains(t, validate(es), "bucket name")
+
+	maxFileSize := int64(0)
+	es.Split = &v1alpha1.WebhookSplit{Multipart: &v1alpha1.WebhookMultipartSplit{MaxFileSize: &maxFileSize}}
+	assert.ErrorContains(t, validate(es), "only applies to the files streamed to s3")
+	es.Split.Multipart.S3 = &v1alpha1.S3Artifact{Endpoint: "s3.amazonaws.com", Bucket: &v1alpha1.S3Bucket{Name: "uploads"}, AccessKey: &corev1.SecretKeySelector{}, SecretKey: &corev1.SecretKeySelector{}}
+	assert.ErrorContains(t, validate(es), "maxFileSize must be positive")
+
+	es.Split = &v1alpha1.WebhookSplit{NDJSON: true}
+	es.Method = "GET"
+	assert.ErrorContains(t, validate(es), "method must be POST")
+
+	es.Method = "POST"
+	es.CloudEvents = true
+	assert.ErrorContains(t, validate(es), "cloudevents")
+}
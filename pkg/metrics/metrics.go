@@ -23,6 +23,8 @@ const (
 	labelEventName       = "event_name"
 	labelSensorName      = "sensor_name"
 	labelTriggerName     = "trigger_name"
+	labelReason          = "reason"
+	labelPath            = "path"
 )
 
 var (
@@ -43,6 +45,8 @@ type Metrics struct {
 	eventsSentFailed        *prometheus.CounterVec
 	eventsProcessingFailed  *prometheus.CounterVec
 	eventProcessingDuration *prometheus.SummaryVec
+	webhookRejected         *prometheus.CounterVec
+	schemaViolations        *prometheus.CounterVec
 	actionTriggered         *prometheus.CounterVec
 	actionFailed            *prometheus.CounterVec
 	actionRetriesFailed     *prometheus.CounterVec
@@ -93,6 +97,22 @@ func NewMetrics(namespace string) *Metrics {
 				labelNamespace: namespace,
 			},
 		}, []string{labelEventSourceName, labelEventName}),
+		webhookRejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "webhook_requests_rejected_total",
+			Help:      "How many webhook requests have been rejected by the rate limit, the max in flight requests or the request timeout. https://argoproj.github.io/argo-events/metrics/#argo_events_webhook_requests_rejected_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelEventSourceName, labelEventName, labelReason}),
+		schemaViolations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "event_schema_violations_total",
+			Help:      "How many violations of the JSON Schema of the events have been found, by violating path. https://argoproj.github.io/argo-events/metrics/#argo_events_event_schema_violations_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelEventSourceName, labelEventName, labelPath}),
 		actionTriggered: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: prefix,
 			Name:      "action_triggered_total",
@@ -134,6 +154,8 @@ func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
 	m.eventsSentFailed.Collect(ch)
 	m.eventsProcessingFailed.Collect(ch)
 	m.eventProcessingDuration.Collect(ch)
+	m.webhookRejected.Collect(ch)
+	m.schemaViolations.Collect(ch)
 	m.actionTriggered.Collect(ch)
 	m.actionFailed.Collect(ch)
 	m.actionRetriesFailed.Collect(ch)
@@ -146,6 +168,8 @@ func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
 	m.eventsSentFailed.Describe(ch)
 	m.eventsProcessingFailed.Describe(ch)
 	m.eventProcessingDuration.Describe(ch)
+	m.webhookRejected.Describe(ch)
+	m.schemaViolations.Describe(ch)
 	m.actionTriggered.Describe(ch)
 	m.actionFailed.Describe(ch)
 	m.actionRetriesFailed.Describe(ch)
@@ -189,6 +213,16 @@ func (m *Metrics) EventProcessingDuration(eventSourceName, eventName string, num
 	m.eventProcessingDuration.WithLabelValues(eventSourceName, eventName).Observe(num)
 }
 
+// WebhookRequestRejected counts a webhook request rejected for the reason
+func (m *Metrics) WebhookRequestRejected(eventSourceName, eventName, reason string) {
+	m.webhookRejected.WithLabelValues(eventSourceName, eventName, reason).Inc()
+}
+
+// EventSchemaViolated counts a violation of the JSON Schema of an event at the path
+func (m *Metrics) EventSchemaViolated(eventSourceName, eventName, path string) {
+	m.schemaViolations.WithLabelValues(eventSourceName, eventName, path).Inc()
+}
+
 func (m *Metrics) ActionTriggered(sensorName, triggerName string) {
 	m.actionTriggered.WithLabelValues(sensorName, triggerName).Inc()
 }
@@ -149,6 +149,12 @@ func Start(eventsOpts ArgoEventsControllerOpts) {
 		logger.Fatalw("Unable to watch EventSources", zap.Error(err))
 	}
 
+	// Watch EventBus and enqueue the EventSources to be migrated to it
+	if err := eventSourceController.Watch(source.Kind(mgr.GetCache(), &aev1.EventBus{},
+		handler.TypedEnqueueRequestsFromMapFunc(eventsource.EventBusMigrationMapFunc(mgr.GetClient())))); err != nil {
+		logger.Fatalw("Unable to watch EventBus", zap.Error(err))
+	}
+
 	// Watch Deployments and enqueue owning EventSource key
 	if err := eventSourceController.Watch(source.Kind(mgr.GetCache(), &appv1.Deployment{},
 		handler.TypedEnqueueRequestForOwner[*appv1.Deployment](mgr.GetScheme(), mgr.GetRESTMapper(), &aev1.EventSource{}, handler.OnlyControllerOwner()),
@@ -180,6 +186,12 @@ func Start(eventsOpts ArgoEventsControllerOpts) {
 		logger.Fatalw("Unable to watch Sensors", zap.Error(err))
 	}
 
+	// Watch EventBus and enqueue the Sensors to be migrated to it
+	if err := sensorController.Watch(source.Kind(mgr.GetCache(), &aev1.EventBus{},
+		handler.TypedEnqueueRequestsFromMapFunc(sensor.EventBusMigrationMapFunc(mgr.GetClient())))); err != nil {
+		logger.Fatalw("Unable to watch EventBus", zap.Error(err))
+	}
+
 	// Watch Deployments and enqueue owning Sensor key
 	if err := sensorController.Watch(source.Kind(mgr.GetCache(), &appv1.Deployment{},
 		handler.TypedEnqueueRequestForOwner[*appv1.Deployment](mgr.GetScheme(), mgr.GetRESTMapper(), &aev1.Sensor{}, handler.OnlyControllerOwner()),
@@ -0,0 +1,75 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+// GenerateSharedClientAuthSecretName returns the name of the Secret holding the client credentials
+// of a namespace the EventBus is shared with, the Secret is created in that namespace.
+func GenerateSharedClientAuthSecretName(eventBus *v1alpha1.EventBus) string {
+	return fmt.Sprintf("eventbus-%s-%s-js-client-auth", eventBus.Namespace, eventBus.Name)
+}
+
+// GetBusConfig returns the EventBus configuration to be used by the EventSources and Sensors in the namespace.
+func GetBusConfig(eventBus *v1alpha1.EventBus, namespace string) (*v1alpha1.BusConfig, error) {
+	if namespace == eventBus.Namespace {
+		return &eventBus.Status.Config, nil
+	}
+	if !eventBus.IsSharedWith(namespace) {
+		return nil, fmt.Errorf("eventbus %s/%s is not shared with namespace %q", eventBus.Namespace, eventBus.Name, namespace)
+	}
+	if eventBus.Spec.JetStream == nil || eventBus.Status.Config.JetStream == nil {
+		return nil, fmt.Errorf("sharing is only supported by jetstream eventbus")
+	}
+	busConfig := eventBus.Status.Config.DeepCopy()
+	busConfig.JetStream.AccessSecret = &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: GenerateSharedClientAuthSecretName(eventBus),
+		},
+		Key: v1alpha1.JetStreamClientAuthSecretKey,
+	}
+	return busConfig, nil
+}
+
+// GetSuccessorEventBus returns the EventBus in the namespace which is migrating from the named EventBus,
+// nil is returned if there is no such one.
+func GetSuccessorEventBus(ctx context.Context, c client.Client, namespace, name string) (*v1alpha1.EventBus, error) {
+	ebl := &v1alpha1.EventBusList{}
+	if err := c.List(ctx, ebl, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+	for _, eb := range ebl.Items {
+		if eb.Name != name && eb.Spec.Migration != nil && eb.Spec.Migration.From == name {
+			return &eb, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetMigrationPhase returns the migration phase of a successor EventBus, empty if it is nil or the migration has not started.
+func GetMigrationPhase(successor *v1alpha1.EventBus) v1alpha1.EventBusMigrationPhase {
+	if successor == nil || successor.Spec.Migration == nil || successor.Status.Migration == nil {
+		return ""
+	}
+	return successor.Status.Migration.Phase
+}
+
+// GetMigrationNamespaces returns the namespaces where the EventSources and Sensors to be migrated
+// to the successor EventBus could be, empty if the EventBus is not a successor.
+func GetMigrationNamespaces(ctx context.Context, c client.Client, successor *v1alpha1.EventBus) []string {
+	if successor.Spec.Migration == nil {
+		return nil
+	}
+	result := []string{successor.Namespace}
+	oldBus := &v1alpha1.EventBus{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: successor.Namespace, Name: successor.Spec.Migration.From}, oldBus); err == nil {
+		result = append(result, oldBus.SharedNamespaces()...)
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+func init() {
+	_ = v1alpha1.AddToScheme(scheme.Scheme)
+}
+
+func TestGetBusConfig(t *testing.T) {
+	eb := &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "bus", Name: "default"},
+		Spec: v1alpha1.EventBusSpec{
+			JetStream: &v1alpha1.JetStreamBus{Version: "latest"},
+		},
+		Status: v1alpha1.EventBusStatus{
+			Config: v1alpha1.BusConfig{
+				JetStream: &v1alpha1.JetStreamConfig{
+					URL: "nats://eventbus-default-js-svc.bus.svc:4222",
+					AccessSecret: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-default-js-client-auth"},
+						Key:                  v1alpha1.JetStreamClientAuthSecretKey,
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("same namespace", func(t *testing.T) {
+		c, err := GetBusConfig(eb, "bus")
+		assert.NoError(t, err)
+		assert.Equal(t, "eventbus-default-js-client-auth", c.JetStream.AccessSecret.Name)
+	})
+
+	t.Run("not shared", func(t *testing.T) {
+		_, err := GetBusConfig(eb, "team-a")
+		assert.Error(t, err)
+	})
+
+	t.Run("shared", func(t *testing.T) {
+		shared := eb.DeepCopy()
+		shared.Spec.Sharing = &v1alpha1.EventBusSharing{AllowedNamespaces: []string{"team-a"}}
+		c, err := GetBusConfig(shared, "team-a")
+		assert.NoError(t, err)
+		assert.Equal(t, shared.Status.Config.JetStream.URL, c.JetStream.URL)
+		assert.Equal(t, "eventbus-bus-default-js-client-auth", c.JetStream.AccessSecret.Name)
+		assert.Equal(t, v1alpha1.JetStreamClientAuthSecretKey, c.JetStream.AccessSecret.Key)
+		assert.Equal(t, "eventbus-default-js-client-auth", shared.Status.Config.JetStream.AccessSecret.Name)
+	})
+}
+
+func TestGetSuccessorEventBus(t *testing.T) {
+	ctx := context.TODO()
+	oldBus := &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "bus", Name: "default"},
+		Spec: v1alpha1.EventBusSpec{
+			JetStream: &v1alpha1.JetStreamBus{Version: "latest"},
+			Sharing:   &v1alpha1.EventBusSharing{AllowedNamespaces: []string{"team-a"}},
+		},
+	}
+	newBus := &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "bus", Name: "js"},
+		Spec: v1alpha1.EventBusSpec{
+			JetStream: &v1alpha1.JetStreamBus{Version: "latest"},
+			Migration: &v1alpha1.EventBusMigration{From: "default"},
+		},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(oldBus, newBus).Build()
+
+	t.Run("successor found", func(t *testing.T) {
+		successor, err := GetSuccessorEventBus(ctx, cl, "bus", "default")
+		assert.NoError(t, err)
+		assert.NotNil(t, successor)
+		assert.Equal(t, "js", successor.Name)
+		assert.Equal(t, v1alpha1.EventBusMigrationPhase(""), GetMigrationPhase(successor))
+		successor.Status.Migration = &v1alpha1.EventBusMigrationStatus{Phase: v1alpha1.EventBusMigrationDualPublishing}
+		assert.Equal(t, v1alpha1.EventBusMigrationDualPublishing, GetMigrationPhase(successor))
+		assert.Equal(t, []string{"bus", "team-a"}, GetMigrationNamespaces(ctx, cl, successor))
+	})
+
+	t.Run("no successor", func(t *testing.T) {
+		successor, err := GetSuccessorEventBus(ctx, cl, "bus", "js")
+		assert.NoError(t, err)
+		assert.Nil(t, successor)
+		assert.Equal(t, v1alpha1.EventBusMigrationPhase(""), GetMigrationPhase(successor))
+	})
+}
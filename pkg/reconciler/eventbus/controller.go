@@ -66,6 +66,9 @@ func (r *eventBusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	if err := r.client.Status().Update(ctx, busCopy); err != nil {
 		return reconcile.Result{}, err
 	}
+	if reconcileErr == nil && migrationInProgress(busCopy) {
+		return ctrl.Result{RequeueAfter: migrationResyncPeriod}, nil
+	}
 	return ctrl.Result{}, reconcileErr
 }
 
@@ -94,7 +97,10 @@ func (r *eventBusReconciler) reconcile(ctx context.Context, eventBus *v1alpha1.E
 	} else {
 		eventBus.Status.MarkConfigured()
 	}
-	return installer.Install(ctx, eventBus, r.client, r.kubeClient, r.config, log)
+	if err := installer.Install(ctx, eventBus, r.client, r.kubeClient, r.config, log); err != nil {
+		return err
+	}
+	return reconcileMigration(ctx, r.client, eventBus)
 }
 
 func (r *eventBusReconciler) needsUpdate(old, new *v1alpha1.EventBus) bool {
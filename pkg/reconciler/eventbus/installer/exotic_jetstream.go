@@ -1,10 +1,13 @@
 package installer
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 )
@@ -29,6 +32,22 @@ func (i *exoticJetStreamInstaller) Install(ctx context.Context) (*v1alpha1.BusCo
 	if JetStreamObj == nil {
 		return nil, fmt.Errorf("invalid request")
 	}
+	if JetStreamObj.Stream != nil {
+		v := viper.New()
+		v.SetConfigType("yaml")
+		if err := v.ReadConfig(bytes.NewBufferString(JetStreamObj.StreamConfig)); err != nil {
+			return nil, fmt.Errorf("invalid jetstream stream config, %w", err)
+		}
+		if err := applyStreamConfig(v, JetStreamObj.Stream); err != nil {
+			return nil, fmt.Errorf("invalid stream config, %w", err)
+		}
+		b, err := yaml.Marshal(v.AllSettings())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal merged stream config, %w", err)
+		}
+		JetStreamObj = JetStreamObj.DeepCopy()
+		JetStreamObj.StreamConfig = string(b)
+	}
 	i.eventBus.Status.MarkDeployed("Skipped", "Skip deployment because of using exotic config.")
 	i.logger.Info("use exotic config")
 	busConfig := &v1alpha1.BusConfig{
@@ -41,6 +41,24 @@ func TestInstallationJSExotic(t *testing.T) {
 	})
 }
 
+func TestInstallationJSExoticWithStreamConfig(t *testing.T) {
+	t.Run("installation with typed stream config", func(t *testing.T) {
+		bus := testJSExoticBus.DeepCopy()
+		bus.Spec.JetStreamExotic.StreamConfig = "maxAge: 24h"
+		replicas := int32(3)
+		bus.Spec.JetStreamExotic.Stream = &v1alpha1.JetStreamStreamConfig{
+			Replicas: &replicas,
+		}
+		installer := NewExoticJetStreamInstaller(bus, logging.NewArgoEventsLogger())
+		conf, err := installer.Install(context.TODO())
+		assert.NoError(t, err)
+		assert.NotNil(t, conf.JetStream)
+		assert.Contains(t, conf.JetStream.StreamConfig, "maxage: 24h")
+		assert.Contains(t, conf.JetStream.StreamConfig, "replicas: 3")
+		assert.Equal(t, "maxAge: 24h", bus.Spec.JetStreamExotic.StreamConfig)
+	})
+}
+
 func TestUninstallationJSExotic(t *testing.T) {
 	t.Run("uninstallation with exotic jetstream config", func(t *testing.T) {
 		installer := NewExoticJetStreamInstaller(testJSExoticBus, logging.NewArgoEventsLogger())
@@ -10,6 +10,7 @@ import (
 
 	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/reconciler"
+	controllerscommon "github.com/argoproj/argo-events/pkg/reconciler/common"
 )
 
 // Installer is an interface for event bus installation
@@ -32,6 +33,11 @@ func Install(ctx context.Context, eventBus *aev1.EventBus, client client.Client,
 		logger.Errorw("installation error", zap.Error(err))
 		return err
 	}
+	if err := validateStreamConfigUpdate(eventBus.Status.Config.JetStream, busConfig.JetStream); err != nil {
+		logger.Errorw("invalid stream config update", zap.Error(err))
+		eventBus.Status.MarkDeployFailed("InvalidStreamConfigUpdate", err.Error())
+		return err
+	}
 	eventBus.Status.Config = *busConfig
 	return nil
 }
@@ -50,15 +56,19 @@ func getInstaller(eventBus *aev1.EventBus, client client.Client, kubeClient kube
 		return NewExoticKafkaInstaller(eventBus, logger), nil
 	} else if js := eventBus.Spec.JetStreamExotic; js != nil {
 		return NewExoticJetStreamInstaller(eventBus, logger), nil
+	} else if redis := eventBus.Spec.Redis; redis != nil {
+		return NewExoticRedisInstaller(eventBus, logger), nil
+	} else if pulsar := eventBus.Spec.Pulsar; pulsar != nil {
+		return NewExoticPulsarInstaller(eventBus, logger), nil
 	}
 	return nil, fmt.Errorf("invalid eventbus spec")
 }
 
 func getLabels(bus *aev1.EventBus) map[string]string {
 	return map[string]string{
-		"controller":        "eventbus-controller",
-		"eventbus-name":     bus.Name,
-		aev1.LabelOwnerName: bus.Name,
+		"controller":           "eventbus-controller",
+		aev1.LabelEventBusName: bus.Name,
+		aev1.LabelOwnerName:    bus.Name,
 	}
 }
 
@@ -71,7 +81,18 @@ func getLabels(bus *aev1.EventBus) map[string]string {
 //
 // It could also be used to check if the EventBus object can be safely deleted.
 func Uninstall(ctx context.Context, eventBus *aev1.EventBus, client client.Client, kubeClient kubernetes.Interface, config *reconciler.GlobalConfig, logger *zap.SugaredLogger) error {
-	linkedEventSources, err := linkedEventSources(ctx, eventBus.Namespace, eventBus.Name, client)
+	successor, err := controllerscommon.GetSuccessorEventBus(ctx, client, eventBus.Namespace, eventBus.Name)
+	if err != nil {
+		logger.Errorw("failed to query successor EventBus", zap.Error(err))
+		return fmt.Errorf("failed to check if there is any successor EventBus, %w", err)
+	}
+	if controllerscommon.GetMigrationPhase(successor) == aev1.EventBusMigrationCompleted && successor.Status.Migration.EventSources.Done() {
+		// The EventSources and Sensors have been migrated to the successor EventBus.
+		logger.Infow("eventbus has been migrated, skip checking linked EventSources and Sensors", "successor", successor.Name)
+		return uninstall(ctx, eventBus, client, kubeClient, config, logger)
+	}
+
+	linkedEventSources, err := linkedEventSources(ctx, eventBus, client)
 	if err != nil {
 		logger.Errorw("failed to query linked EventSources", zap.Error(err))
 		return fmt.Errorf("failed to check if there is any EventSource linked, %w", err)
@@ -80,7 +101,7 @@ func Uninstall(ctx context.Context, eventBus *aev1.EventBus, client client.Clien
 		return fmt.Errorf("can not delete an EventBus with %v EventSources connected", linkedEventSources)
 	}
 
-	linkedSensors, err := linkedSensors(ctx, eventBus.Namespace, eventBus.Name, client)
+	linkedSensors, err := linkedSensors(ctx, eventBus, client)
 	if err != nil {
 		logger.Errorw("failed to query linked Sensors", zap.Error(err))
 		return fmt.Errorf("failed to check if there is any Sensor linked, %w", err)
@@ -89,6 +110,10 @@ func Uninstall(ctx context.Context, eventBus *aev1.EventBus, client client.Clien
 		return fmt.Errorf("can not delete an EventBus with %v Sensors connected", linkedSensors)
 	}
 
+	return uninstall(ctx, eventBus, client, kubeClient, config, logger)
+}
+
+func uninstall(ctx context.Context, eventBus *aev1.EventBus, client client.Client, kubeClient kubernetes.Interface, config *reconciler.GlobalConfig, logger *zap.SugaredLogger) error {
 	installer, err := getInstaller(eventBus, client, kubeClient, config, logger)
 	if err != nil {
 		logger.Errorw("failed to get an installer", zap.Error(err))
@@ -97,42 +122,54 @@ func Uninstall(ctx context.Context, eventBus *aev1.EventBus, client client.Clien
 	return installer.Uninstall(ctx)
 }
 
-func linkedEventSources(ctx context.Context, namespace, eventBusName string, c client.Client) (int, error) {
-	esl := &aev1.EventSourceList{}
-	if err := c.List(ctx, esl, &client.ListOptions{
-		Namespace: namespace,
-	}); err != nil {
-		return 0, err
-	}
+func linkedEventSources(ctx context.Context, eventBus *aev1.EventBus, c client.Client) (int, error) {
 	result := 0
-	for _, es := range esl.Items {
-		ebName := es.Spec.EventBusName
-		if ebName == "" {
-			ebName = aev1.DefaultEventBusName
+	for _, namespace := range append([]string{eventBus.Namespace}, eventBus.SharedNamespaces()...) {
+		esl := &aev1.EventSourceList{}
+		if err := c.List(ctx, esl, &client.ListOptions{
+			Namespace: namespace,
+		}); err != nil {
+			return 0, err
 		}
-		if ebName == eventBusName {
-			result++
+		for _, es := range esl.Items {
+			ebName := es.Spec.EventBusName
+			if ebName == "" {
+				ebName = aev1.DefaultEventBusName
+			}
+			if ebName == eventBus.Name && linkedEventBusNamespace(es.Namespace, es.Spec.EventBusNamespace) == eventBus.Namespace {
+				result++
+			}
 		}
 	}
 	return result, nil
 }
 
-func linkedSensors(ctx context.Context, namespace, eventBusName string, c client.Client) (int, error) {
-	sl := &aev1.SensorList{}
-	if err := c.List(ctx, sl, &client.ListOptions{
-		Namespace: namespace,
-	}); err != nil {
-		return 0, err
-	}
+func linkedSensors(ctx context.Context, eventBus *aev1.EventBus, c client.Client) (int, error) {
 	result := 0
-	for _, s := range sl.Items {
-		sName := s.Spec.EventBusName
-		if sName == "" {
-			sName = aev1.DefaultEventBusName
+	for _, namespace := range append([]string{eventBus.Namespace}, eventBus.SharedNamespaces()...) {
+		sl := &aev1.SensorList{}
+		if err := c.List(ctx, sl, &client.ListOptions{
+			Namespace: namespace,
+		}); err != nil {
+			return 0, err
 		}
-		if sName == eventBusName {
-			result++
+		for _, s := range sl.Items {
+			sName := s.Spec.EventBusName
+			if sName == "" {
+				sName = aev1.DefaultEventBusName
+			}
+			if sName == eventBus.Name && linkedEventBusNamespace(s.Namespace, s.Spec.EventBusNamespace) == eventBus.Namespace {
+				result++
+			}
 		}
 	}
 	return result, nil
 }
+
+// linkedEventBusNamespace returns the namespace of the EventBus an EventSource or Sensor uses.
+func linkedEventBusNamespace(namespace, eventBusNamespace string) string {
+	if eventBusNamespace == "" {
+		return namespace
+	}
+	return eventBusNamespace
+}
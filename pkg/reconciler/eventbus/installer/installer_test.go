@@ -91,10 +91,34 @@ func TestGetLinkedEventSources(t *testing.T) {
 		ctx := context.Background()
 		err := cl.Create(ctx, es, &client.CreateOptions{})
 		assert.Nil(t, err)
-		n, err := linkedEventSources(ctx, testNamespace, "test-sa", cl)
+		eb := &aev1.EventBus{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test-sa"}}
+		n, err := linkedEventSources(ctx, eb, cl)
 		assert.Nil(t, err)
 		assert.Equal(t, n, 1)
 	})
+
+	t.Run("get linked eventsources in shared namespaces", func(t *testing.T) {
+		es := fakeEmptyEventSource()
+		es.Namespace = "tenant"
+		es.Spec.EventBusName = "test-sa"
+		es.Spec.EventBusNamespace = testNamespace
+		es.Spec.Calendar = fakeCalendarEventSourceMap("test")
+		other := fakeEmptyEventSource()
+		other.Namespace = "tenant"
+		other.Name = "other"
+		other.Spec.EventBusName = "test-sa"
+		other.Spec.Calendar = fakeCalendarEventSourceMap("test")
+		cl := fake.NewClientBuilder().WithObjects(es, other).Build()
+		ctx := context.Background()
+		eb := &aev1.EventBus{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test-sa"}}
+		n, err := linkedEventSources(ctx, eb, cl)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+		eb.Spec.Sharing = &aev1.EventBusSharing{AllowedNamespaces: []string{"tenant"}}
+		n, err = linkedEventSources(ctx, eb, cl)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, n)
+	})
 }
 
 func TestGetLinkedSensors(t *testing.T) {
@@ -105,7 +129,8 @@ func TestGetLinkedSensors(t *testing.T) {
 		ctx := context.Background()
 		err := cl.Create(ctx, s, &client.CreateOptions{})
 		assert.Nil(t, err)
-		n, err := linkedSensors(ctx, testNamespace, "test-sa", cl)
+		eb := &aev1.EventBus{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test-sa"}}
+		n, err := linkedSensors(ctx, eb, cl)
 		assert.Nil(t, err)
 		assert.Equal(t, n, 1)
 	})
@@ -5,6 +5,7 @@ import (
 	"context"
 	"embed"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/reconciler"
+	controllerscommon "github.com/argoproj/argo-events/pkg/reconciler/common"
 	"github.com/argoproj/argo-events/pkg/shared/tls"
 	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
 )
@@ -89,6 +91,9 @@ func (r *jetStreamInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig,
 			return nil, fmt.Errorf("failed to merge customized stream config, %w", err)
 		}
 	}
+	if err := applyStreamConfig(v, r.eventBus.Spec.JetStream.Stream); err != nil {
+		return nil, fmt.Errorf("invalid stream config, %w", err)
+	}
 	b, err := yaml.Marshal(v.AllSettings())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal merged buffer config, %w", err)
@@ -129,6 +134,100 @@ func (r *jetStreamInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig,
 	}, nil
 }
 
+// applyStreamConfig overrides the stream settings with the ones specified in the typed stream config.
+// The keys are the ones understood by the JetStream eventbus drivers.
+func applyStreamConfig(v *viper.Viper, stream *v1alpha1.JetStreamStreamConfig) error {
+	if stream == nil {
+		return nil
+	}
+	switch stream.Retention {
+	case "":
+	case v1alpha1.JetStreamRetentionLimits:
+		v.Set("retention", 0)
+	case v1alpha1.JetStreamRetentionInterest:
+		v.Set("retention", 1)
+	case v1alpha1.JetStreamRetentionWorkQueue:
+		v.Set("retention", 2)
+	default:
+		return fmt.Errorf("unsupported retention policy %q", stream.Retention)
+	}
+	switch stream.Discard {
+	case "":
+	case v1alpha1.JetStreamDiscardOld:
+		v.Set("discard", 0)
+	case v1alpha1.JetStreamDiscardNew:
+		v.Set("discard", 1)
+	default:
+		return fmt.Errorf("unsupported discard policy %q", stream.Discard)
+	}
+	switch stream.Compression {
+	case "":
+	case v1alpha1.JetStreamCompressionNone, v1alpha1.JetStreamCompressionS2:
+		v.Set("compression", strings.ToLower(string(stream.Compression)))
+	default:
+		return fmt.Errorf("unsupported compression %q", stream.Compression)
+	}
+	if stream.MaxMsgs != nil {
+		v.Set("maxMsgs", *stream.MaxMsgs)
+	}
+	if stream.MaxBytes != nil {
+		v.Set("maxBytes", *stream.MaxBytes)
+	}
+	if stream.MaxAge != nil {
+		if _, err := time.ParseDuration(*stream.MaxAge); err != nil {
+			return fmt.Errorf("invalid maxAge %q, %w", *stream.MaxAge, err)
+		}
+		v.Set("maxAge", *stream.MaxAge)
+	}
+	if stream.MaxMsgSize != nil {
+		v.Set("maxMsgSize", *stream.MaxMsgSize)
+	}
+	if stream.Replicas != nil {
+		v.Set("replicas", *stream.Replicas)
+	}
+	if stream.Duplicates != nil {
+		if _, err := time.ParseDuration(*stream.Duplicates); err != nil {
+			return fmt.Errorf("invalid duplicates %q, %w", *stream.Duplicates, err)
+		}
+		v.Set("duplicates", *stream.Duplicates)
+	}
+	if p := stream.Placement; p != nil {
+		if p.Cluster != "" {
+			v.Set("placementCluster", p.Cluster)
+		}
+		if len(p.Tags) > 0 {
+			v.Set("placementTags", p.Tags)
+		}
+	}
+	return nil
+}
+
+// validateStreamConfigUpdate rejects the stream settings changes that the JetStream server can not apply to an
+// existing stream. The drivers would otherwise keep running with the previous stream configuration.
+func validateStreamConfigUpdate(previous, desired *v1alpha1.JetStreamConfig) error {
+	if previous == nil || desired == nil || previous.StreamConfig == "" {
+		return nil
+	}
+	prev := viper.New()
+	prev.SetConfigType("yaml")
+	if err := prev.ReadConfig(bytes.NewBufferString(previous.StreamConfig)); err != nil {
+		// Nothing to compare with
+		return nil
+	}
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(bytes.NewBufferString(desired.StreamConfig)); err != nil {
+		return fmt.Errorf("invalid stream config, %w", err)
+	}
+	// The retention policy can not be changed to or from WorkQueue once the stream exists
+	const workQueueRetention = 2
+	if oldRetention, newRetention := prev.GetInt("retention"), v.GetInt("retention"); oldRetention != newRetention &&
+		(oldRetention == workQueueRetention || newRetention == workQueueRetention) {
+		return fmt.Errorf("stream retention policy can not be changed to or from WorkQueue on an existing stream, recreate the EventBus or migrate to a new one")
+	}
+	return nil
+}
+
 // buildJetStreamService builds a Service for Jet Stream
 func (r *jetStreamInstaller) buildJetStreamServiceSpec() corev1.ServiceSpec {
 	return corev1.ServiceSpec{
@@ -543,18 +642,13 @@ func (r *jetStreamInstaller) createSecrets(ctx context.Context) error {
 		jsUser := sharedutil.RandomString(8)
 		jsPass := sharedutil.RandomString(16)
 		sysPassword := sharedutil.RandomString(24)
-		authTpl := template.Must(template.ParseFS(jetStremAssets, "assets/jetstream/server-auth.conf"))
-		var authTplOutput bytes.Buffer
-		if err := authTpl.Execute(&authTplOutput, struct {
-			JetStreamUser     string
-			JetStreamPassword string
-			SysPassword       string
-		}{
-			JetStreamUser:     jsUser,
-			JetStreamPassword: jsPass,
-			SysPassword:       sysPassword,
-		}); err != nil {
-			return fmt.Errorf("failed to parse nats auth template, error: %w", err)
+		sharedAccounts, err := r.provisionSharedAccounts(ctx, nil)
+		if err != nil {
+			return err
+		}
+		authConf, err := renderServerAuth(jsUser, jsPass, sysPassword, sharedAccounts)
+		if err != nil {
+			return err
 		}
 
 		// Generate TLS self signed certificate for Jetstream bus: includes TLS private key, certificate, and CA certificate
@@ -589,14 +683,16 @@ func (r *jetStreamInstaller) createSecrets(ctx context.Context) error {
 			},
 			Type: corev1.SecretTypeOpaque,
 			Data: map[string][]byte{
-				v1alpha1.JetStreamServerSecretAuthKey:       authTplOutput.Bytes(),
-				v1alpha1.JetStreamServerSecretEncryptionKey: []byte(encryptionKey),
-				v1alpha1.JetStreamServerPrivateKeyKey:       serverKeyPEM,
-				v1alpha1.JetStreamServerCertKey:             serverCertPEM,
-				v1alpha1.JetStreamServerCACertKey:           caCertPEM,
-				v1alpha1.JetStreamClusterPrivateKeyKey:      clusterKeyPEM,
-				v1alpha1.JetStreamClusterCertKey:            clusterCertPEM,
-				v1alpha1.JetStreamClusterCACertKey:          clusterCACertPEM,
+				v1alpha1.JetStreamServerSecretAuthKey:             authConf,
+				v1alpha1.JetStreamServerSecretEncryptionKey:       []byte(encryptionKey),
+				v1alpha1.JetStreamServerSecretSysPasswordKey:      []byte(sysPassword),
+				v1alpha1.JetStreamServerSecretSharedNamespacesKey: []byte(sharedNamespacesValue(sharedAccounts)),
+				v1alpha1.JetStreamServerPrivateKeyKey:             serverKeyPEM,
+				v1alpha1.JetStreamServerCertKey:                   serverCertPEM,
+				v1alpha1.JetStreamServerCACertKey:                 caCertPEM,
+				v1alpha1.JetStreamClusterPrivateKeyKey:            clusterKeyPEM,
+				v1alpha1.JetStreamClusterCertKey:                  clusterCertPEM,
+				v1alpha1.JetStreamClusterCACertKey:                clusterCACertPEM,
 			},
 		}
 
@@ -638,11 +734,179 @@ func (r *jetStreamInstaller) createSecrets(ctx context.Context) error {
 			return fmt.Errorf("failed to create nats client auth secret, err: %w", err)
 		}
 		r.logger.Infow("created nats client auth secret successfully")
+		return nil
+	}
+
+	return r.reconcileSharedAccounts(ctx, oldSObj, oldCObj)
+}
+
+// jetStreamSharedAccount is a NATS account dedicated to a namespace the EventBus is shared with.
+type jetStreamSharedAccount struct {
+	Namespace string
+	Account   string
+	User      string
+	Password  string
+}
+
+func renderServerAuth(jsUser, jsPass, sysPassword string, sharedAccounts []jetStreamSharedAccount) ([]byte, error) {
+	authTpl := template.Must(template.ParseFS(jetStremAssets, "assets/jetstream/server-auth.conf"))
+	var authTplOutput bytes.Buffer
+	if err := authTpl.Execute(&authTplOutput, struct {
+		JetStreamUser     string
+		JetStreamPassword string
+		SysPassword       string
+		SharedAccounts    []jetStreamSharedAccount
+	}{
+		JetStreamUser:     jsUser,
+		JetStreamPassword: jsPass,
+		SysPassword:       sysPassword,
+		SharedAccounts:    sharedAccounts,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to parse nats auth template, error: %w", err)
+	}
+	return authTplOutput.Bytes(), nil
+}
+
+func sharedNamespacesValue(sharedAccounts []jetStreamSharedAccount) string {
+	namespaces := []string{}
+	for _, a := range sharedAccounts {
+		namespaces = append(namespaces, a.Namespace)
 	}
+	return strings.Join(namespaces, ",")
+}
 
+// reconcileSharedAccounts keeps the accounts in the server auth config in sync with the namespaces
+// the EventBus is shared with.
+func (r *jetStreamInstaller) reconcileSharedAccounts(ctx context.Context, serverObj, clientObj *corev1.Secret) error {
+	previous := []string{}
+	if x := string(serverObj.Data[v1alpha1.JetStreamServerSecretSharedNamespacesKey]); x != "" {
+		previous = strings.Split(x, ",")
+	}
+	if len(previous) == 0 && len(r.eventBus.SharedNamespaces()) == 0 {
+		return nil
+	}
+	sharedAccounts, err := r.provisionSharedAccounts(ctx, previous)
+	if err != nil {
+		return err
+	}
+	creds := &jetStreamCredential{}
+	if err := yaml.Unmarshal(clientObj.Data[v1alpha1.JetStreamClientAuthSecretKey], creds); err != nil {
+		return fmt.Errorf("failed to parse nats client auth secret, err: %w", err)
+	}
+	sysPassword := string(serverObj.Data[v1alpha1.JetStreamServerSecretSysPasswordKey])
+	if sysPassword == "" {
+		// Secrets created by old versions do not keep the system account password.
+		sysPassword = sharedutil.RandomString(24)
+	}
+	authConf, err := renderServerAuth(creds.Username, creds.Password, sysPassword, sharedAccounts)
+	if err != nil {
+		return err
+	}
+	namespaces := sharedNamespacesValue(sharedAccounts)
+	if bytes.Equal(serverObj.Data[v1alpha1.JetStreamServerSecretAuthKey], authConf) && string(serverObj.Data[v1alpha1.JetStreamServerSecretSharedNamespacesKey]) == namespaces {
+		return nil
+	}
+	newServerObj := serverObj.DeepCopy()
+	newServerObj.Data[v1alpha1.JetStreamServerSecretAuthKey] = authConf
+	newServerObj.Data[v1alpha1.JetStreamServerSecretSysPasswordKey] = []byte(sysPassword)
+	newServerObj.Data[v1alpha1.JetStreamServerSecretSharedNamespacesKey] = []byte(namespaces)
+	if err := r.client.Update(ctx, newServerObj); err != nil {
+		return fmt.Errorf("failed to update nats server auth secret, err: %w", err)
+	}
+	r.logger.Infow("updated shared accounts in nats server auth secret successfully", "namespaces", namespaces)
+	return nil
+}
+
+type jetStreamCredential struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// provisionSharedAccounts makes sure the client auth secret exists in each of the namespaces the EventBus is shared with,
+// and deletes the ones in the namespaces of the previous list which are not shared any more.
+func (r *jetStreamInstaller) provisionSharedAccounts(ctx context.Context, previous []string) ([]jetStreamSharedAccount, error) {
+	namespaces := r.eventBus.SharedNamespaces()
+	result := []jetStreamSharedAccount{}
+	for _, ns := range namespaces {
+		account := jetStreamSharedAccount{
+			Namespace: ns,
+			Account:   "ns-" + ns,
+			User:      "ns-" + ns,
+		}
+		password, err := r.ensureSharedClientAuthSecret(ctx, ns, account.User)
+		if err != nil {
+			return nil, err
+		}
+		account.Password = password
+		result = append(result, account)
+	}
+	for _, ns := range previous {
+		if slices.Contains(namespaces, ns) {
+			continue
+		}
+		if err := r.deleteSharedClientAuthSecret(ctx, ns); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *jetStreamInstaller) ensureSharedClientAuthSecret(ctx context.Context, namespace, user string) (string, error) {
+	name := controllerscommon.GenerateSharedClientAuthSecretName(r.eventBus)
+	existing, err := r.kubeClient.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		creds := &jetStreamCredential{}
+		if err := yaml.Unmarshal(existing.Data[v1alpha1.JetStreamClientAuthSecretKey], creds); err == nil && creds.Username == user && creds.Password != "" {
+			return creds.Password, nil
+		}
+		if err := r.kubeClient.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return "", withSharingHint(fmt.Errorf("failed to delete malformed shared nats client auth secret in namespace %q, err: %w", namespace, err), namespace)
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return "", withSharingHint(fmt.Errorf("failed to get shared nats client auth secret in namespace %q, err: %w", namespace, err), namespace)
+	}
+	password := sharedutil.RandomString(16)
+	obj := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			// Owner references can not be set across namespaces, the secrets are tracked by labels and cleaned up explicitly.
+			Labels: map[string]string{
+				"controller":                    "eventbus-controller",
+				v1alpha1.LabelEventBusName:      r.eventBus.Name,
+				v1alpha1.LabelEventBusNamespace: r.eventBus.Namespace,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			v1alpha1.JetStreamClientAuthSecretKey: []byte(fmt.Sprintf("username: %s\npassword: %s", user, password)),
+		},
+	}
+	if _, err := r.kubeClient.CoreV1().Secrets(namespace).Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+		return "", withSharingHint(fmt.Errorf("failed to create shared nats client auth secret in namespace %q, err: %w", namespace, err), namespace)
+	}
+	r.logger.Infow("created shared nats client auth secret successfully", "namespace", namespace)
+	return password, nil
+}
+
+func (r *jetStreamInstaller) deleteSharedClientAuthSecret(ctx context.Context, namespace string) error {
+	name := controllerscommon.GenerateSharedClientAuthSecretName(r.eventBus)
+	if err := r.kubeClient.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return withSharingHint(fmt.Errorf("failed to delete shared nats client auth secret in namespace %q, err: %w", namespace, err), namespace)
+	}
+	r.logger.Infow("deleted shared nats client auth secret", "namespace", namespace)
 	return nil
 }
 
+// withSharingHint explains a forbidden error on the Secrets of a shared namespace. A namespace scoped installation
+// only grants the controller access to the Secrets of its own namespace, so it can not share an EventBus.
+func withSharingHint(err error, namespace string) error {
+	if !apierrors.IsForbidden(err) {
+		return err
+	}
+	return fmt.Errorf("%w; sharing an EventBus requires the controller to manage Secrets in namespace %q, which is not granted to a namespace scoped installation", err, namespace)
+}
+
 func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 	data := make(map[string]string)
 	svcName := generateJetStreamServiceName(r.eventBus)
@@ -727,9 +991,33 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 }
 
 func (r *jetStreamInstaller) Uninstall(ctx context.Context) error {
+	if err := r.uninstallSharedAccounts(ctx); err != nil {
+		return err
+	}
 	return r.uninstallPVCs(ctx)
 }
 
+// uninstallSharedAccounts cleans up the client auth secrets created in the namespaces the EventBus is shared with,
+// they are not garbage collected because of no owner references.
+func (r *jetStreamInstaller) uninstallSharedAccounts(ctx context.Context) error {
+	namespaces := r.eventBus.SharedNamespaces()
+	if serverObj, err := r.getSecret(ctx, generateJetStreamServerSecretName(r.eventBus)); err == nil {
+		if x := string(serverObj.Data[v1alpha1.JetStreamServerSecretSharedNamespacesKey]); x != "" {
+			namespaces = append(namespaces, strings.Split(x, ",")...)
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get nats server auth secret, err: %w", err)
+	}
+	slices.Sort(namespaces)
+	for _, ns := range slices.Compact(namespaces) {
+		if err := r.deleteSharedClientAuthSecret(ctx, ns); err != nil {
+			r.logger.Errorw("failed to delete shared client auth secret when uninstalling", zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *jetStreamInstaller) uninstallPVCs(ctx context.Context) error {
 	// StatefulSet doesn't clean up PVC, needs to do it separately
 	// https://github.com/kubernetes/kubernetes/issues/55045
@@ -1,19 +1,25 @@
 package installer
 
 import (
+	"bytes"
 	"context"
-
+	"fmt"
 	"testing"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	controllerscommon "github.com/argoproj/argo-events/pkg/reconciler/common"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -138,9 +144,11 @@ func TestJetStreamCreateObjects(t *testing.T) {
 		s := &corev1.Secret{}
 		err = cl.Get(ctx, types.NamespacedName{Namespace: testObj.Namespace, Name: generateJetStreamServerSecretName(testObj)}, s)
 		assert.NoError(t, err)
-		assert.Equal(t, 8, len(s.Data))
+		assert.Equal(t, 10, len(s.Data))
 		assert.Contains(t, s.Data, v1alpha1.JetStreamServerSecretAuthKey)
 		assert.Contains(t, s.Data, v1alpha1.JetStreamServerSecretEncryptionKey)
+		assert.Contains(t, s.Data, v1alpha1.JetStreamServerSecretSysPasswordKey)
+		assert.Contains(t, s.Data, v1alpha1.JetStreamServerSecretSharedNamespacesKey)
 		assert.Contains(t, s.Data, v1alpha1.JetStreamServerPrivateKeyKey)
 		assert.Contains(t, s.Data, v1alpha1.JetStreamServerCertKey)
 		assert.Contains(t, s.Data, v1alpha1.JetStreamServerCACertKey)
@@ -311,3 +319,197 @@ func Test_JSBufferGetReplicas(t *testing.T) {
 	s.Replicas = &five
 	assert.Equal(t, 5, s.GetReplicas())
 }
+
+func TestApplyStreamConfig(t *testing.T) {
+	t.Run("nil stream config", func(t *testing.T) {
+		v := viper.New()
+		assert.NoError(t, applyStreamConfig(v, nil))
+		assert.Empty(t, v.AllSettings())
+	})
+
+	t.Run("typed stream config", func(t *testing.T) {
+		v := viper.New()
+		v.SetConfigType("yaml")
+		err := v.ReadConfig(bytes.NewBufferString("maxAge: 24h\nmaxMsgs: 100\nretention: 0\n"))
+		assert.NoError(t, err)
+		maxMsgs := int64(1000)
+		maxAge := "72h"
+		err = applyStreamConfig(v, &v1alpha1.JetStreamStreamConfig{
+			Retention:   v1alpha1.JetStreamRetentionWorkQueue,
+			Discard:     v1alpha1.JetStreamDiscardNew,
+			Compression: v1alpha1.JetStreamCompressionS2,
+			MaxMsgs:     &maxMsgs,
+			MaxAge:      &maxAge,
+			Placement: &v1alpha1.JetStreamPlacement{
+				Tags: []string{"ssd"},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, v.GetInt("retention"))
+		assert.Equal(t, 1, v.GetInt("discard"))
+		assert.Equal(t, "s2", v.GetString("compression"))
+		assert.Equal(t, int64(1000), v.GetInt64("maxMsgs"))
+		assert.Equal(t, "72h", v.GetString("maxAge"))
+		assert.Equal(t, []string{"ssd"}, v.GetStringSlice("placementTags"))
+	})
+
+	t.Run("invalid stream config", func(t *testing.T) {
+		v := viper.New()
+		err := applyStreamConfig(v, &v1alpha1.JetStreamStreamConfig{Retention: "Forever"})
+		assert.Error(t, err)
+		duplicates := "5 minutes"
+		err = applyStreamConfig(v, &v1alpha1.JetStreamStreamConfig{Duplicates: &duplicates})
+		assert.Error(t, err)
+	})
+}
+
+func TestJetStreamInstallWithStreamConfig(t *testing.T) {
+	testObj := testJetStreamEventBus.DeepCopy()
+	streamConfig := "maxAge: 24h\nmaxMsgs: 100\n"
+	maxMsgs := int64(1000)
+	testObj.Spec.JetStream.StreamConfig = &streamConfig
+	testObj.Spec.JetStream.Stream = &v1alpha1.JetStreamStreamConfig{
+		Retention: v1alpha1.JetStreamRetentionInterest,
+		MaxMsgs:   &maxMsgs,
+	}
+	i := &jetStreamInstaller{
+		client:     fake.NewClientBuilder().Build(),
+		kubeClient: k8sfake.NewSimpleClientset(),
+		eventBus:   testObj,
+		config:     fakeConfig,
+		labels:     testLabels,
+		logger:     zaptest.NewLogger(t).Sugar(),
+	}
+	conf, err := i.Install(context.TODO())
+	assert.NoError(t, err)
+	assert.NotNil(t, conf.JetStream)
+	v := viper.New()
+	v.SetConfigType("yaml")
+	err = v.ReadConfig(bytes.NewBufferString(conf.JetStream.StreamConfig))
+	assert.NoError(t, err)
+	assert.Equal(t, "24h", v.GetString("maxAge"))
+	assert.Equal(t, int64(1000), v.GetInt64("maxMsgs"))
+	assert.Equal(t, 1, v.GetInt("retention"))
+}
+
+func TestJetStreamSharedAccounts(t *testing.T) {
+	ctx := context.TODO()
+	testObj := testJetStreamEventBus.DeepCopy()
+	testObj.Spec.Sharing = &v1alpha1.EventBusSharing{AllowedNamespaces: []string{"tenant-b", "tenant-a"}}
+	serverObj := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testObj.Namespace,
+			Name:      generateJetStreamServerSecretName(testObj),
+		},
+		Data: map[string][]byte{
+			v1alpha1.JetStreamServerSecretAuthKey:        []byte("old"),
+			v1alpha1.JetStreamServerSecretSysPasswordKey: []byte("sys-pass"),
+		},
+	}
+	clientObj := &corev1.Secret{
+		Data: map[string][]byte{
+			v1alpha1.JetStreamClientAuthSecretKey: []byte("username: js-user\npassword: js-pass"),
+		},
+	}
+	cl := fake.NewClientBuilder().WithObjects(serverObj).Build()
+	kubeClient := k8sfake.NewSimpleClientset()
+	i := &jetStreamInstaller{
+		client:     cl,
+		kubeClient: kubeClient,
+		eventBus:   testObj,
+		labels:     testLabels,
+		logger:     zaptest.NewLogger(t).Sugar(),
+	}
+	getServerSecret := func() *corev1.Secret {
+		s := &corev1.Secret{}
+		err := cl.Get(ctx, types.NamespacedName{Namespace: testObj.Namespace, Name: generateJetStreamServerSecretName(testObj)}, s)
+		assert.NoError(t, err)
+		return s
+	}
+	secretName := controllerscommon.GenerateSharedClientAuthSecretName(testObj)
+
+	t.Run("provision shared accounts", func(t *testing.T) {
+		err := i.reconcileSharedAccounts(ctx, serverObj, clientObj)
+		assert.NoError(t, err)
+		s := getServerSecret()
+		assert.Equal(t, "tenant-a,tenant-b", string(s.Data[v1alpha1.JetStreamServerSecretSharedNamespacesKey]))
+		auth := string(s.Data[v1alpha1.JetStreamServerSecretAuthKey])
+		assert.Contains(t, auth, `"user": "js-user", "pass": "js-pass"`)
+		assert.Contains(t, auth, `"user": "sys", "pass": "sys-pass"`)
+		assert.Contains(t, auth, `"ns-tenant-a": {`)
+		assert.Contains(t, auth, `"ns-tenant-b": {`)
+		for _, ns := range []string{"tenant-a", "tenant-b"} {
+			ts, err := kubeClient.CoreV1().Secrets(ns).Get(ctx, secretName, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, testObj.Name, ts.Labels[v1alpha1.LabelEventBusName])
+			assert.Equal(t, testObj.Namespace, ts.Labels[v1alpha1.LabelEventBusNamespace])
+			assert.Contains(t, string(ts.Data[v1alpha1.JetStreamClientAuthSecretKey]), "username: ns-"+ns)
+		}
+	})
+
+	t.Run("revoke shared accounts", func(t *testing.T) {
+		before, err := kubeClient.CoreV1().Secrets("tenant-a").Get(ctx, secretName, metav1.GetOptions{})
+		assert.NoError(t, err)
+		testObj.Spec.Sharing.AllowedNamespaces = []string{"tenant-a"}
+		err = i.reconcileSharedAccounts(ctx, getServerSecret(), clientObj)
+		assert.NoError(t, err)
+		s := getServerSecret()
+		assert.Equal(t, "tenant-a", string(s.Data[v1alpha1.JetStreamServerSecretSharedNamespacesKey]))
+		assert.NotContains(t, string(s.Data[v1alpha1.JetStreamServerSecretAuthKey]), "ns-tenant-b")
+		_, err = kubeClient.CoreV1().Secrets("tenant-b").Get(ctx, secretName, metav1.GetOptions{})
+		assert.Error(t, err)
+		after, err := kubeClient.CoreV1().Secrets("tenant-a").Get(ctx, secretName, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, before.Data, after.Data)
+	})
+
+	t.Run("uninstall shared accounts", func(t *testing.T) {
+		err := i.uninstallSharedAccounts(ctx)
+		assert.NoError(t, err)
+		_, err = kubeClient.CoreV1().Secrets("tenant-a").Get(ctx, secretName, metav1.GetOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("forbidden in shared namespace", func(t *testing.T) {
+		kubeClient.PrependReactor("get", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(corev1.Resource("secrets"), secretName, fmt.Errorf("no permission"))
+		})
+		err := i.reconcileSharedAccounts(ctx, getServerSecret(), clientObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "namespace scoped installation")
+	})
+}
+
+func TestValidateStreamConfigUpdate(t *testing.T) {
+	jsConfig := func(streamConfig string) *v1alpha1.JetStreamConfig {
+		return &v1alpha1.JetStreamConfig{StreamConfig: streamConfig}
+	}
+
+	t.Run("first installation", func(t *testing.T) {
+		assert.NoError(t, validateStreamConfigUpdate(nil, jsConfig("retention: 2\n")))
+		assert.NoError(t, validateStreamConfigUpdate(jsConfig(""), jsConfig("retention: 2\n")))
+	})
+
+	t.Run("updatable settings", func(t *testing.T) {
+		assert.NoError(t, validateStreamConfigUpdate(jsConfig("retention: 0\nmaxMsgs: 10\n"), jsConfig("retention: 1\nmaxMsgs: 100\n")))
+	})
+
+	t.Run("retention changed to or from workqueue", func(t *testing.T) {
+		err := validateStreamConfigUpdate(jsConfig("retention: 0\n"), jsConfig("retention: 2\n"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "WorkQueue")
+		assert.Error(t, validateStreamConfigUpdate(jsConfig("retention: 2\n"), jsConfig("maxMsgs: 100\n")))
+	})
+
+	t.Run("install fails on retention change", func(t *testing.T) {
+		testObj := testJetStreamEventBus.DeepCopy()
+		testObj.Spec.JetStream.Stream = &v1alpha1.JetStreamStreamConfig{
+			Retention: v1alpha1.JetStreamRetentionWorkQueue,
+		}
+		testObj.Status.Config.JetStream = jsConfig("retention: 0\n")
+		err := Install(context.TODO(), testObj, fake.NewClientBuilder().Build(), k8sfake.NewSimpleClientset(), fakeConfig, zaptest.NewLogger(t).Sugar())
+		assert.Error(t, err)
+		assert.False(t, testObj.Status.IsReady())
+		assert.Equal(t, "retention: 0\n", testObj.Status.Config.JetStream.StreamConfig)
+	})
+}
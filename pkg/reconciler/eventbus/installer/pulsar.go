@@ -0,0 +1,47 @@
+package installer
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+// exoticPulsarInstaller is an installation implementation of exotic pulsar config.
+type exoticPulsarInstaller struct {
+	eventBus *v1alpha1.EventBus
+
+	logger *zap.SugaredLogger
+}
+
+// NewExoticPulsarInstaller return a new exoticPulsarInstaller
+func NewExoticPulsarInstaller(eventBus *v1alpha1.EventBus, logger *zap.SugaredLogger) Installer {
+	return &exoticPulsarInstaller{
+		eventBus: eventBus,
+		logger:   logger.Named("exotic-pulsar"),
+	}
+}
+
+func (i *exoticPulsarInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig, error) {
+	pulsarObj := i.eventBus.Spec.Pulsar
+	if pulsarObj == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+	if pulsarObj.Topic == "" {
+		pulsarObj.Topic = fmt.Sprintf("%s-%s", i.eventBus.Namespace, i.eventBus.Name)
+	}
+
+	i.eventBus.Status.MarkDeployed("Skipped", "Skip deployment because of using exotic config.")
+	i.logger.Info("use exotic config")
+	busConfig := &v1alpha1.BusConfig{
+		Pulsar: pulsarObj,
+	}
+	return busConfig, nil
+}
+
+func (i *exoticPulsarInstaller) Uninstall(ctx context.Context) error {
+	i.logger.Info("nothing to uninstall")
+	return nil
+}
@@ -0,0 +1,63 @@
+package installer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+)
+
+const (
+	testPulsarName = "test-pulsar"
+	testPulsarURL  = "pulsar://pulsar:6650"
+)
+
+func testPulsarExoticBus() *v1alpha1.EventBus {
+	return &v1alpha1.EventBus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "EventBus",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testPulsarName,
+		},
+		Spec: v1alpha1.EventBusSpec{
+			Pulsar: &v1alpha1.PulsarBus{
+				URL: testPulsarURL,
+			},
+		},
+	}
+}
+
+func TestInstallationPulsarExotic(t *testing.T) {
+	t.Run("installation with exotic pulsar config", func(t *testing.T) {
+		installer := NewExoticPulsarInstaller(testPulsarExoticBus(), logging.NewArgoEventsLogger())
+		conf, err := installer.Install(context.TODO())
+		assert.NoError(t, err)
+		assert.NotNil(t, conf.Pulsar)
+		assert.Equal(t, testPulsarURL, conf.Pulsar.URL)
+		assert.Equal(t, testNamespace+"-"+testPulsarName, conf.Pulsar.Topic)
+	})
+
+	t.Run("installation with topic specified", func(t *testing.T) {
+		eb := testPulsarExoticBus()
+		eb.Spec.Pulsar.Topic = "events"
+		installer := NewExoticPulsarInstaller(eb, logging.NewArgoEventsLogger())
+		conf, err := installer.Install(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, "events", conf.Pulsar.Topic)
+	})
+}
+
+func TestUninstallationPulsarExotic(t *testing.T) {
+	t.Run("uninstallation with exotic pulsar config", func(t *testing.T) {
+		installer := NewExoticPulsarInstaller(testPulsarExoticBus(), logging.NewArgoEventsLogger())
+		err := installer.Uninstall(context.TODO())
+		assert.NoError(t, err)
+	})
+}
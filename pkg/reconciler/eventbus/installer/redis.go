@@ -0,0 +1,47 @@
+package installer
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+// exoticRedisInstaller is an installation implementation of exotic redis config.
+type exoticRedisInstaller struct {
+	eventBus *v1alpha1.EventBus
+
+	logger *zap.SugaredLogger
+}
+
+// NewExoticRedisInstaller return a new exoticRedisInstaller
+func NewExoticRedisInstaller(eventBus *v1alpha1.EventBus, logger *zap.SugaredLogger) Installer {
+	return &exoticRedisInstaller{
+		eventBus: eventBus,
+		logger:   logger.Named("exotic-redis"),
+	}
+}
+
+func (i *exoticRedisInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig, error) {
+	redisObj := i.eventBus.Spec.Redis
+	if redisObj == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+	if redisObj.Stream == "" {
+		redisObj.Stream = fmt.Sprintf("%s-%s", i.eventBus.Namespace, i.eventBus.Name)
+	}
+
+	i.eventBus.Status.MarkDeployed("Skipped", "Skip deployment because of using exotic config.")
+	i.logger.Info("use exotic config")
+	busConfig := &v1alpha1.BusConfig{
+		Redis: redisObj,
+	}
+	return busConfig, nil
+}
+
+func (i *exoticRedisInstaller) Uninstall(ctx context.Context) error {
+	i.logger.Info("nothing to uninstall")
+	return nil
+}
@@ -0,0 +1,63 @@
+package installer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+)
+
+const (
+	testRedisName = "test-redis"
+	testRedisURL  = "redis:6379"
+)
+
+func testRedisExoticBus() *v1alpha1.EventBus {
+	return &v1alpha1.EventBus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "EventBus",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testRedisName,
+		},
+		Spec: v1alpha1.EventBusSpec{
+			Redis: &v1alpha1.RedisBus{
+				URL: testRedisURL,
+			},
+		},
+	}
+}
+
+func TestInstallationRedisExotic(t *testing.T) {
+	t.Run("installation with exotic redis config", func(t *testing.T) {
+		installer := NewExoticRedisInstaller(testRedisExoticBus(), logging.NewArgoEventsLogger())
+		conf, err := installer.Install(context.TODO())
+		assert.NoError(t, err)
+		assert.NotNil(t, conf.Redis)
+		assert.Equal(t, testRedisURL, conf.Redis.URL)
+		assert.Equal(t, testNamespace+"-"+testRedisName, conf.Redis.Stream)
+	})
+
+	t.Run("installation with stream specified", func(t *testing.T) {
+		eb := testRedisExoticBus()
+		eb.Spec.Redis.Stream = "events"
+		installer := NewExoticRedisInstaller(eb, logging.NewArgoEventsLogger())
+		conf, err := installer.Install(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, "events", conf.Redis.Stream)
+	})
+}
+
+func TestUninstallationRedisExotic(t *testing.T) {
+	t.Run("uninstallation with exotic redis config", func(t *testing.T) {
+		installer := NewExoticRedisInstaller(testRedisExoticBus(), logging.NewArgoEventsLogger())
+		err := installer.Uninstall(context.TODO())
+		assert.NoError(t, err)
+	})
+}
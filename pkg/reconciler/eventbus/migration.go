@@ -0,0 +1,230 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+)
+
+const (
+	// migrationResyncPeriod is how often the progress of an ongoing migration is refreshed.
+	migrationResyncPeriod = 10 * time.Second
+)
+
+// reconcileMigration moves the EventSources and Sensors using the EventBus migrating from
+// to the given EventBus phase by phase, and records the progress in the status.
+//
+// The EventSources start publishing to both of the EventBuses first, once all of them do,
+// the Sensors keep consuming from the old EventBus for the transition window, then they
+// switch over to the new EventBus, and finally the EventSources stop publishing to the old one
+// once all of the Sensors are ready, i.e. they have caught up with the events replayed from the new EventBus.
+func reconcileMigration(ctx context.Context, c client.Client, eventBus *v1alpha1.EventBus) error {
+	log := logging.FromContext(ctx)
+	m := eventBus.Spec.Migration
+	if m == nil {
+		eventBus.Status.Migration = nil
+		return nil
+	}
+	status := eventBus.Status.Migration
+	if status == nil || status.From != m.From {
+		status = &v1alpha1.EventBusMigrationStatus{
+			From:  m.From,
+			Phase: v1alpha1.EventBusMigrationPending,
+		}
+		eventBus.Status.Migration = status
+	}
+
+	namespaces := []string{eventBus.Namespace}
+	oldBus := &v1alpha1.EventBus{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: eventBus.Namespace, Name: m.From}, oldBus); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get EventBus %q, %w", m.From, err)
+		}
+		if status.Phase != v1alpha1.EventBusMigrationCompleted {
+			status.Message = fmt.Sprintf("EventBus %q to migrate from is not found", m.From)
+			return nil
+		}
+	} else {
+		namespaces = append(namespaces, oldBus.SharedNamespaces()...)
+	}
+
+	dualPublishing := func(d *appv1.Deployment) bool {
+		return d.Annotations[v1alpha1.AnnotationSuccessorEventBus] == eventBus.Name
+	}
+	publishingToNew := func(d *appv1.Deployment) bool {
+		return d.Annotations[v1alpha1.AnnotationEventBus] == eventBus.Name && d.Annotations[v1alpha1.AnnotationSuccessorEventBus] == ""
+	}
+	consumingFromNew := func(d *appv1.Deployment) bool {
+		return d.Annotations[v1alpha1.AnnotationEventBus] == eventBus.Name
+	}
+
+	now := metav1.Now()
+	switch status.Phase {
+	case v1alpha1.EventBusMigrationPending:
+		if !eventBus.Status.IsReady() {
+			status.Message = "Waiting for the EventBus to be ready"
+			return nil
+		}
+		log.Infow("start migrating from eventbus", "from", m.From)
+		status.Phase = v1alpha1.EventBusMigrationDualPublishing
+		fallthrough
+	case v1alpha1.EventBusMigrationDualPublishing:
+		var err error
+		if status.EventSources, err = eventSourcesProgress(ctx, c, namespaces, eventBus.Namespace, m.From, dualPublishing); err != nil {
+			return err
+		}
+		if status.Sensors, err = sensorsProgress(ctx, c, namespaces, eventBus.Namespace, m.From, consumingFromNew); err != nil {
+			return err
+		}
+		if !status.EventSources.Done() {
+			status.Message = "Waiting for the EventSources to publish to both of the EventBuses"
+			return nil
+		}
+		if status.DualPublishingSince == nil {
+			status.DualPublishingSince = &now
+		}
+		if remaining := m.GetTransitionWindow() - now.Sub(status.DualPublishingSince.Time); remaining > 0 {
+			status.Message = fmt.Sprintf("All the EventSources publish to both of the EventBuses, Sensors switch over in %v", remaining.Round(time.Second))
+			return nil
+		}
+		log.Infow("switching sensors to the new eventbus", "from", m.From)
+		status.Phase = v1alpha1.EventBusMigrationSwitchingSensors
+		status.SwitchingSensorsSince = &now
+		fallthrough
+	case v1alpha1.EventBusMigrationSwitchingSensors:
+		var err error
+		if status.Sensors, err = sensorsProgress(ctx, c, namespaces, eventBus.Namespace, m.From, consumingFromNew); err != nil {
+			return err
+		}
+		if !status.Sensors.Done() {
+			status.Message = "Waiting for the Sensors to switch over to the new EventBus and catch up with the replayed events"
+			return nil
+		}
+		log.Infow("eventbus migration completed", "from", m.From)
+		status.Phase = v1alpha1.EventBusMigrationCompleted
+		status.CompletedAt = &now
+		fallthrough
+	case v1alpha1.EventBusMigrationCompleted:
+		var err error
+		if status.EventSources, err = eventSourcesProgress(ctx, c, namespaces, eventBus.Namespace, m.From, publishingToNew); err != nil {
+			return err
+		}
+		if status.Sensors, err = sensorsProgress(ctx, c, namespaces, eventBus.Namespace, m.From, consumingFromNew); err != nil {
+			return err
+		}
+		if !status.EventSources.Done() {
+			status.Message = "Waiting for the EventSources to stop publishing to the old EventBus"
+			return nil
+		}
+		status.Message = fmt.Sprintf("Migration completed, EventBus %q is not used any more", m.From)
+	default:
+		return fmt.Errorf("unknown migration phase %q", status.Phase)
+	}
+	return nil
+}
+
+// migrationInProgress tells if the migration status needs to be refreshed later.
+func migrationInProgress(eventBus *v1alpha1.EventBus) bool {
+	s := eventBus.Status.Migration
+	if eventBus.Spec.Migration == nil || s == nil {
+		return false
+	}
+	return s.Phase != v1alpha1.EventBusMigrationCompleted || !s.EventSources.Done()
+}
+
+func eventSourcesProgress(ctx context.Context, c client.Client, namespaces []string, busNamespace, busName string, migrated func(*appv1.Deployment) bool) (v1alpha1.EventBusMigrationProgress, error) {
+	result := v1alpha1.EventBusMigrationProgress{}
+	for _, ns := range namespaces {
+		esl := &v1alpha1.EventSourceList{}
+		if err := c.List(ctx, esl, &client.ListOptions{Namespace: ns}); err != nil {
+			return result, err
+		}
+		for _, es := range esl.Items {
+			if !usesEventBus(es.Namespace, es.Spec.EventBusName, es.Spec.EventBusNamespace, busNamespace, busName) {
+				continue
+			}
+			result.Total++
+			ok, err := deploymentMigrated(ctx, c, &es, v1alpha1.LabelEventSourceName, migrated)
+			if err != nil {
+				return result, err
+			}
+			if ok {
+				result.Migrated++
+			}
+		}
+	}
+	return result, nil
+}
+
+func sensorsProgress(ctx context.Context, c client.Client, namespaces []string, busNamespace, busName string, migrated func(*appv1.Deployment) bool) (v1alpha1.EventBusMigrationProgress, error) {
+	result := v1alpha1.EventBusMigrationProgress{}
+	for _, ns := range namespaces {
+		sl := &v1alpha1.SensorList{}
+		if err := c.List(ctx, sl, &client.ListOptions{Namespace: ns}); err != nil {
+			return result, err
+		}
+		for _, s := range sl.Items {
+			if !usesEventBus(s.Namespace, s.Spec.EventBusName, s.Spec.EventBusNamespace, busNamespace, busName) {
+				continue
+			}
+			result.Total++
+			ok, err := deploymentMigrated(ctx, c, &s, v1alpha1.LabelSensorName, migrated)
+			if err != nil {
+				return result, err
+			}
+			if ok {
+				result.Migrated++
+			}
+		}
+	}
+	return result, nil
+}
+
+func usesEventBus(namespace, eventBusName, eventBusNamespace, busNamespace, busName string) bool {
+	if eventBusName == "" {
+		eventBusName = v1alpha1.DefaultEventBusName
+	}
+	if eventBusNamespace == "" {
+		eventBusNamespace = namespace
+	}
+	return eventBusName == busName && eventBusNamespace == busNamespace
+}
+
+// deploymentMigrated tells if the Deployment of the owner matches the migration phase and has been rolled out.
+func deploymentMigrated(ctx context.Context, c client.Client, owner metav1.Object, nameLabel string, migrated func(*appv1.Deployment) bool) (bool, error) {
+	dl := &appv1.DeploymentList{}
+	if err := c.List(ctx, dl, &client.ListOptions{
+		Namespace:     owner.GetNamespace(),
+		LabelSelector: labels.SelectorFromSet(map[string]string{nameLabel: owner.GetName()}),
+	}); err != nil {
+		return false, err
+	}
+	for _, d := range dl.Items {
+		if !metav1.IsControlledBy(&d, owner) {
+			continue
+		}
+		return migrated(&d) && deploymentRolledOut(&d), nil
+	}
+	return false, nil
+}
+
+func deploymentRolledOut(d *appv1.Deployment) bool {
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	return d.Status.ObservedGeneration >= d.Generation &&
+		d.Status.UpdatedReplicas >= replicas &&
+		d.Status.AvailableReplicas >= replicas &&
+		d.Status.Replicas == d.Status.UpdatedReplicas
+}
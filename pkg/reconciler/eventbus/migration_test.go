@@ -0,0 +1,147 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+)
+
+func fakeMigrationDeployment(owner client.Object, gvk string, nameLabel string) *appv1.Deployment {
+	return &appv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: owner.GetNamespace(),
+			Name:      owner.GetName() + "-deploy",
+			Labels:    map[string]string{nameLabel: owner.GetName()},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: v1alpha1.SchemeGroupVersion.String(),
+					Kind:       gvk,
+					Name:       owner.GetName(),
+					UID:        owner.GetUID(),
+					Controller: ptr.To(true),
+				},
+			},
+			Annotations: map[string]string{v1alpha1.AnnotationEventBus: v1alpha1.DefaultEventBusName},
+		},
+		Status: appv1.DeploymentStatus{
+			Replicas:          1,
+			UpdatedReplicas:   1,
+			AvailableReplicas: 1,
+		},
+	}
+}
+
+func TestReconcileMigration(t *testing.T) {
+	ctx := context.TODO()
+	oldBus := &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: v1alpha1.DefaultEventBusName},
+		Spec: v1alpha1.EventBusSpec{
+			NATS: &v1alpha1.NATSBus{Native: &v1alpha1.NativeStrategy{}},
+		},
+	}
+	newBus := &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "js"},
+		Spec: v1alpha1.EventBusSpec{
+			JetStream: &v1alpha1.JetStreamBus{Version: "testVersion"},
+			Migration: &v1alpha1.EventBusMigration{From: v1alpha1.DefaultEventBusName},
+		},
+	}
+	es := &v1alpha1.EventSource{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "es", UID: "es-uid"}}
+	sensor := &v1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "sensor", UID: "sensor-uid"}}
+	otherSensor := &v1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "other", UID: "other-uid"},
+		Spec:       v1alpha1.SensorSpec{EventBusName: "other"},
+	}
+	esDeploy := fakeMigrationDeployment(es, "EventSource", v1alpha1.LabelEventSourceName)
+	sensorDeploy := fakeMigrationDeployment(sensor, "Sensor", v1alpha1.LabelSensorName)
+	cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(oldBus, es, sensor, otherSensor, esDeploy, sensorDeploy).Build()
+
+	updateAnnotations := func(d *appv1.Deployment, annotations map[string]string) {
+		t.Helper()
+		obj := &appv1.Deployment{}
+		assert.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: d.Namespace, Name: d.Name}, obj))
+		obj.Annotations = annotations
+		assert.NoError(t, cl.Update(ctx, obj))
+	}
+
+	eb := newBus.DeepCopy()
+	t.Run("pending until the eventbus is ready", func(t *testing.T) {
+		assert.NoError(t, reconcileMigration(ctx, cl, eb))
+		assert.Equal(t, v1alpha1.EventBusMigrationPending, eb.Status.Migration.Phase)
+		assert.True(t, migrationInProgress(eb))
+	})
+
+	t.Run("dual publishing", func(t *testing.T) {
+		eb.Status.InitConditions()
+		eb.Status.MarkDeployed("test", "test")
+		eb.Status.MarkConfigured()
+		assert.NoError(t, reconcileMigration(ctx, cl, eb))
+		assert.Equal(t, v1alpha1.EventBusMigrationDualPublishing, eb.Status.Migration.Phase)
+		assert.Equal(t, v1alpha1.EventBusMigrationProgress{Total: 1, Migrated: 0}, eb.Status.Migration.EventSources)
+		assert.Equal(t, v1alpha1.EventBusMigrationProgress{Total: 1, Migrated: 0}, eb.Status.Migration.Sensors)
+		assert.Nil(t, eb.Status.Migration.DualPublishingSince)
+
+		updateAnnotations(esDeploy, map[string]string{
+			v1alpha1.AnnotationEventBus:          v1alpha1.DefaultEventBusName,
+			v1alpha1.AnnotationSuccessorEventBus: "js",
+		})
+		assert.NoError(t, reconcileMigration(ctx, cl, eb))
+		assert.Equal(t, v1alpha1.EventBusMigrationDualPublishing, eb.Status.Migration.Phase)
+		assert.True(t, eb.Status.Migration.EventSources.Done())
+		assert.NotNil(t, eb.Status.Migration.DualPublishingSince)
+		assert.Contains(t, eb.Status.Migration.Message, "Sensors switch over in")
+	})
+
+	t.Run("switching sensors after the transition window", func(t *testing.T) {
+		eb.Status.Migration.DualPublishingSince = &metav1.Time{Time: time.Now().Add(-11 * time.Minute)}
+		assert.NoError(t, reconcileMigration(ctx, cl, eb))
+		assert.Equal(t, v1alpha1.EventBusMigrationSwitchingSensors, eb.Status.Migration.Phase)
+		assert.NotNil(t, eb.Status.Migration.SwitchingSensorsSince)
+		assert.Equal(t, v1alpha1.EventBusMigrationProgress{Total: 1, Migrated: 0}, eb.Status.Migration.Sensors)
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		updateAnnotations(sensorDeploy, map[string]string{v1alpha1.AnnotationEventBus: "js"})
+		assert.NoError(t, reconcileMigration(ctx, cl, eb))
+		assert.Equal(t, v1alpha1.EventBusMigrationCompleted, eb.Status.Migration.Phase)
+		assert.NotNil(t, eb.Status.Migration.CompletedAt)
+		assert.Equal(t, v1alpha1.EventBusMigrationProgress{Total: 1, Migrated: 0}, eb.Status.Migration.EventSources)
+		assert.True(t, migrationInProgress(eb))
+
+		updateAnnotations(esDeploy, map[string]string{v1alpha1.AnnotationEventBus: "js"})
+		assert.NoError(t, reconcileMigration(ctx, cl, eb))
+		assert.Equal(t, v1alpha1.EventBusMigrationCompleted, eb.Status.Migration.Phase)
+		assert.True(t, eb.Status.Migration.EventSources.Done())
+		assert.False(t, migrationInProgress(eb))
+	})
+
+	t.Run("migration removed", func(t *testing.T) {
+		eb.Spec.Migration = nil
+		assert.NoError(t, reconcileMigration(ctx, cl, eb))
+		assert.Nil(t, eb.Status.Migration)
+	})
+}
+
+func TestDeploymentRolledOut(t *testing.T) {
+	d := &appv1.Deployment{
+		Spec:   appv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+		Status: appv1.DeploymentStatus{Replicas: 3, UpdatedReplicas: 2, AvailableReplicas: 2},
+	}
+	assert.False(t, deploymentRolledOut(d))
+	d.Status.Replicas = 2
+	assert.True(t, deploymentRolledOut(d))
+	d.Generation = 2
+	d.Status.ObservedGeneration = 1
+	assert.False(t, deploymentRolledOut(d))
+}
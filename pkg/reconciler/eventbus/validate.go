@@ -2,14 +2,18 @@ package eventbus
 
 import (
 	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 )
 
 // ValidateEventBus accepts an EventBus and performs validation against it
 func ValidateEventBus(eb *v1alpha1.EventBus) error {
-	if eb.Spec.NATS == nil && eb.Spec.JetStream == nil && eb.Spec.Kafka == nil && eb.Spec.JetStreamExotic == nil {
-		return fmt.Errorf("invalid spec: either \"nats\", \"jetstream\", \"jetstreamExotic\", or \"kafka\" needs to be specified")
+	if eb.Spec.NATS == nil && eb.Spec.JetStream == nil && eb.Spec.Kafka == nil && eb.Spec.JetStreamExotic == nil && eb.Spec.Redis == nil && eb.Spec.Pulsar == nil {
+		return fmt.Errorf("invalid spec: either \"nats\", \"jetstream\", \"jetstreamExotic\", \"kafka\", \"redis\" or \"pulsar\" needs to be specified")
 	}
 	if x := eb.Spec.NATS; x != nil {
 		if x.Native != nil && x.Exotic != nil {
@@ -35,16 +39,102 @@ func ValidateEventBus(eb *v1alpha1.EventBus) error {
 		if x.Replicas != nil && (*x.Replicas == 2 || *x.Replicas <= 0) {
 			return fmt.Errorf("invalid spec: a jetstream eventbus requires 1 replica or >= 3 replicas")
 		}
+		if err := validateJetStreamStreamConfig(x.Stream); err != nil {
+			return fmt.Errorf("invalid \"spec.jetstream.stream\": %w", err)
+		}
 	}
 	if x := eb.Spec.Kafka; x != nil {
 		if x.URL == "" {
 			return fmt.Errorf("\"spec.kafka.url\" is missing")
 		}
 	}
+	if x := eb.Spec.Redis; x != nil {
+		if x.URL == "" {
+			return fmt.Errorf("\"spec.redis.url\" is missing")
+		}
+		if x.MaxLen < 0 {
+			return fmt.Errorf("invalid \"spec.redis.maxLen\", it can not be negative")
+		}
+	}
+	if x := eb.Spec.Pulsar; x != nil {
+		if x.URL == "" {
+			return fmt.Errorf("\"spec.pulsar.url\" is missing")
+		}
+		switch x.SubscriptionType {
+		case "", v1alpha1.PulsarSubscriptionShared, v1alpha1.PulsarSubscriptionKeyShared:
+		default:
+			return fmt.Errorf("unsupported \"spec.pulsar.subscriptionType\" %q", x.SubscriptionType)
+		}
+	}
 	if x := eb.Spec.JetStreamExotic; x != nil {
 		if x.URL == "" {
 			return fmt.Errorf("\"spec.jetstreamExotic.url\" is missing")
 		}
+		if err := validateJetStreamStreamConfig(x.Stream); err != nil {
+			return fmt.Errorf("invalid \"spec.jetstreamExotic.stream\": %w", err)
+		}
+	}
+	if x := eb.Spec.Migration; x != nil {
+		if x.From == "" {
+			return fmt.Errorf("\"spec.migration.from\" is missing")
+		}
+		if x.From == eb.Name {
+			return fmt.Errorf("invalid spec: an eventbus can not migrate from itself")
+		}
+		if eb.Spec.JetStream == nil && eb.Spec.JetStreamExotic == nil && eb.Spec.Kafka == nil {
+			// Only JetStream and Kafka are able to replay the events to restore the state of the Sensors switching over.
+			return fmt.Errorf("invalid spec: \"spec.migration\" is only supported by \"jetstream\" and \"kafka\" eventbus")
+		}
+		if x.TransitionWindow != nil {
+			if _, err := time.ParseDuration(*x.TransitionWindow); err != nil {
+				return fmt.Errorf("invalid \"spec.migration.transitionWindow\" %q, %w", *x.TransitionWindow, err)
+			}
+		}
+	}
+	if x := eb.Spec.Sharing; x != nil {
+		if eb.Spec.JetStream == nil {
+			return fmt.Errorf("invalid spec: \"spec.sharing\" is only supported by \"jetstream\" eventbus")
+		}
+		for _, ns := range x.AllowedNamespaces {
+			if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+				return fmt.Errorf("invalid namespace %q in \"spec.sharing.allowedNamespaces\": %s", ns, strings.Join(errs, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+func validateJetStreamStreamConfig(s *v1alpha1.JetStreamStreamConfig) error {
+	if s == nil {
+		return nil
+	}
+	switch s.Retention {
+	case "", v1alpha1.JetStreamRetentionLimits, v1alpha1.JetStreamRetentionInterest, v1alpha1.JetStreamRetentionWorkQueue:
+	default:
+		return fmt.Errorf("unsupported retention policy %q", s.Retention)
+	}
+	switch s.Discard {
+	case "", v1alpha1.JetStreamDiscardOld, v1alpha1.JetStreamDiscardNew:
+	default:
+		return fmt.Errorf("unsupported discard policy %q", s.Discard)
+	}
+	switch s.Compression {
+	case "", v1alpha1.JetStreamCompressionNone, v1alpha1.JetStreamCompressionS2:
+	default:
+		return fmt.Errorf("unsupported compression %q", s.Compression)
+	}
+	if s.MaxAge != nil {
+		if _, err := time.ParseDuration(*s.MaxAge); err != nil {
+			return fmt.Errorf("invalid maxAge %q, %w", *s.MaxAge, err)
+		}
+	}
+	if s.Duplicates != nil {
+		if _, err := time.ParseDuration(*s.Duplicates); err != nil {
+			return fmt.Errorf("invalid duplicates %q, %w", *s.Duplicates, err)
+		}
+	}
+	if s.Replicas != nil && *s.Replicas != 1 && *s.Replicas != 3 && *s.Replicas != 5 {
+		return fmt.Errorf("stream replicas must be 1, 3 or 5")
 	}
 	return nil
 }
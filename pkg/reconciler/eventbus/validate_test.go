@@ -61,6 +61,30 @@ var (
 			},
 		},
 	}
+
+	testRedisEventBus = &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+			Name:      v1alpha1.DefaultEventBusName,
+		},
+		Spec: v1alpha1.EventBusSpec{
+			Redis: &v1alpha1.RedisBus{
+				URL: "redis:6379",
+			},
+		},
+	}
+
+	testPulsarEventBus = &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+			Name:      v1alpha1.DefaultEventBusName,
+		},
+		Spec: v1alpha1.EventBusSpec{
+			Pulsar: &v1alpha1.PulsarBus{
+				URL: "pulsar://pulsar:6650",
+			},
+		},
+	}
 )
 
 func TestValidate(t *testing.T) {
@@ -79,6 +103,16 @@ func TestValidate(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("test good redis eventbus", func(t *testing.T) {
+		err := ValidateEventBus(testRedisEventBus)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test good pulsar eventbus", func(t *testing.T) {
+		err := ValidateEventBus(testPulsarEventBus)
+		assert.NoError(t, err)
+	})
+
 	t.Run("test good js exotic eventbus", func(t *testing.T) {
 		err := ValidateEventBus(testJetStreamExoticBus)
 		assert.NoError(t, err)
@@ -139,6 +173,82 @@ func TestValidate(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("test js eventbus stream config", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.JetStream.Stream = &v1alpha1.JetStreamStreamConfig{
+			Retention:   v1alpha1.JetStreamRetentionInterest,
+			Discard:     v1alpha1.JetStreamDiscardNew,
+			Compression: v1alpha1.JetStreamCompressionS2,
+			MaxAge:      ptr.To("72h"),
+			Replicas:    ptr.To[int32](3),
+		}
+		err := ValidateEventBus(eb)
+		assert.NoError(t, err)
+		eb.Spec.JetStream.Stream.Retention = "Forever"
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported retention policy")
+		eb.Spec.JetStream.Stream.Retention = ""
+		eb.Spec.JetStream.Stream.MaxAge = ptr.To("3 days")
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid maxAge")
+		eb.Spec.JetStream.Stream.MaxAge = nil
+		eb.Spec.JetStream.Stream.Replicas = ptr.To[int32](2)
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "stream replicas must be 1, 3 or 5")
+	})
+
+	t.Run("test js eventbus sharing", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.Sharing = &v1alpha1.EventBusSharing{AllowedNamespaces: []string{"team-a", "team-b"}}
+		err := ValidateEventBus(eb)
+		assert.NoError(t, err)
+		eb.Spec.Sharing.AllowedNamespaces = []string{"Team_A"}
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid namespace")
+		kafka := testKafkaEventBus.DeepCopy()
+		kafka.Spec.Sharing = &v1alpha1.EventBusSharing{AllowedNamespaces: []string{"team-a"}}
+		err = ValidateEventBus(kafka)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported by \"jetstream\" eventbus")
+	})
+
+	t.Run("test eventbus migration", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Name = "js"
+		eb.Spec.Migration = &v1alpha1.EventBusMigration{From: "default", TransitionWindow: ptr.To("30m")}
+		err := ValidateEventBus(eb)
+		assert.NoError(t, err)
+		eb.Spec.Migration.TransitionWindow = ptr.To("abc")
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid \"spec.migration.transitionWindow\"")
+		eb.Spec.Migration = &v1alpha1.EventBusMigration{}
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "\"spec.migration.from\" is missing")
+		eb.Spec.Migration.From = eb.Name
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not migrate from itself")
+		nats := testNatsEventBus.DeepCopy()
+		nats.Spec.Migration = &v1alpha1.EventBusMigration{From: "js"}
+		err = ValidateEventBus(nats)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "\"spec.migration\" is only supported by \"jetstream\" and \"kafka\" eventbus")
+		kafka := testKafkaEventBus.DeepCopy()
+		kafka.Spec.Migration = &v1alpha1.EventBusMigration{From: "js"}
+		err = ValidateEventBus(kafka)
+		assert.NoError(t, err)
+		exotic := testJetStreamExoticBus.DeepCopy()
+		exotic.Spec.Migration = &v1alpha1.EventBusMigration{From: "js"}
+		err = ValidateEventBus(exotic)
+		assert.NoError(t, err)
+	})
+
 	t.Run("test kafka eventbus no URL", func(t *testing.T) {
 		eb := testKafkaEventBus.DeepCopy()
 		eb.Spec.Kafka.URL = ""
@@ -154,4 +264,31 @@ func TestValidate(t *testing.T) {
 		assert.Error(t, err)
 		assert.True(t, strings.Contains(err.Error(), "\"spec.jetstreamExotic.url\" is missing"))
 	})
+	t.Run("test redis eventbus", func(t *testing.T) {
+		eb := testRedisEventBus.DeepCopy()
+		eb.Spec.Redis.URL = ""
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "\"spec.redis.url\" is missing")
+		eb = testRedisEventBus.DeepCopy()
+		eb.Spec.Redis.MaxLen = -1
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be negative")
+	})
+
+	t.Run("test pulsar eventbus", func(t *testing.T) {
+		eb := testPulsarEventBus.DeepCopy()
+		eb.Spec.Pulsar.URL = ""
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "\"spec.pulsar.url\" is missing")
+		eb = testPulsarEventBus.DeepCopy()
+		eb.Spec.Pulsar.SubscriptionType = v1alpha1.PulsarSubscriptionShared
+		assert.NoError(t, ValidateEventBus(eb))
+		eb.Spec.Pulsar.SubscriptionType = "Exclusive"
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported \"spec.pulsar.subscriptionType\"")
+	})
 }
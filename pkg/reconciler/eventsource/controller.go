@@ -7,12 +7,15 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	controllerscommon "github.com/argoproj/argo-events/pkg/reconciler/common"
 	"github.com/argoproj/argo-events/pkg/shared/logging"
 )
 
@@ -104,3 +107,31 @@ func (r *reconciler) needsUpdate(old, new *v1alpha1.EventSource) bool {
 	}
 	return false
 }
+
+// EventBusMigrationMapFunc returns a function mapping a successor EventBus to the EventSources using the EventBus
+// it migrates from, so that they are reconciled when the migration moves on.
+func EventBusMigrationMapFunc(c client.Client) handler.TypedMapFunc[*v1alpha1.EventBus, reconcile.Request] {
+	return func(ctx context.Context, eventBus *v1alpha1.EventBus) []reconcile.Request {
+		result := []reconcile.Request{}
+		for _, ns := range controllerscommon.GetMigrationNamespaces(ctx, c, eventBus) {
+			esl := &v1alpha1.EventSourceList{}
+			if err := c.List(ctx, esl, &client.ListOptions{Namespace: ns}); err != nil {
+				continue
+			}
+			for _, eventSource := range esl.Items {
+				eventBusName := eventSource.Spec.EventBusName
+				if eventBusName == "" {
+					eventBusName = v1alpha1.DefaultEventBusName
+				}
+				eventBusNamespace := eventSource.Spec.EventBusNamespace
+				if eventBusNamespace == "" {
+					eventBusNamespace = eventSource.Namespace
+				}
+				if eventBusName == eventBus.Spec.Migration.From && eventBusNamespace == eventBus.Namespace {
+					result = append(result, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: eventSource.Namespace, Name: eventSource.Name}})
+				}
+			}
+		}
+		return result
+	}
+}
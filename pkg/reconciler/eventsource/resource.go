@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
@@ -26,6 +27,9 @@ type AdaptorArgs struct {
 	Image       string
 	EventSource *v1alpha1.EventSource
 	Labels      map[string]string
+	// SuccessorEventBus is the EventBus being migrated to, the EventSource publishes to both
+	// of the EventBuses when it is set.
+	SuccessorEventBus *v1alpha1.EventBus
 }
 
 // Reconcile does the real logic
@@ -37,23 +41,54 @@ func Reconcile(client client.Client, args *AdaptorArgs, logger *zap.SugaredLogge
 	if len(eventSource.Spec.EventBusName) > 0 {
 		eventBusName = eventSource.Spec.EventBusName
 	}
-	err := client.Get(ctx, types.NamespacedName{Namespace: eventSource.Namespace, Name: eventBusName}, eventBus)
+	eventBusNamespace := eventSource.Namespace
+	if len(eventSource.Spec.EventBusNamespace) > 0 {
+		eventBusNamespace = eventSource.Spec.EventBusNamespace
+	}
+	successor, err := controllerscommon.GetSuccessorEventBus(ctx, client, eventBusNamespace, eventBusName)
+	if err != nil {
+		eventSource.Status.MarkDeployFailed("GetEventBusFailed", "Failed to get successor EventBus.")
+		logger.Errorw("failed to get successor EventBus", "eventBusName", eventBusName, "eventBusNamespace", eventBusNamespace, "error", err)
+		return err
+	}
+	err = client.Get(ctx, types.NamespacedName{Namespace: eventBusNamespace, Name: eventBusName}, eventBus)
+	if apierrors.IsNotFound(err) && controllerscommon.GetMigrationPhase(successor) == v1alpha1.EventBusMigrationCompleted {
+		// The old EventBus is allowed to be deleted after the migration is completed.
+		eventBus, err = successor, nil
+	}
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			eventSource.Status.MarkDeployFailed("EventBusNotFound", "EventBus not found.")
-			logger.Errorw("EventBus not found", "eventBusName", eventBusName, "error", err)
+			logger.Errorw("EventBus not found", "eventBusName", eventBusName, "eventBusNamespace", eventBusNamespace, "error", err)
 			return fmt.Errorf("eventbus %s not found", eventBusName)
 		}
 		eventSource.Status.MarkDeployFailed("GetEventBusFailed", "Failed to get EventBus.")
-		logger.Errorw("failed to get EventBus", "eventBusName", eventBusName, "error", err)
+		logger.Errorw("failed to get EventBus", "eventBusName", eventBusName, "eventBusNamespace", eventBusNamespace, "error", err)
 		return err
 	}
+	if !eventBus.IsSharedWith(eventSource.Namespace) {
+		eventSource.Status.MarkDeployFailed("EventBusNotShared", "EventBus is not shared with the namespace.")
+		logger.Errorw("event bus is not shared with the namespace", "eventBusName", eventBusName, "eventBusNamespace", eventBusNamespace)
+		return fmt.Errorf("eventbus %s/%s is not shared with namespace %s", eventBusNamespace, eventBusName, eventSource.Namespace)
+	}
 	if !eventBus.Status.IsReady() {
 		eventSource.Status.MarkDeployFailed("EventBusNotReady", "EventBus not ready.")
 		logger.Errorw("event bus is not in ready status", "eventBusName", eventBusName, "error", err)
 		return fmt.Errorf("eventbus not ready")
 	}
 
+	args.SuccessorEventBus = nil
+	switch controllerscommon.GetMigrationPhase(successor) {
+	case v1alpha1.EventBusMigrationDualPublishing, v1alpha1.EventBusMigrationSwitchingSensors:
+		if successor.IsSharedWith(eventSource.Namespace) {
+			args.SuccessorEventBus = successor
+		}
+	case v1alpha1.EventBusMigrationCompleted:
+		if successor.IsSharedWith(eventSource.Namespace) {
+			eventBus = successor
+		}
+	}
+
 	expectedDeploy, err := buildDeployment(args, eventBus)
 	if err != nil {
 		eventSource.Status.MarkDeployFailed("BuildDeploymentSpecFailed", "Failed to build Deployment spec.")
@@ -71,7 +106,13 @@ func Reconcile(client client.Client, args *AdaptorArgs, logger *zap.SugaredLogge
 		if deploy.Annotations != nil && deploy.Annotations[v1alpha1.AnnotationResourceSpecHash] != expectedDeploy.Annotations[v1alpha1.AnnotationResourceSpecHash] {
 			deploy.Spec = expectedDeploy.Spec
 			deploy.SetLabels(expectedDeploy.Labels)
-			deploy.Annotations[v1alpha1.AnnotationResourceSpecHash] = expectedDeploy.Annotations[v1alpha1.AnnotationResourceSpecHash]
+			for _, k := range []string{v1alpha1.AnnotationResourceSpecHash, v1alpha1.AnnotationEventBus, v1alpha1.AnnotationSuccessorEventBus} {
+				if v, ok := expectedDeploy.Annotations[k]; ok {
+					deploy.Annotations[k] = v
+				} else {
+					delete(deploy.Annotations, k)
+				}
+			}
 			err = client.Update(ctx, deploy)
 			if err != nil {
 				eventSource.Status.MarkDeployFailed("UpdateDeploymentFailed", "Failed to update existing deployment")
@@ -175,7 +216,11 @@ func buildDeployment(args *AdaptorArgs, eventBus *v1alpha1.EventBus) (*appv1.Dep
 	if err != nil {
 		return nil, fmt.Errorf("failed marshal eventsource spec")
 	}
-	busConfigBytes, err := json.Marshal(eventBus.Status.Config)
+	busConfig, err := controllerscommon.GetBusConfig(eventBus, args.EventSource.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	busConfigBytes, err := json.Marshal(busConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed marshal event bus config: %v", err)
 	}
@@ -223,31 +268,87 @@ func buildDeployment(args *AdaptorArgs, eventBus *v1alpha1.EventBus) (*appv1.Dep
 	var clientCertSecret *corev1.SecretKeySelector
 	var clientKeySecret *corev1.SecretKeySelector
 	switch {
-	case eventBus.Status.Config.NATS != nil:
+	case busConfig.NATS != nil:
 		caCertSecret = nil
 		clientCertSecret = nil
 		clientKeySecret = nil
-		accessSecret = eventBus.Status.Config.NATS.AccessSecret
+		accessSecret = busConfig.NATS.AccessSecret
 		secretObjs = []interface{}{eventSourceCopy}
-	case eventBus.Status.Config.JetStream != nil:
-		tlsOptions := eventBus.Status.Config.JetStream.TLS
+	case busConfig.JetStream != nil:
+		tlsOptions := busConfig.JetStream.TLS
 		if tlsOptions != nil {
 			caCertSecret = tlsOptions.CACertSecret
 			clientCertSecret = tlsOptions.ClientCertSecret
 			clientKeySecret = tlsOptions.ClientKeySecret
 		}
-		accessSecret = eventBus.Status.Config.JetStream.AccessSecret
+		accessSecret = busConfig.JetStream.AccessSecret
 		secretObjs = []interface{}{eventSourceCopy}
-	case eventBus.Status.Config.Kafka != nil:
+	case busConfig.Kafka != nil:
 		caCertSecret = nil
 		clientCertSecret = nil
 		clientKeySecret = nil
 		accessSecret = nil
 		secretObjs = []interface{}{eventSourceCopy, eventBus} // kafka requires secrets for sasl and tls
+	case busConfig.Redis != nil, busConfig.Pulsar != nil:
+		caCertSecret = nil
+		clientCertSecret = nil
+		clientKeySecret = nil
+		accessSecret = nil
+		secretObjs = []interface{}{eventSourceCopy, eventBus} // redis and pulsar require secrets for auth and tls
 	default:
 		return nil, fmt.Errorf("unsupported event bus")
 	}
 
+	certSecrets := []*corev1.SecretKeySelector{caCertSecret, clientCertSecret, clientKeySecret}
+	annotations := map[string]string{v1alpha1.AnnotationEventBus: eventBus.Name}
+	if successor := args.SuccessorEventBus; successor != nil {
+		successorConfig, err := controllerscommon.GetBusConfig(successor, args.EventSource.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		successorConfigBytes, err := json.Marshal(successorConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed marshal successor event bus config: %v", err)
+		}
+		env = append(env, corev1.EnvVar{
+			Name:  v1alpha1.EnvVarEventBusSuccessorConfig,
+			Value: base64.StdEncoding.EncodeToString(successorConfigBytes),
+		})
+		var successorAccessSecret *corev1.SecretKeySelector
+		switch {
+		case successorConfig.JetStream != nil:
+			if tlsOptions := successorConfig.JetStream.TLS; tlsOptions != nil {
+				certSecrets = append(certSecrets, tlsOptions.CACertSecret, tlsOptions.ClientCertSecret, tlsOptions.ClientKeySecret)
+			}
+			successorAccessSecret = successorConfig.JetStream.AccessSecret
+		case successorConfig.Kafka != nil, successorConfig.Redis != nil, successorConfig.Pulsar != nil:
+			secretObjs = append(secretObjs, successor)
+		default:
+			return nil, fmt.Errorf("unsupported successor event bus")
+		}
+		if successorAccessSecret != nil {
+			volumes = append(volumes, corev1.Volume{
+				Name: "successor-auth-volume",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: successorAccessSecret.Name,
+						Items: []corev1.KeyToPath{
+							{
+								Key:  successorAccessSecret.Key,
+								Path: "auth.yaml",
+							},
+						},
+					},
+				},
+			})
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name:      "successor-auth-volume",
+				MountPath: v1alpha1.EventBusSuccessorAuthFileMountPath,
+			})
+		}
+		annotations[v1alpha1.AnnotationSuccessorEventBus] = successor.Name
+	}
+
 	if accessSecret != nil {
 		// Mount the secret as volume instead of using envFrom to gain the ability
 		// for the sensor deployment to auto reload when the secret changes
@@ -272,8 +373,8 @@ func buildDeployment(args *AdaptorArgs, eventBus *v1alpha1.EventBus) (*appv1.Dep
 	}
 
 	uniqueCertVolumeMap := make(map[string][]corev1.KeyToPath)
-	for _, secret := range []*corev1.SecretKeySelector{caCertSecret, clientCertSecret, clientKeySecret} {
-		if secret != nil {
+	for _, secret := range certSecrets {
+		if secret != nil && !slices.ContainsFunc(uniqueCertVolumeMap[secret.Name], func(k corev1.KeyToPath) bool { return k.Key == secret.Key }) {
 			uniqueCertVolumeMap[secret.Name] = append(uniqueCertVolumeMap[secret.Name], corev1.KeyToPath{
 				Key:  secret.Key,
 				Path: secret.Key,
@@ -335,6 +436,7 @@ func buildDeployment(args *AdaptorArgs, eventBus *v1alpha1.EventBus) (*appv1.Dep
 			Namespace:    args.EventSource.Namespace,
 			GenerateName: fmt.Sprintf("%s-eventsource-", args.EventSource.Name),
 			Labels:       mergeLabels(args.EventSource.Labels, args.Labels),
+			Annotations:  annotations,
 		},
 		Spec: *deploymentSpec,
 	}
@@ -208,6 +208,50 @@ func Test_BuildDeployment(t *testing.T) {
 		assert.True(t, hasCertVolumeMount)
 	})
 
+	t.Run("test successor eventbus during migration", func(t *testing.T) {
+		successor := fakeEventBusJetstreamWithTLS.DeepCopy()
+		successor.Name = "js"
+		successor.Spec.Migration = &v1alpha1.EventBusMigration{From: v1alpha1.DefaultEventBusName}
+		successor.Status.Config.JetStream.AccessSecret = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "js-client-auth"},
+			Key:                  "client-auth",
+		}
+		successor.Status.Config.JetStream.TLS.CACertSecret.Name = "secret-2"
+		args := &AdaptorArgs{
+			Image:             testImage,
+			EventSource:       testEventSource,
+			Labels:            testLabels,
+			SuccessorEventBus: successor,
+		}
+		deployment, err := buildDeployment(args, fakeEventBus.DeepCopy())
+		assert.Nil(t, err)
+		assert.Equal(t, v1alpha1.DefaultEventBusName, deployment.Annotations[v1alpha1.AnnotationEventBus])
+		assert.Equal(t, "js", deployment.Annotations[v1alpha1.AnnotationSuccessorEventBus])
+		hasSuccessorConfig := false
+		for _, e := range deployment.Spec.Template.Spec.Containers[0].Env {
+			if e.Name == v1alpha1.EnvVarEventBusSuccessorConfig {
+				hasSuccessorConfig = true
+				assert.NotEmpty(t, e.Value)
+			}
+		}
+		assert.True(t, hasSuccessorConfig)
+		volumes := map[string]corev1.Volume{}
+		for _, v := range deployment.Spec.Template.Spec.Volumes {
+			volumes[v.Name] = v
+		}
+		assert.Contains(t, volumes, "secret-2")
+		assert.Contains(t, volumes, "successor-auth-volume")
+		assert.Equal(t, "js-client-auth", volumes["successor-auth-volume"].Secret.SecretName)
+		hasSuccessorAuthMount := false
+		for _, m := range deployment.Spec.Template.Spec.Containers[0].VolumeMounts {
+			if m.Name == "successor-auth-volume" {
+				hasSuccessorAuthMount = true
+				assert.Equal(t, v1alpha1.EventBusSuccessorAuthFileMountPath, m.MountPath)
+			}
+		}
+		assert.True(t, hasSuccessorAuthMount)
+	})
+
 	t.Run("test secret volume and volumemount order deterministic", func(t *testing.T) {
 		args := &AdaptorArgs{
 			Image:       testImage,
@@ -309,6 +353,50 @@ func TestResourceReconcile(t *testing.T) {
 		assert.False(t, testEventSource.Status.IsReady())
 	})
 
+	t.Run("test resource reconcile with eventbus not shared", func(t *testing.T) {
+		ctx := context.TODO()
+		cl := fake.NewClientBuilder().Build()
+		testBus := fakeEventBusJetstream.DeepCopy()
+		testBus.Namespace = "bus"
+		testBus.Status.MarkDeployed("test", "test")
+		testBus.Status.MarkConfigured()
+		err := cl.Create(ctx, testBus)
+		assert.Nil(t, err)
+		es := testEventSource.DeepCopy()
+		es.Spec.EventBusNamespace = "bus"
+		args := &AdaptorArgs{
+			Image:       testImage,
+			EventSource: es,
+			Labels:      testLabels,
+		}
+		err = Reconcile(cl, args, logging.NewArgoEventsLogger())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is not shared with namespace")
+		assert.False(t, es.Status.IsReady())
+	})
+
+	t.Run("test resource reconcile with shared eventbus", func(t *testing.T) {
+		ctx := context.TODO()
+		cl := fake.NewClientBuilder().Build()
+		testBus := fakeEventBusJetstream.DeepCopy()
+		testBus.Namespace = "bus"
+		testBus.Spec.Sharing = &v1alpha1.EventBusSharing{AllowedNamespaces: []string{testNamespace}}
+		testBus.Status.MarkDeployed("test", "test")
+		testBus.Status.MarkConfigured()
+		err := cl.Create(ctx, testBus)
+		assert.Nil(t, err)
+		es := testEventSource.DeepCopy()
+		es.Spec.EventBusNamespace = "bus"
+		args := &AdaptorArgs{
+			Image:       testImage,
+			EventSource: es,
+			Labels:      testLabels,
+		}
+		err = Reconcile(cl, args, logging.NewArgoEventsLogger())
+		assert.Nil(t, err)
+		assert.True(t, es.Status.IsReady())
+	})
+
 	for _, eb := range []*v1alpha1.EventBus{fakeEventBus, fakeEventBusJetstream, fakeEventBusKafka} {
 		testBus := eb.DeepCopy()
 
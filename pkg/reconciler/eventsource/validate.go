@@ -2,10 +2,15 @@ package eventsource
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/eventsources"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/routing"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/schema"
+	"github.com/argoproj/argo-events/pkg/eventsources/common/transform"
 )
 
 // ValidateEventSource validates if the eventSource is valid
@@ -15,7 +20,7 @@ func ValidateEventSource(eventSource *aev1.EventSource) error {
 		recreateTypes[esType] = true
 	}
 
-	servers, _ := eventsources.GetEventingServers(eventSource, nil)
+	servers, options := eventsources.GetEventingServers(eventSource, nil)
 
 	eventNames := make(map[string]bool)
 	rollingUpdates, recreates := 0, 0
@@ -45,6 +50,63 @@ func ValidateEventSource(eventSource *aev1.EventSource) error {
 		}
 	}
 
+	// The routed events would be mixed up with the events of another event, or with the other routed events.
+	routedNames := make(map[string]string)
+	routedEvents := make([]string, 0, len(options))
+	for eName, opts := range options {
+		if len(opts.Routes) != 0 {
+			routedEvents = append(routedEvents, eName)
+		}
+	}
+	sort.Strings(routedEvents)
+	for _, eName := range routedEvents {
+		if err := routing.Validate(options[eName].Routes); err != nil {
+			eventSource.Status.MarkSourcesNotProvided("InvalidEventSource", fmt.Sprintf("Invalid routes: %s - %s", eventSource.Name, eName))
+			return fmt.Errorf("invalid routes of %q, %w", eName, err)
+		}
+		for _, route := range options[eName].Routes {
+			if eventNames[route.EventName] {
+				eventSource.Status.MarkSourcesNotProvided("InvalidEventSource", fmt.Sprintf("Invalid routes: %s - %s", eventSource.Name, eName))
+				return fmt.Errorf("routed event name %q of %q is the name of an event", route.EventName, eName)
+			}
+			if other, ok := routedNames[route.EventName]; ok {
+				eventSource.Status.MarkSourcesNotProvided("InvalidEventSource", fmt.Sprintf("Invalid routes: %s - %s", eventSource.Name, eName))
+				return fmt.Errorf("routed event name %q of %q is also routed by %q", route.EventName, eName, other)
+			}
+			routedNames[route.EventName] = eName
+		}
+	}
+
+	for eName, opts := range options {
+		spec := opts.Schema
+		if spec == nil {
+			continue
+		}
+		if err := schema.Validate(spec); err != nil {
+			eventSource.Status.MarkSourcesNotProvided("InvalidEventSource", fmt.Sprintf("Invalid schema: %s - %s", eventSource.Name, eName))
+			return fmt.Errorf("invalid schema of %q, %w", eName, err)
+		}
+		if spec.DeadLetter != nil && eventNames[spec.DeadLetter.EventName] {
+			// The dead letter events would be mixed up with the events of another event.
+			eventSource.Status.MarkSourcesNotProvided("InvalidEventSource", fmt.Sprintf("Invalid schema: %s - %s", eventSource.Name, eName))
+			return fmt.Errorf("dead letter event name %q of %q is the name of an event", spec.DeadLetter.EventName, eName)
+		}
+		if spec.DeadLetter != nil && routedNames[spec.DeadLetter.EventName] != "" {
+			eventSource.Status.MarkSourcesNotProvided("InvalidEventSource", fmt.Sprintf("Invalid schema: %s - %s", eventSource.Name, eName))
+			return fmt.Errorf("dead letter event name %q of %q is a routed event name", spec.DeadLetter.EventName, eName)
+		}
+	}
+
+	for eName, opts := range options {
+		if opts.Transform == nil {
+			continue
+		}
+		if err := transform.Validate(opts.Transform); err != nil {
+			eventSource.Status.MarkSourcesNotProvided("InvalidEventSource", fmt.Sprintf("Invalid transform: %s - %s", eventSource.Name, eName))
+			return fmt.Errorf("invalid transform of %q, %w", eName, err)
+		}
+	}
+
 	if rollingUpdates > 0 && recreates > 0 {
 		// We don't allow this as if we use recreate strategy for the deployment it will have downtime
 		eventSource.Status.MarkSourcesNotProvided("InvalidEventSource", "Some types of event sources can not be put in one spec")
@@ -54,3 +116,36 @@ func ValidateEventSource(eventSource *aev1.EventSource) error {
 	eventSource.Status.MarkSourcesProvided()
 	return nil
 }
+
+// minioEventOptions are the options of the other event sources set on the Minio events of an EventSource
+type minioEventOptions struct {
+	Spec struct {
+		Minio map[string]struct {
+			Schema    json.RawMessage `json:"schema"`
+			Transform json.RawMessage `json:"transform"`
+			Routes    json.RawMessage `json:"routes"`
+		} `json:"minio"`
+	} `json:"spec"`
+}
+
+// ValidateMinioEventOptions rejects the schema, transform and routes set on the Minio events of a raw EventSource.
+// The spec of a Minio event is the S3 artifact shared with the triggers, which does not have them, so they would be
+// silently ignored.
+func ValidateMinioEventOptions(raw []byte) error {
+	options := &minioEventOptions{}
+	if err := json.Unmarshal(raw, options); err != nil {
+		return err
+	}
+	eventNames := make([]string, 0, len(options.Spec.Minio))
+	for eName := range options.Spec.Minio {
+		eventNames = append(eventNames, eName)
+	}
+	sort.Strings(eventNames)
+	for _, eName := range eventNames {
+		opts := options.Spec.Minio[eName]
+		if len(opts.Schema) != 0 || len(opts.Transform) != 0 || len(opts.Routes) != 0 {
+			return fmt.Errorf("the minio event %q does not support schema, transform and routes", eName)
+		}
+	}
+	return nil
+}
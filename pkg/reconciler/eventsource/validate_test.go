@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 )
 
 func TestValidate(t *testing.T) {
@@ -39,4 +42,114 @@ func TestValidate(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, "more than one \"test\" found in the spec", err.Error())
 	})
+
+	t.Run("validate schema", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Kafka = fakeKafkaEventSourceMap("test1")
+		testEventSource.Spec.MQTT = fakeMQTTEventSourceMap("test2")
+		kafka := testEventSource.Spec.Kafka["test1"]
+		kafka.Schema = &aev1.EventSourceSchema{
+			Inline:     `{"type": "object", "required": ["value"]}`,
+			DeadLetter: &aev1.EventSourceDeadLetter{EventName: "test1-invalid"},
+		}
+		testEventSource.Spec.Kafka["test1"] = kafka
+		assert.NoError(t, ValidateEventSource(testEventSource))
+
+		kafka.Schema.DeadLetter.EventName = "test2"
+		err := ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Equal(t, "dead letter event name \"test2\" of \"test1\" is the name of an event", err.Error())
+
+		kafka.Schema.Inline = `{"type": 1}`
+		err = ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid schema of \"test1\"")
+	})
+
+	t.Run("validate transform", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Kafka = fakeKafkaEventSourceMap("test1")
+		kafka := testEventSource.Spec.Kafka["test1"]
+		kafka.Transform = &aev1.EventSourceTransform{
+			JQ:   "{id: .value.id}",
+			Mask: []string{"$..email"},
+		}
+		testEventSource.Spec.Kafka["test1"] = kafka
+		assert.NoError(t, ValidateEventSource(testEventSource))
+
+		kafka.Transform.Mask = []string{"email"}
+		err := ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid transform of \"test1\"")
+	})
+
+	t.Run("validate resource transform", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Resource = map[string]aev1.ResourceEventSource{
+			"test1": {
+				GroupVersionResource: metav1.GroupVersionResource{Version: "v1", Resource: "pods"},
+				EventTypes:           []aev1.ResourceEventType{aev1.ADD},
+				Transform:            &aev1.EventSourceTransform{Mask: []string{"$..env"}},
+			},
+		}
+		assert.NoError(t, ValidateEventSource(testEventSource))
+
+		testEventSource.Spec.Resource["test1"].Transform.Mask = []string{"env"}
+		err := ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid transform of \"test1\"")
+	})
+
+	t.Run("validate routes", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Kafka = fakeKafkaEventSourceMap("test1")
+		testEventSource.Spec.MQTT = fakeMQTTEventSourceMap("test2")
+		kafka := testEventSource.Spec.Kafka["test1"]
+		kafka.Routes = []aev1.EventSourceRoute{
+			{EventName: "created", JSONPath: "$.value.type", Value: "created"},
+			{EventName: "deleted", Expression: `value.type == "deleted"`},
+		}
+		testEventSource.Spec.Kafka["test1"] = kafka
+		assert.NoError(t, ValidateEventSource(testEventSource))
+
+		kafka.Routes[1].EventName = "test2"
+		err := ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Equal(t, "routed event name \"test2\" of \"test1\" is the name of an event", err.Error())
+
+		mqtt := testEventSource.Spec.MQTT["test2"]
+		mqtt.Routes = []aev1.EventSourceRoute{{EventName: "created", Expression: "true"}}
+		testEventSource.Spec.MQTT["test2"] = mqtt
+		kafka.Routes[1].EventName = "deleted"
+		err = ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Equal(t, "routed event name \"created\" of \"test2\" is also routed by \"test1\"", err.Error())
+
+		mqtt.Routes = nil
+		testEventSource.Spec.MQTT["test2"] = mqtt
+		kafka.Schema = &aev1.EventSourceSchema{
+			Inline:     `{"type": "object"}`,
+			DeadLetter: &aev1.EventSourceDeadLetter{EventName: "deleted"},
+		}
+		testEventSource.Spec.Kafka["test1"] = kafka
+		err = ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Equal(t, "dead letter event name \"deleted\" of \"test1\" is a routed event name", err.Error())
+
+		kafka.Schema = nil
+		kafka.Routes[0].JSONPath = ""
+		testEventSource.Spec.Kafka["test1"] = kafka
+		err = ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid routes of \"test1\"")
+	})
+	t.Run("validate minio event options", func(t *testing.T) {
+		raw := []byte(`{"spec":{"minio":{"uploads":{"endpoint":"minio:9000"}}}}`)
+		assert.NoError(t, ValidateMinioEventOptions(raw))
+
+		raw = []byte(`{"spec":{"minio":{"uploads":{"endpoint":"minio:9000","transform":{"jq":"."}}}}}`)
+		err := ValidateMinioEventOptions(raw)
+		assert.Error(t, err)
+		assert.Equal(t, "the minio event \"uploads\" does not support schema, transform and routes", err.Error())
+	})
 }
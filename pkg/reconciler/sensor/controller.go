@@ -18,19 +18,25 @@ package sensor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	controllerscommon "github.com/argoproj/argo-events/pkg/reconciler/common"
 	"github.com/argoproj/argo-events/pkg/shared/logging"
 )
 
@@ -39,8 +45,15 @@ const (
 	ControllerName = "sensor-controller"
 
 	finalizerName = ControllerName
+
+	// switchOverResyncPeriod is how often a Sensor switching over to a new EventBus is checked
+	// while its Deployment on the old EventBus is being scaled down.
+	switchOverResyncPeriod = 5 * time.Second
 )
 
+// errScalingDown is returned while the Deployment of a Sensor consuming from the old EventBus is being scaled down.
+var errScalingDown = errors.New("waiting for the sensor deployment on the old eventbus to be scaled down")
+
 type reconciler struct {
 	client client.Client
 	scheme *runtime.Scheme
@@ -67,7 +80,13 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	log := r.logger.With("namespace", sensor.Namespace).With("sensor", sensor.Name)
 	ctx = logging.WithLogger(ctx, log)
 	sensorCopy := sensor.DeepCopy()
+	result := ctrl.Result{}
 	reconcileErr := r.reconcile(ctx, sensorCopy)
+	if errors.Is(reconcileErr, errScalingDown) {
+		log.Info(reconcileErr.Error())
+		result.RequeueAfter = switchOverResyncPeriod
+		reconcileErr = nil
+	}
 	if reconcileErr != nil {
 		log.Errorw("reconcile error", zap.Error(reconcileErr))
 	}
@@ -80,7 +99,7 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	if err := r.client.Status().Update(ctx, sensorCopy); err != nil {
 		return reconcile.Result{}, err
 	}
-	return ctrl.Result{}, reconcileErr
+	return result, reconcileErr
 }
 
 // reconcile does the real logic
@@ -103,17 +122,36 @@ func (r *reconciler) reconcile(ctx context.Context, sensor *v1alpha1.Sensor) err
 	if len(sensor.Spec.EventBusName) > 0 {
 		eventBusName = sensor.Spec.EventBusName
 	}
-	err := r.client.Get(ctx, types.NamespacedName{Namespace: sensor.Namespace, Name: eventBusName}, eventBus)
+	eventBusNamespace := sensor.Namespace
+	if len(sensor.Spec.EventBusNamespace) > 0 {
+		eventBusNamespace = sensor.Spec.EventBusNamespace
+	}
+	successor, err := controllerscommon.GetSuccessorEventBus(ctx, r.client, eventBusNamespace, eventBusName)
+	if err != nil {
+		sensor.Status.MarkDeployFailed("GetEventBusFailed", "Failed to get successor EventBus.")
+		log.Errorw("failed to get successor EventBus", "eventBusName", eventBusName, "eventBusNamespace", eventBusNamespace, "error", err)
+		return err
+	}
+	err = r.client.Get(ctx, types.NamespacedName{Namespace: eventBusNamespace, Name: eventBusName}, eventBus)
+	if apierrors.IsNotFound(err) && controllerscommon.GetMigrationPhase(successor) == v1alpha1.EventBusMigrationCompleted {
+		// The old EventBus is allowed to be deleted after the migration is completed.
+		eventBus, err = successor, nil
+	}
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			sensor.Status.MarkDeployFailed("EventBusNotFound", "EventBus not found.")
-			log.Errorw("EventBus not found", "eventBusName", eventBusName, "error", err)
+			log.Errorw("EventBus not found", "eventBusName", eventBusName, "eventBusNamespace", eventBusNamespace, "error", err)
 			return fmt.Errorf("eventbus %s not found", eventBusName)
 		}
 		sensor.Status.MarkDeployFailed("GetEventBusFailed", "Failed to get EventBus.")
-		log.Errorw("failed to get EventBus", "eventBusName", eventBusName, "error", err)
+		log.Errorw("failed to get EventBus", "eventBusName", eventBusName, "eventBusNamespace", eventBusNamespace, "error", err)
 		return err
 	}
+	if !eventBus.IsSharedWith(sensor.Namespace) {
+		sensor.Status.MarkDeployFailed("EventBusNotShared", "EventBus is not shared with the namespace.")
+		log.Errorw("event bus is not shared with the namespace", "eventBusName", eventBusName, "eventBusNamespace", eventBusNamespace)
+		return fmt.Errorf("eventbus %s/%s is not shared with namespace %s", eventBusNamespace, eventBusName, sensor.Namespace)
+	}
 
 	if err := ValidateSensor(sensor, eventBus); err != nil {
 		log.Errorw("validation error", "error", err)
@@ -128,9 +166,63 @@ func (r *reconciler) reconcile(ctx context.Context, sensor *v1alpha1.Sensor) err
 			v1alpha1.LabelOwnerName:  sensor.Name,
 		},
 	}
+	switch controllerscommon.GetMigrationPhase(successor) {
+	case v1alpha1.EventBusMigrationSwitchingSensors, v1alpha1.EventBusMigrationCompleted:
+		if successor.IsSharedWith(sensor.Namespace) && eventBus != successor {
+			// Replay the events published to the successor since the EventSources started dual publishing to restore
+			// the dependency state, the ones consumed from the old EventBus before the switch over do not fire triggers.
+			until, err := switchOverTime(ctx, r.client, args)
+			if err != nil {
+				sensor.Status.MarkDeployFailed("SwitchOverFailed", "Failed to switch over to the new EventBus.")
+				return err
+			}
+			eventBus = successor
+			args.ReplaySince = successor.Status.Migration.DualPublishingSince
+			args.ReplayUntil = until
+		}
+	}
 	return Reconcile(r.client, eventBus, args, log)
 }
 
+// switchOverTime returns the time the Deployment of the Sensor stopped consuming from the old EventBus.
+// The Deployment is scaled down first so that the events are never consumed from both of the EventBuses,
+// errScalingDown is returned until all of its Pods are gone. The time is kept in an annotation of the
+// Deployment once it is switched over.
+func switchOverTime(ctx context.Context, c client.Client, args *AdaptorArgs) (*metav1.Time, error) {
+	deploy, err := getDeployment(ctx, c, args)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Created during the migration, it has never consumed from the old EventBus.
+			return ptr.To(switchOverNow()), nil
+		}
+		return nil, err
+	}
+	if v, ok := deploy.Annotations[v1alpha1.AnnotationEventBusReplayUntil]; ok {
+		until, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid annotation %s %q, %w", v1alpha1.AnnotationEventBusReplayUntil, v, err)
+		}
+		return ptr.To(metav1.NewTime(until)), nil
+	}
+	if deploy.Spec.Replicas == nil || *deploy.Spec.Replicas != 0 {
+		logging.FromContext(ctx).Infow("scaling down the sensor deployment on the old eventbus", "deploymentName", deploy.Name)
+		deploy.Spec.Replicas = ptr.To[int32](0)
+		if err := c.Update(ctx, deploy); err != nil {
+			return nil, err
+		}
+		return nil, errScalingDown
+	}
+	if deploy.Status.ObservedGeneration < deploy.Generation || deploy.Status.Replicas > 0 {
+		return nil, errScalingDown
+	}
+	return ptr.To(switchOverNow()), nil
+}
+
+// switchOverNow returns the current time rounded up to the second, the precision it is recorded with.
+func switchOverNow() metav1.Time {
+	return metav1.NewTime(time.Now().Truncate(time.Second).Add(time.Second))
+}
+
 func (r *reconciler) needsUpdate(old, new *v1alpha1.Sensor) bool {
 	if old == nil {
 		return true
@@ -140,3 +232,31 @@ func (r *reconciler) needsUpdate(old, new *v1alpha1.Sensor) bool {
 	}
 	return false
 }
+
+// EventBusMigrationMapFunc returns a function mapping a successor EventBus to the Sensors using the EventBus
+// it migrates from, so that they are reconciled when the migration moves on.
+func EventBusMigrationMapFunc(c client.Client) handler.TypedMapFunc[*v1alpha1.EventBus, reconcile.Request] {
+	return func(ctx context.Context, eventBus *v1alpha1.EventBus) []reconcile.Request {
+		result := []reconcile.Request{}
+		for _, ns := range controllerscommon.GetMigrationNamespaces(ctx, c, eventBus) {
+			sl := &v1alpha1.SensorList{}
+			if err := c.List(ctx, sl, &client.ListOptions{Namespace: ns}); err != nil {
+				continue
+			}
+			for _, s := range sl.Items {
+				eventBusName := s.Spec.EventBusName
+				if eventBusName == "" {
+					eventBusName = v1alpha1.DefaultEventBusName
+				}
+				eventBusNamespace := s.Spec.EventBusNamespace
+				if eventBusNamespace == "" {
+					eventBusNamespace = s.Namespace
+				}
+				if eventBusName == eventBus.Spec.Migration.From && eventBusNamespace == eventBus.Namespace {
+					result = append(result, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: s.Namespace, Name: s.Name}})
+				}
+			}
+		}
+		return result
+	}
+}
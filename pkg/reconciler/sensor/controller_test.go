@@ -18,10 +18,12 @@ package sensor
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -68,6 +70,74 @@ func TestReconcile(t *testing.T) {
 	})
 }
 
+func TestReconcileSwitchOver(t *testing.T) {
+	ctx := context.TODO()
+	cl := fake.NewClientBuilder().Build()
+	oldBus := fakeEventBus.DeepCopy()
+	oldBus.Status.MarkDeployed("test", "test")
+	oldBus.Status.MarkConfigured()
+	assert.NoError(t, cl.Create(ctx, oldBus))
+	r := &reconciler{
+		client:      cl,
+		scheme:      scheme.Scheme,
+		sensorImage: testImage,
+		logger:      logging.NewArgoEventsLogger(),
+	}
+	s := sensorObj.DeepCopy()
+	assert.NoError(t, r.reconcile(ctx, s))
+
+	since := metav1.NewTime(time.Now().Add(-time.Hour))
+	successor := fakeEventBusJetstream.DeepCopy()
+	successor.Name = "jetstream"
+	successor.Spec.Migration = &v1alpha1.EventBusMigration{From: oldBus.Name}
+	successor.Status.MarkDeployed("test", "test")
+	successor.Status.MarkConfigured()
+	successor.Status.Migration = &v1alpha1.EventBusMigrationStatus{
+		From:                oldBus.Name,
+		Phase:               v1alpha1.EventBusMigrationSwitchingSensors,
+		DualPublishingSince: &since,
+	}
+	assert.NoError(t, cl.Create(ctx, successor))
+
+	getDeploy := func() *appv1.Deployment {
+		dl := &appv1.DeploymentList{}
+		assert.NoError(t, cl.List(ctx, dl))
+		assert.Len(t, dl.Items, 1)
+		return &dl.Items[0]
+	}
+
+	// The deployment on the old eventbus is scaled down first.
+	err := r.reconcile(ctx, s)
+	assert.ErrorIs(t, err, errScalingDown)
+	deploy := getDeploy()
+	assert.Equal(t, oldBus.Name, deploy.Annotations[v1alpha1.AnnotationEventBus])
+	assert.Equal(t, int32(0), *deploy.Spec.Replicas)
+
+	deploy.Status.Replicas = 1
+	assert.NoError(t, cl.Status().Update(ctx, deploy))
+	err = r.reconcile(ctx, s)
+	assert.ErrorIs(t, err, errScalingDown)
+
+	// Switched over once the pods are gone, the replay cutoff is kept in the annotation.
+	deploy = getDeploy()
+	deploy.Status.Replicas = 0
+	deploy.Status.ObservedGeneration = deploy.Generation
+	assert.NoError(t, cl.Status().Update(ctx, deploy))
+	assert.NoError(t, r.reconcile(ctx, s))
+	deploy = getDeploy()
+	assert.Equal(t, successor.Name, deploy.Annotations[v1alpha1.AnnotationEventBus])
+	assert.Equal(t, s.Spec.GetReplicas(), *deploy.Spec.Replicas)
+	until := deploy.Annotations[v1alpha1.AnnotationEventBusReplayUntil]
+	assert.NotEmpty(t, until)
+	assert.NotNil(t, deploy.Spec.Template.Spec.Containers[0].ReadinessProbe)
+	hash := deploy.Annotations[v1alpha1.AnnotationResourceSpecHash]
+
+	assert.NoError(t, r.reconcile(ctx, s))
+	deploy = getDeploy()
+	assert.Equal(t, until, deploy.Annotations[v1alpha1.AnnotationEventBusReplayUntil])
+	assert.Equal(t, hash, deploy.Annotations[v1alpha1.AnnotationResourceSpecHash])
+}
+
 func init() {
 	_ = v1alpha1.AddToScheme(scheme.Scheme)
 	_ = appv1.AddToScheme(scheme.Scheme)
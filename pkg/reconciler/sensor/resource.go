@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"go.uber.org/zap"
 	appv1 "k8s.io/api/apps/v1"
@@ -30,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
@@ -42,6 +44,11 @@ type AdaptorArgs struct {
 	Image  string
 	Sensor *v1alpha1.Sensor
 	Labels map[string]string
+	// ReplaySince and ReplayUntil are set when the Sensor switches to a new EventBus during a migration,
+	// the events published since ReplaySince are replayed, and the ones before ReplayUntil, which is when
+	// the Sensor stopped consuming from the old EventBus, only restore the dependency state without firing triggers.
+	ReplaySince *metav1.Time
+	ReplayUntil *metav1.Time
 }
 
 // Reconcile does the real logic
@@ -82,6 +89,12 @@ func Reconcile(client client.Client, eventBus *v1alpha1.EventBus, args *AdaptorA
 			deploy.Spec = expectedDeploy.Spec
 			deploy.SetLabels(expectedDeploy.Labels)
 			deploy.Annotations[v1alpha1.AnnotationResourceSpecHash] = expectedDeploy.Annotations[v1alpha1.AnnotationResourceSpecHash]
+			deploy.Annotations[v1alpha1.AnnotationEventBus] = expectedDeploy.Annotations[v1alpha1.AnnotationEventBus]
+			if until, ok := expectedDeploy.Annotations[v1alpha1.AnnotationEventBusReplayUntil]; ok {
+				deploy.Annotations[v1alpha1.AnnotationEventBusReplayUntil] = until
+			} else {
+				delete(deploy.Annotations, v1alpha1.AnnotationEventBusReplayUntil)
+			}
 			err = client.Update(ctx, deploy)
 			if err != nil {
 				sensor.Status.MarkDeployFailed("UpdateDeploymentFailed", "Failed to update existing deployment")
@@ -136,7 +149,11 @@ func buildDeployment(args *AdaptorArgs, eventBus *v1alpha1.EventBus) (*appv1.Dep
 	if err != nil {
 		return nil, fmt.Errorf("failed marshal sensor spec")
 	}
-	busConfigBytes, err := json.Marshal(eventBus.Status.Config)
+	busConfig, err := controllerscommon.GetBusConfig(eventBus, args.Sensor.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	busConfigBytes, err := json.Marshal(busConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed marshal event bus config: %v", err)
 	}
@@ -163,6 +180,15 @@ func buildDeployment(args *AdaptorArgs, eventBus *v1alpha1.EventBus) (*appv1.Dep
 			Value: base64.StdEncoding.EncodeToString(busConfigBytes),
 		},
 	}
+	if args.ReplaySince != nil && args.ReplayUntil != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  v1alpha1.EnvVarEventBusReplaySince,
+			Value: args.ReplaySince.UTC().Format(time.RFC3339),
+		}, corev1.EnvVar{
+			Name:  v1alpha1.EnvVarEventBusReplayUntil,
+			Value: args.ReplayUntil.UTC().Format(time.RFC3339),
+		})
+	}
 
 	volumes := []corev1.Volume{
 		{
@@ -184,27 +210,33 @@ func buildDeployment(args *AdaptorArgs, eventBus *v1alpha1.EventBus) (*appv1.Dep
 	var clientCertSecret *corev1.SecretKeySelector
 	var clientKeySecret *corev1.SecretKeySelector
 	switch {
-	case eventBus.Status.Config.NATS != nil:
+	case busConfig.NATS != nil:
 		caCertSecret = nil
 		clientCertSecret = nil
 		clientKeySecret = nil
-		accessSecret = eventBus.Status.Config.NATS.AccessSecret
+		accessSecret = busConfig.NATS.AccessSecret
 		secretObjs = []interface{}{sensorCopy}
-	case eventBus.Status.Config.JetStream != nil:
-		tlsOptions := eventBus.Status.Config.JetStream.TLS
+	case busConfig.JetStream != nil:
+		tlsOptions := busConfig.JetStream.TLS
 		if tlsOptions != nil {
 			caCertSecret = tlsOptions.CACertSecret
 			clientCertSecret = tlsOptions.ClientCertSecret
 			clientKeySecret = tlsOptions.ClientKeySecret
 		}
-		accessSecret = eventBus.Status.Config.JetStream.AccessSecret
+		accessSecret = busConfig.JetStream.AccessSecret
 		secretObjs = []interface{}{sensorCopy}
-	case eventBus.Status.Config.Kafka != nil:
+	case busConfig.Kafka != nil:
 		caCertSecret = nil
 		clientCertSecret = nil
 		clientKeySecret = nil
 		accessSecret = nil
 		secretObjs = []interface{}{sensorCopy, eventBus} // kafka requires secrets for sasl and tls
+	case busConfig.Redis != nil, busConfig.Pulsar != nil:
+		caCertSecret = nil
+		clientCertSecret = nil
+		clientKeySecret = nil
+		accessSecret = nil
+		secretObjs = []interface{}{sensorCopy, eventBus} // redis and pulsar require secrets for auth and tls
 	default:
 		return nil, fmt.Errorf("unsupported event bus")
 	}
@@ -288,6 +320,19 @@ func buildDeployment(args *AdaptorArgs, eventBus *v1alpha1.EventBus) (*appv1.Dep
 	})
 
 	deploymentSpec.Template.Spec.Containers[0].Env = append(deploymentSpec.Template.Spec.Containers[0].Env, env...)
+	if args.ReplaySince != nil && args.ReplayUntil != nil {
+		// The Sensor is not ready until it catches up with the replayed events,
+		// the migration waits for it before the EventSources stop publishing to the old EventBus.
+		deploymentSpec.Template.Spec.Containers[0].ReadinessProbe = &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: v1alpha1.SensorReadinessPath,
+					Port: intstr.FromInt32(v1alpha1.SensorMetricsPort),
+				},
+			},
+			PeriodSeconds: 5,
+		}
+	}
 	deploymentSpec.Template.Spec.Containers[0].VolumeMounts = append(deploymentSpec.Template.Spec.Containers[0].VolumeMounts, volumeMounts...)
 	deploymentSpec.Template.Spec.Volumes = append(deploymentSpec.Template.Spec.Volumes, volumes...)
 
@@ -295,10 +340,14 @@ func buildDeployment(args *AdaptorArgs, eventBus *v1alpha1.EventBus) (*appv1.Dep
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    args.Sensor.Namespace,
 			GenerateName: fmt.Sprintf("%s-sensor-", args.Sensor.Name),
+			Annotations:  map[string]string{v1alpha1.AnnotationEventBus: eventBus.Name},
 			Labels:       mergeLabels(args.Sensor.Labels, args.Labels),
 		},
 		Spec: *deploymentSpec,
 	}
+	if args.ReplaySince != nil && args.ReplayUntil != nil {
+		deployment.Annotations[v1alpha1.AnnotationEventBusReplayUntil] = args.ReplayUntil.UTC().Format(time.RFC3339)
+	}
 	if err := controllerscommon.SetObjectMeta(args.Sensor, deployment, v1alpha1.SensorGroupVersionKind); err != nil {
 		return nil, err
 	}
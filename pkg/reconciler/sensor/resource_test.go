@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	appv1 "k8s.io/api/apps/v1"
@@ -253,6 +254,38 @@ func Test_BuildDeployment(t *testing.T) {
 		assert.Equal(t, int32(3), *deployment.Spec.RevisionHistoryLimit)
 	})
 
+	t.Run("test replay window during migration", func(t *testing.T) {
+		since := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+		until := metav1.NewTime(since.Add(10 * time.Minute))
+		args := &AdaptorArgs{
+			Image:       testImage,
+			Sensor:      sensorObj,
+			Labels:      testLabels,
+			ReplaySince: &since,
+			ReplayUntil: &until,
+		}
+		deployment, err := buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		assert.Equal(t, fakeEventBus.Name, deployment.Annotations[v1alpha1.AnnotationEventBus])
+		env := map[string]string{}
+		for _, e := range deployment.Spec.Template.Spec.Containers[0].Env {
+			env[e.Name] = e.Value
+		}
+		assert.Equal(t, "2026-01-02T03:04:05Z", env[v1alpha1.EnvVarEventBusReplaySince])
+		assert.Equal(t, "2026-01-02T03:14:05Z", env[v1alpha1.EnvVarEventBusReplayUntil])
+		assert.Equal(t, "2026-01-02T03:14:05Z", deployment.Annotations[v1alpha1.AnnotationEventBusReplayUntil])
+		probe := deployment.Spec.Template.Spec.Containers[0].ReadinessProbe
+		assert.NotNil(t, probe)
+		assert.Equal(t, v1alpha1.SensorReadinessPath, probe.HTTPGet.Path)
+		assert.Equal(t, int32(v1alpha1.SensorMetricsPort), probe.HTTPGet.Port.IntVal)
+
+		args.ReplaySince, args.ReplayUntil = nil, nil
+		deployment, err = buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		assert.Nil(t, deployment.Spec.Template.Spec.Containers[0].ReadinessProbe)
+		assert.NotContains(t, deployment.Annotations, v1alpha1.AnnotationEventBusReplayUntil)
+	})
+
 	t.Run("test kafka eventbus secrets attached", func(t *testing.T) {
 		args := &AdaptorArgs{
 			Image:  testImage,
@@ -302,6 +335,50 @@ func Test_BuildDeployment(t *testing.T) {
 		assert.True(t, hasTLSSecretVolumeMount)
 	})
 
+	t.Run("test redis and pulsar eventbus secrets attached", func(t *testing.T) {
+		args := &AdaptorArgs{
+			Image:  testImage,
+			Sensor: sensorObj,
+			Labels: testLabels,
+		}
+
+		redisBus := &v1alpha1.RedisBus{
+			URL:      "redis:6379",
+			Password: &corev1.SecretKeySelector{Key: "password", LocalObjectReference: corev1.LocalObjectReference{Name: "redis-secret"}},
+		}
+		pulsarBus := &v1alpha1.PulsarBus{
+			URL:             "pulsar://pulsar:6650",
+			AuthTokenSecret: &corev1.SecretKeySelector{Key: "token", LocalObjectReference: corev1.LocalObjectReference{Name: "pulsar-secret"}},
+		}
+		for secretName, spec := range map[string]v1alpha1.EventBusSpec{
+			"redis-secret":  {Redis: redisBus},
+			"pulsar-secret": {Pulsar: pulsarBus},
+		} {
+			testBus := fakeEventBusKafka.DeepCopy()
+			testBus.Spec = spec
+			testBus.Status.Config = v1alpha1.BusConfig{Redis: spec.Redis, Pulsar: spec.Pulsar}
+
+			deployment, err := buildDeployment(args, testBus)
+			assert.Nil(t, err)
+			assert.NotNil(t, deployment)
+
+			hasSecretVolume := false
+			hasSecretVolumeMount := false
+			for _, volume := range deployment.Spec.Template.Spec.Volumes {
+				if volume.Name == "secret-"+secretName {
+					hasSecretVolume = true
+				}
+			}
+			for _, volumeMount := range deployment.Spec.Template.Spec.Containers[0].VolumeMounts {
+				if volumeMount.Name == "secret-"+secretName {
+					hasSecretVolumeMount = true
+				}
+			}
+			assert.True(t, hasSecretVolume)
+			assert.True(t, hasSecretVolumeMount)
+		}
+	})
+
 	t.Run("test jetstream eventbus secrets attached", func(t *testing.T) {
 		args := &AdaptorArgs{
 			Image:  testImage,
@@ -399,6 +476,41 @@ func TestResourceReconcile(t *testing.T) {
 		assert.False(t, sensorObj.Status.IsReady())
 	})
 
+	t.Run("test resource reconcile with shared eventbus", func(t *testing.T) {
+		ctx := context.TODO()
+		cl := fake.NewClientBuilder().Build()
+		testBus := fakeEventBusJetstream.DeepCopy()
+		testBus.Namespace = "bus"
+		testBus.Spec.Sharing = &v1alpha1.EventBusSharing{AllowedNamespaces: []string{testNamespace}}
+		testBus.Status.MarkDeployed("test", "test")
+		testBus.Status.MarkConfigured()
+		s := sensorObj.DeepCopy()
+		s.Spec.EventBusNamespace = "bus"
+		args := &AdaptorArgs{
+			Image:  testImage,
+			Sensor: s,
+			Labels: testLabels,
+		}
+		err := Reconcile(cl, testBus, args, logging.NewArgoEventsLogger())
+		assert.Nil(t, err)
+		assert.True(t, s.Status.IsReady())
+
+		deployList := &appv1.DeploymentList{}
+		err = cl.List(ctx, deployList, &client.ListOptions{
+			Namespace: testNamespace,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(deployList.Items))
+		hasAuthVolume := false
+		for _, vol := range deployList.Items[0].Spec.Template.Spec.Volumes {
+			if vol.Name == "auth-volume" {
+				hasAuthVolume = true
+				assert.Equal(t, "eventbus-bus-default-js-client-auth", vol.Secret.SecretName)
+			}
+		}
+		assert.True(t, hasAuthVolume)
+	})
+
 	for _, eb := range []*v1alpha1.EventBus{fakeEventBus, fakeEventBusJetstream, fakeEventBusKafka} {
 		testBus := eb.DeepCopy()
 
@@ -4,7 +4,9 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"time"
 
 	"go.uber.org/zap"
 	"k8s.io/client-go/dynamic"
@@ -82,6 +84,26 @@ func Start() {
 
 	logger.Infow("starting sensor server", "version", argoevents.GetVersion())
 	sensorExecutionCtx := sensors.NewSensorContext(kubeClient, dynamicClient, sensor, busConfig, ebSubject, hostname, m)
+	if replayUntil, defined := os.LookupEnv(v1alpha1.EnvVarEventBusReplayUntil); defined {
+		until, err := time.Parse(time.RFC3339, replayUntil)
+		if err != nil {
+			logger.Fatalw("failed to parse replay until time", zap.Error(err))
+		}
+		since, err := time.Parse(time.RFC3339, os.Getenv(v1alpha1.EnvVarEventBusReplaySince))
+		if err != nil {
+			logger.Fatalw("failed to parse replay since time", zap.Error(err))
+		}
+		sensorExecutionCtx.SetReplayWindow(since, until)
+	}
+	// The readiness is served with the metrics, the controller probes it while the Sensor replays the events
+	// after switching over to a new EventBus.
+	http.HandleFunc(v1alpha1.SensorReadinessPath, func(w http.ResponseWriter, _ *http.Request) {
+		if !sensorExecutionCtx.IsReady() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
 	if err := sensorExecutionCtx.Start(ctx); err != nil {
 		logger.Fatalw("failed to listen to events", zap.Error(err))
 	}
@@ -18,6 +18,8 @@ limitations under the License.
 
 import (
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	eventhubs "github.com/Azure/azure-event-hubs-go/v3"
@@ -49,6 +51,15 @@ type SensorContext struct {
 	// EventBus subject
 	eventBusSubject string
 	hostname        string
+	// replaySince and replayUntil are set when the Sensor is switched over to a new EventBus,
+	// the events published before replayUntil are only replayed to rebuild the dependency state.
+	replaySince time.Time
+	replayUntil time.Time
+	// ready is closed once all of the triggers are ready to process the new events.
+	ready     chan struct{}
+	readyOnce sync.Once
+	// standby is set when another replica is elected as the leader.
+	standby atomic.Bool
 
 	// httpClients holds the reference to HTTP clients for HTTP triggers.
 	httpClients sharedutil.StringKeyedMap[*http.Client]
@@ -95,5 +106,35 @@ func NewSensorContext(kubeClient kubernetes.Interface, dynamicClient dynamic.Int
 		azureEventHubsClients:  sharedutil.NewStringKeyedMap[*eventhubs.Hub](),
 		azureServiceBusClients: sharedutil.NewStringKeyedMap[*servicebus.Sender](),
 		metrics:                metrics,
+		ready:                  make(chan struct{}),
 	}
 }
+
+// SetReplayWindow sets the window of the events to be replayed from the EventBus without triggering.
+func (sensorCtx *SensorContext) SetReplayWindow(since, until time.Time) {
+	sensorCtx.replaySince = since
+	sensorCtx.replayUntil = until
+}
+
+// Ready returns a channel closed once all of the triggers have subscribed to the EventBus,
+// and have caught up with the replayed events if any.
+func (sensorCtx *SensorContext) Ready() <-chan struct{} {
+	return sensorCtx.ready
+}
+
+// IsReady tells if the Sensor is ready, a standby replica is ready as long as another replica leads.
+func (sensorCtx *SensorContext) IsReady() bool {
+	if sensorCtx.standby.Load() {
+		return true
+	}
+	select {
+	case <-sensorCtx.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+func (sensorCtx *SensorContext) markReady() {
+	sensorCtx.readyOnce.Do(func() { close(sensorCtx.ready) })
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/Knetic/govaluate"
 	sprig "github.com/Masterminds/sprig/v3"
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	sharedtransform "github.com/argoproj/argo-events/pkg/shared/transform"
 	sharedutil "github.com/argoproj/argo-events/pkg/shared/util"
 	"github.com/tidwall/gjson"
 	lua "github.com/yuin/gopher-lua"
@@ -531,7 +532,7 @@ func filterScript(script string, event *v1alpha1.Event) (bool, error) {
 	if err = json.Unmarshal(jsData, &payloadJson); err != nil {
 		return false, err
 	}
-	lEvent := mapToTable(payloadJson)
+	lEvent := sharedtransform.MapToTable(payloadJson)
 	l.SetGlobal("event", lEvent)
 	if err = l.DoString(script); err != nil {
 		return false, err
@@ -72,6 +72,7 @@ func (sensorCtx *SensorContext) Start(ctx context.Context) error {
 
 	elector.RunOrDie(ctx, leaderelection.LeaderCallbacks{
 		OnStartedLeading: func(ctx context.Context) {
+			sensorCtx.standby.Store(false)
 			if err := sensorCtx.listenEvents(ctx); err != nil {
 				log.Fatalw("failed to start", zap.Error(err))
 			}
@@ -79,6 +80,9 @@ func (sensorCtx *SensorContext) Start(ctx context.Context) error {
 		OnStoppedLeading: func() {
 			log.Fatalf("leader lost: %s", sensorCtx.hostname)
 		},
+		OnStandby: func() {
+			sensorCtx.standby.Store(true)
+		},
 	})
 
 	return nil
@@ -115,6 +119,14 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if !sensorCtx.replayUntil.IsZero() {
+		if d, ok := ebDriver.(eventbuscommon.ReplayableSensorDriver); ok {
+			logger.Infow("replaying events from the eventbus", "since", sensorCtx.replaySince, "until", sensorCtx.replayUntil)
+			d.SetReplayWindow(sensorCtx.replaySince, sensorCtx.replayUntil)
+		} else {
+			logger.Warn("the eventbus does not support replaying events, the events published before the switch over are not replayed")
+		}
+	}
 	err = sharedutil.DoWithRetry(&sharedutil.DefaultBackoff, func() error {
 		return ebDriver.Initialize()
 	})
@@ -122,6 +134,14 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 		return err
 	}
 
+	readyWG := &sync.WaitGroup{}
+	readyWG.Add(len(sensor.Spec.Triggers))
+	go func() {
+		readyWG.Wait()
+		logger.Info("Sensor is ready.")
+		sensorCtx.markReady()
+	}()
+
 	wg := &sync.WaitGroup{}
 	for _, t := range sensor.Spec.Triggers {
 		sensorCtx.metrics.InitSensorMetrics(sensorCtx.sensor.Name, t.Template.Name)
@@ -132,6 +152,10 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 			triggerLogger := logger.With(logging.LabelTriggerName, trigger.Template.Name)
 
 			defer wg.Done()
+			// A trigger failing to start does not hold the others back.
+			var readyOnce sync.Once
+			triggerReady := func() { readyOnce.Do(readyWG.Done) }
+			defer triggerReady()
 			depExpression, err := sensorCtx.getDependencyExpression(ctx, trigger)
 			if err != nil {
 				triggerLogger.Errorw("failed to get dependency expression", zap.Error(err))
@@ -173,6 +197,18 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 			}
 			defer conn.Close()
 
+			if rc, ok := conn.(eventbuscommon.ReadyTriggerConnection); ok {
+				go func() {
+					select {
+					case <-rc.Ready():
+						triggerReady()
+					case <-ctx.Done():
+					}
+				}()
+			} else {
+				triggerReady()
+			}
+
 			transformFunc := func(depName string, event cloudevents.Event) (*cloudevents.Event, error) {
 				dep, ok := depMapping[depName]
 				if !ok {
@@ -146,3 +146,16 @@ func TestGetDependencyExpression(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestSensorReadiness(t *testing.T) {
+	sensorCtx := NewSensorContext(nil, nil, sensorObj, nil, "", "fake-pod", nil)
+	assert.False(t, sensorCtx.IsReady())
+	sensorCtx.standby.Store(true)
+	assert.True(t, sensorCtx.IsReady())
+	sensorCtx.standby.Store(false)
+	assert.False(t, sensorCtx.IsReady())
+	sensorCtx.markReady()
+	sensorCtx.markReady()
+	assert.True(t, sensorCtx.IsReady())
+	<-sensorCtx.Ready()
+}
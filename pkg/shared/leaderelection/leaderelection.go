@@ -35,11 +35,15 @@ type Elector interface {
 type LeaderCallbacks struct {
 	OnStartedLeading func(context.Context)
 	OnStoppedLeading func()
+	// OnStandby is called when another replica is elected as the leader, it is optional.
+	OnStandby func()
 }
 
 func NewElector(ctx context.Context, eventBusConfig aev1.BusConfig, clusterName string, clusterSize int, namespace string, leasename string, hostname string) (Elector, error) {
 	switch {
-	case eventBusConfig.Kafka != nil || strings.ToLower(os.Getenv(aev1.EnvVarLeaderElection)) == "k8s":
+	case eventBusConfig.Embedded != nil:
+		return &embeddedElector{}, nil
+	case eventBusConfig.Kafka != nil || eventBusConfig.Redis != nil || eventBusConfig.Pulsar != nil || strings.ToLower(os.Getenv(aev1.EnvVarLeaderElection)) == "k8s":
 		return newKubernetesElector(namespace, leasename, hostname)
 	case eventBusConfig.NATS != nil:
 		return newEventBusElector(ctx, eventBusConfig.NATS.Auth, clusterName, clusterSize, eventBusConfig.NATS.URL, nil)
@@ -54,6 +58,13 @@ func NewElector(ctx context.Context, eventBusConfig aev1.BusConfig, clusterName
 	}
 }
 
+// embeddedElector is the elector of the in-process EventBus, there is only one replica in the process so it always leads.
+type embeddedElector struct{}
+
+func (e *embeddedElector) RunOrDie(ctx context.Context, callbacks LeaderCallbacks) {
+	callbacks.OnStartedLeading(ctx)
+}
+
 func newEventBusElector(ctx context.Context, authStrategy *aev1.AuthStrategy, clusterName string, clusterSize int, url string, tls *aev1.TLSConfig) (Elector, error) {
 	auth, err := getEventBusAuth(ctx, authStrategy)
 	if err != nil {
@@ -190,6 +201,12 @@ func (e *natsEventBusElector) RunOrDie(ctx context.Context, callbacks LeaderCall
 		}
 	}
 
+	// The followers learn about the leader from its heartbeats without any state change,
+	// so the leader is polled to tell when another replica leads.
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	lastLeader := ""
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -199,6 +216,13 @@ func (e *natsEventBusElector) RunOrDie(ctx context.Context, callbacks LeaderCall
 			handleStateChange(sc)
 		case err := <-errChan:
 			log.Errorw("Error happened", zap.Error(err))
+		case <-ticker.C:
+			if leader := node.Leader(); leader != lastLeader {
+				lastLeader = leader
+				if leader != "" && leader != node.Id() && callbacks.OnStandby != nil {
+					callbacks.OnStandby()
+				}
+			}
 		}
 	}
 }
@@ -269,6 +293,11 @@ func (e *kubernetesElector) RunOrDie(ctx context.Context, callbacks LeaderCallba
 				Callbacks: leaderelection.LeaderCallbacks{
 					OnStartedLeading: callbacks.OnStartedLeading,
 					OnStoppedLeading: callbacks.OnStoppedLeading,
+					OnNewLeader: func(identity string) {
+						if identity != e.hostname && callbacks.OnStandby != nil {
+							callbacks.OnStandby()
+						}
+					},
 				},
 			})
 
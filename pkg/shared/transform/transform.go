@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package transform transforms JSON data with jq commands and lua scripts, the transformations
+// are shared by the sensor dependencies and the event sources.
+package transform
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"github.com/tidwall/gjson"
+	lua "github.com/yuin/gopher-lua"
+)
+
+// JQ transforms the JSON object data with the jq command, the output must be a JSON object.
+func JQ(data []byte, command string) ([]byte, error) {
+	query, err := gojq.Parse(command)
+	if err != nil {
+		return nil, err
+	}
+	var temp map[string]interface{}
+	if err = json.Unmarshal(data, &temp); err != nil {
+		return nil, err
+	}
+	iter := query.Run(temp)
+	v, ok := iter.Next()
+	if !ok {
+		return nil, fmt.Errorf("no output available from the jq command execution")
+	}
+	switch v.(type) {
+	case map[string]interface{}:
+		resultContent, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if !gjson.ValidBytes(resultContent) {
+			return nil, fmt.Errorf("jq transformation output is not a JSON object")
+		}
+		return resultContent, nil
+	default:
+		return nil, fmt.Errorf("jq transformation output must be a JSON object")
+	}
+}
+
+// Script transforms the JSON object data with the lua script, the data is the event global of the script,
+// which must return a table.
+func Script(data []byte, script string) ([]byte, error) {
+	l := lua.NewState()
+	defer l.Close()
+	var payloadJson map[string]interface{}
+	if err := json.Unmarshal(data, &payloadJson); err != nil {
+		return nil, err
+	}
+	lEvent := MapToTable(payloadJson)
+	l.SetGlobal("event", lEvent)
+	if err := l.DoString(script); err != nil {
+		return nil, err
+	}
+	lv := l.Get(-1)
+	tbl, ok := lv.(*lua.LTable)
+	if !ok {
+		return nil, fmt.Errorf("transformation script output type is not of lua table")
+	}
+	result := toGoValue(tbl)
+	resultJson, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	if !gjson.Valid(string(resultJson)) {
+		return nil, fmt.Errorf("script transformation output is not a JSON object")
+	}
+	return resultJson, nil
+}
+
+// MapToTable converts a Go map to a lua table
+func MapToTable(m map[string]interface{}) *lua.LTable {
+	resultTable := &lua.LTable{}
+	for key, element := range m {
+		switch t := element.(type) {
+		case float64:
+			resultTable.RawSetString(key, lua.LNumber(t))
+		case int64:
+			resultTable.RawSetString(key, lua.LNumber(t))
+		case string:
+			resultTable.RawSetString(key, lua.LString(t))
+		case bool:
+			resultTable.RawSetString(key, lua.LBool(t))
+		case []byte:
+			resultTable.RawSetString(key, lua.LString(string(t)))
+		case map[string]interface{}:
+			table := MapToTable(element.(map[string]interface{}))
+			resultTable.RawSetString(key, table)
+		case time.Time:
+			resultTable.RawSetString(key, lua.LNumber(t.Unix()))
+		case []map[string]interface{}:
+			sliceTable := &lua.LTable{}
+			for _, s := range element.([]map[string]interface{}) {
+				table := MapToTable(s)
+				sliceTable.Append(table)
+			}
+			resultTable.RawSetString(key, sliceTable)
+		case []interface{}:
+			sliceTable := &lua.LTable{}
+			for _, s := range element.([]interface{}) {
+				switch tt := s.(type) {
+				case map[string]interface{}:
+					t := MapToTable(s.(map[string]interface{}))
+					sliceTable.Append(t)
+				case float64:
+					sliceTable.Append(lua.LNumber(tt))
+				case string:
+					sliceTable.Append(lua.LString(tt))
+				case bool:
+					sliceTable.Append(lua.LBool(tt))
+				}
+			}
+			resultTable.RawSetString(key, sliceTable)
+		default:
+		}
+	}
+	return resultTable
+}
+
+// toGoValue converts the given LValue to a Go object.
+func toGoValue(lv lua.LValue) interface{} {
+	switch v := lv.(type) {
+	case *lua.LNilType:
+		return nil
+	case lua.LBool:
+		return bool(v)
+	case lua.LString:
+		return string(v)
+	case lua.LNumber:
+		return float64(v)
+	case *lua.LTable:
+		maxn := v.MaxN()
+		// Check for __is_array metatable to force array output
+		if mt := v.Metatable; mt != nil {
+			if mtTable, ok := mt.(*lua.LTable); ok {
+				if arrFlag := mtTable.RawGetString("__is_array"); arrFlag != lua.LNil && arrFlag == lua.LTrue {
+					// Always treat as array, even if empty
+					ret := make([]interface{}, 0, maxn)
+					for i := 1; i <= maxn; i++ {
+						ret = append(ret, toGoValue(v.RawGetInt(i)))
+					}
+					return ret
+				}
+			}
+		}
+		if maxn == 0 {
+			ret := make(map[string]interface{})
+			v.ForEach(func(key, value lua.LValue) {
+				keystr := key.String()
+				ret[keystr] = toGoValue(value)
+			})
+			return ret
+		} else { // array
+			ret := make([]interface{}, 0, maxn)
+			for i := 1; i <= maxn; i++ {
+				ret = append(ret, toGoValue(v.RawGetInt(i)))
+			}
+			return ret
+		}
+	default:
+		return v
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJQ(t *testing.T) {
+	result, err := JQ([]byte(`{"a":1,"b":{"c":"x"}}`), ".b")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"c":"x"}`, string(result))
+
+	_, err = JQ([]byte(`{"a":1}`), ".a")
+	assert.EqualError(t, err, "jq transformation output must be a JSON object")
+
+	_, err = JQ([]byte(`{"a":1}`), "empty")
+	assert.Error(t, err)
+
+	_, err = JQ([]byte(`[1]`), ".")
+	assert.Error(t, err)
+}
+
+func TestScript(t *testing.T) {
+	result, err := Script([]byte(`{"a":1,"tags":["x","y"],"b":{"c":true}}`), `event.a = event.a + 1; event.b.c = false; return event`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a":2,"tags":["x","y"],"b":{"c":false}}`, string(result))
+
+	_, err = Script([]byte(`{"a":1}`), `return 1`)
+	assert.EqualError(t, err, "transformation script output type is not of lua table")
+
+	_, err = Script([]byte(`{"a":1}`), `return (`)
+	assert.Error(t, err)
+}
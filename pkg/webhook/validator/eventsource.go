@@ -4,6 +4,8 @@ import (
 	"context"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
@@ -28,6 +30,22 @@ func NewEventSourceValidator(client kubernetes.Interface, ebClient eventsclient.
 }
 
 func (es *eventsource) ValidateCreate(ctx context.Context) *admissionv1.AdmissionResponse {
+	eventBusName := v1alpha1.DefaultEventBusName
+	if len(es.newes.Spec.EventBusName) > 0 {
+		eventBusName = es.newes.Spec.EventBusName
+	}
+	if es.eventBusClient == nil {
+		return DeniedResponse("invalid EventBus: eventBusClient is nil")
+	}
+	// The EventBus might be created after the EventSource, only deny the ones using an existing EventBus not shared with them.
+	eventBus, err := es.eventBusClient.Get(ctx, eventBusName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return DeniedResponse("failed to get EventBus eventBusName=%s; err=%v", eventBusName, err)
+	}
+	if err == nil && !eventBus.IsSharedWith(es.newes.Namespace) {
+		return DeniedResponse("EventBus %s/%s is not shared with namespace %s", eventBus.Namespace, eventBus.Name, es.newes.Namespace)
+	}
+
 	if err := eventsourcecontroller.ValidateEventSource(es.newes); err != nil {
 		return DeniedResponse("invalid EventSource: %s", err.Error())
 	}
@@ -35,7 +53,9 @@ func (es *eventsource) ValidateCreate(ctx context.Context) *admissionv1.Admissio
 }
 
 func (es *eventsource) ValidateUpdate(ctx context.Context) *admissionv1.AdmissionResponse {
-	if es.oldes.Generation == es.newes.Generation {
+	if es.oldes.Generation == es.newes.Generation &&
+		es.oldes.Spec.EventBusName == es.newes.Spec.EventBusName &&
+		es.oldes.Spec.EventBusNamespace == es.newes.Spec.EventBusNamespace {
 		return AllowedResponse()
 	}
 	return es.ValidateCreate(ctx)
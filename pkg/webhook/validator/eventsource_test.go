@@ -1,12 +1,14 @@
 package validator
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 )
@@ -34,3 +36,49 @@ func TestValidateEventSource(t *testing.T) {
 		assert.True(t, r.Allowed)
 	}
 }
+
+// TestValidateEventSourceEventBusNotShared tests that ValidateCreate denies an EventSource using an EventBus
+// of another namespace which is not shared with it
+func TestValidateEventSourceEventBusNotShared(t *testing.T) {
+	testBus := fakeBus.DeepCopy()
+	testBus.Namespace = "other-ns"
+	testBus.Name = "not-shared"
+
+	es := fakeCalendarEventSource()
+	es.Spec.EventBusName = testBus.Name
+	es.Spec.EventBusNamespace = testBus.Namespace
+	v := NewEventSourceValidator(fakeK8sClient, fakeEventsClient.EventBus(testBus.Namespace), fakeEventsClient.EventSources(es.Namespace), fakeEventsClient.Sensors(es.Namespace), nil, es)
+	// The EventBus does not exist yet
+	r := v.ValidateCreate(contextWithLogger(t))
+	assert.True(t, r.Allowed)
+
+	_, err := fakeEventsClient.EventBus(testBus.Namespace).Create(context.TODO(), testBus, metav1.CreateOptions{})
+	assert.Nil(t, err)
+	r = v.ValidateCreate(contextWithLogger(t))
+	assert.False(t, r.Allowed)
+	assert.Contains(t, r.Result.Message, "is not shared with namespace")
+
+	testBus.Spec.Sharing = &v1alpha1.EventBusSharing{AllowedNamespaces: []string{es.Namespace}}
+	_, err = fakeEventsClient.EventBus(testBus.Namespace).Update(context.TODO(), testBus, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+	r = v.ValidateCreate(contextWithLogger(t))
+	assert.True(t, r.Allowed)
+}
+
+// TestValidateEventSourceEventBusNamespaceChanged tests that ValidateUpdate checks the EventBus again
+// when eventBusNamespace is changed
+func TestValidateEventSourceEventBusNamespaceChanged(t *testing.T) {
+	testBus := fakeBus.DeepCopy()
+	testBus.Namespace = "another-ns"
+	_, err := fakeEventsClient.EventBus(testBus.Namespace).Create(context.TODO(), testBus, metav1.CreateOptions{})
+	assert.Nil(t, err)
+
+	oldEs := fakeCalendarEventSource()
+	newEs := oldEs.DeepCopy()
+	newEs.Spec.EventBusName = testBus.Name
+	newEs.Spec.EventBusNamespace = testBus.Namespace
+	v := NewEventSourceValidator(fakeK8sClient, fakeEventsClient.EventBus(testBus.Namespace), fakeEventsClient.EventSources(newEs.Namespace), fakeEventsClient.Sensors(newEs.Namespace), oldEs, newEs)
+	r := v.ValidateUpdate(contextWithLogger(t))
+	assert.False(t, r.Allowed)
+	assert.Contains(t, r.Result.Message, "is not shared with namespace")
+}
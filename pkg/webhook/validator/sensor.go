@@ -40,6 +40,9 @@ func (s *sensor) ValidateCreate(ctx context.Context) *admissionv1.AdmissionRespo
 	if err != nil {
 		return DeniedResponse("failed to get EventBus eventBusName=%s; err=%v", eventBusName, err)
 	}
+	if !eventBus.IsSharedWith(s.newSensor.Namespace) {
+		return DeniedResponse("EventBus %s/%s is not shared with namespace %s", eventBus.Namespace, eventBus.Name, s.newSensor.Namespace)
+	}
 
 	if err := sensorcontroller.ValidateSensor(s.newSensor, eventBus); err != nil {
 		return DeniedResponse("invalid Sensor: %s", err.Error())
@@ -48,7 +51,9 @@ func (s *sensor) ValidateCreate(ctx context.Context) *admissionv1.AdmissionRespo
 }
 
 func (s *sensor) ValidateUpdate(ctx context.Context) *admissionv1.AdmissionResponse {
-	if s.oldSensor.Generation == s.newSensor.Generation {
+	if s.oldSensor.Generation == s.newSensor.Generation &&
+		s.oldSensor.Spec.EventBusName == s.newSensor.Spec.EventBusName &&
+		s.oldSensor.Spec.EventBusNamespace == s.newSensor.Spec.EventBusNamespace {
 		return AllowedResponse()
 	}
 	return s.ValidateCreate(ctx)
@@ -189,6 +189,18 @@ func TestValidateSensorUpdateNewGenerationValidationFails(t *testing.T) {
 	assert.Contains(t, r.Result.Message, "failed to get EventBus", "Error message should mention EventBus failure")
 }
 
+// TestValidateSensorUpdateEventBusNamespaceChanged tests that ValidateUpdate checks the EventBus again when eventBusNamespace is changed
+func TestValidateSensorUpdateEventBusNamespaceChanged(t *testing.T) {
+	testSensor := fakeSensorWithFinalizer.DeepCopy()
+	testSensor.Spec.EventBusNamespace = "non-existent-ns"
+
+	v := NewSensorValidator(fakeK8sClient, fakeEventsClient.EventBus(testSensor.Spec.EventBusNamespace), fakeEventsClient.EventSources(testNamespace), fakeEventsClient.Sensors(testNamespace), fakeSensorWithFinalizer, testSensor)
+	r := v.ValidateUpdate(contextWithLogger(t))
+
+	assert.False(t, r.Allowed, "ValidateUpdate should validate the EventBus when eventBusNamespace changes")
+	assert.Contains(t, r.Result.Message, "failed to get EventBus")
+}
+
 // TestValidateSensorCreateDenied tests that ValidateCreate returns denied response when EventBus is not found
 func TestValidateSensorCreateDenied(t *testing.T) {
 	testSensor := fakeSensorWithFinalizer.DeepCopy()
@@ -12,6 +12,7 @@ import (
 
 	aev1 "github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
 	eventsclient "github.com/argoproj/argo-events/pkg/client/clientset/versioned/typed/events/v1alpha1"
+	eventsourcecontroller "github.com/argoproj/argo-events/pkg/reconciler/eventsource"
 	"github.com/argoproj/argo-events/pkg/shared/logging"
 )
 
@@ -61,7 +62,15 @@ func GetValidator(ctx context.Context, client kubernetes.Interface, aeClient eve
 				return nil, err
 			}
 		}
-		return NewEventSourceValidator(client, aeClient.EventBus(new.Namespace), aeClient.EventSources(new.Namespace), aeClient.Sensors(new.Namespace), old, new), nil
+		// the options set on the Minio events are not in the EventSource type, they are only in the raw object.
+		if err := eventsourcecontroller.ValidateMinioEventOptions(newBytes); err != nil {
+			return nil, fmt.Errorf("invalid EventSource: %w", err)
+		}
+		eventBusNamespace := new.Namespace
+		if len(new.Spec.EventBusNamespace) > 0 {
+			eventBusNamespace = new.Spec.EventBusNamespace
+		}
+		return NewEventSourceValidator(client, aeClient.EventBus(eventBusNamespace), aeClient.EventSources(new.Namespace), aeClient.Sensors(new.Namespace), old, new), nil
 	case aev1.SensorGroupVersionKind.Kind:
 		var new *aev1.Sensor
 		if len(newBytes) > 0 {
@@ -79,7 +88,11 @@ func GetValidator(ctx context.Context, client kubernetes.Interface, aeClient eve
 				return nil, err
 			}
 		}
-		return NewSensorValidator(client, aeClient.EventBus(new.Namespace), aeClient.EventSources(new.Namespace), aeClient.Sensors(new.Namespace), old, new), nil
+		eventBusNamespace := new.Namespace
+		if len(new.Spec.EventBusNamespace) > 0 {
+			eventBusNamespace = new.Spec.EventBusNamespace
+		}
+		return NewSensorValidator(client, aeClient.EventBus(eventBusNamespace), aeClient.EventSources(new.Namespace), aeClient.Sensors(new.Namespace), old, new), nil
 	default:
 		return nil, fmt.Errorf("unrecognized GVK %v", kind)
 	}
@@ -164,6 +164,11 @@ func TestGetValidator(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, v)
 	})
+	t.Run("test get EventSource validator with minio options", func(t *testing.T) {
+		byts := []byte(`{"metadata":{"name":"test-es"},"spec":{"minio":{"uploads":{"endpoint":"minio:9000","routes":[]}}}}`)
+		_, err := GetValidator(contextWithLogger(t), fakeK8sClient, fakeEventsClient, fromSchemaGVK(aev1.EventSourceGroupVersionKind), nil, byts)
+		assert.ErrorContains(t, err, "does not support schema, transform and routes")
+	})
 	t.Run("test get Sensor validator", func(t *testing.T) {
 		byts, err := json.Marshal(fakeSensor())
 		assert.NoError(t, err)
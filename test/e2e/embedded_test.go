@@ -0,0 +1,107 @@
+//go:build functional
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/events/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/dev"
+	"github.com/argoproj/argo-events/pkg/shared/logging"
+)
+
+// TestEmbeddedEventBus runs a webhook EventSource and a Sensor with an HTTP trigger
+// in process with the embedded EventBus, it does not need a cluster.
+func TestEmbeddedEventBus(t *testing.T) {
+	received := make(chan string, 1)
+	triggerServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- string(body)
+	}))
+	defer triggerServer.Close()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	es := &v1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook"},
+		Spec: v1alpha1.EventSourceSpec{
+			Webhook: map[string]v1alpha1.WebhookEventSource{
+				"example": {
+					WebhookContext: v1alpha1.WebhookContext{
+						Port:     fmt.Sprint(port),
+						Endpoint: "/example",
+						Method:   http.MethodPost,
+					},
+				},
+			},
+		},
+	}
+	sensor := &v1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook"},
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{
+				{Name: "dep", EventSourceName: "webhook", EventName: "example"},
+			},
+			Triggers: []v1alpha1.Trigger{
+				{
+					Template: &v1alpha1.TriggerTemplate{
+						Name: "http-trigger",
+						HTTP: &v1alpha1.HTTPTrigger{
+							URL:    triggerServer.URL,
+							Method: http.MethodPost,
+							Payload: []v1alpha1.TriggerParameter{
+								{
+									Src:  &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "body.message"},
+									Dest: "message",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background(), zaptest.NewLogger(t).Sugar()))
+	defer cancel()
+	go func() {
+		_ = dev.Run(ctx, dev.Options{
+			EventSources: []*v1alpha1.EventSource{es},
+			Sensors:      []*v1alpha1.Sensor{sensor},
+			BusName:      t.Name(),
+		})
+	}()
+
+	url := fmt.Sprintf("http://127.0.0.1:%d/example", port)
+	assert.Eventually(t, func() bool {
+		resp, err := http.Post(url, "application/json", strings.NewReader(`{"message":"hello"}`))
+		if err != nil {
+			return false
+		}
+		_ = resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, 30*time.Second, 500*time.Millisecond)
+
+	select {
+	case body := <-received:
+		assert.JSONEq(t, `{"message":"hello"}`, body)
+	case <-time.After(30 * time.Second):
+		t.Fatal("trigger was not fired")
+	}
+}